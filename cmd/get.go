@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/script"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+)
+
+type getFlags struct {
+	output        string
+	selector      string
+	filter        string
+	sort          string
+	reverse       bool
+	allNamespaces bool
+}
+
+func getCmd() *cobra.Command {
+	var flags getFlags
+	cmd := cobra.Command{
+		Use:   "get RESOURCE",
+		Short: "Print a resource listing",
+		Long:  "Print a resource listing using K9s views columns, without a terminal UI",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := printResources(args[0], flags); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		},
+	}
+	cmd.Flags().StringVarP(
+		&flags.output,
+		"output", "o",
+		render.ExportTable,
+		"Output format. One of: "+strings.Join(render.ExportFormats, "|"),
+	)
+	cmd.Flags().StringVarP(
+		&flags.selector,
+		"selector", "l",
+		"",
+		"Label selector to filter on",
+	)
+	cmd.Flags().StringVar(
+		&flags.filter,
+		"filter",
+		"",
+		"Filter rows as in table views, either a regex or a fuzzy filter (-f)",
+	)
+	cmd.Flags().StringVar(
+		&flags.sort,
+		"sort",
+		"",
+		"Column to sort rows by",
+	)
+	cmd.Flags().BoolVar(
+		&flags.reverse,
+		"reverse",
+		false,
+		"Sort rows in descending order",
+	)
+	cmd.Flags().BoolVarP(
+		&flags.allNamespaces,
+		"all-namespaces", "A",
+		false,
+		"List resources across all namespaces",
+	)
+
+	return &cmd
+}
+
+func printResources(res string, flags getFlags) error {
+	cfg := loadConfiguration()
+	ns := cfg.ActiveNamespace()
+	if flags.allNamespaces {
+		ns = client.AllNamespaces
+	}
+
+	f := watch.NewFactory(cfg.GetConnection())
+	f.Start(ns)
+	defer f.Terminate()
+
+	aliases := dao.NewAlias(f)
+	if _, err := aliases.Ensure(); err != nil {
+		return err
+	}
+	gvr, ok := aliases.AsGVR(res)
+	if !ok {
+		return fmt.Errorf("Huh? `%s` resource not found", res)
+	}
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return err
+	}
+	if !meta.Namespaced {
+		ns = client.ClusterScope
+	}
+
+	scripts := script.NewEngine()
+	if err := scripts.Load(config.K9sScriptsDir); err != nil {
+		log.Error().Err(err).Msg("Scripts load failed")
+	}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, internal.KeyGVR, gvr.String())
+	ctx = context.WithValue(ctx, internal.KeyLabels, flags.selector)
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(ns))
+	ctx = context.WithValue(ctx, internal.KeyScripts, scripts)
+	ctx = context.WithValue(ctx, internal.KeyAliases, aliases)
+
+	if _, err := f.CanForResource(client.CleanseNamespace(ns), gvr.String(), client.MonitorAccess); err == nil {
+		f.WaitForCacheSync()
+	}
+
+	data, err := listResources(ctx, gvr.String(), ns)
+	if err != nil {
+		return err
+	}
+	if data, err = arrangeRows(data, flags); err != nil {
+		return err
+	}
+	ui.SetRenderColors(config.NewStyles())
+	colorer := render.DefaultColorer
+	if m, ok := model.Registry[gvr.String()]; ok && m.Renderer != nil {
+		colorer = m.Renderer.ColorerFunc()
+	}
+
+	return render.Export(os.Stdout, flags.output, data, colorer)
+}
+
+func listResources(ctx context.Context, gvr, ns string) (render.TableData, error) {
+	t := model.NewTable(gvr)
+	t.SetNamespace(client.CleanseNamespace(ns))
+	var l tableLoader
+	t.AddListener(&l)
+	t.Refresh(ctx)
+	if l.err != nil {
+		return render.TableData{}, l.err
+	}
+
+	// A listing is a snapshot so rows are reported as is.
+	data := t.Peek()
+	for i := range data.RowEvents {
+		data.RowEvents[i].Kind = render.EventUnchanged
+	}
+	if len(data.RowEvents) > 0 {
+		data.RowEvents.Sort(data.Namespace, 0, true)
+	}
+
+	return data, nil
+}
+
+// arrangeRows filters and sorts listed rows.
+func arrangeRows(data render.TableData, flags getFlags) (render.TableData, error) {
+	if flags.filter != "" {
+		var err error
+		if data, err = ui.FilterData(flags.filter, data.Header.IndexOf("NAME"), data); err != nil {
+			return data, fmt.Errorf("Invalid filter %q -- %s", flags.filter, err)
+		}
+	}
+	if flags.sort == "" && !flags.reverse {
+		return data, nil
+	}
+
+	col := 0
+	if flags.sort != "" {
+		if col = data.Header.IndexOf(strings.ToUpper(flags.sort)); col == -1 {
+			return data, fmt.Errorf("Invalid sort column %q. Must be one of %s", flags.sort, strings.Join(data.Header.Columns(), ", "))
+		}
+	}
+	if len(data.RowEvents) > 0 {
+		data.RowEvents.Sort(data.Namespace, col, !flags.reverse)
+	}
+
+	return data, nil
+}
+
+type tableLoader struct {
+	err error
+}
+
+// TableDataChanged notifies the model data changed.
+func (*tableLoader) TableDataChanged(render.TableData) {}
+
+// TableLoadFailed notifies the load failed.
+func (l *tableLoader) TableLoadFailed(err error) {
+	l.err = err
+}
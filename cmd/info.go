@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/config"
@@ -25,7 +26,11 @@ func printInfo() {
 
 	printLogo(color.Cyan)
 	printTuple(sectionFmt, "Configuration", config.K9sConfigFile, color.Cyan)
+	printTuple(sectionFmt, "Config Layers", strings.Join(config.ConfigDirs(), ", "), color.Cyan)
+	printTuple(sectionFmt, "Clusters", config.K9sClustersDir, color.Cyan)
+	printTuple(sectionFmt, "Contexts", config.K9sContextsDir, color.Cyan)
 	printTuple(sectionFmt, "Logs", config.K9sLogs, color.Cyan)
+	printTuple(sectionFmt, "Audit Log", config.K9sAuditFile, color.Cyan)
 	printTuple(sectionFmt, "Screen Dumps", config.K9sDumpDir, color.Cyan)
 }
 
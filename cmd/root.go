@@ -39,7 +39,7 @@ var (
 
 func init() {
 	const falseFlag = "false"
-	rootCmd.AddCommand(versionCmd(), infoCmd())
+	rootCmd.AddCommand(versionCmd(), infoCmd(), getCmd())
 	initK9sFlags()
 	initK8sFlags()
 
@@ -97,6 +97,13 @@ func run(cmd *cobra.Command, args []string) {
 func loadConfiguration() *config.Config {
 	log.Info().Msg("🐶 K9s starting up...")
 
+	if isBoolSet(k9sFlags.TrustProject) {
+		config.K9sTrustProject = true
+	}
+	if config.K9sProjectDir != "" && !config.IsTrustedProject(config.K9sProjectDir) {
+		log.Warn().Msgf("Skipping untrusted project config %s", config.K9sProjectDir)
+	}
+
 	// Load K9s config file...
 	k8sCfg := client.NewConfig(k8sFlags)
 	k9sCfg := config.NewConfig(k8sCfg)
@@ -128,13 +135,18 @@ func loadConfiguration() *config.Config {
 	if err := k9sCfg.Refine(k8sFlags); err != nil {
 		log.Panic().Err(err)
 	}
+	k8sCfg.SetTunnels(client.NewTunnels(k9sCfg.K9s.TunnelSpecs(k8sCfg)))
+	k8sCfg.SetProtobuf(k9sCfg.K9s.UseProtobuf())
 	k9sCfg.SetConnection(client.InitConnectionOrDie(k8sCfg))
 
 	// Try to access server version if that fail. Connectivity issue?
-	if !k9sCfg.GetConnection().CheckConnectivity() {
+	if k9sCfg.GetConnection().CheckConnectivity() {
+		log.Info().Msg("✅ Kubernetes connectivity")
+	} else if k8sCfg.NeedsOIDCLogin() {
+		log.Warn().Msg("OIDC token expired. Login required")
+	} else {
 		log.Panic().Msgf("K9s can't connect to cluster")
 	}
-	log.Info().Msg("✅ Kubernetes connectivity")
 	if err := k9sCfg.Save(); err != nil {
 		log.Error().Err(err).Msg("Config save")
 	}
@@ -191,7 +203,7 @@ func initK9sFlags() {
 		k9sFlags.Command,
 		"command", "c",
 		config.DefaultCommand,
-		"Specify the default command to view when the application launches",
+		"Specify the default command or deep link to view when the application launches",
 	)
 	rootCmd.Flags().BoolVar(
 		k9sFlags.ReadOnly,
@@ -199,47 +211,53 @@ func initK9sFlags() {
 		false,
 		"Disable all commands that modify the cluster",
 	)
+	rootCmd.Flags().BoolVar(
+		k9sFlags.TrustProject,
+		"trust-project",
+		false,
+		"Layer the .k9s project config found from the current directory",
+	)
 }
 
 func initK8sFlags() {
 	k8sFlags = genericclioptions.NewConfigFlags(false)
 
-	rootCmd.Flags().StringVar(
+	rootCmd.PersistentFlags().StringVar(
 		k8sFlags.KubeConfig,
 		"kubeconfig",
 		"",
 		"Path to the kubeconfig file to use for CLI requests",
 	)
 
-	rootCmd.Flags().StringVar(
+	rootCmd.PersistentFlags().StringVar(
 		k8sFlags.Timeout,
 		"request-timeout",
 		"",
 		"The length of time to wait before giving up on a single server request",
 	)
 
-	rootCmd.Flags().StringVar(
+	rootCmd.PersistentFlags().StringVar(
 		k8sFlags.Context,
 		"context",
 		"",
 		"The name of the kubeconfig context to use",
 	)
 
-	rootCmd.Flags().StringVar(
+	rootCmd.PersistentFlags().StringVar(
 		k8sFlags.ClusterName,
 		"cluster",
 		"",
 		"The name of the kubeconfig cluster to use",
 	)
 
-	rootCmd.Flags().StringVar(
+	rootCmd.PersistentFlags().StringVar(
 		k8sFlags.AuthInfoName,
 		"user",
 		"",
 		"The name of the kubeconfig user to use",
 	)
 
-	rootCmd.Flags().StringVarP(
+	rootCmd.PersistentFlags().StringVarP(
 		k8sFlags.Namespace,
 		"namespace",
 		"n",
@@ -252,14 +270,14 @@ func initK8sFlags() {
 }
 
 func initAsFlags() {
-	rootCmd.Flags().StringVar(
+	rootCmd.PersistentFlags().StringVar(
 		k8sFlags.Impersonate,
 		"as",
 		"",
 		"Username to impersonate for the operation",
 	)
 
-	rootCmd.Flags().StringArrayVar(
+	rootCmd.PersistentFlags().StringArrayVar(
 		k8sFlags.ImpersonateGroup,
 		"as-group",
 		[]string{},
@@ -268,35 +286,35 @@ func initAsFlags() {
 }
 
 func initCertFlags() {
-	rootCmd.Flags().BoolVar(
+	rootCmd.PersistentFlags().BoolVar(
 		k8sFlags.Insecure,
 		"insecure-skip-tls-verify",
 		false,
 		"If true, the server's caCertFile will not be checked for validity",
 	)
 
-	rootCmd.Flags().StringVar(
+	rootCmd.PersistentFlags().StringVar(
 		k8sFlags.CAFile,
 		"certificate-authority",
 		"",
 		"Path to a cert file for the certificate authority",
 	)
 
-	rootCmd.Flags().StringVar(
+	rootCmd.PersistentFlags().StringVar(
 		k8sFlags.KeyFile,
 		"client-key",
 		"",
 		"Path to a client key file for TLS",
 	)
 
-	rootCmd.Flags().StringVar(
+	rootCmd.PersistentFlags().StringVar(
 		k8sFlags.CertFile,
 		"client-certificate",
 		"",
 		"Path to a client certificate file for TLS",
 	)
 
-	rootCmd.Flags().StringVar(
+	rootCmd.PersistentFlags().StringVar(
 		k8sFlags.BearerToken,
 		"token",
 		"",
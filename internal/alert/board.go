@@ -0,0 +1,193 @@
+package alert
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Alert represents a rule matched by a resource.
+type Alert struct {
+	ID       string
+	Rule     string
+	GVR      string
+	Path     string
+	Message  string
+	Severity string
+	Fired    time.Time
+	Acked    bool
+	Snoozed  time.Time
+}
+
+// IsSnoozed checks if the alert is muted at the given time.
+func (a Alert) IsSnoozed(now time.Time) bool {
+	return now.Before(a.Snoozed)
+}
+
+// IsActive checks if the alert still requires attention.
+func (a Alert) IsActive(now time.Time) bool {
+	return !a.Acked && !a.IsSnoozed(now)
+}
+
+// Board tracks alert rules and their fired alerts.
+type Board struct {
+	rules  []*Rule
+	alerts map[string]*Alert
+	mx     sync.RWMutex
+}
+
+// NewBoard returns a new alert board.
+func NewBoard() *Board {
+	return &Board{alerts: make(map[string]*Alert)}
+}
+
+// Update records the resources currently matching a rule in a given namespace
+// and returns the alerts that need notifying. Alerts in that namespace no
+// longer matching are resolved.
+func (b *Board) Update(r *Rule, ns string, paths []string, now time.Time) []Alert {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	matched := make(map[string]struct{}, len(paths))
+	var fired []Alert
+	for _, p := range paths {
+		id := alertID(r.Name, p)
+		matched[id] = struct{}{}
+		a, ok := b.alerts[id]
+		if !ok {
+			a = &Alert{
+				ID:       id,
+				Rule:     r.Name,
+				GVR:      r.GVR,
+				Path:     p,
+				Message:  r.Text(p),
+				Severity: r.Level(),
+				Fired:    now,
+			}
+			b.alerts[id] = a
+			fired = append(fired, *a)
+			continue
+		}
+		if !a.Snoozed.IsZero() && !a.IsSnoozed(now) {
+			a.Snoozed = time.Time{}
+			if !a.Acked {
+				fired = append(fired, *a)
+			}
+		}
+	}
+	for id, a := range b.alerts {
+		if _, ok := matched[id]; !ok && a.Rule == r.Name && inNamespace(a.Path, ns) {
+			delete(b.alerts, id)
+		}
+	}
+
+	return fired
+}
+
+// SetRules sets the active alert rules. Alerts raised by rules no longer
+// defined are dropped.
+func (b *Board) SetRules(rr []*Rule) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	b.rules = rr
+	names := make(map[string]struct{}, len(rr))
+	for _, r := range rr {
+		names[r.Name] = struct{}{}
+	}
+	for id, a := range b.alerts {
+		if _, ok := names[a.Rule]; !ok {
+			delete(b.alerts, id)
+		}
+	}
+}
+
+// Rules returns the active alert rules.
+func (b *Board) Rules() []*Rule {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	return append([]*Rule(nil), b.rules...)
+}
+
+// Ack acknowledges an alert.
+func (b *Board) Ack(id string) error {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	a, ok := b.alerts[id]
+	if !ok {
+		return fmt.Errorf("no alert %q found", id)
+	}
+	a.Acked = true
+
+	return nil
+}
+
+// Snooze mutes an alert for a given duration.
+func (b *Board) Snooze(id string, d time.Duration, now time.Time) error {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	a, ok := b.alerts[id]
+	if !ok {
+		return fmt.Errorf("no alert %q found", id)
+	}
+	a.Snoozed = now.Add(d)
+
+	return nil
+}
+
+// List returns all alerts, most recent first.
+func (b *Board) List() []Alert {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	aa := make([]Alert, 0, len(b.alerts))
+	for _, a := range b.alerts {
+		aa = append(aa, *a)
+	}
+	sort.Slice(aa, func(i, j int) bool {
+		if aa[i].Fired.Equal(aa[j].Fired) {
+			return aa[i].ID < aa[j].ID
+		}
+		return aa[i].Fired.After(aa[j].Fired)
+	})
+
+	return aa
+}
+
+// Active returns the number of alerts requiring attention.
+func (b *Board) Active(now time.Time) int {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	var n int
+	for _, a := range b.alerts {
+		if a.IsActive(now) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func alertID(rule, path string) string {
+	return rule + ":" + path
+}
+
+// inNamespace checks if a resource path belongs to a given namespace.
+func inNamespace(path, ns string) bool {
+	if client.IsAllNamespaces(ns) {
+		return true
+	}
+	pns, _ := client.Namespaced(path)
+
+	return pns == ns
+}
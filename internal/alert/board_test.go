@@ -0,0 +1,99 @@
+package alert_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/alert"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoardUpdate(t *testing.T) {
+	r := makeRule(t, "r1")
+	b := alert.NewBoard()
+	now := time.Now()
+
+	fired := b.Update(r, "", []string{"default/p1", "default/p2"}, now)
+	assert.Equal(t, 2, len(fired))
+	assert.Equal(t, 2, b.Active(now))
+
+	fired = b.Update(r, "", []string{"default/p1", "default/p2"}, now.Add(time.Second))
+	assert.Equal(t, 0, len(fired))
+
+	fired = b.Update(r, "", []string{"default/p2"}, now.Add(2*time.Second))
+	assert.Equal(t, 0, len(fired))
+	aa := b.List()
+	assert.Equal(t, 1, len(aa))
+	assert.Equal(t, "r1:default/p2", aa[0].ID)
+	assert.Equal(t, "r1 matched default/p2", aa[0].Message)
+	assert.Equal(t, config.AlertWarn, aa[0].Severity)
+}
+
+func TestBoardUpdateNamespace(t *testing.T) {
+	r := makeRule(t, "r1")
+	b := alert.NewBoard()
+	now := time.Now()
+
+	b.Update(r, "", []string{"default/p1", "fred/p1"}, now)
+	fired := b.Update(r, "fred", nil, now.Add(time.Second))
+	assert.Equal(t, 0, len(fired))
+	aa := b.List()
+	assert.Equal(t, 1, len(aa))
+	assert.Equal(t, "r1:default/p1", aa[0].ID)
+}
+
+func TestBoardAck(t *testing.T) {
+	r := makeRule(t, "r1")
+	b := alert.NewBoard()
+	now := time.Now()
+	b.Update(r, "", []string{"default/p1"}, now)
+
+	assert.Nil(t, b.Ack("r1:default/p1"))
+	assert.Equal(t, 0, b.Active(now))
+	assert.True(t, b.List()[0].Acked)
+	assert.EqualError(t, b.Ack("r1:fred"), `no alert "r1:fred" found`)
+
+	b.Update(r, "", nil, now)
+	fired := b.Update(r, "", []string{"default/p1"}, now)
+	assert.Equal(t, 1, len(fired))
+}
+
+func TestBoardSnooze(t *testing.T) {
+	r := makeRule(t, "r1")
+	b := alert.NewBoard()
+	now := time.Now()
+	b.Update(r, "", []string{"default/p1"}, now)
+
+	assert.Nil(t, b.Snooze("r1:default/p1", time.Hour, now))
+	assert.Equal(t, 0, b.Active(now))
+	assert.Equal(t, 0, len(b.Update(r, "", []string{"default/p1"}, now.Add(time.Minute))))
+
+	fired := b.Update(r, "", []string{"default/p1"}, now.Add(2*time.Hour))
+	assert.Equal(t, 1, len(fired))
+	assert.Equal(t, 1, b.Active(now.Add(2*time.Hour)))
+}
+
+func TestBoardSetRules(t *testing.T) {
+	r1, r2 := makeRule(t, "r1"), makeRule(t, "r2")
+	b := alert.NewBoard()
+	now := time.Now()
+	b.Update(r1, "", []string{"default/p1"}, now)
+	b.Update(r2, "", []string{"default/p1"}, now)
+	assert.Equal(t, 2, len(b.List()))
+
+	b.SetRules([]*alert.Rule{r2})
+	assert.Equal(t, []*alert.Rule{r2}, b.Rules())
+	aa := b.List()
+	assert.Equal(t, 1, len(aa))
+	assert.Equal(t, "r2", aa[0].Rule)
+}
+
+// Helpers...
+
+func makeRule(t *testing.T, name string) *alert.Rule {
+	r, err := alert.NewRule(name, config.AlertRule{GVR: "v1/pods", Condition: "True"})
+	assert.Nil(t, err)
+
+	return r
+}
@@ -0,0 +1,70 @@
+package alert
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/script"
+)
+
+// Rule represents a compiled alert rule.
+type Rule struct {
+	config.AlertRule
+
+	Name string
+	cond *script.Condition
+}
+
+// NewRule returns a new compiled alert rule.
+func NewRule(name string, spec config.AlertRule) (*Rule, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid alert rule %q -- %s", name, err)
+	}
+	cond, err := script.NewCondition(spec.Condition)
+	if err != nil {
+		return nil, fmt.Errorf("invalid alert rule %q -- %s", name, err)
+	}
+
+	return &Rule{AlertRule: spec, Name: name, cond: cond}, nil
+}
+
+// NewRules compiles all alert rules applicable to the given context.
+// Invalid rules are reported but do not prevent others from loading.
+func NewRules(aa config.Alerts, context, cluster string) ([]*Rule, []error) {
+	var (
+		rr   []*Rule
+		errs []error
+	)
+	for name, spec := range aa.Alert {
+		if !spec.InContext(context, cluster) {
+			continue
+		}
+		r, err := NewRule(name, spec)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, w := range spec.Webhooks {
+			if _, ok := aa.Webhooks[w]; !ok {
+				errs = append(errs, fmt.Errorf("invalid alert rule %q -- no webhook %q found", name, w))
+			}
+		}
+		rr = append(rr, r)
+	}
+
+	return rr, errs
+}
+
+// Match checks if a resource matches the rule condition.
+func (r *Rule) Match(o map[string]interface{}) (bool, error) {
+	return r.cond.Eval(o)
+}
+
+// Text returns the alert message for a given resource.
+func (r *Rule) Text(path string) string {
+	if r.Message == "" {
+		return fmt.Sprintf("%s matched %s", r.Name, path)
+	}
+
+	return fmt.Sprintf("%s -- %s", path, r.Message)
+}
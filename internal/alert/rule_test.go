@@ -0,0 +1,95 @@
+package alert_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/alert"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRule(t *testing.T) {
+	uu := map[string]struct {
+		spec config.AlertRule
+		fail bool
+		err  string
+	}{
+		"ok": {
+			spec: config.AlertRule{GVR: "v1/pods", Condition: `o["status"]["phase"] == "Failed"`},
+		},
+		"noGVR": {
+			spec: config.AlertRule{Condition: "True"},
+			fail: true,
+			err:  `invalid alert rule "r1" -- no gvr specified`,
+		},
+		"badCond": {
+			spec: config.AlertRule{GVR: "v1/pods", Condition: "o[="},
+			fail: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, err := alert.NewRule("r1", u.spec)
+			switch {
+			case u.err != "":
+				assert.EqualError(t, err, u.err)
+			case u.fail:
+				assert.Error(t, err)
+			default:
+				assert.Nil(t, err)
+				assert.Equal(t, "r1", r.Name)
+			}
+		})
+	}
+}
+
+func TestNewRules(t *testing.T) {
+	aa := config.NewAlerts()
+	aa.Alert["r1"] = config.AlertRule{GVR: "v1/pods", Condition: "True"}
+	aa.Alert["r2"] = config.AlertRule{GVR: "v1/nodes", Condition: "True", ContextScope: config.ContextScope{Contexts: []string{"prod"}}}
+	aa.Alert["r3"] = config.AlertRule{GVR: "v1/nodes"}
+
+	rr, errs := alert.NewRules(aa, "dev", "c1")
+	assert.Equal(t, 1, len(rr))
+	assert.Equal(t, "r1", rr[0].Name)
+	assert.Equal(t, 1, len(errs))
+}
+
+func TestNewRulesWebhooks(t *testing.T) {
+	aa := config.NewAlerts()
+	aa.Webhooks["team"] = config.Webhook{URL: "https://example.com/hook"}
+	aa.Alert["r1"] = config.AlertRule{GVR: "v1/pods", Condition: "True", Webhooks: []string{"team", "fred"}}
+
+	rr, errs := alert.NewRules(aa, "dev", "c1")
+	assert.Equal(t, 1, len(rr))
+	assert.Equal(t, 1, len(errs))
+	assert.EqualError(t, errs[0], `invalid alert rule "r1" -- no webhook "fred" found`)
+}
+
+func TestRuleMatch(t *testing.T) {
+	r, err := alert.NewRule("crash", config.AlertRule{
+		GVR:       "v1/pods",
+		Condition: `any([c["restartCount"] > 3 for c in o["status"].get("containerStatuses", [])])`,
+		Message:   "Too many restarts",
+	})
+	assert.Nil(t, err)
+
+	ok, err := r.Match(map[string]interface{}{
+		"status": map[string]interface{}{
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"restartCount": int64(1)},
+				map[string]interface{}{"restartCount": int64(5)},
+			},
+		},
+	})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	ok, err = r.Match(map[string]interface{}{"status": map[string]interface{}{}})
+	assert.Nil(t, err)
+	assert.False(t, ok)
+
+	assert.Equal(t, "default/p1 -- Too many restarts", r.Text("default/p1"))
+}
@@ -0,0 +1,361 @@
+package alert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	webhookTimeout = 10 * time.Second
+
+	// MaxBatch caps the number of notifications posted in a single request.
+	MaxBatch = 50
+
+	// maxPending caps the notifications queued per webhook. Older ones are
+	// dropped first.
+	maxPending = 500
+
+	// maxAttempts caps the delivery attempts of a batch.
+	maxAttempts = 5
+
+	minBackoff = 10 * time.Second
+	maxBackoff = 5 * time.Minute
+)
+
+// Notification represents a fired alert handed to webhook templates.
+type Notification struct {
+	Rule      string    `json:"rule"`
+	GVR       string    `json:"gvr"`
+	Path      string    `json:"path"`
+	Namespace string    `json:"namespace,omitempty"`
+	Name      string    `json:"name"`
+	Message   string    `json:"message"`
+	Severity  string    `json:"severity"`
+	Context   string    `json:"context"`
+	Cluster   string    `json:"cluster"`
+	Fired     time.Time `json:"fired"`
+}
+
+// NewNotification returns a notification for an alert fired in a given
+// context.
+func NewNotification(a Alert, context, cluster string) Notification {
+	ns, n := client.Namespaced(a.Path)
+	return Notification{
+		Rule:      a.Rule,
+		GVR:       a.GVR,
+		Path:      a.Path,
+		Namespace: ns,
+		Name:      n,
+		Message:   a.Message,
+		Severity:  a.Severity,
+		Context:   context,
+		Cluster:   cluster,
+		Fired:     a.Fired,
+	}
+}
+
+// Batch represents the notifications handed to a webhook template.
+type Batch struct {
+	Context string
+	Cluster string
+	Alerts  []Notification
+}
+
+// Webhook represents a compiled alert webhook.
+type Webhook struct {
+	config.Webhook
+
+	Name string
+	tpl  *template.Template
+}
+
+// Webhooks represents a collection of webhooks keyed by name.
+type Webhooks map[string]*Webhook
+
+// NewWebhook returns a new compiled webhook.
+func NewWebhook(name string, spec config.Webhook) (*Webhook, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid webhook %q -- %s", name, err)
+	}
+	w := Webhook{Webhook: spec, Name: name}
+	if spec.Template == "" {
+		return &w, nil
+	}
+	tpl, err := template.New(name).Funcs(templateFuncs(spec.Raw)).Parse(spec.Template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid webhook %q -- %s", name, err)
+	}
+	w.tpl = tpl
+
+	return &w, nil
+}
+
+// NewWebhooks compiles all webhooks. Invalid webhooks are reported but do
+// not prevent others from loading.
+func NewWebhooks(aa config.Alerts) (Webhooks, []error) {
+	hh := make(Webhooks, len(aa.Webhooks))
+	var errs []error
+	for name, spec := range aa.Webhooks {
+		w, err := NewWebhook(name, spec)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		hh[name] = w
+	}
+
+	return hh, errs
+}
+
+// Payload renders the request body for a batch of notifications.
+func (w *Webhook) Payload(b Batch) ([]byte, error) {
+	if w.tpl != nil {
+		if !w.Raw {
+			b = escapeBatch(b)
+		}
+		var buff bytes.Buffer
+		if err := w.tpl.Execute(&buff, b); err != nil {
+			return nil, err
+		}
+		return buff.Bytes(), nil
+	}
+	if w.Kind == config.WebhookSlack {
+		ll := make([]string, 0, len(b.Alerts))
+		for _, n := range b.Alerts {
+			ll = append(ll, fmt.Sprintf("[%s] %s", n.Severity, n.Message))
+		}
+		return json.Marshal(map[string]string{
+			"text": fmt.Sprintf("%s (%s)", strings.Join(ll, "\n"), b.Context),
+		})
+	}
+
+	return json.Marshal(b.Alerts)
+}
+
+// Send posts a batch of notifications to the webhook.
+func (w *Webhook) Send(c *http.Client, b Batch) error {
+	body, err := w.Payload(b)
+	if err != nil {
+		return fmt.Errorf("webhook %q payload failed -- %s", w.Name, err)
+	}
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook %q failed -- %s", w.Name, err)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing webhook response")
+		}
+	}()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook %q failed -- %s", w.Name, resp.Status)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Dispatcher...
+
+// Dispatcher batches notifications per webhook. Each webhook receives at most
+// one request per flush and failed deliveries are retried with an
+// exponential backoff.
+type Dispatcher struct {
+	client  *http.Client
+	hooks   Webhooks
+	pending map[string]*outbox
+	mx      sync.Mutex
+}
+
+type outbox struct {
+	alerts   []Notification
+	attempts int
+	retryAt  time.Time
+}
+
+// NewDispatcher returns a new webhook dispatcher.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		client:  &http.Client{Timeout: webhookTimeout},
+		hooks:   make(Webhooks),
+		pending: make(map[string]*outbox),
+	}
+}
+
+// SetWebhooks sets the webhooks fired alerts are posted to. Notifications
+// queued for webhooks no longer defined are dropped.
+func (d *Dispatcher) SetWebhooks(hh Webhooks) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	d.hooks = hh
+	for name := range d.pending {
+		if _, ok := hh[name]; !ok {
+			delete(d.pending, name)
+		}
+	}
+}
+
+// Enqueue queues a notification for the named webhooks.
+func (d *Dispatcher) Enqueue(names []string, n Notification) []error {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	var errs []error
+	for _, name := range names {
+		if _, ok := d.hooks[name]; !ok {
+			errs = append(errs, fmt.Errorf("no webhook %q found", name))
+			continue
+		}
+		o, ok := d.pending[name]
+		if !ok {
+			o = new(outbox)
+			d.pending[name] = o
+		}
+		o.alerts = append(o.alerts, n)
+		if len(o.alerts) > maxPending {
+			o.alerts = o.alerts[len(o.alerts)-maxPending:]
+		}
+	}
+
+	return errs
+}
+
+// Pending returns the number of notifications queued for a webhook.
+func (d *Dispatcher) Pending(name string) int {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	if o, ok := d.pending[name]; ok {
+		return len(o.alerts)
+	}
+
+	return 0
+}
+
+// Flush posts a batch to each webhook due for delivery.
+func (d *Dispatcher) Flush(context, cluster string, now time.Time) []error {
+	type job struct {
+		hook  *Webhook
+		batch []Notification
+	}
+	d.mx.Lock()
+	jj := make([]job, 0, len(d.pending))
+	for name, o := range d.pending {
+		if len(o.alerts) == 0 || now.Before(o.retryAt) {
+			continue
+		}
+		n := len(o.alerts)
+		if n > MaxBatch {
+			n = MaxBatch
+		}
+		jj = append(jj, job{hook: d.hooks[name], batch: append([]Notification(nil), o.alerts[:n]...)})
+	}
+	d.mx.Unlock()
+
+	var errs []error
+	for _, j := range jj {
+		err := j.hook.Send(d.client, Batch{Context: context, Cluster: cluster, Alerts: j.batch})
+		d.delivered(j.hook.Name, len(j.batch), err, now)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// delivered records a delivery outcome, backing off failed webhooks.
+func (d *Dispatcher) delivered(name string, n int, err error, now time.Time) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	o, ok := d.pending[name]
+	if !ok {
+		return
+	}
+	if err == nil || o.attempts+1 >= maxAttempts {
+		if err != nil {
+			log.Warn().Msgf("Webhook %s dropped %d notifications after %d attempts", name, n, maxAttempts)
+		}
+		if n > len(o.alerts) {
+			n = len(o.alerts)
+		}
+		o.alerts, o.attempts, o.retryAt = o.alerts[n:], 0, time.Time{}
+		return
+	}
+	o.attempts++
+	o.retryAt = now.Add(backoff(o.attempts))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func backoff(attempts int) time.Duration {
+	d := minBackoff
+	for i := 1; i < attempts && d < maxBackoff; i++ {
+		d *= 2
+	}
+	if d > maxBackoff {
+		return maxBackoff
+	}
+
+	return d
+}
+
+// templateFuncs returns the template helpers. When values are escaped,
+// json quotes them as is.
+func templateFuncs(raw bool) template.FuncMap {
+	return template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			if s, ok := v.(string); ok && !raw {
+				return `"` + s + `"`, nil
+			}
+			bb, err := json.Marshal(v)
+			return string(bb), err
+		},
+	}
+}
+
+// escapeBatch json escapes the batch values so they can be safely embedded
+// in json strings.
+func escapeBatch(b Batch) Batch {
+	e := Batch{Context: jsonEscape(b.Context), Cluster: jsonEscape(b.Cluster)}
+	e.Alerts = make([]Notification, 0, len(b.Alerts))
+	for _, n := range b.Alerts {
+		n.Rule, n.GVR, n.Path = jsonEscape(n.Rule), jsonEscape(n.GVR), jsonEscape(n.Path)
+		n.Namespace, n.Name = jsonEscape(n.Namespace), jsonEscape(n.Name)
+		n.Message, n.Severity = jsonEscape(n.Message), jsonEscape(n.Severity)
+		n.Context, n.Cluster = jsonEscape(n.Context), jsonEscape(n.Cluster)
+		e.Alerts = append(e.Alerts, n)
+	}
+
+	return e
+}
+
+func jsonEscape(s string) string {
+	bb, err := json.Marshal(s)
+	if err != nil {
+		return ""
+	}
+
+	return string(bb[1 : len(bb)-1])
+}
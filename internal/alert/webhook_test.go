@@ -0,0 +1,151 @@
+package alert_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/alert"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWebhooks(t *testing.T) {
+	aa := config.NewAlerts()
+	aa.Webhooks["ok"] = config.Webhook{URL: "https://example.com/hook"}
+	aa.Webhooks["badURL"] = config.Webhook{URL: "fred"}
+	aa.Webhooks["badTpl"] = config.Webhook{URL: "https://example.com/hook", Template: "{{ .Fred"}
+
+	hh, errs := alert.NewWebhooks(aa)
+	assert.Equal(t, 1, len(hh))
+	assert.Equal(t, 2, len(errs))
+	_, ok := hh["ok"]
+	assert.True(t, ok)
+}
+
+func TestWebhookPayload(t *testing.T) {
+	n := makeNotification()
+
+	uu := map[string]struct {
+		spec config.Webhook
+		e    string
+	}{
+		"slack": {
+			spec: config.Webhook{Kind: config.WebhookSlack, URL: "https://hooks.slack.com/x"},
+			e:    `{"text":"[error] default/p1 -- \"Too\" many restarts\n[error] default/p1 -- \"Too\" many restarts (prod)"}`,
+		},
+		"template": {
+			spec: config.Webhook{URL: "https://example.com/hook", Template: `{{ range .Alerts }}{"pod": {{ json .Name }}, "msg": {{ json .Message }}}{{ end }}`},
+			e:    `{"pod": "p1", "msg": "default/p1 -- \"Too\" many restarts"}{"pod": "p1", "msg": "default/p1 -- \"Too\" many restarts"}`,
+		},
+		"escaped": {
+			spec: config.Webhook{URL: "https://example.com/hook", Template: `{"cluster": "{{ .Cluster }}", "msg": "{{ (index .Alerts 0).Message }}"}`},
+			e:    `{"cluster": "c1", "msg": "default/p1 -- \"Too\" many restarts"}`,
+		},
+		"raw": {
+			spec: config.Webhook{URL: "https://example.com/hook", Raw: true, Template: `{{ (index .Alerts 0).Message }} {{ json (index .Alerts 0).Name }}`},
+			e:    `default/p1 -- "Too" many restarts "p1"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			w, err := alert.NewWebhook(k, u.spec)
+			assert.Nil(t, err)
+			raw, err := w.Payload(alert.Batch{Context: "prod", Cluster: "c1", Alerts: []alert.Notification{n, n}})
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, string(raw))
+		})
+	}
+}
+
+func TestWebhookPayloadDefault(t *testing.T) {
+	w, err := alert.NewWebhook("fred", config.Webhook{URL: "https://example.com/hook"})
+	assert.Nil(t, err)
+
+	raw, err := w.Payload(alert.Batch{Alerts: []alert.Notification{makeNotification()}})
+	assert.Nil(t, err)
+	var nn []alert.Notification
+	assert.Nil(t, json.Unmarshal(raw, &nn))
+	assert.Equal(t, []alert.Notification{makeNotification()}, nn)
+}
+
+func TestDispatcherFlush(t *testing.T) {
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := ioutil.ReadAll(r.Body)
+		got = append(got, r.Header.Get("X-Token")+" "+string(raw))
+		if r.URL.Path == "/toast" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	aa := config.NewAlerts()
+	aa.Webhooks["ok"] = config.Webhook{URL: srv.URL + "/ok", Headers: map[string]string{"X-Token": "blee"}, Template: "{{ range .Alerts }}{{ .Rule }};{{ end }}"}
+	aa.Webhooks["toast"] = config.Webhook{URL: srv.URL + "/toast", Template: "{{ .Cluster }}"}
+	hh, errs := alert.NewWebhooks(aa)
+	assert.Equal(t, 0, len(errs))
+
+	d := alert.NewDispatcher()
+	d.SetWebhooks(hh)
+	errs = d.Enqueue([]string{"ok", "toast", "fred"}, makeNotification())
+	assert.Equal(t, 1, len(errs))
+	assert.EqualError(t, errs[0], `no webhook "fred" found`)
+	d.Enqueue([]string{"ok", "toast"}, makeNotification())
+
+	now := time.Now()
+	errs = d.Flush("prod", "c1", now)
+	sort.Strings(got)
+	assert.Equal(t, []string{" c1", "blee crashing;crashing;"}, got)
+	assert.Equal(t, 1, len(errs))
+	assert.EqualError(t, errs[0], `webhook "toast" failed -- 500 Internal Server Error`)
+	assert.Equal(t, 0, d.Pending("ok"))
+	assert.Equal(t, 2, d.Pending("toast"))
+
+	got = nil
+	assert.Equal(t, 0, len(d.Flush("prod", "c1", now.Add(time.Second))))
+	assert.Equal(t, 0, len(got))
+	assert.Equal(t, 1, len(d.Flush("prod", "c1", now.Add(time.Minute))))
+	assert.Equal(t, []string{" c1"}, got)
+}
+
+func TestDispatcherDropsAfterAttempts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	aa := config.NewAlerts()
+	aa.Webhooks["toast"] = config.Webhook{URL: srv.URL}
+	hh, _ := alert.NewWebhooks(aa)
+	d := alert.NewDispatcher()
+	d.SetWebhooks(hh)
+	d.Enqueue([]string{"toast"}, makeNotification())
+
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, 1, len(d.Flush("prod", "c1", now)))
+		now = now.Add(time.Hour)
+	}
+	assert.Equal(t, 0, d.Pending("toast"))
+}
+
+// Helpers...
+
+func makeNotification() alert.Notification {
+	return alert.NewNotification(alert.Alert{
+		ID:       "crashing:default/p1",
+		Rule:     "crashing",
+		GVR:      "v1/pods",
+		Path:     "default/p1",
+		Message:  `default/p1 -- "Too" many restarts`,
+		Severity: config.AlertError,
+		Fired:    time.Date(2020, 3, 1, 10, 0, 0, 0, time.UTC),
+	}, "prod", "c1")
+}
@@ -0,0 +1,216 @@
+package auditlog
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Audit stages reported while a request is still in flight.
+const (
+	stageRequestReceived = "RequestReceived"
+	stageResponseStarted = "ResponseStarted"
+)
+
+// Event represents a Kubernetes audit event.
+type Event struct {
+	AuditID          string          `json:"auditID" yaml:"auditID"`
+	Level            string          `json:"level" yaml:"level"`
+	Stage            string          `json:"stage" yaml:"stage"`
+	RequestURI       string          `json:"requestURI" yaml:"requestURI"`
+	Verb             string          `json:"verb" yaml:"verb"`
+	User             UserInfo        `json:"user" yaml:"user"`
+	ImpersonatedUser *UserInfo       `json:"impersonatedUser,omitempty" yaml:"impersonatedUser,omitempty"`
+	SourceIPs        []string        `json:"sourceIPs,omitempty" yaml:"sourceIPs,omitempty"`
+	UserAgent        string          `json:"userAgent,omitempty" yaml:"userAgent,omitempty"`
+	ObjectRef        *ObjectRef      `json:"objectRef,omitempty" yaml:"objectRef,omitempty"`
+	ResponseStatus   *ResponseStatus `json:"responseStatus,omitempty" yaml:"responseStatus,omitempty"`
+	RequestObject    interface{}     `json:"requestObject,omitempty" yaml:"requestObject,omitempty"`
+	ResponseObject   interface{}     `json:"responseObject,omitempty" yaml:"responseObject,omitempty"`
+	StageTimestamp   time.Time       `json:"stageTimestamp" yaml:"stageTimestamp"`
+}
+
+// UserInfo represents the user issuing a request.
+type UserInfo struct {
+	Username string   `json:"username" yaml:"username"`
+	Groups   []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+}
+
+// ObjectRef represents the object targeted by a request.
+type ObjectRef struct {
+	Resource    string `json:"resource,omitempty" yaml:"resource,omitempty"`
+	Namespace   string `json:"namespace,omitempty" yaml:"namespace,omitempty"`
+	Name        string `json:"name,omitempty" yaml:"name,omitempty"`
+	APIGroup    string `json:"apiGroup,omitempty" yaml:"apiGroup,omitempty"`
+	APIVersion  string `json:"apiVersion,omitempty" yaml:"apiVersion,omitempty"`
+	Subresource string `json:"subresource,omitempty" yaml:"subresource,omitempty"`
+}
+
+// ResponseStatus represents a request outcome.
+type ResponseStatus struct {
+	Code    int    `json:"code" yaml:"code"`
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// EventList represents a batch of audit events as posted by the api server
+// audit webhook backend.
+type EventList struct {
+	Items []Event `json:"items"`
+}
+
+// Username returns the effective user name of the request.
+func (e Event) Username() string {
+	if e.ImpersonatedUser != nil {
+		return e.ImpersonatedUser.Username
+	}
+
+	return e.User.Username
+}
+
+// Resource returns the targeted resource, ie pods or deployments.apps/scale.
+func (e Event) Resource() string {
+	if e.ObjectRef == nil {
+		return ""
+	}
+	r := e.ObjectRef.Resource
+	if e.ObjectRef.APIGroup != "" {
+		r += "." + e.ObjectRef.APIGroup
+	}
+	if e.ObjectRef.Subresource != "" {
+		r += "/" + e.ObjectRef.Subresource
+	}
+
+	return r
+}
+
+// Code returns the response code if any.
+func (e Event) Code() int {
+	if e.ResponseStatus == nil {
+		return 0
+	}
+
+	return e.ResponseStatus.Code
+}
+
+// Filter represents audit events selection criteria. Criteria are matched
+// as case insensitive substrings.
+type Filter struct {
+	User, Verb, Resource string
+}
+
+// ParseFilter parses a filter of the form `user=fred verb=delete resource=pods`.
+func ParseFilter(s string) (Filter, error) {
+	var f Filter
+	for _, t := range strings.Fields(s) {
+		tokens := strings.SplitN(t, "=", 2)
+		if len(tokens) != 2 {
+			return Filter{}, fmt.Errorf("invalid filter %q. Expecting key=value", t)
+		}
+		switch strings.ToLower(tokens[0]) {
+		case "user":
+			f.User = tokens[1]
+		case "verb":
+			f.Verb = tokens[1]
+		case "resource":
+			f.Resource = tokens[1]
+		default:
+			return Filter{}, fmt.Errorf("unknown filter %q. Expecting user, verb or resource", tokens[0])
+		}
+	}
+
+	return f, nil
+}
+
+// IsEmpty checks if the filter selects all events.
+func (f Filter) IsEmpty() bool {
+	return f.User == "" && f.Verb == "" && f.Resource == ""
+}
+
+// Matches checks if an event meets the filter criteria.
+func (f Filter) Matches(e Event) bool {
+	return contains(e.Username(), f.User) &&
+		contains(e.Verb, f.Verb) &&
+		contains(e.Resource(), f.Resource)
+}
+
+// String returns the filter representation.
+func (f Filter) String() string {
+	var ss []string
+	if f.User != "" {
+		ss = append(ss, "user="+f.User)
+	}
+	if f.Verb != "" {
+		ss = append(ss, "verb="+f.Verb)
+	}
+	if f.Resource != "" {
+		ss = append(ss, "resource="+f.Resource)
+	}
+
+	return strings.Join(ss, " ")
+}
+
+// Stream tracks the most recent audit events.
+type Stream struct {
+	events []Event
+	max    int
+	mx     sync.RWMutex
+}
+
+// NewStream returns a stream retaining up to max events.
+func NewStream(max int) *Stream {
+	return &Stream{max: max}
+}
+
+// Add records events. Events for in flight requests are skipped as they are
+// reported again once complete.
+func (s *Stream) Add(ee ...Event) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	for _, e := range ee {
+		if e.Stage == stageRequestReceived || e.Stage == stageResponseStarted {
+			continue
+		}
+		s.events = append(s.events, e)
+	}
+	if over := len(s.events) - s.max; over > 0 {
+		s.events = append([]Event(nil), s.events[over:]...)
+	}
+}
+
+// List returns the events matching a filter, most recent first.
+func (s *Stream) List(f Filter) []Event {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	ee := make([]Event, 0, len(s.events))
+	for i := len(s.events) - 1; i >= 0; i-- {
+		if f.Matches(s.events[i]) {
+			ee = append(ee, s.events[i])
+		}
+	}
+
+	return ee
+}
+
+// Get returns an event given its id.
+func (s *Stream) Get(id string) (Event, bool) {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	for i := len(s.events) - 1; i >= 0; i-- {
+		if s.events[i].AuditID == id {
+			return s.events[i], true
+		}
+	}
+
+	return Event{}, false
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func contains(s, sub string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(sub))
+}
@@ -0,0 +1,106 @@
+package auditlog_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/auditlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFilter(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   auditlog.Filter
+		err bool
+	}{
+		"empty": {},
+		"full": {
+			s: "user=fred verb=delete resource=pods",
+			e: auditlog.Filter{User: "fred", Verb: "delete", Resource: "pods"},
+		},
+		"caps": {
+			s: "User=system:serviceaccount:ci:deployer",
+			e: auditlog.Filter{User: "system:serviceaccount:ci:deployer"},
+		},
+		"noValue": {s: "fred", err: true},
+		"unknown": {s: "ns=default", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f, err := auditlog.ParseFilter(u.s)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, f)
+			if err == nil {
+				g, _ := auditlog.ParseFilter(f.String())
+				assert.Equal(t, f, g)
+			}
+		})
+	}
+}
+
+func TestFilterMatches(t *testing.T) {
+	e := auditlog.Event{
+		Verb:      "delete",
+		User:      auditlog.UserInfo{Username: "fred@example.com"},
+		ObjectRef: &auditlog.ObjectRef{Resource: "deployments", APIGroup: "apps", Namespace: "default", Name: "blee"},
+	}
+	uu := map[string]struct {
+		f auditlog.Filter
+		e bool
+	}{
+		"all":      {e: true},
+		"user":     {f: auditlog.Filter{User: "FRED"}, e: true},
+		"verb":     {f: auditlog.Filter{Verb: "delete"}, e: true},
+		"group":    {f: auditlog.Filter{Resource: "deployments.apps"}, e: true},
+		"all-in":   {f: auditlog.Filter{User: "fred", Verb: "del", Resource: "deploy"}, e: true},
+		"noUser":   {f: auditlog.Filter{User: "blee"}},
+		"noVerb":   {f: auditlog.Filter{Verb: "get"}},
+		"noResult": {f: auditlog.Filter{Resource: "pods"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.f.Matches(e))
+		})
+	}
+}
+
+func TestEventAccessors(t *testing.T) {
+	e := auditlog.Event{
+		User:             auditlog.UserInfo{Username: "admin"},
+		ImpersonatedUser: &auditlog.UserInfo{Username: "fred"},
+		ObjectRef:        &auditlog.ObjectRef{Resource: "deployments", APIGroup: "apps", Subresource: "scale"},
+		ResponseStatus:   &auditlog.ResponseStatus{Code: 403},
+	}
+
+	assert.Equal(t, "fred", e.Username())
+	assert.Equal(t, "deployments.apps/scale", e.Resource())
+	assert.Equal(t, 403, e.Code())
+	assert.Equal(t, "", auditlog.Event{}.Resource())
+	assert.Equal(t, 0, auditlog.Event{}.Code())
+}
+
+func TestStream(t *testing.T) {
+	s := auditlog.NewStream(2)
+	s.Add(
+		auditlog.Event{AuditID: "1", Stage: "ResponseComplete", Verb: "get"},
+		auditlog.Event{AuditID: "2", Stage: "RequestReceived", Verb: "delete"},
+		auditlog.Event{AuditID: "2", Stage: "ResponseComplete", Verb: "delete"},
+	)
+	s.Add(auditlog.Event{AuditID: "3", Stage: "Panic", Verb: "list"})
+
+	ee := s.List(auditlog.Filter{})
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "3", ee[0].AuditID)
+	assert.Equal(t, "2", ee[1].AuditID)
+	assert.Equal(t, 1, len(s.List(auditlog.Filter{Verb: "delete"})))
+
+	e, ok := s.Get("2")
+	assert.True(t, ok)
+	assert.Equal(t, "delete", e.Verb)
+	_, ok = s.Get("1")
+	assert.False(t, ok)
+}
@@ -0,0 +1,178 @@
+package auditlog
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// maxBatchSize caps the size of a webhook payload.
+	maxBatchSize = 10 * 1024 * 1024
+
+	// fileBatchSize represents the number of events recorded at once while
+	// reading an audit log.
+	fileBatchSize = 500
+)
+
+// Webhook represents the settings of an audit webhook backend.
+type Webhook struct {
+	// Address represents the listen address.
+	Address string
+
+	// CertFile and KeyFile represent the TLS certificate and key. Plain
+	// http is served when not set.
+	CertFile, KeyFile string
+
+	// Token represents the bearer token requests must present if any.
+	Token string
+}
+
+// Handler returns an audit webhook backend recording posted events. Requests
+// must present the given bearer token unless empty.
+func Handler(s *Stream, token string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !authorized(r, token) {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var l EventList
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxBatchSize)).Decode(&l); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.Add(l.Items...)
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+// ServeWebhook serves an audit webhook backend until the context is canceled.
+func ServeWebhook(ctx context.Context, cfg Webhook, s *Stream) error {
+	l, err := net.Listen("tcp", cfg.Address)
+	if err != nil {
+		return err
+	}
+
+	srv := http.Server{Handler: Handler(s, cfg.Token)}
+	go func() {
+		<-ctx.Done()
+		if err := srv.Close(); err != nil {
+			log.Error().Err(err).Msg("Audit webhook close failed")
+		}
+	}()
+	go func() {
+		log.Info().Msgf("Receiving audit events on %s", l.Addr())
+		var err error
+		if cfg.CertFile != "" {
+			err = srv.ServeTLS(l, cfg.CertFile, cfg.KeyFile)
+		} else {
+			err = srv.Serve(l)
+		}
+		if err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msg("Audit webhook failed")
+		}
+	}()
+
+	return nil
+}
+
+// TailFile follows an audit log file written by the api server log backend,
+// recording events as they are appended. Rotated or truncated files are
+// read again from the start.
+func TailFile(ctx context.Context, path string, poll time.Duration, s *Stream) {
+	t := tailer{path: path}
+	ticker := time.NewTicker(poll)
+	defer ticker.Stop()
+	for {
+		if err := t.read(s); err != nil {
+			log.Debug().Err(err).Msgf("Audit log read failed")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// authorized checks if a request presents the expected bearer token.
+func authorized(r *http.Request, token string) bool {
+	if token == "" {
+		return true
+	}
+	const prefix = "Bearer "
+	h := r.Header.Get("Authorization")
+	if !strings.HasPrefix(h, prefix) {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(h, prefix)), []byte(token)) == 1
+}
+
+type tailer struct {
+	path   string
+	offset int64
+	info   os.FileInfo
+}
+
+func (t *tailer) read(s *Stream) error {
+	f, err := os.Open(t.path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing audit log")
+		}
+	}()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if t.info != nil && (!os.SameFile(t.info, fi) || fi.Size() < t.offset) {
+		t.offset = 0
+	}
+	t.info = fi
+	if _, err := f.Seek(t.offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	r := bufio.NewReader(f)
+	ee := make([]Event, 0, fileBatchSize)
+	for {
+		line, err := r.ReadBytes('\n')
+		if err != nil {
+			// Partial lines are picked up on the next read.
+			break
+		}
+		t.offset += int64(len(line))
+		var e Event
+		if err := json.Unmarshal(line, &e); err != nil {
+			continue
+		}
+		if ee = append(ee, e); len(ee) == fileBatchSize {
+			s.Add(ee...)
+			ee = ee[:0]
+		}
+	}
+	s.Add(ee...)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package auditlog
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	uu := map[string]struct {
+		method, body string
+		token, auth  string
+		code, count  int
+	}{
+		"ok": {
+			method: http.MethodPost,
+			body:   `{"kind":"EventList","items":[{"auditID":"1","stage":"ResponseComplete","verb":"delete"},{"auditID":"2","stage":"ResponseComplete","verb":"get"}]}`,
+			code:   http.StatusOK,
+			count:  2,
+		},
+		"method": {method: http.MethodGet, code: http.StatusMethodNotAllowed},
+		"toast":  {method: http.MethodPost, body: "{", code: http.StatusBadRequest},
+		"token": {
+			method: http.MethodPost,
+			body:   `{"kind":"EventList","items":[{"auditID":"1","stage":"ResponseComplete","verb":"delete"}]}`,
+			token:  "fred",
+			auth:   "Bearer fred",
+			code:   http.StatusOK,
+			count:  1,
+		},
+		"noToken": {
+			method: http.MethodPost,
+			body:   `{"kind":"EventList","items":[{"auditID":"1","stage":"ResponseComplete","verb":"delete"}]}`,
+			token:  "fred",
+			code:   http.StatusUnauthorized,
+		},
+		"badToken": {
+			method: http.MethodPost,
+			body:   `{"kind":"EventList","items":[{"auditID":"1","stage":"ResponseComplete","verb":"delete"}]}`,
+			token:  "fred",
+			auth:   "Bearer blee",
+			code:   http.StatusUnauthorized,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := NewStream(10)
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(u.method, "/", strings.NewReader(u.body))
+			if u.auth != "" {
+				r.Header.Set("Authorization", u.auth)
+			}
+			Handler(s, u.token).ServeHTTP(w, r)
+			assert.Equal(t, u.code, w.Code)
+			assert.Equal(t, u.count, len(s.List(Filter{})))
+		})
+	}
+}
+
+func TestTailerRead(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	s, tl := NewStream(10), tailer{path: path}
+	assert.NotNil(t, tl.read(s))
+
+	write(t, path, `{"auditID":"1","verb":"get"}`+"\n"+"toast\n"+`{"auditID":"2",`, os.O_CREATE|os.O_WRONLY)
+	assert.Nil(t, tl.read(s))
+	assert.Equal(t, 1, len(s.List(Filter{})))
+
+	write(t, path, `"verb":"delete"}`+"\n", os.O_APPEND|os.O_WRONLY)
+	assert.Nil(t, tl.read(s))
+	ee := s.List(Filter{})
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "delete", ee[0].Verb)
+
+	write(t, path, `{"auditID":"3","verb":"list"}`+"\n", os.O_TRUNC|os.O_WRONLY)
+	assert.Nil(t, tl.read(s))
+	ee = s.List(Filter{})
+	assert.Equal(t, 3, len(ee))
+	assert.Equal(t, "list", ee[0].Verb)
+}
+
+func write(t *testing.T, path, s string, flags int) {
+	f, err := os.OpenFile(path, flags, 0600)
+	assert.Nil(t, err)
+	_, err = f.WriteString(s)
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+}
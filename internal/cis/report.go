@@ -0,0 +1,166 @@
+package cis
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Check statuses.
+const (
+	// StatusPass represents a passing check.
+	StatusPass = "PASS"
+	// StatusFail represents a failing check.
+	StatusFail = "FAIL"
+	// StatusWarn represents a check requiring manual review.
+	StatusWarn = "WARN"
+	// StatusInfo represents an informational check.
+	StatusInfo = "INFO"
+)
+
+// Report represents kube-bench CIS benchmark results.
+type Report struct {
+	Controls []Controls `json:"Controls"`
+}
+
+// Controls represents a benchmark target, ie master or node.
+type Controls struct {
+	ID       string  `json:"id" yaml:"id"`
+	Version  string  `json:"version" yaml:"version"`
+	Text     string  `json:"text" yaml:"text"`
+	NodeType string  `json:"node_type" yaml:"nodeType"`
+	Groups   []Group `json:"tests" yaml:"groups"`
+}
+
+// Group represents a benchmark section.
+type Group struct {
+	ID     string  `json:"section" yaml:"id"`
+	Text   string  `json:"desc" yaml:"text"`
+	Pass   int     `json:"pass" yaml:"pass"`
+	Fail   int     `json:"fail" yaml:"fail"`
+	Warn   int     `json:"warn" yaml:"warn"`
+	Info   int     `json:"info" yaml:"info"`
+	Checks []Check `json:"results" yaml:"checks"`
+}
+
+// Check represents a benchmark control check.
+type Check struct {
+	ID          string   `json:"test_number" yaml:"id"`
+	Text        string   `json:"test_desc" yaml:"text"`
+	Status      string   `json:"status" yaml:"status"`
+	Scored      bool     `json:"scored" yaml:"scored"`
+	Audit       string   `json:"audit,omitempty" yaml:"audit,omitempty"`
+	Expected    string   `json:"expected_result,omitempty" yaml:"expected,omitempty"`
+	Actual      string   `json:"actual_value,omitempty" yaml:"actual,omitempty"`
+	Reason      string   `json:"reason,omitempty" yaml:"reason,omitempty"`
+	Info        []string `json:"test_info,omitempty" yaml:"info,omitempty"`
+	Remediation string   `json:"remediation,omitempty" yaml:"remediation,omitempty"`
+}
+
+// Load reads kube-bench results from a file.
+func Load(path string) (*Report, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(raw)
+}
+
+// Parse parses kube-bench json results. Both the current report format and
+// the legacy one, listing controls either as an array or one per line, are
+// supported.
+func Parse(raw []byte) (*Report, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return nil, errors.New("no kube-bench results found")
+	}
+
+	var r Report
+	if raw[0] == '[' {
+		if err := json.Unmarshal(raw, &r.Controls); err != nil {
+			return nil, fmt.Errorf("invalid kube-bench results -- %s", err)
+		}
+		return &r, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	for {
+		var m map[string]json.RawMessage
+		if err := dec.Decode(&m); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid kube-bench results -- %s", err)
+		}
+		if cc, ok := m["Controls"]; ok {
+			var ctrls []Controls
+			if err := json.Unmarshal(cc, &ctrls); err != nil {
+				return nil, fmt.Errorf("invalid kube-bench results -- %s", err)
+			}
+			r.Controls = append(r.Controls, ctrls...)
+			continue
+		}
+		var c Controls
+		if err := remarshal(m, &c); err != nil {
+			return nil, fmt.Errorf("invalid kube-bench results -- %s", err)
+		}
+		r.Controls = append(r.Controls, c)
+	}
+	if len(r.Controls) == 0 {
+		return nil, errors.New("no kube-bench results found")
+	}
+
+	return &r, nil
+}
+
+// Group returns a section given its id.
+func (r *Report) Group(id string) (Group, bool) {
+	for _, c := range r.Controls {
+		for _, g := range c.Groups {
+			if g.ID == id {
+				return g, true
+			}
+		}
+	}
+
+	return Group{}, false
+}
+
+// Check returns a check given its id.
+func (r *Report) Check(id string) (Check, bool) {
+	for _, c := range r.Controls {
+		for _, g := range c.Groups {
+			for _, ck := range g.Checks {
+				if ck.ID == id {
+					return ck, true
+				}
+			}
+		}
+	}
+
+	return Check{}, false
+}
+
+// Save writes kube-bench raw results to a file.
+func Save(path string, raw []byte) error {
+	if _, err := Parse(raw); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func remarshal(m map[string]json.RawMessage, o interface{}) error {
+	raw, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(raw, o)
+}
@@ -0,0 +1,92 @@
+package cis_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/cis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	r, err := cis.Load("testdata/results.json")
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, len(r.Controls))
+	assert.Equal(t, "master", r.Controls[0].NodeType)
+	g, ok := r.Group("1.1")
+	assert.True(t, ok)
+	assert.Equal(t, "Master Node Configuration Files", g.Text)
+	assert.Equal(t, 2, len(g.Checks))
+	assert.Equal(t, 1, g.Fail)
+
+	c, ok := r.Check("1.1.2")
+	assert.True(t, ok)
+	assert.Equal(t, cis.StatusFail, c.Status)
+	assert.Equal(t, "fred:fred", c.Actual)
+	assert.Equal(t, "chown root:root /etc/kubernetes/manifests/kube-apiserver.yaml", c.Remediation)
+
+	_, ok = r.Group("9.9")
+	assert.False(t, ok)
+	_, ok = r.Check("9.9.9")
+	assert.False(t, ok)
+}
+
+func TestParse(t *testing.T) {
+	uu := map[string]struct {
+		raw      string
+		controls int
+		err      bool
+	}{
+		"report": {
+			raw:      `{"Controls":[{"id":"1","tests":[]},{"id":"4","tests":[]}]}`,
+			controls: 2,
+		},
+		"array": {
+			raw:      `[{"id":"1","tests":[]},{"id":"4","tests":[]}]`,
+			controls: 2,
+		},
+		"lines": {
+			raw:      "{\"id\":\"1\",\"tests\":[]}\n{\"id\":\"4\",\"tests\":[]}\n",
+			controls: 2,
+		},
+		"empty": {err: true},
+		"noJSON": {
+			raw: "[FAIL] 1.1.1 Ensure that ...",
+			err: true,
+		},
+		"noControls": {
+			raw: `{"Controls":[]}`,
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, err := cis.Parse([]byte(u.raw))
+			assert.Equal(t, u.err, err != nil)
+			if err == nil {
+				assert.Equal(t, u.controls, len(r.Controls))
+			}
+		})
+	}
+}
+
+func TestSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-cis")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "results.json")
+	assert.NotNil(t, cis.Save(path, []byte("toast")))
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+
+	assert.Nil(t, cis.Save(path, []byte(`[{"id":"1","tests":[]}]`)))
+	r, err := cis.Load(path)
+	assert.Nil(t, err)
+	assert.Equal(t, "1", r.Controls[0].ID)
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// APICalls tracks K9s calls to the api server.
+var APICalls = NewAPIStats()
+
+// LatencyBuckets represents the api calls latency histogram upper bounds in seconds.
+var LatencyBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// APICall represents an api call verb and response code.
+type APICall struct {
+	Verb string
+	Code int
+}
+
+// APILatency represents an api calls latency histogram. Buckets are
+// cumulative and match LatencyBuckets.
+type APILatency struct {
+	Buckets []int
+	Count   int
+	Sum     float64
+}
+
+// APISnapshot represents api calls stats at a point in time.
+type APISnapshot struct {
+	Calls     map[APICall]int
+	Latencies map[string]APILatency
+}
+
+// APIStats collects api calls counts and latencies.
+type APIStats struct {
+	calls map[APICall]int
+	verbs map[string]*APILatency
+	mx    sync.Mutex
+}
+
+// NewAPIStats returns a new api calls collector.
+func NewAPIStats() *APIStats {
+	return &APIStats{
+		calls: make(map[APICall]int),
+		verbs: make(map[string]*APILatency),
+	}
+}
+
+// RecordCall records an api call. A zero code indicates the call failed
+// without a response.
+func (s *APIStats) RecordCall(verb string, code int, d time.Duration) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.calls[APICall{Verb: verb, Code: code}]++
+	h, ok := s.verbs[verb]
+	if !ok {
+		h = &APILatency{Buckets: make([]int, len(LatencyBuckets))}
+		s.verbs[verb] = h
+	}
+	secs := d.Seconds()
+	for i, b := range LatencyBuckets {
+		if secs <= b {
+			h.Buckets[i]++
+		}
+	}
+	h.Count++
+	h.Sum += secs
+}
+
+// Snapshot returns a copy of the current stats.
+func (s *APIStats) Snapshot() APISnapshot {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	snap := APISnapshot{
+		Calls:     make(map[APICall]int, len(s.calls)),
+		Latencies: make(map[string]APILatency, len(s.verbs)),
+	}
+	for k, n := range s.calls {
+		snap.Calls[k] = n
+	}
+	for verb, h := range s.verbs {
+		l := *h
+		l.Buckets = append([]int(nil), h.Buckets...)
+		snap.Latencies[verb] = l
+	}
+
+	return snap
+}
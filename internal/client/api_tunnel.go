@@ -0,0 +1,329 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+const (
+	// TunnelSSH represents a tunnel through an SSH bastion.
+	TunnelSSH = "ssh"
+	// TunnelHTTP represents a tunnel through an HTTP CONNECT proxy.
+	TunnelHTTP = "http"
+
+	tunnelTimeout = 10 * time.Second
+)
+
+type (
+	// DialFunc dials a network address.
+	DialFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+	// TunnelSpec represents a tunnel used to reach an api server.
+	TunnelSpec struct {
+		Kind           string
+		Address        string
+		User           string
+		IdentityFile   string
+		KnownHostsFile string
+	}
+
+	// TunnelSpecFunc returns the tunnel spec of a given context or nil if the
+	// context api server is reached directly.
+	TunnelSpecFunc func(context string) (*TunnelSpec, error)
+
+	apiTunnel interface {
+		Dial(ctx context.Context, network, addr string) (net.Conn, error)
+		Close() error
+	}
+)
+
+// Tunnels tracks the api server tunnels established for each context.
+type Tunnels struct {
+	specFn  TunnelSpecFunc
+	tunnels map[string]apiTunnel
+	specs   map[string]TunnelSpec
+	mx      sync.Mutex
+}
+
+// NewTunnels returns a new tunnels tracker.
+func NewTunnels(fn TunnelSpecFunc) *Tunnels {
+	return &Tunnels{
+		specFn:  fn,
+		tunnels: make(map[string]apiTunnel),
+		specs:   make(map[string]TunnelSpec),
+	}
+}
+
+// DialerFor returns a dialer going through the tunnel of a given context,
+// establishing the tunnel if needed. Returns nil if the context does not
+// require a tunnel.
+func (t *Tunnels) DialerFor(context string) (DialFunc, error) {
+	spec, err := t.specFn(context)
+	if err != nil || spec == nil {
+		return nil, err
+	}
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+	if tu, ok := t.tunnels[context]; ok {
+		if t.specs[context] == *spec {
+			return tu.Dial, nil
+		}
+		t.close(context)
+	}
+	tu, err := openTunnel(spec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open %s tunnel via %s -- %s", spec.Kind, spec.Address, err)
+	}
+	log.Debug().Msgf("Opened %s tunnel via %s for context %q", spec.Kind, spec.Address, context)
+	t.tunnels[context], t.specs[context] = tu, *spec
+
+	return tu.Dial, nil
+}
+
+// Close tears down the tunnel of a given context if any.
+func (t *Tunnels) Close(context string) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.close(context)
+}
+
+// CloseAll tears down all tunnels.
+func (t *Tunnels) CloseAll() {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	for k := range t.tunnels {
+		t.close(k)
+	}
+}
+
+func (t *Tunnels) close(context string) {
+	tu, ok := t.tunnels[context]
+	if !ok {
+		return
+	}
+	if err := tu.Close(); err != nil {
+		log.Warn().Err(err).Msgf("Closing tunnel for context %q", context)
+	}
+	delete(t.tunnels, context)
+	delete(t.specs, context)
+}
+
+func openTunnel(spec *TunnelSpec) (apiTunnel, error) {
+	switch spec.Kind {
+	case TunnelSSH:
+		t := sshTunnel{spec: spec}
+		if _, err := t.connect(); err != nil {
+			return nil, err
+		}
+		return &t, nil
+	case TunnelHTTP:
+		return &httpTunnel{spec: spec}, nil
+	default:
+		return nil, fmt.Errorf("unsupported tunnel kind %q", spec.Kind)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// SSH tunnel...
+
+type sshTunnel struct {
+	spec   *TunnelSpec
+	client *ssh.Client
+	mx     sync.Mutex
+}
+
+// Dial dials an address from the bastion, reconnecting once should the
+// bastion connection have dropped.
+func (t *sshTunnel) Dial(_ context.Context, network, addr string) (net.Conn, error) {
+	c, err := t.connect()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := c.Dial(network, addr)
+	if err == nil {
+		return conn, nil
+	}
+	log.Warn().Err(err).Msgf("SSH tunnel dial failed. Reconnecting to %s", t.spec.Address)
+	t.drop(c)
+	if c, err = t.connect(); err != nil {
+		return nil, err
+	}
+
+	return c.Dial(network, addr)
+}
+
+// Close closes the bastion connection.
+func (t *sshTunnel) Close() error {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.client == nil {
+		return nil
+	}
+	err := t.client.Close()
+	t.client = nil
+
+	return err
+}
+
+func (t *sshTunnel) connect() (*ssh.Client, error) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.client != nil {
+		return t.client, nil
+	}
+	c, err := dialSSH(t.spec)
+	if err != nil {
+		return nil, err
+	}
+	t.client = c
+
+	return c, nil
+}
+
+func (t *sshTunnel) drop(c *ssh.Client) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if t.client != c {
+		return
+	}
+	_ = t.client.Close()
+	t.client = nil
+}
+
+func dialSSH(spec *TunnelSpec) (*ssh.Client, error) {
+	hostKeys, err := knownhosts.New(spec.KnownHostsFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load known hosts -- %s", err)
+	}
+	auth, agentConn, err := sshAuth(spec)
+	if err != nil {
+		return nil, err
+	}
+	if agentConn != nil {
+		defer agentConn.Close()
+	}
+
+	cfg := ssh.ClientConfig{
+		User:            spec.User,
+		Auth:            auth,
+		HostKeyCallback: hostKeys,
+		Timeout:         tunnelTimeout,
+	}
+
+	return ssh.Dial("tcp", spec.Address, &cfg)
+}
+
+// sshAuth returns the identity file and ssh agent auth methods. The agent
+// connection, if any, must be closed once the handshake completes.
+func sshAuth(spec *TunnelSpec) ([]ssh.AuthMethod, net.Conn, error) {
+	var mm []ssh.AuthMethod
+	if spec.IdentityFile != "" {
+		raw, err := ioutil.ReadFile(spec.IdentityFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		signer, err := ssh.ParsePrivateKey(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid identity file %s -- %s", spec.IdentityFile, err)
+		}
+		mm = append(mm, ssh.PublicKeys(signer))
+	}
+
+	var conn net.Conn
+	if sock := os.Getenv("SSH_AUTH_SOCK"); sock != "" {
+		var err error
+		if conn, err = net.Dial("unix", sock); err != nil {
+			log.Warn().Err(err).Msg("Unable to reach ssh agent")
+		} else {
+			mm = append(mm, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+		}
+	}
+	if len(mm) == 0 {
+		return nil, nil, fmt.Errorf("no identity file or ssh agent available to authenticate to %s", spec.Address)
+	}
+
+	return mm, conn, nil
+}
+
+// ----------------------------------------------------------------------------
+// HTTP CONNECT tunnel...
+
+type httpTunnel struct {
+	spec *TunnelSpec
+}
+
+// Dial opens a CONNECT tunnel to the given address through the proxy.
+func (t *httpTunnel) Dial(ctx context.Context, network, addr string) (net.Conn, error) {
+	d := net.Dialer{Timeout: tunnelTimeout}
+	conn, err := d.DialContext(ctx, "tcp", t.spec.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.SetDeadline(time.Now().Add(tunnelTimeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	req := http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, &req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s refused tunnel to %s -- %s", t.spec.Address, addr, resp.Status)
+	}
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return &bufferedConn{Conn: conn, r: r}, nil
+}
+
+// Close is a noop as CONNECT tunnels are opened per connection.
+func (*httpTunnel) Close() error {
+	return nil
+}
+
+// bufferedConn reads data the proxy might have sent past its response.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+// Read reads from the buffered connection.
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
@@ -0,0 +1,109 @@
+package client_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTunnelsDirect(t *testing.T) {
+	tt := client.NewTunnels(func(string) (*client.TunnelSpec, error) {
+		return nil, nil
+	})
+
+	dial, err := tt.DialerFor("fred")
+	assert.Nil(t, err)
+	assert.Nil(t, dial)
+}
+
+func TestTunnelsSpecFailed(t *testing.T) {
+	tt := client.NewTunnels(func(string) (*client.TunnelSpec, error) {
+		return nil, errors.New("boom")
+	})
+
+	_, err := tt.DialerFor("fred")
+	assert.Equal(t, errors.New("boom"), err)
+}
+
+func TestTunnelsInvalidKind(t *testing.T) {
+	tt := client.NewTunnels(func(string) (*client.TunnelSpec, error) {
+		return &client.TunnelSpec{Kind: "socks", Address: "localhost:1080"}, nil
+	})
+
+	_, err := tt.DialerFor("fred")
+	assert.NotNil(t, err)
+}
+
+func TestTunnelsHTTPConnect(t *testing.T) {
+	uu := map[string]struct {
+		status int
+		err    bool
+	}{
+		"ok":      {status: http.StatusOK},
+		"refused": {status: http.StatusForbidden, err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			assert.Nil(t, err)
+			defer l.Close()
+			targets := make(chan string, 1)
+			go connectProxy(l, u.status, targets)
+
+			tt := client.NewTunnels(func(string) (*client.TunnelSpec, error) {
+				return &client.TunnelSpec{Kind: client.TunnelHTTP, Address: l.Addr().String()}, nil
+			})
+			defer tt.CloseAll()
+			dial, err := tt.DialerFor("fred")
+			assert.Nil(t, err)
+
+			conn, err := dial(context.Background(), "tcp", "api.fred.io:6443")
+			assert.Equal(t, "api.fred.io:6443", <-targets)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			defer conn.Close()
+			_, err = conn.Write([]byte("ping"))
+			assert.Nil(t, err)
+			buff := make([]byte, 4)
+			_, err = io.ReadFull(conn, buff)
+			assert.Nil(t, err)
+			assert.Equal(t, "ping", string(buff))
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// connectProxy accepts a single CONNECT request and echoes the tunneled data.
+func connectProxy(l net.Listener, status int, targets chan<- string) {
+	conn, err := l.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		return
+	}
+	targets <- req.Host
+	resp := http.Response{StatusCode: status, ProtoMajor: 1, ProtoMinor: 1}
+	if err := resp.Write(conn); err != nil || status != http.StatusOK {
+		return
+	}
+	_, _ = io.Copy(conn, r)
+}
@@ -146,10 +146,12 @@ func (a *APIClient) CheckConnectivity() (status bool) {
 	}()
 
 	if a.checkClientSet == nil {
-		cfg, err := a.config.flags.ToRESTConfig()
+		rc, err := a.config.RESTConfig()
 		if err != nil {
+			log.Error().Err(err).Msgf("Unable to connect to api server")
 			return
 		}
+		cfg := restclient.CopyConfig(rc)
 		cfg.Timeout = checkConnTimeout
 
 		if a.checkClientSet, err = kubernetes.NewForConfig(cfg); err != nil {
@@ -201,8 +203,12 @@ func (a *APIClient) DialOrDie() kubernetes.Interface {
 		return a.client
 	}
 
+	cfg := a.RestConfigOrDie()
+	if a.config.Protobuf() {
+		cfg = ProtoConfig(cfg)
+	}
 	var err error
-	if a.client, err = kubernetes.NewForConfig(a.RestConfigOrDie()); err != nil {
+	if a.client, err = kubernetes.NewForConfig(cfg); err != nil {
 		log.Fatal().Err(err).Msgf("Unable to connect to api server")
 	}
 	return a.client
@@ -287,6 +293,23 @@ func (a *APIClient) SwitchContext(ctx string) error {
 	return nil
 }
 
+// RefreshCredentials reloads the kubeconfig and drops all api clients so
+// rotated credentials are picked up.
+func (a *APIClient) RefreshCredentials() error {
+	a.config.Reload()
+	if _, err := a.config.RESTConfig(); err != nil {
+		return err
+	}
+	a.clearCache()
+	a.reset()
+	a.mx.Lock()
+	a.checkClientSet, a.cachedClient = nil, nil
+	a.mx.Unlock()
+	_ = a.supportsMxServer()
+
+	return nil
+}
+
 func (a *APIClient) reset() {
 	a.mx.Lock()
 	defer a.mx.Unlock()
@@ -26,14 +26,18 @@ type Config struct {
 	currentContext string
 	rawConfig      *clientcmdapi.Config
 	restConfig     *restclient.Config
+	tunnels        *Tunnels
+	protobuf       bool
+	oidcTokens     map[string]*OIDCTokens
 	mutex          *sync.RWMutex
 }
 
 // NewConfig returns a new k8s config or an error if the flags are invalid.
 func NewConfig(f *genericclioptions.ConfigFlags) *Config {
 	return &Config{
-		flags: f,
-		mutex: &sync.RWMutex{},
+		flags:      f,
+		oidcTokens: make(map[string]*OIDCTokens),
+		mutex:      &sync.RWMutex{},
 	}
 }
 
@@ -60,6 +64,63 @@ func (c *Config) SwitchContext(name string) error {
 	return nil
 }
 
+// ContextConfig returns a new configuration targeting the given context.
+// The kubeconfig location and overrides are shared with this configuration.
+func (c *Config) ContextConfig(name string) *Config {
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.KubeConfig = c.flags.KubeConfig
+	flags.CacheDir = c.flags.CacheDir
+	flags.Impersonate = c.flags.Impersonate
+	flags.ImpersonateGroup = c.flags.ImpersonateGroup
+	flags.Insecure = c.flags.Insecure
+	flags.Timeout = c.flags.Timeout
+	flags.Context = &name
+	cfg := NewConfig(flags)
+	cfg.tunnels = c.tunnels
+	cfg.protobuf = c.protobuf
+
+	return cfg
+}
+
+// SetProtobuf specifies whether built-in resources are transported as protobuf.
+func (c *Config) SetProtobuf(b bool) {
+	c.protobuf = b
+}
+
+// Protobuf checks if built-in resources are transported as protobuf.
+func (c *Config) Protobuf() bool {
+	return c.protobuf
+}
+
+// SetTunnels sets the tunnels used to reach the contexts api servers.
+func (c *Config) SetTunnels(t *Tunnels) {
+	c.tunnels = t
+}
+
+// CloseTunnels tears down all api server tunnels.
+func (c *Config) CloseTunnels() {
+	if c.tunnels != nil {
+		c.tunnels.CloseAll()
+	}
+}
+
+// KubeConfigFiles returns the kubeconfig files backing this configuration.
+func (c *Config) KubeConfigFiles() []string {
+	if isSet(c.flags.KubeConfig) {
+		return []string{*c.flags.KubeConfig}
+	}
+
+	return clientcmd.NewDefaultClientConfigLoadingRules().GetLoadingPrecedence()
+}
+
+// Reload discards the loaded kubeconfig so it is read again on next access.
+func (c *Config) Reload() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.reset()
+}
+
 func (c *Config) reset() {
 	c.clientConfig, c.rawConfig, c.restConfig = nil, nil, nil
 }
@@ -203,6 +264,24 @@ func (c *Config) ImpersonateUser() (string, error) {
 	return "", errors.New("no user set")
 }
 
+// Impersonate acts as the given user and groups. An empty user drops the
+// impersonation and restores the kubeconfig identity.
+func (c *Config) Impersonate(user string, groups []string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if user == "" {
+		groups = nil
+	}
+	c.flags.Impersonate, c.flags.ImpersonateGroup = &user, &groups
+	c.reset()
+}
+
+// Impersonating returns true if acting as another user.
+func (c *Config) Impersonating() bool {
+	return isSet(c.flags.Impersonate)
+}
+
 // CurrentUserName retrieves the active user name.
 func (c *Config) CurrentUserName() (string, error) {
 	if isSet(c.flags.Impersonate) {
@@ -304,12 +383,31 @@ func (c *Config) RESTConfig() (*restclient.Config, error) {
 		return c.restConfig, nil
 	}
 
-	var err error
-	if c.restConfig, err = c.flags.ToRESTConfig(); err != nil {
+	cfg, err := c.flags.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.QPS = defaultQPS
+	cfg.Burst = defaultBurst
+	cfg.Wrap(instrument)
+	if c.tunnels != nil {
+		ctx, err := c.CurrentContextName()
+		if err != nil {
+			return nil, err
+		}
+		dial, err := c.tunnels.DialerFor(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if dial != nil {
+			log.Debug().Msgf("Tunneling API Server connections for context %q", ctx)
+			cfg.Dial = dial
+		}
+	}
+	if err := c.useOIDCToken(cfg); err != nil {
 		return nil, err
 	}
-	c.restConfig.QPS = defaultQPS
-	c.restConfig.Burst = defaultBurst
+	c.restConfig = cfg
 	log.Debug().Msgf("Connecting to API Server %s", c.restConfig.Host)
 
 	return c.restConfig, nil
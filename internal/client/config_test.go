@@ -1,8 +1,12 @@
 package client_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/derailed/k9s/internal/client"
@@ -249,3 +253,72 @@ func TestNamespaceNames(t *testing.T) {
 	assert.Equal(t, 2, len(nns))
 	assert.Equal(t, []string{"ns1", "ns2"}, nns)
 }
+
+func TestConfigContextConfig(t *testing.T) {
+	name, kubeConfig := "blee", "./testdata/config"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig, Context: &name})
+
+	c := cfg.ContextConfig("duh")
+	ctx, err := c.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "duh", ctx)
+	assert.Equal(t, kubeConfig, *c.Flags().KubeConfig)
+
+	ctx, err = cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", ctx)
+}
+
+func TestConfigReload(t *testing.T) {
+	raw, err := ioutil.ReadFile("./testdata/config")
+	assert.Nil(t, err)
+	dir, err := ioutil.TempDir("", "k9s-reload")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	kubeConfig := filepath.Join(dir, "config")
+	assert.Nil(t, ioutil.WriteFile(kubeConfig, raw, 0600))
+
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+	assert.Equal(t, []string{kubeConfig}, cfg.KubeConfigFiles())
+	ctx, err := cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", ctx)
+
+	raw = bytes.Replace(raw, []byte("current-context: fred"), []byte("current-context: blee"), 1)
+	assert.Nil(t, ioutil.WriteFile(kubeConfig, raw, 0600))
+	ctx, err = cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", ctx)
+
+	cfg.Reload()
+	ctx, err = cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", ctx)
+}
+
+func TestConfigImpersonate(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+	assert.False(t, cfg.Impersonating())
+
+	cfg.Impersonate("system:serviceaccount:ci:deployer", []string{"ci", "ops"})
+	assert.True(t, cfg.Impersonating())
+	user, err := cfg.CurrentUserName()
+	assert.Nil(t, err)
+	assert.Equal(t, "system:serviceaccount:ci:deployer", user)
+	gg, err := cfg.ImpersonateGroups()
+	assert.Nil(t, err)
+	assert.Equal(t, "ci,ops", gg)
+	rc, err := cfg.RESTConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, "system:serviceaccount:ci:deployer", rc.Impersonate.UserName)
+	assert.Equal(t, []string{"ci", "ops"}, rc.Impersonate.Groups)
+
+	cfg.Impersonate("", []string{"ci"})
+	assert.False(t, cfg.Impersonating())
+	user, err = cfg.CurrentUserName()
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", user)
+	_, err = cfg.ImpersonateGroups()
+	assert.NotNil(t, err)
+}
@@ -172,6 +172,8 @@ func mapVerb(v string) ([]string, error) {
 		return []string{"delete"}, nil
 	case "edit":
 		return []string{"patch", "update"}, nil
+	case "patch":
+		return []string{"patch"}, nil
 	default:
 		return []string{}, fmt.Errorf("no standard verb for %q", v)
 	}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumented records api calls counts and latencies and traces them when
+// tracing is enabled.
+type instrumented struct {
+	rt http.RoundTripper
+}
+
+var _ http.RoundTripper = (*instrumented)(nil)
+
+func instrument(rt http.RoundTripper) http.RoundTripper {
+	return &instrumented{rt: rt}
+}
+
+// RoundTrip executes and records an api call.
+func (i *instrumented) RoundTrip(req *http.Request) (*http.Response, error) {
+	verb := requestVerb(req)
+	var span trace.Span
+	if tracing.Enabled() {
+		ctx, s := tracing.Start(req.Context(), "k8s "+verb, trace.SpanKindClient)
+		defer s.End()
+		s.SetAttributes(
+			attribute.String("http.method", req.Method),
+			attribute.String("http.target", req.URL.Path),
+			attribute.String("k8s.verb", verb),
+		)
+		// Lets the api server join its own spans to the trace.
+		req = req.Clone(ctx)
+		tracing.Inject(ctx, req.Header)
+		span = s
+	}
+
+	t := time.Now()
+	resp, err := i.rt.RoundTrip(req)
+	var code int
+	if resp != nil {
+		code = resp.StatusCode
+	}
+	APICalls.RecordCall(verb, code, time.Since(t))
+	if span != nil {
+		span.SetAttributes(attribute.Int("http.status_code", code))
+		tracing.SetError(span, err)
+	}
+
+	return resp, err
+}
+
+// requestVerb returns an api call verb. Watches are told apart from gets.
+func requestVerb(req *http.Request) string {
+	if req.Method == http.MethodGet && req.URL != nil && req.URL.Query().Get("watch") == "true" {
+		return "watch"
+	}
+
+	return strings.ToLower(req.Method)
+}
@@ -0,0 +1,63 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestVerb(t *testing.T) {
+	uu := map[string]struct {
+		method, url, e string
+	}{
+		"get": {
+			method: http.MethodGet,
+			url:    "https://k8s/api/v1/namespaces/default/pods",
+			e:      "get",
+		},
+		"watch": {
+			method: http.MethodGet,
+			url:    "https://k8s/api/v1/pods?resourceVersion=10&watch=true",
+			e:      "watch",
+		},
+		"patch": {
+			method: http.MethodPatch,
+			url:    "https://k8s/apis/apps/v1/namespaces/default/deployments/fred",
+			e:      "patch",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			req, err := http.NewRequest(u.method, u.url, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, requestVerb(req))
+		})
+	}
+}
+
+func TestRoundTripUntraced(t *testing.T) {
+	var h http.Header
+	rt := instrument(roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		h = req.Header
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+
+	req, err := http.NewRequest(http.MethodGet, "https://k8s/api/v1/pods", nil)
+	assert.Nil(t, err)
+	resp, err := rt.RoundTrip(req)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "", h.Get("traceparent"))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
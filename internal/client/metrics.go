@@ -3,48 +3,81 @@ package client
 import (
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/cache"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
-const (
-	mxCacheSize   = 100
-	mxCacheExpiry = 1 * time.Minute
+var (
+	metricsDials = make(map[Connection]*MetricsServer)
+	mxPoll       = DefaultMetricsPoll
+	mxStaleness  = DefaultMetricsStaleness
+	mxMutex      sync.Mutex
 )
 
-// MetricsDial tracks global metric server handle.
-var MetricsDial *MetricsServer
-
-// DialMetrics dials the metrics server.
+// DialMetrics dials the metrics server of a given connection. Metrics are
+// shared by all callers using the same connection.
 func DialMetrics(c Connection) *MetricsServer {
-	if MetricsDial == nil {
-		MetricsDial = NewMetricsServer(c)
+	mxMutex.Lock()
+	defer mxMutex.Unlock()
+
+	m, ok := metricsDials[c]
+	if !ok {
+		m = newMetricsServer(c, mxPoll, mxStaleness)
+		metricsDials[c] = m
 	}
 
-	return MetricsDial
+	return m
+}
+
+// DropMetrics releases the metrics server handle of a closed connection.
+func DropMetrics(c Connection) {
+	mxMutex.Lock()
+	defer mxMutex.Unlock()
+
+	delete(metricsDials, c)
 }
 
-// ResetMetrics resets the metric server handle.
+// ResetMetrics resets the metric server handles.
 func ResetMetrics() {
-	MetricsDial = nil
+	mxMutex.Lock()
+	defer mxMutex.Unlock()
+
+	metricsDials = make(map[Connection]*MetricsServer)
+}
+
+// ConfigureMetrics sets how long metrics are shared before metrics-server is
+// polled again and how long they are served when metrics-server can't be
+// reached.
+func ConfigureMetrics(poll, staleness time.Duration) {
+	mxMutex.Lock()
+	mxPoll, mxStaleness = poll, staleness
+	mxMutex.Unlock()
+	ResetMetrics()
 }
 
 // MetricsServer serves cluster metrics for nodes and pods.
 type MetricsServer struct {
 	Connection
 
-	cache *cache.LRUExpireCache
+	cache *mxCache
 }
 
 // NewMetricsServer return a metric server instance.
 func NewMetricsServer(c Connection) *MetricsServer {
+	mxMutex.Lock()
+	defer mxMutex.Unlock()
+
+	return newMetricsServer(c, mxPoll, mxStaleness)
+}
+
+func newMetricsServer(c Connection, poll, staleness time.Duration) *MetricsServer {
 	return &MetricsServer{
 		Connection: c,
-		cache:      cache.NewLRUExpireCache(mxCacheSize),
+		cache:      newMxCache(poll, staleness),
 	}
 }
 
@@ -127,29 +160,26 @@ func (m *MetricsServer) FetchNodesMetrics() (*mv1beta1.NodeMetricsList, error) {
 		return mx, err
 	}
 
-	const key = "nodes"
-	if entry, ok := m.cache.Get(key); ok && entry != nil {
-		mxList, ok := entry.(*mv1beta1.NodeMetricsList)
-		if !ok {
-			return nil, fmt.Errorf("expected nodemetricslist but got %T", entry)
+	o, err := m.cache.fetch("nodes", time.Now(), func() (interface{}, error) {
+		client, err := m.MXDial()
+		if err != nil {
+			return nil, err
 		}
-		return mxList, nil
-	}
-
-	client, err := m.MXDial()
+		return client.MetricsV1beta1().NodeMetricses().List(metav1.ListOptions{})
+	})
 	if err != nil {
 		return mx, err
 	}
-	mxList, err := client.MetricsV1beta1().NodeMetricses().List(metav1.ListOptions{})
-	if err != nil {
-		return mx, err
+	mxList, ok := o.(*mv1beta1.NodeMetricsList)
+	if !ok {
+		return mx, fmt.Errorf("expected nodemetricslist but got %T", o)
 	}
-	m.cache.Add(key, mxList, mxCacheExpiry)
 
 	return mxList, nil
 }
 
-// FetchPodsMetrics return all metrics for pods in a given namespace.
+// FetchPodsMetrics return all metrics for pods in a given namespace. Fresh
+// metrics for all namespaces are reused rather than polled again.
 func (m *MetricsServer) FetchPodsMetrics(ns string) (*mv1beta1.PodMetricsList, error) {
 	mx := new(mv1beta1.PodMetricsList)
 	const msg = "user is not authorized to list pods metrics"
@@ -161,29 +191,34 @@ func (m *MetricsServer) FetchPodsMetrics(ns string) (*mv1beta1.PodMetricsList, e
 		return mx, err
 	}
 
-	key := FQN(ns, "pods")
-	if entry, ok := m.cache.Get(key); ok {
-		mxList, ok := entry.(*mv1beta1.PodMetricsList)
-		if !ok {
-			return mx, fmt.Errorf("expected podmetricslist but got %T", entry)
+	now := time.Now()
+	if ns != AllNamespaces {
+		if o, ok := m.cache.fresh(FQN(AllNamespaces, "pods"), now); ok {
+			if all, ok := o.(*mv1beta1.PodMetricsList); ok {
+				return inNamespace(all, ns), nil
+			}
 		}
-		return mxList, nil
 	}
-
-	client, err := m.MXDial()
+	o, err := m.cache.fetch(FQN(ns, "pods"), now, func() (interface{}, error) {
+		client, err := m.MXDial()
+		if err != nil {
+			return nil, err
+		}
+		return client.MetricsV1beta1().PodMetricses(ns).List(metav1.ListOptions{})
+	})
 	if err != nil {
 		return mx, err
 	}
-	mxList, err := client.MetricsV1beta1().PodMetricses(ns).List(metav1.ListOptions{})
-	if err != nil {
-		return mx, err
+	mxList, ok := o.(*mv1beta1.PodMetricsList)
+	if !ok {
+		return mx, fmt.Errorf("expected podmetricslist but got %T", o)
 	}
-	m.cache.Add(key, mxList, mxCacheExpiry)
 
-	return mxList, err
+	return mxList, nil
 }
 
-// FetchPodMetrics return all metrics for pods in a given namespace.
+// FetchPodMetrics return the metrics of a given pod. Fresh metrics of the pod
+// namespace are reused rather than polled again.
 func (m *MetricsServer) FetchPodMetrics(fqn string) (*mv1beta1.PodMetrics, error) {
 	var mx *mv1beta1.PodMetrics
 	const msg = "user is not authorized to list pod metrics"
@@ -196,26 +231,35 @@ func (m *MetricsServer) FetchPodMetrics(fqn string) (*mv1beta1.PodMetrics, error
 		return mx, err
 	}
 
-	var key = FQN(ns, "pods")
-	if entry, ok := m.cache.Get(key); ok {
-		if list, ok := entry.(*mv1beta1.PodMetricsList); ok && list != nil {
-			for _, m := range list.Items {
-				if FQN(m.Namespace, m.Name) == fqn {
-					return &m, nil
+	now := time.Now()
+	for _, key := range []string{FQN(ns, "pods"), FQN(AllNamespaces, "pods")} {
+		o, ok := m.cache.fresh(key, now)
+		if !ok {
+			continue
+		}
+		if list, ok := o.(*mv1beta1.PodMetricsList); ok && list != nil {
+			for i := range list.Items {
+				if FQN(list.Items[i].Namespace, list.Items[i].Name) == fqn {
+					return &list.Items[i], nil
 				}
 			}
 		}
 	}
 
-	client, err := m.MXDial()
+	o, err := m.cache.fetch("pod:"+fqn, now, func() (interface{}, error) {
+		client, err := m.MXDial()
+		if err != nil {
+			return nil, err
+		}
+		return client.MetricsV1beta1().PodMetricses(ns).Get(n, metav1.GetOptions{})
+	})
 	if err != nil {
 		return mx, err
 	}
-	mx, err = client.MetricsV1beta1().PodMetricses(ns).Get(n, metav1.GetOptions{})
-	if err != nil {
-		return mx, err
+	mx, ok := o.(*mv1beta1.PodMetrics)
+	if !ok {
+		return mx, fmt.Errorf("expected podmetrics but got %T", o)
 	}
-	m.cache.Add(key, mx, mxCacheExpiry)
 
 	return mx, nil
 }
@@ -247,6 +291,18 @@ func ToMB(v int64) float64 {
 	return float64(v) / megaByte
 }
 
+// inNamespace returns the pods metrics of a given namespace.
+func inNamespace(all *mv1beta1.PodMetricsList, ns string) *mv1beta1.PodMetricsList {
+	mx := mv1beta1.PodMetricsList{ListMeta: all.ListMeta}
+	for _, p := range all.Items {
+		if p.Namespace == ns {
+			mx.Items = append(mx.Items, p)
+		}
+	}
+
+	return &mx
+}
+
 func toPerc(v1, v2 float64) float64 {
 	if v2 == 0 {
 		return 0
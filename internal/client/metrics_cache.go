@@ -0,0 +1,127 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultMetricsPoll represents the time metrics are shared before
+	// metrics-server is polled again.
+	DefaultMetricsPoll = 15 * time.Second
+
+	// DefaultMetricsStaleness represents the time cached metrics are
+	// served when metrics-server can't be reached.
+	DefaultMetricsStaleness = 2 * time.Minute
+
+	// maxMxEntries caps the number of cached metrics responses.
+	maxMxEntries = 500
+)
+
+// mxCache caches metrics-server responses for the poll interval and
+// coalesces concurrent requests for the same metrics into a single call.
+// Entries expire once no longer usable and the oldest ones are evicted when
+// the cache is full.
+type mxCache struct {
+	poll    time.Duration
+	stale   time.Duration
+	entries map[string]mxEntry
+	calls   map[string]*mxCall
+	mx      sync.Mutex
+}
+
+type mxEntry struct {
+	val interface{}
+	at  time.Time
+}
+
+type mxCall struct {
+	wg  sync.WaitGroup
+	val interface{}
+	err error
+}
+
+func newMxCache(poll, stale time.Duration) *mxCache {
+	return &mxCache{
+		poll:    poll,
+		stale:   stale,
+		entries: make(map[string]mxEntry),
+		calls:   make(map[string]*mxCall),
+	}
+}
+
+// fresh returns a cached value polled within the poll interval.
+func (c *mxCache) fresh(key string, now time.Time) (interface{}, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || now.Sub(e.at) >= c.poll {
+		return nil, false
+	}
+
+	return e.val, true
+}
+
+// fetch returns a fresh cached value or polls metrics-server for it. When
+// polling fails, values cached within the staleness period are returned.
+func (c *mxCache) fetch(key string, now time.Time, f func() (interface{}, error)) (interface{}, error) {
+	if v, ok := c.fresh(key, now); ok {
+		return v, nil
+	}
+
+	c.mx.Lock()
+	if call, ok := c.calls[key]; ok {
+		c.mx.Unlock()
+		call.wg.Wait()
+		return call.val, call.err
+	}
+	call := new(mxCall)
+	call.wg.Add(1)
+	c.calls[key] = call
+	c.mx.Unlock()
+
+	call.val, call.err = f()
+
+	c.mx.Lock()
+	if call.err == nil {
+		c.entries[key] = mxEntry{val: call.val, at: now}
+		c.prune(now)
+	} else if e, ok := c.entries[key]; ok && now.Sub(e.at) < c.stale {
+		log.Warn().Err(call.err).Msgf("Using stale %s metrics", key)
+		call.val, call.err = e.val, nil
+	}
+	delete(c.calls, key)
+	c.mx.Unlock()
+	call.wg.Done()
+
+	return call.val, call.err
+}
+
+// prune evicts expired entries and the oldest ones past the cache capacity.
+// Callers must hold the cache lock.
+func (c *mxCache) prune(now time.Time) {
+	ttl := c.stale
+	if c.poll > ttl {
+		ttl = c.poll
+	}
+	for k, e := range c.entries {
+		if now.Sub(e.at) >= ttl {
+			delete(c.entries, k)
+		}
+	}
+	for len(c.entries) > maxMxEntries {
+		var (
+			oldest string
+			at     time.Time
+		)
+		for k, e := range c.entries {
+			if oldest == "" || e.at.Before(at) {
+				oldest, at = k, e.at
+			}
+		}
+		delete(c.entries, oldest)
+	}
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMxCacheFetch(t *testing.T) {
+	c, now := newMxCache(10*time.Second, time.Minute), time.Now()
+	var calls int32
+	poll := func() (interface{}, error) {
+		return atomic.AddInt32(&calls, 1), nil
+	}
+
+	v, err := c.fetch("nodes", now, poll)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), v)
+
+	v, err = c.fetch("nodes", now.Add(5*time.Second), poll)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), v)
+
+	v, err = c.fetch("nodes", now.Add(10*time.Second), poll)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), v)
+}
+
+func TestMxCacheStale(t *testing.T) {
+	c, now := newMxCache(10*time.Second, time.Minute), time.Now()
+	fail := func() (interface{}, error) {
+		return nil, errors.New("boom")
+	}
+
+	_, err := c.fetch("nodes", now, fail)
+	assert.NotNil(t, err)
+
+	_, err = c.fetch("nodes", now, func() (interface{}, error) { return "fred", nil })
+	assert.Nil(t, err)
+
+	v, err := c.fetch("nodes", now.Add(30*time.Second), fail)
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", v)
+
+	_, err = c.fetch("nodes", now.Add(2*time.Minute), fail)
+	assert.NotNil(t, err)
+}
+
+func TestMxCachePrune(t *testing.T) {
+	c, now := newMxCache(10*time.Second, time.Minute), time.Now()
+	poll := func() (interface{}, error) { return "fred", nil }
+
+	_, _ = c.fetch("pod:default/p1", now, poll)
+	_, _ = c.fetch("pod:default/p2", now.Add(2*time.Minute), poll)
+	assert.Equal(t, 1, len(c.entries))
+
+	for i := 0; i < maxMxEntries+10; i++ {
+		_, _ = c.fetch(fmt.Sprintf("pod:default/p%d", i), now.Add(2*time.Minute+time.Duration(i)*time.Millisecond), poll)
+	}
+	assert.Equal(t, maxMxEntries, len(c.entries))
+	_, ok := c.entries["pod:default/p0"]
+	assert.False(t, ok)
+}
+
+func TestMxCacheCoalesce(t *testing.T) {
+	c, now := newMxCache(10*time.Second, time.Minute), time.Now()
+	var calls int32
+	release := make(chan struct{})
+	poll := func() (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return "fred", nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := c.fetch("pods", now, poll)
+			assert.Nil(t, err)
+			assert.Equal(t, "fred", v)
+		}()
+	}
+	for {
+		c.mx.Lock()
+		_, ok := c.calls["pods"]
+		c.mx.Unlock()
+		if ok {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestDropMetrics(t *testing.T) {
+	c := &APIClient{}
+	m := DialMetrics(c)
+	assert.True(t, m == DialMetrics(c))
+
+	DropMetrics(c)
+	assert.False(t, m == DialMetrics(c))
+	DropMetrics(c)
+}
@@ -0,0 +1,461 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	oidcProvider       = "oidc"
+	oidcIssuerURL      = "idp-issuer-url"
+	oidcClientID       = "client-id"
+	oidcClientSecret   = "client-secret"
+	oidcExtraScopes    = "extra-scopes"
+	oidcIDToken        = "id-token"
+	oidcRefreshToken   = "refresh-token"
+	deviceCodeGrant    = "urn:ietf:params:oauth:grant-type:device_code"
+	refreshTokenGrant  = "refresh_token"
+	defaultDevicePoll  = 5 * time.Second
+	deviceFlowTimeout  = 10 * time.Second
+	oidcDiscoveryPath  = "/.well-known/openid-configuration"
+	deviceSlowDownStep = 5 * time.Second
+)
+
+// OIDCProvider represents the OIDC auth provider settings of a kubeconfig user.
+type OIDCProvider struct {
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	ExtraScopes  []string
+	IDToken      string
+	RefreshToken string
+}
+
+// Expired checks if the provider id token is missing or expired.
+func (p *OIDCProvider) Expired(now time.Time) bool {
+	exp, err := tokenExpiry(p.IDToken)
+	if err != nil {
+		return true
+	}
+
+	return !now.Before(exp)
+}
+
+// OIDCProvider returns the OIDC settings of the current context user if any.
+func (c *Config) OIDCProvider() (*OIDCProvider, bool) {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return nil, false
+	}
+	ctx, err := c.CurrentContextName()
+	if err != nil {
+		return nil, false
+	}
+	kctx, ok := cfg.Contexts[ctx]
+	if !ok {
+		return nil, false
+	}
+	ai, ok := cfg.AuthInfos[kctx.AuthInfo]
+	if !ok || ai.AuthProvider == nil || ai.AuthProvider.Name != oidcProvider {
+		return nil, false
+	}
+	m := ai.AuthProvider.Config
+	p := OIDCProvider{
+		IssuerURL:    m[oidcIssuerURL],
+		ClientID:     m[oidcClientID],
+		ClientSecret: m[oidcClientSecret],
+		IDToken:      m[oidcIDToken],
+		RefreshToken: m[oidcRefreshToken],
+	}
+	if s := m[oidcExtraScopes]; s != "" {
+		p.ExtraScopes = strings.Split(s, ",")
+	}
+
+	return &p, true
+}
+
+// NeedsOIDCLogin checks if the current context user authenticates via OIDC
+// and its id token has expired.
+func (c *Config) NeedsOIDCLogin() bool {
+	p, ok := c.OIDCProvider()
+
+	return ok && p.Expired(time.Now())
+}
+
+// SaveOIDCTokens persists new OIDC tokens for the current context user.
+func (c *Config) SaveOIDCTokens(t *OIDCTokens) error {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return err
+	}
+	ctx, err := c.CurrentContextName()
+	if err != nil {
+		return err
+	}
+	kctx, ok := cfg.Contexts[ctx]
+	if !ok {
+		return fmt.Errorf("invalid context `%s specified", ctx)
+	}
+	ai, ok := cfg.AuthInfos[kctx.AuthInfo]
+	if !ok || ai.AuthProvider == nil || ai.AuthProvider.Name != oidcProvider {
+		return fmt.Errorf("user %q does not authenticate via oidc", kctx.AuthInfo)
+	}
+	ai.AuthProvider.Config[oidcIDToken] = t.IDToken
+	if t.RefreshToken != "" {
+		ai.AuthProvider.Config[oidcRefreshToken] = t.RefreshToken
+	}
+	acc, err := c.ConfigAccess()
+	if err != nil {
+		return err
+	}
+	if err := clientcmd.ModifyConfig(acc, cfg, true); err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	c.oidcTokens[ctx] = &OIDCTokens{IDToken: t.IDToken, RefreshToken: ai.AuthProvider.Config[oidcRefreshToken]}
+	c.mutex.Unlock()
+
+	return nil
+}
+
+// useOIDCToken authenticates with the tokens obtained via a device login.
+// The oidc auth plugin caches its tokens per provider so it would keep
+// using the expired ones. Instead requests are authenticated by a transport
+// refreshing the tokens as they expire.
+func (c *Config) useOIDCToken(cfg *restclient.Config) error {
+	if cfg.AuthProvider == nil || cfg.AuthProvider.Name != oidcProvider {
+		return nil
+	}
+	ctx, err := c.CurrentContextName()
+	if err != nil {
+		return err
+	}
+	c.mutex.RLock()
+	_, ok := c.oidcTokens[ctx]
+	c.mutex.RUnlock()
+	if !ok {
+		return nil
+	}
+	p, ok := c.OIDCProvider()
+	if !ok {
+		return nil
+	}
+	cfg.AuthProvider = nil
+	cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &oidcTransport{config: c, context: ctx, provider: *p, next: rt}
+	})
+
+	return nil
+}
+
+func (c *Config) oidcTokensFor(ctx string) OIDCTokens {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if t, ok := c.oidcTokens[ctx]; ok {
+		return *t
+	}
+
+	return OIDCTokens{}
+}
+
+// oidcTransport authenticates requests with device login tokens.
+type oidcTransport struct {
+	config   *Config
+	context  string
+	provider OIDCProvider
+	next     http.RoundTripper
+	mx       sync.Mutex
+}
+
+// RoundTrip authenticates a request, refreshing expired tokens first.
+func (t *oidcTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r := utilnet.CloneRequest(req)
+	r.Header.Set("Authorization", "Bearer "+t.token(req.Context()))
+
+	return t.next.RoundTrip(r)
+}
+
+// WrappedRoundTripper returns the wrapped transport.
+func (t *oidcTransport) WrappedRoundTripper() http.RoundTripper {
+	return t.next
+}
+
+// token returns a valid id token. Failed refreshes fall back to the current
+// token so the api server rejects it and a new login gets prompted.
+func (t *oidcTransport) token(ctx context.Context) string {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	tt := t.config.oidcTokensFor(t.context)
+	p := t.provider
+	p.IDToken, p.RefreshToken = tt.IDToken, tt.RefreshToken
+	if !p.Expired(time.Now()) || p.RefreshToken == "" {
+		return tt.IDToken
+	}
+	nt, err := NewDeviceFlow(&p).Refresh(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to refresh OIDC tokens for context %s", t.context)
+		return tt.IDToken
+	}
+	t.config.mutex.Lock()
+	t.config.oidcTokens[t.context] = nt
+	t.config.mutex.Unlock()
+	if err := t.config.SaveOIDCTokens(nt); err != nil {
+		log.Warn().Err(err).Msg("Unable to save refreshed OIDC tokens")
+	}
+
+	return nt.IDToken
+}
+
+// DeviceCode represents a pending OIDC device authorization.
+type DeviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURL         string `json:"verification_url"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// URL returns the url the user must visit to authorize the device.
+func (d *DeviceCode) URL() string {
+	if d.VerificationURI != "" {
+		return d.VerificationURI
+	}
+
+	return d.VerificationURL
+}
+
+// OIDCTokens represents tokens issued by an OIDC provider.
+type OIDCTokens struct {
+	IDToken      string `json:"id_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// DeviceFlow performs an OAuth device authorization grant against an OIDC
+// provider.
+type DeviceFlow struct {
+	provider *OIDCProvider
+	client   *http.Client
+	tokenURL string
+}
+
+// NewDeviceFlow returns a new device flow for the given provider.
+func NewDeviceFlow(p *OIDCProvider) *DeviceFlow {
+	return &DeviceFlow{
+		provider: p,
+		client:   &http.Client{Timeout: deviceFlowTimeout},
+	}
+}
+
+// Start requests a new device code from the provider.
+func (d *DeviceFlow) Start(ctx context.Context) (*DeviceCode, error) {
+	deviceURL, err := d.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if deviceURL == "" {
+		return nil, fmt.Errorf("oidc provider %s does not support the device flow", d.provider.IssuerURL)
+	}
+
+	scopes := append([]string{"openid", "offline_access"}, d.provider.ExtraScopes...)
+	var code DeviceCode
+	if err := d.postForm(ctx, deviceURL, url.Values{"scope": {strings.Join(scopes, " ")}}, &code); err != nil {
+		return nil, err
+	}
+	if code.DeviceCode == "" || code.UserCode == "" {
+		return nil, errors.New("oidc provider returned an invalid device code")
+	}
+
+	return &code, nil
+}
+
+// Wait polls the provider until the device gets authorized, denied or the
+// code expires.
+func (d *DeviceFlow) Wait(ctx context.Context, code *DeviceCode) (*OIDCTokens, error) {
+	poll := defaultDevicePoll
+	if code.Interval > 0 {
+		poll = time.Duration(code.Interval) * time.Second
+	}
+	if code.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(code.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+
+	params := url.Values{
+		"grant_type":  {deviceCodeGrant},
+		"device_code": {code.DeviceCode},
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, errors.New("device authorization expired or canceled")
+		case <-time.After(poll):
+		}
+
+		var t OIDCTokens
+		err := d.postForm(ctx, d.tokenURL, params, &t)
+		if err == nil {
+			if t.IDToken == "" {
+				return nil, errors.New("oidc provider did not issue an id token")
+			}
+			return &t, nil
+		}
+		var oerr *oauthError
+		if !errors.As(err, &oerr) {
+			return nil, err
+		}
+		switch oerr.Code {
+		case "authorization_pending":
+		case "slow_down":
+			poll += deviceSlowDownStep
+		default:
+			return nil, err
+		}
+	}
+}
+
+// Refresh exchanges the provider refresh token for new tokens. The refresh
+// token is kept if the provider does not rotate it.
+func (d *DeviceFlow) Refresh(ctx context.Context) (*OIDCTokens, error) {
+	if d.provider.RefreshToken == "" {
+		return nil, errors.New("no refresh token available")
+	}
+	if d.tokenURL == "" {
+		if _, err := d.discover(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	params := url.Values{
+		"grant_type":    {refreshTokenGrant},
+		"refresh_token": {d.provider.RefreshToken},
+	}
+	var t OIDCTokens
+	if err := d.postForm(ctx, d.tokenURL, params, &t); err != nil {
+		return nil, err
+	}
+	if t.IDToken == "" {
+		return nil, errors.New("oidc provider did not issue an id token")
+	}
+	if t.RefreshToken == "" {
+		t.RefreshToken = d.provider.RefreshToken
+	}
+
+	return &t, nil
+}
+
+// discover looks up the provider endpoints and returns its device
+// authorization endpoint if any.
+func (d *DeviceFlow) discover(ctx context.Context) (string, error) {
+	var disco struct {
+		DeviceURL string `json:"device_authorization_endpoint"`
+		TokenURL  string `json:"token_endpoint"`
+	}
+	issuer := strings.TrimSuffix(d.provider.IssuerURL, "/")
+	if err := d.getJSON(ctx, issuer+oidcDiscoveryPath, &disco); err != nil {
+		return "", err
+	}
+	d.tokenURL = disco.TokenURL
+
+	return disco.DeviceURL, nil
+}
+
+func (d *DeviceFlow) getJSON(ctx context.Context, u string, res interface{}) error {
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+
+	return d.do(req.WithContext(ctx), res)
+}
+
+func (d *DeviceFlow) postForm(ctx context.Context, u string, params url.Values, res interface{}) error {
+	params.Set("client_id", d.provider.ClientID)
+	if d.provider.ClientSecret != "" {
+		params.Set("client_secret", d.provider.ClientSecret)
+	}
+	req, err := http.NewRequest(http.MethodPost, u, strings.NewReader(params.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return d.do(req.WithContext(ctx), res)
+}
+
+func (d *DeviceFlow) do(req *http.Request, res interface{}) error {
+	req.Header.Set("Accept", "application/json")
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var oerr oauthError
+		if err := json.NewDecoder(resp.Body).Decode(&oerr); err != nil || oerr.Code == "" {
+			return fmt.Errorf("%s %s failed -- %s", req.Method, req.URL, resp.Status)
+		}
+		return &oerr
+	}
+
+	return json.NewDecoder(resp.Body).Decode(res)
+}
+
+// oauthError represents an OAuth error response.
+type oauthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+// Error returns the error message.
+func (e *oauthError) Error() string {
+	if e.Description == "" {
+		return e.Code
+	}
+
+	return e.Code + " -- " + e.Description
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// tokenExpiry returns the expiry of a JWT. The token signature is not checked.
+func tokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("invalid jwt")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}, err
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(raw, &claims); err != nil {
+		return time.Time{}, err
+	}
+	if claims.Exp == 0 {
+		return time.Time{}, errors.New("jwt has no expiry")
+	}
+
+	return time.Unix(claims.Exp, 0), nil
+}
@@ -0,0 +1,158 @@
+package client_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOIDCProviderExpired(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		token string
+		e     bool
+	}{
+		"valid":   {token: makeJWT(now.Add(time.Hour))},
+		"expired": {token: makeJWT(now.Add(-time.Hour)), e: true},
+		"missing": {e: true},
+		"garbled": {token: "fred.blee.duh", e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p := client.OIDCProvider{IDToken: u.token}
+			assert.Equal(t, u.e, p.Expired(now))
+		})
+	}
+}
+
+func TestDeviceFlow(t *testing.T) {
+	uu := map[string]struct {
+		tokenStatus int
+		tokenBody   string
+		err         bool
+	}{
+		"authorized": {
+			tokenStatus: http.StatusOK,
+			tokenBody:   `{"id_token": "fred", "refresh_token": "blee"}`,
+		},
+		"denied": {
+			tokenStatus: http.StatusBadRequest,
+			tokenBody:   `{"error": "access_denied"}`,
+			err:         true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mux := http.NewServeMux()
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+			mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"device_authorization_endpoint": "%[1]s/device", "token_endpoint": "%[1]s/token"}`, srv.URL)
+			})
+			mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+				assert.Nil(t, r.ParseForm())
+				assert.Equal(t, "k9s", r.Form.Get("client_id"))
+				assert.Equal(t, "openid offline_access groups", r.Form.Get("scope"))
+				fmt.Fprint(w, `{"device_code": "dc", "user_code": "ABCD-EFGH", "verification_uri": "https://idp/device", "interval": 1}`)
+			})
+			mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+				assert.Nil(t, r.ParseForm())
+				assert.Equal(t, "dc", r.Form.Get("device_code"))
+				w.WriteHeader(u.tokenStatus)
+				fmt.Fprint(w, u.tokenBody)
+			})
+
+			f := client.NewDeviceFlow(&client.OIDCProvider{
+				IssuerURL:   srv.URL + "/",
+				ClientID:    "k9s",
+				ExtraScopes: []string{"groups"},
+			})
+			code, err := f.Start(context.Background())
+			assert.Nil(t, err)
+			assert.Equal(t, "ABCD-EFGH", code.UserCode)
+			assert.Equal(t, "https://idp/device", code.URL())
+
+			tokens, err := f.Wait(context.Background(), code)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, &client.OIDCTokens{IDToken: "fred", RefreshToken: "blee"}, tokens)
+		})
+	}
+}
+
+func TestDeviceFlowRefresh(t *testing.T) {
+	uu := map[string]struct {
+		refresh, body string
+		e             *client.OIDCTokens
+		err           bool
+	}{
+		"rotated": {
+			refresh: "blee",
+			body:    `{"id_token": "fred1", "refresh_token": "blee1"}`,
+			e:       &client.OIDCTokens{IDToken: "fred1", RefreshToken: "blee1"},
+		},
+		"kept": {
+			refresh: "blee",
+			body:    `{"id_token": "fred1"}`,
+			e:       &client.OIDCTokens{IDToken: "fred1", RefreshToken: "blee"},
+		},
+		"noRefresh": {
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mux := http.NewServeMux()
+			srv := httptest.NewServer(mux)
+			defer srv.Close()
+			mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintf(w, `{"token_endpoint": "%s/token"}`, srv.URL)
+			})
+			mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+				assert.Nil(t, r.ParseForm())
+				assert.Equal(t, "refresh_token", r.Form.Get("grant_type"))
+				assert.Equal(t, u.refresh, r.Form.Get("refresh_token"))
+				fmt.Fprint(w, u.body)
+			})
+
+			f := client.NewDeviceFlow(&client.OIDCProvider{
+				IssuerURL:    srv.URL,
+				ClientID:     "k9s",
+				RefreshToken: u.refresh,
+			})
+			tokens, err := f.Refresh(context.Background())
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, tokens)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeJWT(exp time.Time) string {
+	raw, _ := json.Marshal(map[string]int64{"exp": exp.Unix()})
+
+	return "e30." + base64.RawURLEncoding.EncodeToString(raw) + ".sig"
+}
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/discovery"
+	restclient "k8s.io/client-go/rest"
+)
+
+// DefaultProbeTimeout represents the default context probe timeout.
+const DefaultProbeTimeout = 5 * time.Second
+
+// Probe represents a context reachability check.
+type Probe struct {
+	Reachable bool
+	Latency   time.Duration
+	Version   string
+	Err       error
+	At        time.Time
+}
+
+// ProbeContext checks the api server of a given context is reachable and
+// measures its latency.
+func ProbeContext(cfg *Config, name string, timeout time.Duration) Probe {
+	p := Probe{At: time.Now()}
+	c, err := cfg.ContextConfig(name).RESTConfig()
+	if err != nil {
+		p.Err = err
+		return p
+	}
+	rc := restclient.CopyConfig(c)
+	rc.Timeout = timeout
+	dial, err := discovery.NewDiscoveryClientForConfig(rc)
+	if err != nil {
+		p.Err = err
+		return p
+	}
+	t := time.Now()
+	info, err := dial.ServerVersion()
+	if err != nil {
+		p.Err = err
+		return p
+	}
+	p.Reachable, p.Latency, p.Version = true, time.Since(t), info.GitVersion
+
+	return p
+}
+
+// CanProbe checks if a context can be probed unattended. Contexts relying on
+// exec or auth provider plugins are only probed on demand since plugins may
+// prompt for credentials or open a browser.
+func (c *Config) CanProbe(name string) bool {
+	cfg, err := c.RawConfig()
+	if err != nil {
+		return false
+	}
+	ctx, ok := cfg.Contexts[name]
+	if !ok {
+		return false
+	}
+	u, ok := cfg.AuthInfos[ctx.AuthInfo]
+	if !ok {
+		return true
+	}
+
+	return u.Exec == nil && u.AuthProvider == nil
+}
+
+// Probes tracks the latest probe of each context.
+type Probes struct {
+	probes map[string]Probe
+	mx     sync.RWMutex
+}
+
+// NewProbes returns a new probes tracker.
+func NewProbes() *Probes {
+	return &Probes{probes: make(map[string]Probe)}
+}
+
+// Get returns the latest probe of a context if any.
+func (p *Probes) Get(name string) (Probe, bool) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	pr, ok := p.probes[name]
+	return pr, ok
+}
+
+// Set records the latest probe of a context.
+func (p *Probes) Set(name string, pr Probe) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.probes[name] = pr
+}
@@ -0,0 +1,55 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestProbeContextInvalid(t *testing.T) {
+	kubeConfig := "./testdata/config"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+
+	p := client.ProbeContext(cfg, "zorg", time.Second)
+	assert.False(t, p.Reachable)
+	assert.NotNil(t, p.Err)
+}
+
+func TestProbes(t *testing.T) {
+	pp := client.NewProbes()
+
+	_, ok := pp.Get("fred")
+	assert.False(t, ok)
+
+	pp.Set("fred", client.Probe{Reachable: true, Latency: 10 * time.Millisecond, Version: "v1.16.2"})
+	p, ok := pp.Get("fred")
+	assert.True(t, ok)
+	assert.True(t, p.Reachable)
+	assert.Equal(t, "v1.16.2", p.Version)
+}
+
+func TestConfigCanProbe(t *testing.T) {
+	kubeConfig := "./testdata/config.probe"
+	cfg := client.NewConfig(&genericclioptions.ConfigFlags{KubeConfig: &kubeConfig})
+
+	uu := map[string]struct {
+		context string
+		e       bool
+	}{
+		"certs":        {context: "fred", e: true},
+		"exec":         {context: "blee"},
+		"authProvider": {context: "duh"},
+		"anonymous":    {context: "anon", e: true},
+		"missing":      {context: "zorg"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, cfg.CanProbe(u.context))
+		})
+	}
+}
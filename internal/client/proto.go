@@ -0,0 +1,52 @@
+package client
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	restclient "k8s.io/client-go/rest"
+)
+
+const (
+	// ProtobufContentType represents the kubernetes protobuf media type.
+	ProtobufContentType = "application/vnd.kubernetes.protobuf"
+
+	protobufAccept = ProtobufContentType + "," + runtime.ContentTypeJSON
+)
+
+// IsBuiltIn checks if a resource is a kubernetes built-in type that can be
+// transported as protobuf. Custom resources are only served as json.
+func IsBuiltIn(gvr GVR) bool {
+	if gvr.V() == "" || gvr.SubResource() != "" {
+		return false
+	}
+
+	return scheme.Scheme.IsVersionRegistered(gvr.GV())
+}
+
+// ProtoConfig returns a copy of a rest config negotiating protobuf with a
+// json fallback.
+func ProtoConfig(cfg *restclient.Config) *restclient.Config {
+	c := restclient.CopyConfig(cfg)
+	c.ContentType = ProtobufContentType
+	c.AcceptContentTypes = protobufAccept
+
+	return c
+}
+
+// ProtoRESTClient returns a rest client negotiating protobuf for a built-in
+// resource group version.
+func ProtoRESTClient(cfg *restclient.Config, gvr GVR) (restclient.Interface, error) {
+	c := ProtoConfig(cfg)
+	gv := gvr.GV()
+	c.GroupVersion = &gv
+	c.APIPath = "/apis"
+	if gv.Group == "" {
+		c.APIPath = "/api"
+	}
+	c.NegotiatedSerializer = scheme.Codecs.WithoutConversion()
+	if c.UserAgent == "" {
+		c.UserAgent = restclient.DefaultKubernetesUserAgent()
+	}
+
+	return restclient.RESTClientFor(c)
+}
@@ -0,0 +1,40 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	restclient "k8s.io/client-go/rest"
+)
+
+func TestIsBuiltIn(t *testing.T) {
+	uu := map[string]struct {
+		gvr string
+		e   bool
+	}{
+		"core":        {gvr: "v1/pods", e: true},
+		"group":       {gvr: "apps/v1/deployments", e: true},
+		"crd":         {gvr: "fred.example.com/v1/blees"},
+		"aggregated":  {gvr: "metrics.k8s.io/v1beta1/nodes"},
+		"subResource": {gvr: "v1/pods:logs"},
+		"alias":       {gvr: "pods"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, client.IsBuiltIn(client.NewGVR(u.gvr)))
+		})
+	}
+}
+
+func TestProtoConfig(t *testing.T) {
+	cfg := restclient.Config{Host: "https://fred:443"}
+	c := client.ProtoConfig(&cfg)
+
+	assert.Equal(t, client.ProtobufContentType, c.ContentType)
+	assert.Equal(t, client.ProtobufContentType+",application/json", c.AcceptContentTypes)
+	assert.Equal(t, cfg.Host, c.Host)
+	assert.Empty(t, cfg.ContentType)
+}
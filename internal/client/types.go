@@ -76,6 +76,7 @@ type Connection interface {
 	ValidNamespaces() ([]v1.Namespace, error)
 	ServerVersion() (*version.Info, error)
 	CheckConnectivity() bool
+	RefreshCredentials() error
 }
 
 // CurrentMetrics tracks current cpu/mem.
@@ -0,0 +1,141 @@
+package config
+
+// HighContrastSkin names the skin built off the accessibility roles.
+const HighContrastSkin = "high-contrast"
+
+// Accessibility tracks accessibility settings.
+type Accessibility struct {
+	// HighContrast replaces the active skin with a high contrast skin built
+	// off semantic color roles.
+	HighContrast bool `yaml:"highContrast"`
+
+	// StatusGlyphs prefixes rows and flash messages with a glyph or a label
+	// conveying their status so it does not rely on color alone.
+	StatusGlyphs bool `yaml:"statusGlyphs"`
+
+	// Announce names a file or a terminal device selection and flash changes
+	// are written to as plain lines for screen readers to speak.
+	Announce string `yaml:"announce,omitempty"`
+
+	// Roles customizes the high contrast skin colors.
+	Roles *Roles `yaml:"roles,omitempty"`
+}
+
+// Roles tracks the colors of semantic roles.
+type Roles struct {
+	Fg     Color `yaml:"fg"`
+	Bg     Color `yaml:"bg"`
+	Accent Color `yaml:"accent"`
+	Muted  Color `yaml:"muted"`
+	Info   Color `yaml:"info"`
+	Ok     Color `yaml:"ok"`
+	Warn   Color `yaml:"warn"`
+	Error  Color `yaml:"error"`
+}
+
+// NewAccessibility returns a new accessibility configuration.
+func NewAccessibility() *Accessibility {
+	a := Accessibility{}
+	a.Validate()
+
+	return &a
+}
+
+// Validate sets defaults for unspecified settings.
+func (a *Accessibility) Validate() {
+	if a.Roles == nil {
+		a.Roles = NewHighContrastRoles()
+		return
+	}
+	a.Roles.Validate()
+}
+
+// NewHighContrastRoles returns the default high contrast roles.
+func NewHighContrastRoles() *Roles {
+	return &Roles{
+		Fg:     "white",
+		Bg:     "black",
+		Accent: "yellow",
+		Muted:  "silver",
+		Info:   "aqua",
+		Ok:     "lime",
+		Warn:   "orange",
+		Error:  "red",
+	}
+}
+
+// Validate sets the unspecified roles to their high contrast defaults.
+func (r *Roles) Validate() {
+	d := NewHighContrastRoles()
+	for _, c := range []struct {
+		role *Color
+		def  Color
+	}{
+		{&r.Fg, d.Fg},
+		{&r.Bg, d.Bg},
+		{&r.Accent, d.Accent},
+		{&r.Muted, d.Muted},
+		{&r.Info, d.Info},
+		{&r.Ok, d.Ok},
+		{&r.Warn, d.Warn},
+		{&r.Error, d.Error},
+	} {
+		if *c.role == "" {
+			*c.role = c.def
+		}
+	}
+}
+
+// Style returns a skin built off the roles. Each row status is given a
+// distinct color so statuses may still be told apart.
+func (r *Roles) Style() Style {
+	return Style{
+		Body: Body{FgColor: r.Fg, BgColor: r.Bg, LogoColor: r.Accent},
+		Frame: Frame{
+			Title: Title{
+				FgColor:        r.Fg,
+				BgColor:        r.Bg,
+				HighlightColor: r.Accent,
+				CounterColor:   r.Info,
+				FilterColor:    r.Ok,
+			},
+			Border: Border{FgColor: r.Muted, FocusColor: r.Accent},
+			Menu:   Menu{FgColor: r.Fg, KeyColor: r.Accent, NumKeyColor: r.Info},
+			Crumb:  Crumb{FgColor: r.Bg, BgColor: r.Fg, ActiveColor: r.Accent},
+			Status: Status{
+				NewColor:       r.Fg,
+				ModifyColor:    r.Info,
+				AddColor:       r.Ok,
+				ErrorColor:     r.Error,
+				HighlightColor: r.Accent,
+				KillColor:      r.Warn,
+				CompletedColor: r.Muted,
+			},
+		},
+		Info: Info{SectionColor: r.Fg, FgColor: r.Accent},
+		Views: Views{
+			Table: Table{
+				FgColor:     r.Fg,
+				BgColor:     r.Bg,
+				CursorColor: r.Accent,
+				MarkColor:   r.Info,
+				Header:      TableHeader{FgColor: r.Fg, BgColor: r.Bg, SorterColor: r.Accent},
+			},
+			Xray: Xray{
+				FgColor:      r.Fg,
+				BgColor:      r.Bg,
+				CursorColor:  r.Accent,
+				GraphicColor: r.Muted,
+			},
+			Charts: Charts{
+				BgColor:            r.Bg,
+				DialBgColor:        r.Bg,
+				ChartBgColor:       r.Bg,
+				DefaultDialColors:  Colors{r.Ok, r.Error},
+				DefaultChartColors: Colors{r.Ok, r.Error},
+			},
+			Yaml: Yaml{KeyColor: r.Accent, ValueColor: r.Fg, ColonColor: r.Muted},
+			Log:  Log{FgColor: r.Fg, BgColor: r.Bg},
+		},
+	}
+}
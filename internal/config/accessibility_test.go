@@ -0,0 +1,56 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessibilityValidate(t *testing.T) {
+	uu := map[string]struct {
+		a config.Accessibility
+		e config.Roles
+	}{
+		"defaults": {
+			e: *config.NewHighContrastRoles(),
+		},
+		"custom": {
+			a: config.Accessibility{Roles: &config.Roles{Accent: "aqua", Error: "fuchsia"}},
+			e: config.Roles{
+				Fg:     "white",
+				Bg:     "black",
+				Accent: "aqua",
+				Muted:  "silver",
+				Info:   "aqua",
+				Ok:     "lime",
+				Warn:   "orange",
+				Error:  "fuchsia",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.a.Validate()
+			assert.Equal(t, u.e, *u.a.Roles)
+		})
+	}
+}
+
+func TestRolesStyle(t *testing.T) {
+	s := config.NewHighContrastRoles().Style()
+
+	assert.Equal(t, config.Color("white"), s.Body.FgColor)
+	assert.Equal(t, config.Color("black"), s.Views.Table.BgColor)
+	assert.Equal(t, config.Color("yellow"), s.Views.Table.CursorColor)
+	assert.False(t, s.Views.Xray.ShowIcons)
+
+	st := s.Frame.Status
+	cc := map[config.Color]struct{}{}
+	for _, c := range []config.Color{st.NewColor, st.ModifyColor, st.AddColor, st.ErrorColor, st.HighlightColor, st.KillColor, st.CompletedColor} {
+		cc[c] = struct{}{}
+	}
+	assert.Equal(t, 7, len(cc))
+}
@@ -0,0 +1,154 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+)
+
+// K9sAlerts manages K9s alert rules.
+var K9sAlerts = filepath.Join(K9sHome, "alert.yml")
+
+const (
+	// AlertWarn represents a warning alert.
+	AlertWarn = "warn"
+	// AlertError represents an error alert.
+	AlertError = "error"
+
+	// WebhookHTTP represents a generic http webhook.
+	WebhookHTTP = "http"
+	// WebhookSlack represents a slack incoming webhook.
+	WebhookSlack = "slack"
+)
+
+// Alerts represents a collection of alert rules and their notification sinks.
+type Alerts struct {
+	Alert    map[string]AlertRule `yaml:"alert"`
+	Webhooks map[string]Webhook   `yaml:"webhooks,omitempty"`
+}
+
+// Webhook describes an http endpoint fired alerts are posted to.
+type Webhook struct {
+	// Kind represents the webhook flavor, either http (the default) or slack.
+	Kind string `yaml:"kind"`
+
+	// URL represents the webhook url.
+	URL string `yaml:"url"`
+
+	// Headers represents extra request headers.
+	Headers map[string]string `yaml:"headers,omitempty"`
+
+	// Template represents a go template rendering the request payload.
+	// Defaults to a json alert list for http webhooks or a slack message.
+	Template string `yaml:"template,omitempty"`
+
+	// Raw disables the json escaping of template values.
+	Raw bool `yaml:"raw,omitempty"`
+}
+
+// AlertRule describes a condition raising an alert when matched by a resource.
+type AlertRule struct {
+	ContextScope `yaml:",inline"`
+
+	GVR       string   `yaml:"gvr"`
+	Condition string   `yaml:"condition"`
+	Message   string   `yaml:"message"`
+	Severity  string   `yaml:"severity"`
+	Webhooks  []string `yaml:"webhooks,omitempty"`
+
+	// ClusterWide scans resources across all namespaces rather than the
+	// active one.
+	ClusterWide bool `yaml:"clusterWide,omitempty"`
+}
+
+// NewAlerts returns a new alert rules collection.
+func NewAlerts() Alerts {
+	return Alerts{
+		Alert:    make(map[string]AlertRule),
+		Webhooks: make(map[string]Webhook),
+	}
+}
+
+// Validate checks the rule is well formed.
+func (r AlertRule) Validate() error {
+	if r.GVR == "" {
+		return errors.New("no gvr specified")
+	}
+	if r.Condition == "" {
+		return errors.New("no condition specified")
+	}
+	switch r.Severity {
+	case "", AlertWarn, AlertError:
+	default:
+		return fmt.Errorf("invalid severity %q. Must be one of %s or %s", r.Severity, AlertWarn, AlertError)
+	}
+
+	return nil
+}
+
+// Validate checks the webhook is well formed.
+func (w Webhook) Validate() error {
+	switch w.Kind {
+	case "", WebhookHTTP, WebhookSlack:
+	default:
+		return fmt.Errorf("invalid kind %q. Must be one of %s or %s", w.Kind, WebhookHTTP, WebhookSlack)
+	}
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url %q", w.URL)
+	}
+
+	return nil
+}
+
+// Level returns the rule severity, defaulting to a warning.
+func (r AlertRule) Level() string {
+	if r.Severity == "" {
+		return AlertWarn
+	}
+
+	return r.Severity
+}
+
+// Load K9s alert rules. Webhooks are only honored from the user alert file so
+// shared configs can not send cluster data elsewhere.
+func (a Alerts) Load() error {
+	for _, f := range ConfigLayers(K9sAlerts) {
+		if err := a.load(f, f == K9sAlerts); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadAlerts loads alert rules and webhooks from a given file.
+func (a Alerts) LoadAlerts(path string) error {
+	return a.load(path, true)
+}
+
+func (a Alerts) load(path string, webhooks bool) error {
+	return readIncludes(path, func(raw []byte) error {
+		var aa Alerts
+		if err := yaml.Unmarshal(raw, &aa); err != nil {
+			return err
+		}
+		for k, v := range aa.Alert {
+			a.Alert[k] = v
+		}
+		if !webhooks && len(aa.Webhooks) > 0 {
+			log.Warn().Msgf("Skipping webhooks defined outside of %s in %s", K9sAlerts, path)
+			return nil
+		}
+		for k, v := range aa.Webhooks {
+			a.Webhooks[k] = v
+		}
+
+		return nil
+	})
+}
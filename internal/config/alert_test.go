@@ -0,0 +1,94 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertsLoad(t *testing.T) {
+	a := config.NewAlerts()
+	assert.Nil(t, a.LoadAlerts("testdata/alert.yml"))
+
+	assert.Equal(t, 2, len(a.Alert))
+	r, ok := a.Alert["crashing"]
+	assert.True(t, ok)
+	assert.Equal(t, "v1/pods", r.GVR)
+	assert.Equal(t, "Too many restarts", r.Message)
+	assert.Equal(t, config.AlertError, r.Level())
+	assert.Nil(t, r.Validate())
+
+	r, ok = a.Alert["notReady"]
+	assert.True(t, ok)
+	assert.Equal(t, config.AlertWarn, r.Level())
+	assert.True(t, r.InContext("prod", "c1"))
+	assert.False(t, r.InContext("dev", "c1"))
+	assert.Equal(t, []string{"team"}, r.Webhooks)
+
+	assert.Equal(t, 1, len(a.Webhooks))
+	w, ok := a.Webhooks["team"]
+	assert.True(t, ok)
+	assert.Equal(t, config.WebhookSlack, w.Kind)
+	assert.Nil(t, w.Validate())
+}
+
+func TestAlertsLoadLayers(t *testing.T) {
+	defer useConfigDirs("testdata", "/tmp/k9s-test/nope")()
+
+	a := config.NewAlerts()
+	assert.Nil(t, a.Load())
+
+	assert.Equal(t, 2, len(a.Alert))
+	assert.Equal(t, 0, len(a.Webhooks))
+}
+
+func TestAlertRuleValidate(t *testing.T) {
+	uu := map[string]struct {
+		r   config.AlertRule
+		err string
+	}{
+		"ok":       {r: config.AlertRule{GVR: "v1/pods", Condition: "True"}},
+		"noGVR":    {r: config.AlertRule{Condition: "True"}, err: "no gvr specified"},
+		"noCond":   {r: config.AlertRule{GVR: "v1/pods"}, err: "no condition specified"},
+		"badLevel": {r: config.AlertRule{GVR: "v1/pods", Condition: "True", Severity: "fred"}, err: `invalid severity "fred". Must be one of warn or error`},
+		"errLevel": {r: config.AlertRule{GVR: "v1/pods", Condition: "True", Severity: config.AlertError}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.r.Validate()
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestWebhookValidate(t *testing.T) {
+	uu := map[string]struct {
+		w   config.Webhook
+		err string
+	}{
+		"ok":      {w: config.Webhook{URL: "https://example.com/hook"}},
+		"slack":   {w: config.Webhook{Kind: config.WebhookSlack, URL: "https://hooks.slack.com/services/x"}},
+		"badKind": {w: config.Webhook{Kind: "fred", URL: "https://example.com/hook"}, err: `invalid kind "fred". Must be one of http or slack`},
+		"noURL":   {w: config.Webhook{}, err: `invalid url ""`},
+		"badURL":  {w: config.Webhook{URL: "example.com/hook"}, err: `invalid url "example.com/hook"`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.w.Validate()
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
@@ -2,10 +2,13 @@ package config
 
 import (
 	"io/ioutil"
+	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 
 	"github.com/rs/zerolog/log"
+	"github.com/sahilm/fuzzy"
 	"gopkg.in/yaml.v2"
 )
 
@@ -67,6 +70,33 @@ func (a *Aliases) Get(k string) (string, bool) {
 	return v, ok
 }
 
+// Suggest returns aliases fuzzy matching a query, best match first.
+// Only the best matching alias is kept for each resource.
+func (a *Aliases) Suggest(q string, max int) []string {
+	a.mx.RLock()
+	defer a.mx.RUnlock()
+
+	kk := make([]string, 0, len(a.Alias))
+	for k := range a.Alias {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	ss, seen := make([]string, 0, max), make(map[string]struct{})
+	for _, m := range fuzzy.Find(q, kk) {
+		gvr := a.Alias[m.Str]
+		if _, ok := seen[gvr]; ok {
+			continue
+		}
+		seen[gvr] = struct{}{}
+		if ss = append(ss, m.Str); len(ss) == max {
+			break
+		}
+	}
+
+	return ss
+}
+
 // Define declares a new alias.
 func (a *Aliases) Define(gvr string, aliases ...string) {
 	a.mx.Lock()
@@ -83,29 +113,37 @@ func (a *Aliases) Define(gvr string, aliases ...string) {
 // Load K9s aliases.
 func (a *Aliases) Load() error {
 	a.loadDefaultAliases()
-	return a.LoadFileAliases(K9sAlias)
+	for _, f := range ConfigLayers(K9sAlias) {
+		if err := a.LoadFileAliases(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // LoadFileAliases loads alias from a given file.
 func (a *Aliases) LoadFileAliases(path string) error {
-	f, err := ioutil.ReadFile(path)
-	if err != nil {
-		log.Debug().Err(err).Msgf("No custom aliases found")
-		return nil
-	}
+	err := readIncludes(path, func(raw []byte) error {
+		var aa Aliases
+		if err := yaml.Unmarshal(raw, &aa); err != nil {
+			return err
+		}
 
-	var aa Aliases
-	if err := yaml.Unmarshal(f, &aa); err != nil {
-		return err
-	}
+		a.mx.Lock()
+		defer a.mx.Unlock()
+		for k, v := range aa.Alias {
+			a.Alias[k] = v
+		}
 
-	a.mx.Lock()
-	defer a.mx.Unlock()
-	for k, v := range aa.Alias {
-		a.Alias[k] = v
+		return nil
+	})
+	if os.IsNotExist(err) {
+		log.Debug().Err(err).Msgf("No custom aliases found in %s", path)
+		return nil
 	}
 
-	return nil
+	return err
 }
 
 func (a *Aliases) loadDefaultAliases() {
@@ -157,6 +195,22 @@ func (a *Aliases) loadDefaultAliases() {
 		a.Alias["screendump"] = dumps
 		a.Alias[dumps] = dumps
 	}
+	const audit = "audit"
+	{
+		a.Alias["au"] = audit
+		a.Alias[audit] = audit
+	}
+	const alerts = "alerts"
+	{
+		a.Alias["al"] = alerts
+		a.Alias["alert"] = alerts
+		a.Alias[alerts] = alerts
+	}
+	const caches = "caches"
+	{
+		a.Alias["cache"] = caches
+		a.Alias[caches] = caches
+	}
 	const pulses = "pulses"
 	{
 		a.Alias["hz"] = pulses
@@ -69,6 +69,48 @@ func TestAliasDefine(t *testing.T) {
 	}
 }
 
+func TestAliasSuggest(t *testing.T) {
+	a := config.NewAliases()
+	a.Define("apps/v1/deployments", "dp", "deploy", "deployment", "deployments")
+	a.Define("apps/v1/statefulsets", "sts", "statefulset", "statefulsets")
+	a.Define("networking.k8s.io/v1beta1/ingresses", "ing", "ingress", "ingresses")
+	a.Define("policy/v1beta1/podsecuritypolicies", "psp", "podsecuritypolicy", "podsecuritypolicies")
+
+	uu := map[string]struct {
+		q, gvr string
+		count  int
+	}{
+		"deploy": {q: "dply", gvr: "apps/v1/deployments", count: 2},
+		"sts":    {q: "stfs", gvr: "apps/v1/statefulsets", count: 1},
+		"ing":    {q: "ingr", gvr: "networking.k8s.io/v1beta1/ingresses", count: 1},
+		"none":   {q: "zorg"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ss := a.Suggest(u.q, 5)
+			assert.Equal(t, u.count, len(ss))
+			if u.count == 0 {
+				return
+			}
+			gvr, ok := a.Get(ss[0])
+			assert.True(t, ok)
+			assert.Equal(t, u.gvr, gvr)
+		})
+	}
+}
+
+func TestAliasSuggestMax(t *testing.T) {
+	a := config.NewAliases()
+	a.Define("v1/pods", "po", "pod", "pods")
+	a.Define("v1/persistentvolumes", "pv")
+	a.Define("v1/persistentvolumeclaims", "pvc")
+
+	assert.Equal(t, 2, len(a.Suggest("pv", 5)))
+	assert.Equal(t, 1, len(a.Suggest("pv", 1)))
+}
+
 func TestAliasesLoad(t *testing.T) {
 	a := config.NewAliases()
 
@@ -0,0 +1,96 @@
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// K9sAuditFile represents the location of the audit log.
+var K9sAuditFile = filepath.Join(K9sHome, "audit.log")
+
+const (
+	// AuditSuccess represents a successful action.
+	AuditSuccess = "success"
+	// AuditFailure represents a failed action.
+	AuditFailure = "failure"
+)
+
+// AuditEntry represents a mutating or sensitive action performed via K9s.
+type AuditEntry struct {
+	Time    time.Time `json:"time"`
+	User    string    `json:"user"`
+	Context string    `json:"context"`
+	Cluster string    `json:"cluster"`
+	Action  string    `json:"action"`
+	GVR     string    `json:"gvr"`
+	Name    string    `json:"name"`
+	Outcome string    `json:"outcome"`
+	Error   string    `json:"error,omitempty"`
+}
+
+// NewAuditEntry returns a new audit entry given an action outcome.
+func NewAuditEntry(action, gvr, name string, err error) AuditEntry {
+	e := AuditEntry{
+		Time:    time.Now(),
+		Action:  action,
+		GVR:     gvr,
+		Name:    name,
+		Outcome: AuditSuccess,
+	}
+	if err != nil {
+		e.Outcome, e.Error = AuditFailure, err.Error()
+	}
+
+	return e
+}
+
+// AppendAudit appends an entry to the given audit log.
+func AppendAudit(path string, e AuditEntry) error {
+	EnsurePath(path, DefaultDirMod)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing audit log")
+		}
+	}()
+
+	return json.NewEncoder(f).Encode(e)
+}
+
+// LoadAudit loads all entries from the given audit log, oldest first.
+// Malformed entries are skipped.
+func LoadAudit(path string) ([]AuditEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing audit log")
+		}
+	}()
+
+	var ee []AuditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			log.Warn().Err(err).Msgf("Skipping invalid audit entry")
+			continue
+		}
+		ee = append(ee, e)
+	}
+
+	return ee, scanner.Err()
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"net"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// AuditSourceFile represents an audit log written by the api server log backend.
+	AuditSourceFile = "file"
+	// AuditSourceWebhook represents an audit webhook backend served by K9s.
+	AuditSourceWebhook = "webhook"
+
+	defaultAuditEventsMax   = 1000
+	defaultAuditWebhookHost = "localhost"
+	defaultAuditWebhookAddr = defaultAuditWebhookHost + ":9443"
+)
+
+// AuditEvents tracks where Kubernetes audit events are streamed from.
+type AuditEvents struct {
+	// Source represents either file or webhook. An empty source disables the stream.
+	Source string `yaml:"source"`
+
+	// Path represents the audit log path for the file source.
+	Path string `yaml:"path,omitempty"`
+
+	// Address represents the webhook listen address, ie localhost:9443.
+	// Addresses without a host listen on the loopback interface.
+	Address string `yaml:"address,omitempty"`
+
+	// CertFile represents the webhook TLS certificate.
+	CertFile string `yaml:"certFile,omitempty"`
+
+	// KeyFile represents the webhook TLS key.
+	KeyFile string `yaml:"keyFile,omitempty"`
+
+	// Token represents the bearer token the api server must present.
+	Token string `yaml:"token,omitempty"`
+
+	// MaxEvents represents the number of most recent events retained.
+	MaxEvents int `yaml:"maxEvents"`
+}
+
+// NewAuditEvents returns a new audit events configuration.
+func NewAuditEvents() *AuditEvents {
+	return &AuditEvents{MaxEvents: defaultAuditEventsMax}
+}
+
+// Validate disables the stream when misconfigured and fills in defaults.
+func (a *AuditEvents) Validate() {
+	if a.MaxEvents <= 0 {
+		a.MaxEvents = defaultAuditEventsMax
+	}
+	switch a.Source {
+	case "":
+	case AuditSourceFile:
+		if a.Path == "" {
+			log.Warn().Msg("No audit log path specified. Audit events disabled")
+			a.Source = ""
+		}
+	case AuditSourceWebhook:
+		a.validateWebhook()
+	default:
+		log.Warn().Msgf("Invalid audit events source %q. Audit events disabled", a.Source)
+		a.Source = ""
+	}
+}
+
+// validateWebhook defaults the webhook to the loopback interface. Other
+// interfaces are only served over TLS to api servers presenting a token.
+func (a *AuditEvents) validateWebhook() {
+	if a.Address == "" {
+		a.Address = defaultAuditWebhookAddr
+	}
+	host, port, err := net.SplitHostPort(a.Address)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Invalid audit webhook address %q. Audit events disabled", a.Address)
+		a.Source = ""
+		return
+	}
+	if host == "" {
+		host = defaultAuditWebhookHost
+		a.Address = net.JoinHostPort(host, port)
+	}
+	if (a.CertFile == "") != (a.KeyFile == "") {
+		log.Warn().Msg("Audit webhook requires both a certificate and a key. Audit events disabled")
+		a.Source = ""
+		return
+	}
+	if !isLoopback(host) && (a.CertFile == "" || a.Token == "") {
+		log.Warn().Msgf("Audit webhook on %q requires TLS and a token. Audit events disabled", a.Address)
+		a.Source = ""
+	}
+}
+
+// Enabled checks if audit events are streamed.
+func (a *AuditEvents) Enabled() bool {
+	return a.Source != ""
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,71 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEventsValidate(t *testing.T) {
+	uu := map[string]struct {
+		a, e AuditEvents
+	}{
+		"none": {
+			e: AuditEvents{MaxEvents: defaultAuditEventsMax},
+		},
+		"file": {
+			a: AuditEvents{Source: AuditSourceFile, Path: "/var/log/audit.log", MaxEvents: 10},
+			e: AuditEvents{Source: AuditSourceFile, Path: "/var/log/audit.log", MaxEvents: 10},
+		},
+		"noPath": {
+			a: AuditEvents{Source: AuditSourceFile},
+			e: AuditEvents{MaxEvents: defaultAuditEventsMax},
+		},
+		"webhook": {
+			a: AuditEvents{Source: AuditSourceWebhook},
+			e: AuditEvents{Source: AuditSourceWebhook, Address: defaultAuditWebhookAddr, MaxEvents: defaultAuditEventsMax},
+		},
+		"tls": {
+			a: AuditEvents{Source: AuditSourceWebhook, Address: ":8443", CertFile: "tls.crt", KeyFile: "tls.key"},
+			e: AuditEvents{Source: AuditSourceWebhook, Address: "localhost:8443", CertFile: "tls.crt", KeyFile: "tls.key", MaxEvents: defaultAuditEventsMax},
+		},
+		"loopback": {
+			a: AuditEvents{Source: AuditSourceWebhook, Address: "127.0.0.1:8443"},
+			e: AuditEvents{Source: AuditSourceWebhook, Address: "127.0.0.1:8443", MaxEvents: defaultAuditEventsMax},
+		},
+		"public": {
+			a: AuditEvents{Source: AuditSourceWebhook, Address: "0.0.0.0:8443", CertFile: "tls.crt", KeyFile: "tls.key", Token: "fred"},
+			e: AuditEvents{Source: AuditSourceWebhook, Address: "0.0.0.0:8443", CertFile: "tls.crt", KeyFile: "tls.key", Token: "fred", MaxEvents: defaultAuditEventsMax},
+		},
+		"publicNoTLS": {
+			a: AuditEvents{Source: AuditSourceWebhook, Address: "0.0.0.0:8443", Token: "fred"},
+			e: AuditEvents{Address: "0.0.0.0:8443", Token: "fred", MaxEvents: defaultAuditEventsMax},
+		},
+		"publicNoToken": {
+			a: AuditEvents{Source: AuditSourceWebhook, Address: "0.0.0.0:8443", CertFile: "tls.crt", KeyFile: "tls.key"},
+			e: AuditEvents{Address: "0.0.0.0:8443", CertFile: "tls.crt", KeyFile: "tls.key", MaxEvents: defaultAuditEventsMax},
+		},
+		"badAddress": {
+			a: AuditEvents{Source: AuditSourceWebhook, Address: "fred"},
+			e: AuditEvents{Address: "fred", MaxEvents: defaultAuditEventsMax},
+		},
+		"noKey": {
+			a: AuditEvents{Source: AuditSourceWebhook, CertFile: "tls.crt"},
+			e: AuditEvents{Address: defaultAuditWebhookAddr, CertFile: "tls.crt", MaxEvents: defaultAuditEventsMax},
+		},
+		"toast": {
+			a: AuditEvents{Source: "syslog"},
+			e: AuditEvents{MaxEvents: defaultAuditEventsMax},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a := u.a
+			a.Validate()
+			assert.Equal(t, u.e, a)
+			assert.Equal(t, u.e.Source != "", a.Enabled())
+		})
+	}
+}
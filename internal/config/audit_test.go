@@ -0,0 +1,66 @@
+package config_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAuditEntry(t *testing.T) {
+	uu := map[string]struct {
+		err          error
+		outcome, msg string
+	}{
+		"success": {outcome: config.AuditSuccess},
+		"failure": {err: errors.New("boom"), outcome: config.AuditFailure, msg: "boom"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			e := config.NewAuditEntry("delete", "v1/pods", "default/fred", u.err)
+			assert.Equal(t, "delete", e.Action)
+			assert.Equal(t, "v1/pods", e.GVR)
+			assert.Equal(t, "default/fred", e.Name)
+			assert.Equal(t, u.outcome, e.Outcome)
+			assert.Equal(t, u.msg, e.Error)
+			assert.False(t, e.Time.IsZero())
+		})
+	}
+}
+
+func TestAuditAppendLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-audit")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	ee, err := config.LoadAudit(path)
+	assert.Nil(t, err)
+	assert.Empty(t, ee)
+
+	e1 := config.NewAuditEntry("scale", "apps/v1/deployments", "default/fred", nil)
+	e1.User, e1.Context, e1.Cluster = "bozo", "ctx1", "c1"
+	assert.Nil(t, config.AppendAudit(path, e1))
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	assert.Nil(t, err)
+	_, err = f.WriteString("garbage\n")
+	assert.Nil(t, err)
+	assert.Nil(t, f.Close())
+	e2 := config.NewAuditEntry("delete", "v1/pods", "default/blee", errors.New("denied"))
+	assert.Nil(t, config.AppendAudit(path, e2))
+
+	ee, err = config.LoadAudit(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "bozo", ee[0].User)
+	assert.Equal(t, "scale", ee[0].Action)
+	assert.True(t, e1.Time.Equal(ee[0].Time))
+	assert.Equal(t, config.AuditFailure, ee[1].Outcome)
+	assert.Equal(t, "denied", ee[1].Error)
+}
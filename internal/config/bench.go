@@ -1,8 +1,12 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"sort"
+	"strings"
 
 	"gopkg.in/yaml.v2"
 )
@@ -21,8 +25,8 @@ type (
 	// Benchmarks tracks K9s benchmarks configuration.
 	Benchmarks struct {
 		Defaults   Benchmark              `yaml:"defaults"`
-		Services   map[string]BenchConfig `yam':"services"`
-		Containers map[string]BenchConfig `yam':"containers"`
+		Services   map[string]BenchConfig `yaml:"services"`
+		Containers map[string]BenchConfig `yaml:"containers"`
 	}
 
 	// Auth basic auth creds
@@ -44,16 +48,16 @@ type (
 		Path    string      `yaml:"path"`
 		HTTP2   bool        `yaml:"http2"`
 		Body    string      `yaml:"body"`
-		Headers http.Header `yaml:"headers"`
+		Headers http.Header `yaml:"headers,omitempty"`
 	}
 
 	// BenchConfig represents a service benchmark.
 	BenchConfig struct {
-		Name string
-		C    int  `yaml:"concurrency"`
-		N    int  `yaml:"requests"`
-		Auth Auth `yaml:"auth"`
-		HTTP HTTP `yaml:"http"`
+		Name string `yaml:"name,omitempty"`
+		C    int    `yaml:"concurrency"`
+		N    int    `yaml:"requests"`
+		Auth Auth   `yaml:"auth"`
+		HTTP HTTP   `yaml:"http"`
 	}
 )
 
@@ -66,6 +70,9 @@ const (
 	DefaultMethod = "GET"
 )
 
+// BenchMethods lists supported benchmark http verbs.
+var BenchMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+
 func newBenchmark() Benchmark {
 	return Benchmark{
 		C: DefaultC,
@@ -96,6 +103,35 @@ func (s *Bench) Reload(path string) error {
 	return s.load(path)
 }
 
+// Save writes out the benchmark configs to a given file.
+func (s *Bench) Save(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, DefaultFileMod)
+}
+
+// SetService sets a service benchmark spec.
+func (s *Bench) SetService(key string, cfg BenchConfig) {
+	if s.Benchmarks.Services == nil {
+		s.Benchmarks.Services = make(map[string]BenchConfig)
+	}
+	cfg.Name = ""
+	s.Benchmarks.Services[key] = cfg
+}
+
+// SetContainer sets a port-forward container benchmark spec.
+func (s *Bench) SetContainer(key string, cfg BenchConfig) {
+	if s.Benchmarks.Containers == nil {
+		s.Benchmarks.Containers = make(map[string]BenchConfig)
+	}
+	cfg.Name = ""
+	s.Benchmarks.Containers[key] = cfg
+}
+
 // Load K9s benchmark configs from file
 func (s *Bench) load(path string) error {
 	f, err := ioutil.ReadFile(path)
@@ -117,3 +153,62 @@ func DefaultBenchSpec() BenchConfig {
 		},
 	}
 }
+
+// Validate checks a benchmark spec.
+func (b BenchConfig) Validate() error {
+	if b.C <= 0 {
+		return errors.New("concurrency must be greater than 0")
+	}
+	if b.N <= 0 {
+		return errors.New("requests must be greater than 0")
+	}
+	if b.C > b.N {
+		return fmt.Errorf("concurrency %d exceeds requests %d", b.C, b.N)
+	}
+	if !InList(BenchMethods, b.HTTP.Method) {
+		return fmt.Errorf("invalid http method %q. Must be one of %s", b.HTTP.Method, strings.Join(BenchMethods, ", "))
+	}
+	if !strings.HasPrefix(b.HTTP.Path, "/") {
+		return fmt.Errorf("invalid http path %q. Must start with /", b.HTTP.Path)
+	}
+	if b.Auth.Password != "" && b.Auth.User == "" {
+		return errors.New("auth password requires a user")
+	}
+
+	return nil
+}
+
+// ParseHeaders parses semicolon separated `name: value` http headers.
+func ParseHeaders(s string) (http.Header, error) {
+	hh := make(http.Header)
+	for _, h := range strings.Split(s, ";") {
+		if strings.TrimSpace(h) == "" {
+			continue
+		}
+		tokens := strings.SplitN(h, ":", 2)
+		if len(tokens) != 2 || strings.TrimSpace(tokens[0]) == "" {
+			return nil, fmt.Errorf("invalid header %q. Expecting name: value", strings.TrimSpace(h))
+		}
+		hh.Add(strings.TrimSpace(tokens[0]), strings.TrimSpace(tokens[1]))
+	}
+
+	return hh, nil
+}
+
+// FormatHeaders formats http headers as semicolon separated `name: value` pairs.
+func FormatHeaders(hh http.Header) string {
+	kk := make([]string, 0, len(hh))
+	for k := range hh {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		for _, v := range hh[k] {
+			ss = append(ss, k+": "+v)
+		}
+	}
+
+	return strings.Join(ss, "; ")
+}
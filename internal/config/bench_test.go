@@ -1,7 +1,10 @@
 package config
 
 import (
+	"io/ioutil"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -188,3 +191,114 @@ func TestBenchContainerLoad(t *testing.T) {
 		})
 	}
 }
+
+func TestBenchConfigValidate(t *testing.T) {
+	uu := map[string]struct {
+		update func(*BenchConfig)
+		err    string
+	}{
+		"default": {update: func(*BenchConfig) {}},
+		"noC": {
+			update: func(b *BenchConfig) { b.C = 0 },
+			err:    "concurrency must be greater than 0",
+		},
+		"noN": {
+			update: func(b *BenchConfig) { b.N = -1 },
+			err:    "requests must be greater than 0",
+		},
+		"cOverN": {
+			update: func(b *BenchConfig) { b.C, b.N = 10, 5 },
+			err:    "concurrency 10 exceeds requests 5",
+		},
+		"method": {
+			update: func(b *BenchConfig) { b.HTTP.Method = "BOZO" },
+			err:    `invalid http method "BOZO". Must be one of GET, POST, PUT, PATCH, DELETE, HEAD, OPTIONS`,
+		},
+		"path": {
+			update: func(b *BenchConfig) { b.HTTP.Path = "fred" },
+			err:    `invalid http path "fred". Must start with /`,
+		},
+		"auth": {
+			update: func(b *BenchConfig) { b.Auth.Password = "blee" },
+			err:    "auth password requires a user",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			b := DefaultBenchSpec()
+			u.update(&b)
+			err := b.Validate()
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestParseHeaders(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   http.Header
+		err bool
+	}{
+		"empty": {e: http.Header{}},
+		"single": {
+			s: "Accept: text/html",
+			e: http.Header{"Accept": []string{"text/html"}},
+		},
+		"multi": {
+			s: "Accept: text/html; Accept: application/json;X-Blee:a:b;",
+			e: http.Header{"Accept": []string{"text/html", "application/json"}, "X-Blee": []string{"a:b"}},
+		},
+		"toast":  {s: "Accept", err: true},
+		"noName": {s: ": fred", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			hh, err := ParseHeaders(u.s)
+			assert.Equal(t, u.err, err != nil)
+			if err == nil {
+				assert.Equal(t, u.e, hh)
+			}
+		})
+	}
+}
+
+func TestFormatHeaders(t *testing.T) {
+	hh := http.Header{"X-Blee": []string{"a:b"}, "Accept": []string{"text/html", "application/json"}}
+
+	s := FormatHeaders(hh)
+	assert.Equal(t, "Accept: text/html; Accept: application/json; X-Blee: a:b", s)
+	h, err := ParseHeaders(s)
+	assert.Nil(t, err)
+	assert.Equal(t, hh, h)
+}
+
+func TestBenchSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "bench-fred.yml")
+	b, err := NewBench(path)
+	assert.NotNil(t, err)
+	svc, co := DefaultBenchSpec(), DefaultBenchSpec()
+	svc.Name, svc.HTTP.Host = "default/nginx", "10.10.10.10"
+	co.C, co.N = 2, 10
+	b.SetService("default/nginx", svc)
+	b.SetContainer("default/fred:80", co)
+	assert.Nil(t, b.Save(path))
+
+	bb, err := NewBench(path)
+	assert.Nil(t, err)
+	svc.Name = ""
+	assert.Equal(t, svc, bb.Benchmarks.Services["default/nginx"])
+	assert.Equal(t, co, bb.Benchmarks.Containers["default/fred:80"])
+	assert.Equal(t, DefaultC, bb.Benchmarks.Defaults.C)
+}
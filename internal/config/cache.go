@@ -0,0 +1,31 @@
+package config
+
+const megaByte = 1024 * 1024
+
+// Cache tracks resource caches settings.
+type Cache struct {
+	// MaxMemory represents the approximate memory in MiB resource caches may
+	// use before least recently viewed resources are evicted. Zero means no
+	// limit.
+	MaxMemory int `yaml:"maxMemory"`
+}
+
+// NewCache returns a new cache configuration.
+func NewCache() *Cache {
+	c := Cache{}
+	c.Validate()
+
+	return &c
+}
+
+// Validate sets defaults for unspecified settings.
+func (c *Cache) Validate() {
+	if c.MaxMemory < 0 {
+		c.MaxMemory = 0
+	}
+}
+
+// Budget returns the caches memory budget in bytes or 0 if unlimited.
+func (c *Cache) Budget() int64 {
+	return int64(c.MaxMemory) * megaByte
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheBudget(t *testing.T) {
+	uu := map[string]struct {
+		c Cache
+		e int64
+	}{
+		"unlimited": {},
+		"negative":  {c: Cache{MaxMemory: -1}},
+		"budget":    {c: Cache{MaxMemory: 512}, e: 512 * 1024 * 1024},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.c.Validate()
+			assert.Equal(t, u.e, u.c.Budget())
+		})
+	}
+}
@@ -4,13 +4,32 @@ import "github.com/derailed/k9s/internal/client"
 
 // Cluster tracks K9s cluster configuration.
 type Cluster struct {
-	Namespace *Namespace `yaml:"namespace"`
-	View      *View      `yaml:"view"`
+	Namespace    *Namespace    `yaml:"namespace,omitempty"`
+	View         *View         `yaml:"view,omitempty"`
+	FeatureGates *FeatureGates `yaml:"featureGates,omitempty"`
+	Guard        *Guard        `yaml:"guard,omitempty"`
+	ShellPod     *ShellPod     `yaml:"shellPod,omitempty"`
+	Tunnel       *Tunnel       `yaml:"tunnel,omitempty"`
+}
+
+// FeatureGates tracks opt-in features.
+type FeatureGates struct {
+	NodeShell bool `yaml:"nodeShell"`
 }
 
 // NewCluster creates a new cluster configuration.
 func NewCluster() *Cluster {
-	return &Cluster{Namespace: NewNamespace(), View: NewView()}
+	return &Cluster{
+		Namespace:    NewNamespace(),
+		View:         NewView(),
+		FeatureGates: NewFeatureGates(),
+		ShellPod:     NewShellPod(),
+	}
+}
+
+// NewFeatureGates returns a new feature gates configuration.
+func NewFeatureGates() *FeatureGates {
+	return &FeatureGates{}
 }
 
 // Validate a cluster config.
@@ -24,4 +43,13 @@ func (c *Cluster) Validate(conn client.Connection, ks KubeSettings) {
 		c.View = NewView()
 	}
 	c.View.Validate()
+
+	if c.FeatureGates == nil {
+		c.FeatureGates = NewFeatureGates()
+	}
+
+	if c.ShellPod == nil {
+		c.ShellPod = NewShellPod()
+	}
+	c.ShellPod.Validate()
 }
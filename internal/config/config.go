@@ -1,11 +1,13 @@
 package config
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
@@ -23,6 +25,8 @@ var (
 	K9sLogs = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-%s.log", MustK9sUser()))
 	// K9sDumpDir represents a directory where K9s screen dumps will be persisted.
 	K9sDumpDir = filepath.Join(os.TempDir(), fmt.Sprintf("k9s-screens-%s", MustK9sUser()))
+	// K9sScriptsDir represents a directory where K9s user scripts reside.
+	K9sScriptsDir = filepath.Join(K9sHome, "scripts")
 )
 
 type (
@@ -49,6 +53,7 @@ type (
 		K9s      *K9s `yaml:"k9s"`
 		client   client.Connection
 		settings KubeSettings
+		raw      []byte
 	}
 )
 
@@ -105,29 +110,33 @@ func (c *Config) Reset() {
 
 // CurrentCluster fetch the configuration activeCluster.
 func (c *Config) CurrentCluster() *Cluster {
-	if c, ok := c.K9s.Clusters[c.K9s.CurrentCluster]; ok {
-		return c
+	if c.K9s.CurrentCluster == "" {
+		return nil
 	}
-	return nil
+	return c.K9s.ActiveCluster()
 }
 
 // ActiveNamespace returns the active namespace in the current cluster.
 func (c *Config) ActiveNamespace() string {
-	if cl := c.CurrentCluster(); cl != nil {
-		if cl.Namespace != nil {
-			return cl.Namespace.Active
-		}
+	if cl := c.K9s.ActiveCluster(); cl.Namespace != nil {
+		return cl.Namespace.Active
 	}
 	return "default"
 }
 
-// FavNamespaces returns fav namespaces in the current cluster.
+// FavNamespaces returns fav namespaces in the current cluster, pinned and
+// most used ones first.
 func (c *Config) FavNamespaces() []string {
 	cl := c.K9s.ActiveCluster()
-	if cl != nil {
-		return c.K9s.ActiveCluster().Namespace.Favorites
+	if cl == nil {
+		return []string{}
+	}
+	nn := cl.Namespace.Ranked(time.Now())
+	if len(nn) > MaxFavoritesNS {
+		nn = nn[:MaxFavoritesNS]
 	}
-	return []string{}
+
+	return nn
 }
 
 // SetActiveNamespace set the active namespace in the current cluster.
@@ -188,6 +197,38 @@ func (c *Config) Load(path string) error {
 	if cfg.K9s != nil {
 		c.K9s = cfg.K9s
 	}
+	c.K9s.snapshotClusters()
+	c.raw = f
+
+	return nil
+}
+
+// Changed checks if a given config file changed since last loaded or saved.
+func (c *Config) Changed(path string) bool {
+	f, err := ioutil.ReadFile(path)
+
+	return err == nil && !bytes.Equal(f, c.raw)
+}
+
+// Reload reloads K9s settings from a given file. The session state, namely
+// the active context, the clusters settings and the command line overrides,
+// is retained.
+func (c *Config) Reload(path string) error {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(f, &cfg); err != nil {
+		return err
+	}
+	k := NewK9s()
+	if cfg.K9s != nil {
+		k = cfg.K9s
+	}
+	k.retain(c.K9s)
+	c.K9s, c.raw = k, f
+
 	return nil
 }
 
@@ -195,18 +236,30 @@ func (c *Config) Load(path string) error {
 func (c *Config) Save() error {
 	log.Debug().Msg("[Config] Saving configuration...")
 	c.Validate()
+	if err := c.K9s.SaveLayers(); err != nil {
+		log.Error().Err(err).Msg("[Config] Unable to save cluster settings")
+		return err
+	}
 	return c.SaveFile(K9sConfigFile)
 }
 
-// SaveFile K9s configuration to disk.
+// SaveFile K9s configuration to disk. Clusters settings are persisted in
+// their own files.
 func (c *Config) SaveFile(path string) error {
 	EnsurePath(path, DefaultDirMod)
-	cfg, err := yaml.Marshal(c)
+	k := *c.K9s
+	k.Clusters = nil
+	cfg, err := yaml.Marshal(Config{K9s: &k})
 	if err != nil {
 		log.Error().Msgf("[Config] Unable to save K9s config file: %v", err)
 		return err
 	}
-	return ioutil.WriteFile(path, cfg, 0644)
+	if err := ioutil.WriteFile(path, cfg, 0644); err != nil {
+		return err
+	}
+	c.raw = cfg
+
+	return nil
 }
 
 // Validate the configuration.
@@ -218,7 +271,7 @@ func (c *Config) Validate() {
 func (c *Config) Dump(msg string) {
 	log.Debug().Msgf("Current Cluster: %s\n", c.K9s.CurrentCluster)
 	for k, cl := range c.K9s.Clusters {
-		log.Debug().Msgf("K9s cluster: %s -- %s\n", k, cl.Namespace)
+		log.Debug().Msgf("K9s cluster: %s -- %v\n", k, cl.Namespace)
 	}
 }
 
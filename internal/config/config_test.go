@@ -190,6 +190,25 @@ func TestConfigLoadCrap(t *testing.T) {
 	assert.NotNil(t, cfg.Load("testdata/k9s_not_there.yml"))
 }
 
+func TestConfigReload(t *testing.T) {
+	mk := NewMockKubeSettings()
+	cfg := config.NewConfig(mk)
+	assert.Nil(t, cfg.Load("testdata/k9s.yml"))
+	assert.False(t, cfg.Changed("testdata/k9s.yml"))
+	assert.True(t, cfg.Changed("testdata/k9s1.yml"))
+	assert.False(t, cfg.Changed("testdata/k9s_not_there.yml"))
+
+	cfg.K9s.OverrideReadOnly(true)
+	cfg.K9s.CurrentContext, cfg.K9s.CurrentCluster = "blee", "blee"
+	assert.Nil(t, cfg.Reload("testdata/k9s1.yml"))
+	assert.False(t, cfg.Changed("testdata/k9s1.yml"))
+	assert.Equal(t, "blee", cfg.K9s.CurrentContext)
+	assert.Equal(t, "blee", cfg.K9s.CurrentCluster)
+	assert.True(t, cfg.K9s.GetReadOnly())
+	assert.Equal(t, 10, cfg.K9s.RefreshRate)
+	assert.NotNil(t, cfg.Reload("testdata/k9s_not_there.yml"))
+}
+
 func TestConfigSaveFile(t *testing.T) {
 	mc := NewMockConnection()
 	m.When(mc.ValidNamespaces()).ThenReturn(namespaces(), nil)
@@ -260,6 +279,7 @@ func TestSetup(t *testing.T) {
 
 var expectedConfig = `k9s:
   refreshRate: 100
+  informerTTL: 300
   headless: false
   readOnly: true
   logBufferSize: 500
@@ -267,40 +287,14 @@ var expectedConfig = `k9s:
   currentContext: blee
   currentCluster: blee
   fullScreenLogs: false
-  clusters:
-    blee:
-      namespace:
-        active: default
-        favorites:
-        - default
-      view:
-        active: po
-    fred:
-      namespace:
-        active: default
-        favorites:
-        - default
-        - kube-public
-        - istio-system
-        - all
-        - kube-system
-      view:
-        active: po
-    minikube:
-      namespace:
-        active: kube-system
-        favorites:
-        - default
-        - kube-public
-        - istio-system
-        - all
-        - kube-system
-      view:
-        active: ctx
+  restoreSession: false
+  controlSocket: false
+  revealSecrets: false
 `
 
 var resetConfig = `k9s:
   refreshRate: 2
+  informerTTL: 300
   headless: false
   readOnly: false
   logBufferSize: 200
@@ -308,12 +302,7 @@ var resetConfig = `k9s:
   currentContext: blee
   currentCluster: blee
   fullScreenLogs: false
-  clusters:
-    blee:
-      namespace:
-        active: default
-        favorites:
-        - default
-      view:
-        active: po
+  restoreSession: false
+  controlSocket: false
+  revealSecrets: false
 `
@@ -0,0 +1,39 @@
+package config
+
+const maxPort = 65535
+
+// Debug tracks the settings used to troubleshoot K9s performance.
+type Debug struct {
+	// PprofPort represents a localhost port serving pprof profiles. Zero
+	// disables profiling.
+	PprofPort int `yaml:"pprofPort"`
+
+	// MetricsPort represents a localhost port serving K9s prometheus
+	// metrics. Zero disables metrics.
+	MetricsPort int `yaml:"metricsPort,omitempty"`
+}
+
+// NewDebug returns a new debug configuration.
+func NewDebug() *Debug {
+	return &Debug{}
+}
+
+// Validate disables profiling and metrics on invalid ports.
+func (d *Debug) Validate() {
+	if d.PprofPort < 0 || d.PprofPort > maxPort {
+		d.PprofPort = 0
+	}
+	if d.MetricsPort < 0 || d.MetricsPort > maxPort {
+		d.MetricsPort = 0
+	}
+}
+
+// Profiling checks if pprof profiles are served.
+func (d *Debug) Profiling() bool {
+	return d.PprofPort > 0
+}
+
+// Exporting checks if prometheus metrics are served.
+func (d *Debug) Exporting() bool {
+	return d.MetricsPort > 0
+}
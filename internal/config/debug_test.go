@@ -0,0 +1,48 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDebugValidate(t *testing.T) {
+	uu := map[string]struct {
+		d, e      Debug
+		profiling bool
+		exporting bool
+	}{
+		"defaults": {},
+		"port": {
+			d:         Debug{PprofPort: 6060},
+			e:         Debug{PprofPort: 6060},
+			profiling: true,
+		},
+		"negative": {
+			d: Debug{PprofPort: -1},
+		},
+		"toast": {
+			d: Debug{PprofPort: 70000},
+		},
+		"metrics": {
+			d:         Debug{MetricsPort: 9090},
+			e:         Debug{MetricsPort: 9090},
+			exporting: true,
+		},
+		"toastMetrics": {
+			d:         Debug{PprofPort: 6060, MetricsPort: -1},
+			e:         Debug{PprofPort: 6060},
+			profiling: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.d.Validate()
+			assert.Equal(t, u.e, u.d)
+			assert.Equal(t, u.profiling, u.d.Profiling())
+			assert.Equal(t, u.exporting, u.d.Exporting())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package config
+
+// Guarded actions verbs.
+const (
+	VerbEdit        = "edit"
+	VerbDelete      = "delete"
+	VerbKill        = "kill"
+	VerbScale       = "scale"
+	VerbRestart     = "restart"
+	VerbRollback    = "rollback"
+	VerbTrigger     = "trigger"
+	VerbCreate      = "create"
+	VerbPatch       = "patch"
+	VerbShell       = "shell"
+	VerbAttach      = "attach"
+	VerbPortForward = "port-forward"
+	VerbReveal      = "reveal"
+	VerbKubeBench   = "kube-bench"
+	VerbPromote     = "promote"
+	VerbAbort       = "abort"
+	VerbRetry       = "retry"
+)
+
+// DestructiveVerbs lists the verbs requiring confirmation by name.
+var DestructiveVerbs = []string{VerbDelete, VerbKill, VerbScale, VerbRestart, VerbRollback, VerbAbort}
+
+// Guard represents a guard policy restricting actions on a cluster context.
+type Guard struct {
+	ReadOnly    bool     `yaml:"readOnly"`
+	Block       []string `yaml:"block,omitempty"`
+	ConfirmName bool     `yaml:"confirmName"`
+}
+
+// IsReadOnly checks if all modifications are disabled.
+func (g *Guard) IsReadOnly() bool {
+	return g != nil && g.ReadOnly
+}
+
+// IsBlocked checks if a verb is disallowed.
+func (g *Guard) IsBlocked(verb string) bool {
+	if g == nil {
+		return false
+	}
+
+	return (g.ReadOnly && IsMutation(verb)) || InList(g.Block, verb) || InList(g.Block, "*")
+}
+
+// IsMutation checks if a verb alters the cluster. Port-forwards remain
+// available in readonly mode.
+func IsMutation(verb string) bool {
+	return verb != VerbPortForward
+}
+
+// ShouldConfirmName checks if a verb requires typing the resource name to proceed.
+func (g *Guard) ShouldConfirmName(verb string) bool {
+	return g != nil && g.ConfirmName && InList(DestructiveVerbs, verb)
+}
@@ -0,0 +1,100 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardIsBlocked(t *testing.T) {
+	uu := map[string]struct {
+		g    *config.Guard
+		verb string
+		e    bool
+	}{
+		"none":       {verb: config.VerbDelete},
+		"open":       {g: &config.Guard{}, verb: config.VerbDelete},
+		"readOnly":   {g: &config.Guard{ReadOnly: true}, verb: config.VerbShell, e: true},
+		"readOnlyPF": {g: &config.Guard{ReadOnly: true}, verb: config.VerbPortForward},
+		"blocked":    {g: &config.Guard{Block: []string{"shell", "kill"}}, verb: config.VerbKill, e: true},
+		"allowed":    {g: &config.Guard{Block: []string{"shell"}}, verb: config.VerbDelete},
+		"all":        {g: &config.Guard{Block: []string{"*"}}, verb: config.VerbEdit, e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.g.IsBlocked(u.verb))
+		})
+	}
+}
+
+func TestGuardShouldConfirmName(t *testing.T) {
+	uu := map[string]struct {
+		g    *config.Guard
+		verb string
+		e    bool
+	}{
+		"none":      {verb: config.VerbDelete},
+		"off":       {g: &config.Guard{}, verb: config.VerbDelete},
+		"delete":    {g: &config.Guard{ConfirmName: true}, verb: config.VerbDelete, e: true},
+		"scale":     {g: &config.Guard{ConfirmName: true}, verb: config.VerbScale, e: true},
+		"nonDestro": {g: &config.Guard{ConfirmName: true}, verb: config.VerbShell},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.g.ShouldConfirmName(u.verb))
+		})
+	}
+}
+
+func TestK9sActiveGuard(t *testing.T) {
+	defer useLayers("testdata/layers")()
+
+	uu := map[string]struct {
+		context, cluster string
+		blocked, confirm bool
+	}{
+		"none":    {context: "blee", cluster: "blee"},
+		"cluster": {context: "blee", cluster: "c1", blocked: true},
+		"context": {context: "ctx1", cluster: "c1", blocked: true, confirm: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.NewK9s()
+			c.CurrentContext, c.CurrentCluster = u.context, u.cluster
+
+			assert.Equal(t, u.blocked, c.IsBlocked(config.VerbShell))
+			assert.False(t, c.IsBlocked(config.VerbDelete))
+			assert.Equal(t, u.confirm, c.ActiveGuard().ShouldConfirmName(config.VerbDelete))
+			assert.False(t, c.GetReadOnly())
+		})
+	}
+}
+
+func TestK9sMaskSecrets(t *testing.T) {
+	uu := map[string]struct {
+		reveal, readOnly bool
+		e                bool
+	}{
+		"default":  {e: true},
+		"reveal":   {reveal: true},
+		"readOnly": {reveal: true, readOnly: true, e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.NewK9s()
+			c.RevealSecrets = u.reveal
+			c.OverrideReadOnly(u.readOnly)
+
+			assert.Equal(t, u.e, c.MaskSecrets())
+		})
+	}
+}
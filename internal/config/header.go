@@ -0,0 +1,110 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Header info blocks.
+const (
+	HeaderContext = "context"
+	HeaderCluster = "cluster"
+	HeaderUser    = "user"
+	HeaderK9sRev  = "k9s"
+	HeaderK8sRev  = "k8s"
+	HeaderCPU     = "cpu"
+	HeaderMEM     = "mem"
+)
+
+// Header widgets.
+const (
+	WidgetClock      = "clock"
+	WidgetContextTag = "contextTag"
+	WidgetAlerts     = "alerts"
+	WidgetLatency    = "latency"
+)
+
+const defaultClockFormat = "15:04:05"
+
+var (
+	headerBlocks  = []string{HeaderContext, HeaderCluster, HeaderUser, HeaderK9sRev, HeaderK8sRev, HeaderCPU, HeaderMEM}
+	headerWidgets = []string{WidgetClock, WidgetContextTag, WidgetAlerts, WidgetLatency}
+)
+
+// Header tracks the header layout settings.
+type Header struct {
+	// Compact shows a single line header, reclaiming rows for the table.
+	Compact bool `yaml:"compact"`
+
+	// Info lists the cluster info blocks to show in order. Defaults to all blocks.
+	Info []string `yaml:"info,omitempty"`
+
+	// Widgets lists additional widgets to show in order.
+	Widgets []string `yaml:"widgets,omitempty"`
+
+	// ClockFormat represents the clock widget time layout.
+	ClockFormat string `yaml:"clockFormat,omitempty"`
+
+	// ContextColors maps context name patterns to the context tag widget color.
+	ContextColors map[string]string `yaml:"contextColors,omitempty"`
+}
+
+// NewHeader returns a new header configuration.
+func NewHeader() *Header {
+	h := Header{}
+	h.Validate()
+
+	return &h
+}
+
+// Validate sets defaults for unspecified settings and drops unknown blocks and widgets.
+func (h *Header) Validate() {
+	if len(h.Info) == 0 {
+		h.Info = append([]string(nil), headerBlocks...)
+	}
+	h.Info = validNames("info block", h.Info, headerBlocks)
+	h.Widgets = validNames("widget", h.Widgets, headerWidgets)
+	if h.ClockFormat == "" {
+		h.ClockFormat = defaultClockFormat
+	}
+}
+
+// HasWidget checks if a widget is enabled.
+func (h *Header) HasWidget(w string) bool {
+	return InList(h.Widgets, w)
+}
+
+// ContextColor returns the tag color of a given context. Exact names are
+// matched first, then glob patterns such as prod-*.
+func (h *Header) ContextColor(context string) (string, bool) {
+	if c, ok := h.ContextColors[context]; ok {
+		return c, true
+	}
+	for pat, c := range h.ContextColors {
+		if ok, _ := filepath.Match(pat, context); ok {
+			return c, true
+		}
+	}
+
+	return "", false
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func validNames(kind string, nn, valid []string) []string {
+	ss := make([]string, 0, len(nn))
+	for _, n := range nn {
+		if !InList(valid, n) {
+			log.Warn().Msgf("Unknown header %s %q", kind, n)
+			continue
+		}
+		if InList(ss, n) {
+			continue
+		}
+		ss = append(ss, n)
+	}
+
+	return ss
+}
@@ -0,0 +1,87 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderValidate(t *testing.T) {
+	uu := map[string]struct {
+		h, e Header
+	}{
+		"defaults": {
+			e: Header{Info: headerBlocks, Widgets: []string{}, ClockFormat: defaultClockFormat},
+		},
+		"custom": {
+			h: Header{
+				Compact:     true,
+				Info:        []string{HeaderContext, HeaderCPU},
+				Widgets:     []string{WidgetClock, WidgetAlerts},
+				ClockFormat: "15:04",
+			},
+			e: Header{
+				Compact:     true,
+				Info:        []string{HeaderContext, HeaderCPU},
+				Widgets:     []string{WidgetClock, WidgetAlerts},
+				ClockFormat: "15:04",
+			},
+		},
+		"unknown": {
+			h: Header{
+				Info:    []string{"fred", HeaderUser, HeaderUser},
+				Widgets: []string{"blee", WidgetLatency},
+			},
+			e: Header{
+				Info:        []string{HeaderUser},
+				Widgets:     []string{WidgetLatency},
+				ClockFormat: defaultClockFormat,
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.h.Validate()
+			assert.Equal(t, u.e, u.h)
+		})
+	}
+}
+
+func TestHeaderContextColor(t *testing.T) {
+	h := NewHeader()
+	h.ContextColors = map[string]string{
+		"prod-*":  "red",
+		"prod-us": "orange",
+		"dev":     "green",
+	}
+
+	uu := map[string]struct {
+		ctx   string
+		color string
+		ok    bool
+	}{
+		"exact":    {ctx: "dev", color: "green", ok: true},
+		"precede":  {ctx: "prod-us", color: "orange", ok: true},
+		"glob":     {ctx: "prod-eu", color: "red", ok: true},
+		"no-match": {ctx: "staging"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, ok := h.ContextColor(u.ctx)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.color, c)
+		})
+	}
+}
+
+func TestHeaderHasWidget(t *testing.T) {
+	h := Header{Widgets: []string{WidgetClock}}
+	h.Validate()
+
+	assert.True(t, h.HasWidget(WidgetClock))
+	assert.False(t, h.HasWidget(WidgetAlerts))
+}
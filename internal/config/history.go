@@ -0,0 +1,110 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// MaxHistory tracks the maximum number of commands kept per context.
+const MaxHistory = 100
+
+// K9sHistory tracks K9s command history location.
+var K9sHistory = filepath.Join(K9sHome, "history.yml")
+
+// History tracks command prompt history per context, most recent first.
+type History struct {
+	Contexts map[string][]string `yaml:"history"`
+}
+
+// NewHistory returns a new command history.
+func NewHistory() *History {
+	return &History{
+		Contexts: make(map[string][]string),
+	}
+}
+
+// Load K9s command history.
+func (h *History) Load() error {
+	if err := h.LoadHistory(K9sHistory); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// LoadHistory loads command history from a given file.
+func (h *History) LoadHistory(path string) error {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var hh History
+	if err := yaml.Unmarshal(f, &hh); err != nil {
+		return err
+	}
+	for k, v := range hh.Contexts {
+		h.Contexts[k] = v
+	}
+
+	return nil
+}
+
+// Save K9s command history.
+func (h *History) Save() error {
+	return h.SaveHistory(K9sHistory)
+}
+
+// SaveHistory saves command history to a given file.
+func (h *History) SaveHistory(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(h)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, DefaultFileMod)
+}
+
+// Push records a command for a given context. Duplicates get moved to the top.
+func (h *History) Push(context, cmd string) {
+	cmd = strings.TrimSpace(cmd)
+	if cmd == "" {
+		return
+	}
+
+	hh := make([]string, 0, len(h.Contexts[context])+1)
+	hh = append(hh, cmd)
+	for _, c := range h.Contexts[context] {
+		if c == cmd {
+			continue
+		}
+		hh = append(hh, c)
+	}
+	if len(hh) > MaxHistory {
+		hh = hh[:MaxHistory]
+	}
+	h.Contexts[context] = hh
+}
+
+// List returns a context command history, most recent first.
+func (h *History) List(context string) []string {
+	return h.Contexts[context]
+}
+
+// Search returns the most recent command at or past a given index that
+// contains the query and its index.
+func (h *History) Search(context, q string, from int) (string, int, bool) {
+	hh := h.Contexts[context]
+	for i := from; i >= 0 && i < len(hh); i++ {
+		if strings.Contains(hh[i], q) {
+			return hh[i], i, true
+		}
+	}
+
+	return "", -1, false
+}
@@ -0,0 +1,84 @@
+package config_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistoryPush(t *testing.T) {
+	h := config.NewHistory()
+	h.Push("ctx1", "po")
+	h.Push("ctx1", "dp fred")
+	h.Push("ctx1", " po ")
+	h.Push("ctx1", "")
+	h.Push("ctx2", "svc")
+
+	assert.Equal(t, []string{"po", "dp fred"}, h.List("ctx1"))
+	assert.Equal(t, []string{"svc"}, h.List("ctx2"))
+	assert.Nil(t, h.List("ctx3"))
+}
+
+func TestHistoryPushMax(t *testing.T) {
+	h := config.NewHistory()
+	for i := 0; i < config.MaxHistory+10; i++ {
+		h.Push("ctx1", fmt.Sprintf("cmd%d", i))
+	}
+
+	hh := h.List("ctx1")
+	assert.Equal(t, config.MaxHistory, len(hh))
+	assert.Equal(t, fmt.Sprintf("cmd%d", config.MaxHistory+9), hh[0])
+}
+
+func TestHistorySearch(t *testing.T) {
+	h := config.NewHistory()
+	for _, c := range []string{"po kube-system", "dp", "po default", "svc"} {
+		h.Push("ctx1", c)
+	}
+
+	uu := map[string]struct {
+		q     string
+		from  int
+		e     string
+		index int
+		ok    bool
+	}{
+		"first":    {q: "po", e: "po default", index: 1, ok: true},
+		"next":     {q: "po", from: 2, e: "po kube-system", index: 3, ok: true},
+		"exhaust":  {q: "po", from: 4, index: -1},
+		"none":     {q: "bozo", index: -1},
+		"empty":    {q: "", e: "svc", ok: true},
+		"negative": {q: "po", from: -1, index: -1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmd, index, ok := h.Search("ctx1", u.q, u.from)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, cmd)
+			assert.Equal(t, u.index, index)
+		})
+	}
+}
+
+func TestHistorySave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-history")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "history.yml")
+	h := config.NewHistory()
+	h.Push("ctx1", "po")
+	h.Push("ctx1", "dp")
+	assert.Nil(t, h.SaveHistory(path))
+
+	hh := config.NewHistory()
+	assert.Nil(t, hh.LoadHistory(path))
+	assert.Equal(t, []string{"dp", "po"}, hh.List("ctx1"))
+}
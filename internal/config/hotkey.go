@@ -1,14 +1,18 @@
 package config
 
 import (
-	"io/ioutil"
+	"os"
 	"path/filepath"
 
 	"gopkg.in/yaml.v2"
 )
 
-// K9sHotKeys manages K9s hotKeys.
-var K9sHotKeys = filepath.Join(K9sHome, "hotkey.yml")
+var (
+	// K9sHotKeys manages K9s hotKeys.
+	K9sHotKeys = filepath.Join(K9sHome, "hotkey.yml")
+	// K9sHotKeysDir tracks additional K9s hotKeys files.
+	K9sHotKeysDir = filepath.Join(K9sHome, "hotkeys.d")
+)
 
 // HotKeys represents a collection of plugins.
 type HotKeys struct {
@@ -17,9 +21,17 @@ type HotKeys struct {
 
 // HotKey describes a K9s hotkey.
 type HotKey struct {
-	ShortCut    string `yaml:"shortCut"`
-	Description string `yaml:"description"`
-	Command     string `yaml:"command"`
+	ContextScope `yaml:",inline"`
+
+	ShortCut    string        `yaml:"shortCut"`
+	Description string        `yaml:"description"`
+	Command     string        `yaml:"command"`
+	Params      []PluginParam `yaml:"params"`
+}
+
+// Validate checks the hotkey is well formed.
+func (h HotKey) Validate() error {
+	return ValidateParams(h.Params)
 }
 
 // NewHotKeys returns a new plugin.
@@ -29,25 +41,48 @@ func NewHotKeys() HotKeys {
 	}
 }
 
-// Load K9s plugins.
+// Load K9s hotkeys.
 func (h HotKeys) Load() error {
-	return h.LoadHotKeys(K9sHotKeys)
+	for _, f := range ConfigLayers(K9sHotKeys) {
+		if err := h.LoadHotKeys(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	for _, d := range ConfigLayers(K9sHotKeysDir) {
+		if err := h.LoadHotKeysDir(d); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// LoadHotKeys loads plugins from a given file.
-func (h HotKeys) LoadHotKeys(path string) error {
-	f, err := ioutil.ReadFile(path)
+// LoadHotKeysDir loads hotkeys from all yaml files in a given directory.
+func (h HotKeys) LoadHotKeysDir(dir string) error {
+	ff, err := configFiles(dir)
 	if err != nil {
 		return err
 	}
-
-	var hh HotKeys
-	if err := yaml.Unmarshal(f, &hh); err != nil {
-		return err
-	}
-	for k, v := range hh.HotKey {
-		h.HotKey[k] = v
+	for _, f := range ff {
+		if err := h.LoadHotKeys(f); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+// LoadHotKeys loads plugins from a given file.
+func (h HotKeys) LoadHotKeys(path string) error {
+	return readIncludes(path, func(raw []byte) error {
+		var hh HotKeys
+		if err := yaml.Unmarshal(raw, &hh); err != nil {
+			return err
+		}
+		for k, v := range hh.HotKey {
+			h.HotKey[k] = v
+		}
+
+		return nil
+	})
+}
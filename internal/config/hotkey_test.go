@@ -11,11 +11,32 @@ func TestHotKeyLoad(t *testing.T) {
 	h := config.NewHotKeys()
 	assert.Nil(t, h.LoadHotKeys("testdata/hot_key.yml"))
 
-	assert.Equal(t, 1, len(h.HotKey))
+	assert.Equal(t, 2, len(h.HotKey))
 
 	k, ok := h.HotKey["pods"]
 	assert.True(t, ok)
 	assert.Equal(t, "shift-0", k.ShortCut)
 	assert.Equal(t, "Launch pod view", k.Description)
 	assert.Equal(t, "pods", k.Command)
+	assert.Equal(t, 0, len(k.Params))
+
+	k, ok = h.HotKey["podsIn"]
+	assert.True(t, ok)
+	assert.Equal(t, "pods $NS /$FILTER", k.Command)
+	assert.Equal(t, 2, len(k.Params))
+	assert.Equal(t, "ns", k.Params[0].Name)
+	assert.Equal(t, "default", k.Params[0].Default)
+	assert.Equal(t, "filter", k.Params[1].Name)
+}
+
+func TestHotKeyLoadDir(t *testing.T) {
+	h := config.NewHotKeys()
+	assert.Nil(t, h.LoadHotKeysDir("testdata/hotkeys.d"))
+
+	assert.Equal(t, 1, len(h.HotKey))
+	k, ok := h.HotKey["nodes"]
+	assert.True(t, ok)
+	assert.Equal(t, []string{"prod-cluster"}, k.Clusters)
+	assert.True(t, k.InContext("fred", "prod-cluster"))
+	assert.False(t, k.InContext("fred", "dev-cluster"))
 }
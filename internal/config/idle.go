@@ -0,0 +1,38 @@
+package config
+
+const (
+	defaultIdleTimeout     = 300
+	defaultIdleRefreshRate = 30
+)
+
+// Idle tracks the settings used to slow down refreshes while K9s sits idle.
+type Idle struct {
+	// Timeout represents the number of seconds without user input past which
+	// K9s is considered idle. A negative value disables idle detection.
+	Timeout int `yaml:"timeout"`
+	// RefreshRate represents the refresh interval in seconds while idle.
+	RefreshRate int `yaml:"refreshRate"`
+}
+
+// NewIdle returns a new idle configuration.
+func NewIdle() *Idle {
+	i := Idle{}
+	i.Validate()
+
+	return &i
+}
+
+// Validate sets defaults for unspecified settings.
+func (i *Idle) Validate() {
+	if i.Timeout == 0 {
+		i.Timeout = defaultIdleTimeout
+	}
+	if i.RefreshRate <= 0 {
+		i.RefreshRate = defaultIdleRefreshRate
+	}
+}
+
+// Enabled checks if refreshes slow down while idle.
+func (i *Idle) Enabled() bool {
+	return i.Timeout > 0
+}
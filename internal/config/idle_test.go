@@ -0,0 +1,37 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdleValidate(t *testing.T) {
+	uu := map[string]struct {
+		i, e    Idle
+		enabled bool
+	}{
+		"defaults": {
+			e:       Idle{Timeout: defaultIdleTimeout, RefreshRate: defaultIdleRefreshRate},
+			enabled: true,
+		},
+		"custom": {
+			i:       Idle{Timeout: 60, RefreshRate: 10},
+			e:       Idle{Timeout: 60, RefreshRate: 10},
+			enabled: true,
+		},
+		"disabled": {
+			i: Idle{Timeout: -1, RefreshRate: -5},
+			e: Idle{Timeout: -1, RefreshRate: defaultIdleRefreshRate},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.i.Validate()
+			assert.Equal(t, u.e, u.i)
+			assert.Equal(t, u.enabled, u.i.Enabled())
+		})
+	}
+}
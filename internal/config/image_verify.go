@@ -0,0 +1,66 @@
+package config
+
+import (
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	defaultCosignBin = "cosign"
+	defaultVerifyTTL = 3600
+)
+
+// defaultAttestations tracks the SBOM predicate types checked by default.
+var defaultAttestations = []string{"spdxjson", "cyclonedx"}
+
+// ImageVerify tracks the container images signatures verification settings.
+type ImageVerify struct {
+	// Binary represents the cosign binary. Default cosign.
+	Binary string `yaml:"binary,omitempty"`
+
+	// Key represents a cosign public key, kms uri or path, to verify key based signatures.
+	Key string `yaml:"key,omitempty"`
+
+	// Identity represents the expected keyless certificate identity.
+	Identity string `yaml:"identity,omitempty"`
+
+	// Issuer represents the expected keyless certificate OIDC issuer.
+	Issuer string `yaml:"issuer,omitempty"`
+
+	// Attestations represents the SBOM predicate types to look for.
+	Attestations []string `yaml:"attestations,omitempty"`
+
+	// TTL represents the number of seconds verification results are cached for.
+	TTL int `yaml:"ttl"`
+}
+
+// NewImageVerify returns a new image verification configuration.
+func NewImageVerify() *ImageVerify {
+	return &ImageVerify{
+		Binary:       defaultCosignBin,
+		Attestations: defaultAttestations,
+		TTL:          defaultVerifyTTL,
+	}
+}
+
+// Validate fills in defaults and disables keyless verification when
+// either the identity or the issuer is missing.
+func (i *ImageVerify) Validate() {
+	if i.Binary == "" {
+		i.Binary = defaultCosignBin
+	}
+	if len(i.Attestations) == 0 {
+		i.Attestations = defaultAttestations
+	}
+	if i.TTL <= 0 {
+		i.TTL = defaultVerifyTTL
+	}
+	if i.Key == "" && (i.Identity == "") != (i.Issuer == "") {
+		log.Warn().Msg("Keyless image verification requires both an identity and an issuer. Image verification disabled")
+		i.Identity, i.Issuer = "", ""
+	}
+}
+
+// Enabled checks if images signatures are verified.
+func (i *ImageVerify) Enabled() bool {
+	return i.Key != "" || (i.Identity != "" && i.Issuer != "")
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageVerifyValidate(t *testing.T) {
+	uu := map[string]struct {
+		i, e ImageVerify
+		ok   bool
+	}{
+		"none": {
+			e: *NewImageVerify(),
+		},
+		"key": {
+			i:  ImageVerify{Key: "cosign.pub", TTL: 60},
+			e:  ImageVerify{Binary: defaultCosignBin, Key: "cosign.pub", Attestations: defaultAttestations, TTL: 60},
+			ok: true,
+		},
+		"keyless": {
+			i:  ImageVerify{Binary: "/usr/local/bin/cosign", Identity: "fred@blee.io", Issuer: "https://accounts.google.com", Attestations: []string{"spdx"}},
+			e:  ImageVerify{Binary: "/usr/local/bin/cosign", Identity: "fred@blee.io", Issuer: "https://accounts.google.com", Attestations: []string{"spdx"}, TTL: defaultVerifyTTL},
+			ok: true,
+		},
+		"noIssuer": {
+			i: ImageVerify{Identity: "fred@blee.io"},
+			e: *NewImageVerify(),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i := u.i
+			i.Validate()
+			assert.Equal(t, u.e, i)
+			assert.Equal(t, u.ok, i.Enabled())
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+)
+
+const maxIncludeDepth = 10
+
+var (
+	// K9sSystemDirs represents the system wide K9s config dirs, lowest precedence first.
+	K9sSystemDirs = systemDirs()
+	// K9sProjectDir represents the K9s config dir of the current project if any.
+	K9sProjectDir = projectDir()
+	// K9sTrustedProjects lists the project config dirs allowed to be layered.
+	K9sTrustedProjects = filepath.Join(K9sHome, "trusted_projects.yml")
+	// K9sTrustProject layers the current project config dir even if it is not
+	// listed as trusted.
+	K9sTrustProject bool
+)
+
+type includes struct {
+	Include []string `yaml:"include"`
+}
+
+type trustedProjects struct {
+	Projects []string `yaml:"projects"`
+}
+
+// ConfigDirs returns the K9s config dirs, lowest precedence first. System wide
+// settings are overridden by user settings which are overridden by project settings.
+// Project settings are only layered once trusted since they may run commands.
+func ConfigDirs() []string {
+	dd := append([]string{}, K9sSystemDirs...)
+	dd = append(dd, K9sHome)
+	if K9sProjectDir != "" && K9sProjectDir != K9sHome && IsTrustedProject(K9sProjectDir) {
+		dd = append(dd, K9sProjectDir)
+	}
+
+	return dd
+}
+
+// IsTrustedProject checks if a project config dir may be layered, ie it was
+// either trusted on the command line or listed in the trusted projects file.
+// Projects may be listed by their config dir or the dir holding it.
+func IsTrustedProject(dir string) bool {
+	if K9sTrustProject {
+		return true
+	}
+	raw, err := ioutil.ReadFile(K9sTrustedProjects)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn().Err(err).Msgf("Unable to read trusted projects %s", K9sTrustedProjects)
+		}
+		return false
+	}
+	var tt trustedProjects
+	if err := yaml.Unmarshal(raw, &tt); err != nil {
+		log.Warn().Err(err).Msgf("Invalid trusted projects %s", K9sTrustedProjects)
+		return false
+	}
+	for _, p := range tt.Projects {
+		if strings.HasPrefix(p, "~/") {
+			p = filepath.Join(mustK9sHome(), p[2:])
+		}
+		p = filepath.Clean(p)
+		if p == dir || filepath.Join(p, ".k9s") == dir {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ConfigLayers returns the locations of a K9s config file across all config
+// dirs, lowest precedence first. Files outside of K9s home are not layered.
+func ConfigLayers(path string) []string {
+	rel, err := filepath.Rel(K9sHome, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return []string{path}
+	}
+
+	dd := ConfigDirs()
+	pp := make([]string, 0, len(dd))
+	for _, d := range dd {
+		pp = append(pp, filepath.Join(d, rel))
+	}
+
+	return pp
+}
+
+// HomePath maps a file located in a system or project config dir to its
+// K9s home location.
+func HomePath(path string) string {
+	for _, d := range ConfigDirs() {
+		if d == K9sHome {
+			continue
+		}
+		if rel, err := filepath.Rel(d, path); err == nil && !strings.HasPrefix(rel, "..") {
+			return filepath.Join(K9sHome, rel)
+		}
+	}
+
+	return path
+}
+
+// readIncludes reads a config file and the files it includes. Included files
+// are read first so the including file overrides them. Include paths are
+// relative to the including file and may use glob patterns.
+func readIncludes(path string, fn func(raw []byte) error) error {
+	return readInclude(path, fn, nil)
+}
+
+func readInclude(path string, fn func(raw []byte) error, seen []string) error {
+	if InList(seen, path) {
+		return fmt.Errorf("include cycle detected %s", strings.Join(append(seen, path), " -> "))
+	}
+	if len(seen) > maxIncludeDepth {
+		return fmt.Errorf("too many nested includes in %s", seen[0])
+	}
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var ii includes
+	if err := yaml.Unmarshal(raw, &ii); err != nil {
+		return err
+	}
+	for _, i := range ii.Include {
+		ff, err := includeFiles(filepath.Dir(path), i)
+		if err != nil {
+			return fmt.Errorf("invalid include %q in %s -- %s", i, path, err)
+		}
+		for _, f := range ff {
+			if err := readInclude(f, fn, append(seen, path)); err != nil {
+				return fmt.Errorf("include %s -- %s", f, err)
+			}
+		}
+	}
+
+	return fn(raw)
+}
+
+func includeFiles(dir, include string) ([]string, error) {
+	if strings.HasPrefix(include, "~/") {
+		include = filepath.Join(mustK9sHome(), include[2:])
+	}
+	if !filepath.IsAbs(include) {
+		include = filepath.Join(dir, include)
+	}
+
+	ff, err := filepath.Glob(include)
+	if err != nil {
+		return nil, err
+	}
+	if len(ff) == 0 {
+		return nil, errors.New("no such file")
+	}
+
+	return ff, nil
+}
+
+func systemDirs() []string {
+	env := os.Getenv("XDG_CONFIG_DIRS")
+	if env == "" {
+		env = "/etc/xdg"
+	}
+
+	dd := filepath.SplitList(env)
+	ss := make([]string, 0, len(dd))
+	for i := len(dd) - 1; i >= 0; i-- {
+		if filepath.IsAbs(dd[i]) {
+			ss = append(ss, filepath.Join(dd[i], "k9s"))
+		}
+	}
+
+	return ss
+}
+
+// projectDir returns the closest .k9s dir from the current directory.
+func projectDir() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		d := filepath.Join(dir, ".k9s")
+		if fi, err := os.Stat(d); err == nil && fi.IsDir() {
+			return d
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
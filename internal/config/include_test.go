@@ -0,0 +1,143 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigLayers(t *testing.T) {
+	defer useConfigDirs("/etc/xdg/k9s", "/tmp/project/.k9s")()
+
+	uu := map[string]struct {
+		path string
+		e    []string
+	}{
+		"file": {
+			path: filepath.Join(config.K9sHome, "plugin.yml"),
+			e: []string{
+				"/etc/xdg/k9s/plugin.yml",
+				filepath.Join(config.K9sHome, "plugin.yml"),
+				"/tmp/project/.k9s/plugin.yml",
+			},
+		},
+		"dir": {
+			path: filepath.Join(config.K9sHome, "plugins.d"),
+			e: []string{
+				"/etc/xdg/k9s/plugins.d",
+				filepath.Join(config.K9sHome, "plugins.d"),
+				"/tmp/project/.k9s/plugins.d",
+			},
+		},
+		"outside": {
+			path: "testdata/plugin.yml",
+			e:    []string{"testdata/plugin.yml"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.ConfigLayers(u.path))
+		})
+	}
+}
+
+func TestConfigDirsUntrusted(t *testing.T) {
+	defer useConfigDirs("/etc/xdg/k9s", "/tmp/project/.k9s")()
+	config.K9sTrustProject = false
+	tp := config.K9sTrustedProjects
+	config.K9sTrustedProjects = "testdata/trusted_projects.nope"
+	defer func() { config.K9sTrustedProjects = tp }()
+
+	assert.Equal(t, []string{"/etc/xdg/k9s", config.K9sHome}, config.ConfigDirs())
+}
+
+func TestIsTrustedProject(t *testing.T) {
+	tp, trust := config.K9sTrustedProjects, config.K9sTrustProject
+	config.K9sTrustedProjects, config.K9sTrustProject = "testdata/trusted_projects.yml", false
+	defer func() { config.K9sTrustedProjects, config.K9sTrustProject = tp, trust }()
+
+	uu := map[string]struct {
+		dir string
+		e   bool
+	}{
+		"config-dir":  {dir: "/tmp/fred/.k9s", e: true},
+		"project-dir": {dir: "/tmp/blee/.k9s", e: true},
+		"untrusted":   {dir: "/tmp/duh/.k9s", e: false},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.IsTrustedProject(u.dir))
+		})
+	}
+}
+
+func TestHomePath(t *testing.T) {
+	defer useConfigDirs("/etc/xdg/k9s", "/tmp/project/.k9s")()
+
+	assert.Equal(t, filepath.Join(config.K9sHome, "plugin.yml"), config.HomePath("/etc/xdg/k9s/plugin.yml"))
+	assert.Equal(t, filepath.Join(config.K9sHome, "plugins.d", "a.yml"), config.HomePath("/tmp/project/.k9s/plugins.d/a.yml"))
+	assert.Equal(t, "/tmp/fred.yml", config.HomePath("/tmp/fred.yml"))
+}
+
+func TestPluginsLoadLayers(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), "k9s-test-project")
+	assert.Nil(t, os.MkdirAll(dir, config.DefaultDirMod))
+	defer os.RemoveAll(dir)
+	defer useConfigDirs("testdata/include", dir)()
+
+	p := config.NewPlugins()
+	assert.Nil(t, p.Load())
+
+	assert.Equal(t, "Override", p.Plugin["fred"].Description)
+	assert.Equal(t, "Blee", p.Plugin["blee"].Description)
+}
+
+func TestPluginsLoadIncludes(t *testing.T) {
+	p := config.NewPlugins()
+	assert.Nil(t, p.LoadPlugins("testdata/include/plugin.yml"))
+
+	assert.Equal(t, 2, len(p.Plugin))
+	assert.Equal(t, "Override", p.Plugin["fred"].Description)
+	assert.Equal(t, "fred", p.Plugin["fred"].Command)
+	assert.Equal(t, "Blee", p.Plugin["blee"].Description)
+}
+
+func TestIncludeErrors(t *testing.T) {
+	uu := map[string]struct {
+		path, err string
+	}{
+		"cycle": {
+			path: "testdata/include/cycle.yml",
+			err:  "include testdata/include/cycle.yml -- include cycle detected testdata/include/cycle.yml -> testdata/include/cycle.yml",
+		},
+		"missing": {
+			path: "testdata/include/missing.yml",
+			err:  `invalid include "fred.yml" in testdata/include/missing.yml -- no such file`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.EqualError(t, config.NewPlugins().LoadPlugins(u.path), u.err)
+		})
+	}
+}
+
+// Helpers...
+
+func useConfigDirs(system, project string) func() {
+	dd, p, t := config.K9sSystemDirs, config.K9sProjectDir, config.K9sTrustProject
+	config.K9sSystemDirs, config.K9sProjectDir, config.K9sTrustProject = []string{system}, project, true
+
+	return func() {
+		config.K9sSystemDirs, config.K9sProjectDir, config.K9sTrustProject = dd, p, t
+	}
+}
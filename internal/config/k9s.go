@@ -1,17 +1,25 @@
 package config
 
-import "github.com/derailed/k9s/internal/client"
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+)
 
 const (
 	defaultRefreshRate    = 2
 	defaultLogRequestSize = 200
 	defaultLogBufferSize  = 1000
 	defaultReadOnly       = false
+	defaultInformerTTL    = 300
 )
 
 // K9s tracks K9s configuration options.
 type K9s struct {
 	RefreshRate       int                 `yaml:"refreshRate"`
+	InformerTTL       int                 `yaml:"informerTTL"`
 	Headless          bool                `yaml:"headless"`
 	ReadOnly          bool                `yaml:"readOnly"`
 	LogBufferSize     int                 `yaml:"logBufferSize"`
@@ -19,17 +27,42 @@ type K9s struct {
 	CurrentContext    string              `yaml:"currentContext"`
 	CurrentCluster    string              `yaml:"currentCluster"`
 	FullScreenLogs    bool                `yaml:"fullScreenLogs"`
+	RestoreSession    bool                `yaml:"restoreSession"`
+	ControlSocket     bool                `yaml:"controlSocket"`
+	RevealSecrets     bool                `yaml:"revealSecrets"`
+	FavoriteContexts  []string            `yaml:"favoriteContexts,omitempty"`
+	ContextGroups     map[string][]string `yaml:"contextGroups,omitempty"`
 	Clusters          map[string]*Cluster `yaml:"clusters,omitempty"`
+	Skins             *Skins              `yaml:"skins,omitempty"`
+	Mouse             *Mouse              `yaml:"mouse,omitempty"`
+	Paging            *Paging             `yaml:"paging,omitempty"`
+	Idle              *Idle               `yaml:"idle,omitempty"`
+	Cache             *Cache              `yaml:"cache,omitempty"`
+	Metrics           *Metrics            `yaml:"metrics,omitempty"`
+	RefreshRates      RefreshRates        `yaml:"refreshRates,omitempty"`
+	Protobuf          *bool               `yaml:"protobuf,omitempty"`
+	Debug             *Debug              `yaml:"debug,omitempty"`
+	Tracing           *Tracing            `yaml:"tracing,omitempty"`
+	Notify            *Notify             `yaml:"notify,omitempty"`
+	AuditEvents       *AuditEvents        `yaml:"auditEvents,omitempty"`
+	ImageVerify       *ImageVerify        `yaml:"imageVerify,omitempty"`
+	Timeline          *Timeline           `yaml:"timeline,omitempty"`
+	KubeBench         *KubeBench          `yaml:"kubeBench,omitempty"`
+	Header            *Header             `yaml:"header,omitempty"`
+	Accessibility     *Accessibility      `yaml:"accessibility,omitempty"`
 	manualRefreshRate int
 	manualHeadless    *bool
 	manualReadOnly    *bool
 	manualCommand     *string
+	legacy            map[string][]byte
+	layered           string
 }
 
 // NewK9s create a new K9s configuration.
 func NewK9s() *K9s {
 	return &K9s{
 		RefreshRate:    defaultRefreshRate,
+		InformerTTL:    defaultInformerTTL,
 		ReadOnly:       defaultReadOnly,
 		LogBufferSize:  defaultLogBufferSize,
 		LogRequestSize: defaultLogRequestSize,
@@ -37,6 +70,14 @@ func NewK9s() *K9s {
 	}
 }
 
+// retain carries over the session state of a previous configuration.
+func (k *K9s) retain(prev *K9s) {
+	k.CurrentContext, k.CurrentCluster = prev.CurrentContext, prev.CurrentCluster
+	k.Clusters, k.legacy, k.layered = prev.Clusters, prev.legacy, prev.layered
+	k.manualRefreshRate, k.manualHeadless = prev.manualRefreshRate, prev.manualHeadless
+	k.manualReadOnly, k.manualCommand = prev.manualReadOnly, prev.manualCommand
+}
+
 // OverrideRefreshRate set the refresh rate manually.
 func (k *K9s) OverrideRefreshRate(r int) {
 	k.manualRefreshRate = r
@@ -77,27 +118,271 @@ func (k *K9s) GetRefreshRate() int {
 	return rate
 }
 
-// GetReadOnly returns the readonly setting.
+// GetInformerTTL returns how long an unused resource informer keeps running.
+func (k *K9s) GetInformerTTL() time.Duration {
+	if k.InformerTTL <= 0 {
+		return defaultInformerTTL * time.Second
+	}
+
+	return time.Duration(k.InformerTTL) * time.Second
+}
+
+// GetReadOnly returns the readonly setting. The active guard policy may
+// also turn on readonly mode.
 func (k *K9s) GetReadOnly() bool {
 	readOnly := k.ReadOnly
 	if k.manualReadOnly != nil && *k.manualReadOnly {
 		readOnly = *k.manualReadOnly
 	}
-	return readOnly
+	return readOnly || k.ActiveGuard().IsReadOnly()
+}
+
+// ActiveGuard returns the guard policy of the active cluster context if any.
+func (k *K9s) ActiveGuard() *Guard {
+	if k.CurrentCluster == "" {
+		return nil
+	}
+
+	return k.ActiveCluster().Guard
+}
+
+// IsBlocked checks if a verb is disallowed on the active cluster context.
+func (k *K9s) IsBlocked(verb string) bool {
+	return (k.GetReadOnly() && IsMutation(verb)) || k.ActiveGuard().IsBlocked(verb)
 }
 
-// ActiveCluster returns the currently active cluster.
+// MaskSecrets checks if secret values must be masked. Secrets are always
+// masked when reveals are blocked on the active cluster context.
+func (k *K9s) MaskSecrets() bool {
+	return !k.RevealSecrets || k.IsBlocked(VerbReveal)
+}
+
+// ShouldRestoreSession checks if the last session must be restored on startup.
+// A command specified on the command line takes precedence.
+func (k *K9s) ShouldRestoreSession() bool {
+	return k.RestoreSession && (k.manualCommand == nil || *k.manualCommand == "")
+}
+
+// IsFavoriteContext checks if a context is pinned as a favorite.
+func (k *K9s) IsFavoriteContext(n string) bool {
+	return InList(k.FavoriteContexts, n)
+}
+
+// ToggleFavoriteContext pins or unpins a favorite context. Returns true if
+// the context is now a favorite.
+func (k *K9s) ToggleFavoriteContext(n string) bool {
+	for i, f := range k.FavoriteContexts {
+		if f == n {
+			k.FavoriteContexts = append(k.FavoriteContexts[:i], k.FavoriteContexts[i+1:]...)
+			return false
+		}
+	}
+	k.FavoriteContexts = append(k.FavoriteContexts, n)
+
+	return true
+}
+
+// ContextGroup returns the contexts of a named context group.
+func (k *K9s) ContextGroup(n string) ([]string, bool) {
+	cc, ok := k.ContextGroups[n]
+
+	return cc, ok && len(cc) > 0
+}
+
+// ActiveCluster returns the currently active cluster settings, merging all
+// settings layers the first time a cluster context is activated.
 func (k *K9s) ActiveCluster() *Cluster {
 	if k.Clusters == nil {
 		k.Clusters = map[string]*Cluster{}
 	}
 
-	if c, ok := k.Clusters[k.CurrentCluster]; ok {
+	key := k.CurrentContext + "@" + k.CurrentCluster
+	if c, ok := k.Clusters[k.CurrentCluster]; ok && k.layered == key {
 		return c
 	}
-	k.Clusters[k.CurrentCluster] = NewCluster()
+	cl, err := k.LoadCluster()
+	if err != nil {
+		log.Error().Err(err).Msgf("Unable to load cluster settings for %q", k.CurrentCluster)
+	}
+	k.Clusters[k.CurrentCluster], k.layered = cl, key
+
+	return cl
+}
+
+// snapshotClusters records the clusters settings found in the main config file.
+func (k *K9s) snapshotClusters() {
+	k.legacy = make(map[string][]byte, len(k.Clusters))
+	for name, cl := range k.Clusters {
+		if cl == nil {
+			continue
+		}
+		raw, err := yaml.Marshal(cl)
+		if err != nil {
+			log.Error().Err(err).Msgf("Unable to migrate cluster settings for %q", name)
+			continue
+		}
+		k.legacy[name] = raw
+	}
+}
+
+// ActiveSkin returns the skin file mapped to the current context or cluster.
+func (k *K9s) ActiveSkin() (string, bool) {
+	skin, ok := k.Skins.SkinFor(k.CurrentContext, k.CurrentCluster)
+	if !ok {
+		return "", false
+	}
+
+	return SkinFile(skin), true
+}
+
+// MouseConfig returns the mouse settings.
+func (k *K9s) MouseConfig() *Mouse {
+	if k.Mouse == nil {
+		return NewMouse()
+	}
+	k.Mouse.Validate()
+
+	return k.Mouse
+}
+
+// PagingConfig returns the large listings paging settings.
+func (k *K9s) PagingConfig() *Paging {
+	if k.Paging == nil {
+		return NewPaging()
+	}
+	k.Paging.Validate()
+
+	return k.Paging
+}
+
+// UseProtobuf checks if built-in resources are transported as protobuf.
+// Defaults to false.
+func (k *K9s) UseProtobuf() bool {
+	if k.Protobuf == nil {
+		return false
+	}
 
-	return k.Clusters[k.CurrentCluster]
+	return *k.Protobuf
+}
+
+// DebugConfig returns the troubleshooting settings.
+func (k *K9s) DebugConfig() *Debug {
+	if k.Debug == nil {
+		return NewDebug()
+	}
+	k.Debug.Validate()
+
+	return k.Debug
+}
+
+// TracingConfig returns the traces export settings.
+func (k *K9s) TracingConfig() *Tracing {
+	if k.Tracing == nil {
+		return NewTracing()
+	}
+	k.Tracing.Validate()
+
+	return k.Tracing
+}
+
+// AuditEventsConfig returns the audit events stream settings.
+func (k *K9s) AuditEventsConfig() *AuditEvents {
+	if k.AuditEvents == nil {
+		return NewAuditEvents()
+	}
+	k.AuditEvents.Validate()
+
+	return k.AuditEvents
+}
+
+// ImageVerifyConfig returns the container images signatures verification settings.
+func (k *K9s) ImageVerifyConfig() *ImageVerify {
+	if k.ImageVerify == nil {
+		return NewImageVerify()
+	}
+	k.ImageVerify.Validate()
+
+	return k.ImageVerify
+}
+
+// TimelineConfig returns the resources change timeline settings.
+func (k *K9s) TimelineConfig() *Timeline {
+	if k.Timeline == nil {
+		return NewTimeline()
+	}
+	k.Timeline.Validate()
+
+	return k.Timeline
+}
+
+// KubeBenchConfig returns the kube-bench settings.
+func (k *K9s) KubeBenchConfig() *KubeBench {
+	if k.KubeBench == nil {
+		return NewKubeBench()
+	}
+	k.KubeBench.Validate()
+
+	return k.KubeBench
+}
+
+// HeaderConfig returns the header layout settings.
+func (k *K9s) HeaderConfig() *Header {
+	if k.Header == nil {
+		return NewHeader()
+	}
+	k.Header.Validate()
+
+	return k.Header
+}
+
+// AccessibilityConfig returns the accessibility settings.
+func (k *K9s) AccessibilityConfig() *Accessibility {
+	if k.Accessibility == nil {
+		return NewAccessibility()
+	}
+	k.Accessibility.Validate()
+
+	return k.Accessibility
+}
+
+// NotifyConfig returns the desktop notifications settings.
+func (k *K9s) NotifyConfig() *Notify {
+	if k.Notify == nil {
+		return NewNotify()
+	}
+	k.Notify.Validate()
+
+	return k.Notify
+}
+
+// MetricsConfig returns the metrics-server polling settings.
+func (k *K9s) MetricsConfig() *Metrics {
+	if k.Metrics == nil {
+		return NewMetrics()
+	}
+	k.Metrics.Validate()
+
+	return k.Metrics
+}
+
+// CacheConfig returns the resource caches settings.
+func (k *K9s) CacheConfig() *Cache {
+	if k.Cache == nil {
+		return NewCache()
+	}
+	k.Cache.Validate()
+
+	return k.Cache
+}
+
+// IdleConfig returns the idle refresh settings.
+func (k *K9s) IdleConfig() *Idle {
+	if k.Idle == nil {
+		return NewIdle()
+	}
+	k.Idle.Validate()
+
+	return k.Idle
 }
 
 func (k *K9s) validateDefaults() {
@@ -105,6 +390,12 @@ func (k *K9s) validateDefaults() {
 		k.RefreshRate = defaultRefreshRate
 	}
 
+	if k.InformerTTL <= 0 {
+		k.InformerTTL = defaultInformerTTL
+	}
+
+	k.RefreshRates.Validate()
+
 	if k.LogBufferSize <= 0 {
 		k.LogBufferSize = defaultLogBufferSize
 	}
@@ -148,8 +439,5 @@ func (k *K9s) Validate(c client.Connection, ks KubeSettings) {
 		k.CurrentCluster = cl
 	}
 
-	if _, ok := k.Clusters[k.CurrentCluster]; !ok {
-		k.Clusters[k.CurrentCluster] = NewCluster()
-	}
-	k.Clusters[k.CurrentCluster].Validate(c, ks)
+	k.ActiveCluster().Validate(c, ks)
 }
@@ -79,3 +79,77 @@ func TestK9sActiveCluster(t *testing.T) {
 	assert.Equal(t, "kube-system", cl.Namespace.Active)
 	assert.Equal(t, 5, len(cl.Namespace.Favorites))
 }
+
+func TestK9sShouldRestoreSession(t *testing.T) {
+	uu := map[string]struct {
+		restore bool
+		cmd     string
+		e       bool
+	}{
+		"off":      {},
+		"on":       {restore: true, e: true},
+		"override": {restore: true, cmd: "dp"},
+		"blankCmd": {restore: true, cmd: "", e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.NewK9s()
+			c.RestoreSession = u.restore
+			c.OverrideCommand(u.cmd)
+			assert.Equal(t, u.e, c.ShouldRestoreSession())
+		})
+	}
+}
+
+func TestK9sUseProtobuf(t *testing.T) {
+	on, off := true, false
+	uu := map[string]struct {
+		protobuf *bool
+		e        bool
+	}{
+		"default": {},
+		"on":      {protobuf: &on, e: true},
+		"off":     {protobuf: &off},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.NewK9s()
+			c.Protobuf = u.protobuf
+			assert.Equal(t, u.e, c.UseProtobuf())
+		})
+	}
+}
+
+func TestK9sToggleFavoriteContext(t *testing.T) {
+	k := config.NewK9s()
+
+	assert.False(t, k.IsFavoriteContext("fred"))
+	assert.True(t, k.ToggleFavoriteContext("fred"))
+	assert.True(t, k.ToggleFavoriteContext("blee"))
+	assert.True(t, k.IsFavoriteContext("fred"))
+	assert.Equal(t, []string{"fred", "blee"}, k.FavoriteContexts)
+
+	assert.False(t, k.ToggleFavoriteContext("fred"))
+	assert.False(t, k.IsFavoriteContext("fred"))
+	assert.Equal(t, []string{"blee"}, k.FavoriteContexts)
+}
+
+func TestK9sContextGroup(t *testing.T) {
+	k := config.NewK9s()
+	k.ContextGroups = map[string][]string{
+		"prod":  {"prod-us", "prod-eu"},
+		"empty": {},
+	}
+
+	cc, ok := k.ContextGroup("prod")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"prod-us", "prod-eu"}, cc)
+	_, ok = k.ContextGroup("empty")
+	assert.False(t, ok)
+	_, ok = k.ContextGroup("zorg")
+	assert.False(t, ok)
+}
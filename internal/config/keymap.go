@@ -0,0 +1,91 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sKeyMap manages K9s key bindings overrides.
+var K9sKeyMap = filepath.Join(K9sHome, "keymap.yml")
+
+// KeyMap tracks key bindings overrides.
+type KeyMap struct {
+	Bindings []KeyBinding `yaml:"bindings"`
+}
+
+// KeyBinding rebinds or disables an action identified by its id.
+type KeyBinding struct {
+	Action  string   `yaml:"action"`
+	Key     string   `yaml:"key,omitempty"`
+	Disable bool     `yaml:"disable,omitempty"`
+	Views   []string `yaml:"views,omitempty"`
+}
+
+// Validate checks the binding is well formed.
+func (b KeyBinding) Validate() error {
+	if b.Action == "" {
+		return errors.New("a key binding must specify an action")
+	}
+	if !b.Disable && b.Key == "" {
+		return errors.New("a key binding must specify a key or be disabled")
+	}
+
+	return nil
+}
+
+// InView checks if the binding applies to any of the given view names.
+// A binding without views applies everywhere.
+func (b KeyBinding) InView(names ...string) bool {
+	if len(b.Views) == 0 {
+		return true
+	}
+	for _, v := range b.Views {
+		for _, n := range names {
+			if strings.EqualFold(v, n) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// NewKeyMap returns a new key map.
+func NewKeyMap() *KeyMap {
+	return &KeyMap{}
+}
+
+// Load K9s key map.
+func (k *KeyMap) Load() error {
+	for _, f := range ConfigLayers(K9sKeyMap) {
+		if err := k.LoadKeyMap(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadKeyMap loads key bindings from a given file.
+func (k *KeyMap) LoadKeyMap(path string) error {
+	return readIncludes(path, func(raw []byte) error {
+		var km struct {
+			KeyMap KeyMap `yaml:"keyMap"`
+		}
+		if err := yaml.Unmarshal(raw, &km); err != nil {
+			return err
+		}
+		for _, b := range km.KeyMap.Bindings {
+			if err := b.Validate(); err != nil {
+				return err
+			}
+		}
+		k.Bindings = append(k.Bindings, km.KeyMap.Bindings...)
+
+		return nil
+	})
+}
@@ -0,0 +1,27 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyMapLoad(t *testing.T) {
+	k := config.NewKeyMap()
+	assert.Nil(t, k.LoadKeyMap("testdata/keymap.yml"))
+
+	assert.Equal(t, 3, len(k.Bindings))
+	assert.Equal(t, "Port-Forward", k.Bindings[0].Action)
+	assert.Equal(t, "Shift-P", k.Bindings[0].Key)
+	assert.True(t, k.Bindings[0].InView("Pod", "pods"))
+	assert.False(t, k.Bindings[0].InView("Service"))
+	assert.True(t, k.Bindings[1].Disable)
+	assert.True(t, k.Bindings[2].InView("Service"))
+}
+
+func TestKeyMapLoadInvalid(t *testing.T) {
+	k := config.NewKeyMap()
+	assert.EqualError(t, k.LoadKeyMap("testdata/bad_keymap.yml"), "a key binding must specify a key or be disabled")
+	assert.Equal(t, 0, len(k.Bindings))
+}
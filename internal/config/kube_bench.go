@@ -0,0 +1,35 @@
+package config
+
+import "path/filepath"
+
+// DefaultKubeBenchImage represents the kube-bench image used unless specified.
+const DefaultKubeBenchImage = "docker.io/aquasec/kube-bench:v0.6.2"
+
+// K9sKubeBenchDir tracks the kube-bench results.
+var K9sKubeBenchDir = filepath.Join(K9sHome, "kube-bench")
+
+// KubeBench tracks the kube-bench settings.
+type KubeBench struct {
+	// Image represents the kube-bench image, preferably pinned by digest.
+	Image string `yaml:"image"`
+}
+
+// NewKubeBench returns a new kube-bench configuration.
+func NewKubeBench() *KubeBench {
+	k := KubeBench{}
+	k.Validate()
+
+	return &k
+}
+
+// Validate sets defaults for unspecified settings.
+func (k *KubeBench) Validate() {
+	if k.Image == "" {
+		k.Image = DefaultKubeBenchImage
+	}
+}
+
+// Dir returns the directory kube-bench results are saved to for a given cluster.
+func (k *KubeBench) Dir(cluster string) string {
+	return filepath.Join(K9sKubeBenchDir, cluster)
+}
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubeBenchValidate(t *testing.T) {
+	uu := map[string]struct {
+		k, e KubeBench
+	}{
+		"defaults": {
+			e: KubeBench{Image: DefaultKubeBenchImage},
+		},
+		"custom": {
+			k: KubeBench{Image: "fred/kube-bench@sha256:abc"},
+			e: KubeBench{Image: "fred/kube-bench@sha256:abc"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.k.Validate()
+			assert.Equal(t, u.e, u.k)
+		})
+	}
+}
+
+func TestKubeBenchDir(t *testing.T) {
+	assert.Equal(t, filepath.Join(K9sKubeBenchDir, "fred"), NewKubeBench().Dir("fred"))
+}
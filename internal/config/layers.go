@@ -0,0 +1,202 @@
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// K9sDefaultsFile represents the global cluster settings location.
+	K9sDefaultsFile = filepath.Join(K9sHome, "defaults.yml")
+	// K9sClustersDir represents the location of cluster specific settings.
+	K9sClustersDir = filepath.Join(K9sHome, "clusters")
+	// K9sContextsDir represents the location of context specific settings.
+	K9sContextsDir = filepath.Join(K9sHome, "contexts")
+)
+
+const (
+	// LayerStock represents K9s built-in cluster settings.
+	LayerStock = "stock"
+	// LayerGlobal represents cluster settings shared by all clusters.
+	LayerGlobal = "global"
+	// LayerLegacy represents cluster settings found in the main config file.
+	LayerLegacy = "legacy"
+	// LayerCluster represents cluster specific settings.
+	LayerCluster = "cluster"
+	// LayerContext represents context specific settings.
+	LayerContext = "context"
+)
+
+const layerHeader = "# K9s %s %q settings. Overrides %s.\n"
+
+// Layer represents a cluster settings layer.
+type Layer struct {
+	Name string
+	Path string
+	raw  []byte
+}
+
+// Exists checks if the layer is backed by a file.
+func (l Layer) Exists() bool {
+	if l.Path == "" {
+		return false
+	}
+	_, err := os.Stat(l.Path)
+
+	return err == nil
+}
+
+// ClusterFile returns the location of a cluster specific settings file.
+func ClusterFile(cluster string) string {
+	return filepath.Join(K9sClustersDir, layerName(cluster)+".yml")
+}
+
+// ContextFile returns the location of a context specific settings file.
+func ContextFile(context string) string {
+	return filepath.Join(K9sContextsDir, layerName(context)+".yml")
+}
+
+// Layers returns the active cluster settings layers, lowest precedence first.
+func (k *K9s) Layers() []Layer {
+	return k.layersFor(k.CurrentContext, k.CurrentCluster)
+}
+
+// LoadCluster merges all settings layers into the active cluster settings.
+func (k *K9s) LoadCluster() (*Cluster, error) {
+	return k.loadCluster(k.Layers())
+}
+
+// ContextCluster merges all settings layers of a given context and cluster,
+// leaving the active cluster settings untouched.
+func (k *K9s) ContextCluster(context, cluster string) (*Cluster, error) {
+	return k.loadCluster(k.layersFor(context, cluster))
+}
+
+func (k *K9s) layersFor(context, cluster string) []Layer {
+	ll := []Layer{
+		{Name: LayerStock},
+		{Name: LayerGlobal, Path: K9sDefaultsFile},
+	}
+	if raw, ok := k.legacy[cluster]; ok {
+		ll = append(ll, Layer{Name: LayerLegacy, Path: K9sConfigFile, raw: raw})
+	}
+
+	return append(ll,
+		Layer{Name: LayerCluster, Path: ClusterFile(cluster)},
+		Layer{Name: LayerContext, Path: ContextFile(context)},
+	)
+}
+
+func (k *K9s) loadCluster(layers []Layer) (*Cluster, error) {
+	cl := NewCluster()
+	for _, l := range layers {
+		raw := l.raw
+		if raw == nil && l.Name != LayerStock && l.Name != LayerLegacy {
+			var err error
+			if raw, err = ioutil.ReadFile(l.Path); err != nil {
+				if os.IsNotExist(err) {
+					continue
+				}
+				return cl, err
+			}
+		}
+		if err := yaml.Unmarshal(raw, cl); err != nil {
+			return cl, fmt.Errorf("invalid %s settings %s -- %s", l.Name, l.Path, err)
+		}
+	}
+
+	return cl, nil
+}
+
+// SaveLayers persists the active context settings. Missing cluster files are
+// created, migrating any settings found in the main config file.
+func (k *K9s) SaveLayers() error {
+	for name, raw := range k.legacy {
+		if err := createLayer(ClusterFile(name), LayerCluster, name, raw); err != nil {
+			return err
+		}
+	}
+	if err := createLayer(ClusterFile(k.CurrentCluster), LayerCluster, k.CurrentCluster, nil); err != nil {
+		return err
+	}
+
+	path := ContextFile(k.CurrentContext)
+	var ctx Cluster
+	if raw, err := ioutil.ReadFile(path); err == nil {
+		if err := yaml.Unmarshal(raw, &ctx); err != nil {
+			return fmt.Errorf("invalid context settings %s -- %s", path, err)
+		}
+	}
+	cl := k.ActiveCluster()
+	ctx.Namespace, ctx.View = cl.Namespace, cl.View
+	raw, err := yaml.Marshal(ctx)
+	if err != nil {
+		return err
+	}
+
+	return writeLayer(path, LayerContext, k.CurrentContext, raw)
+}
+
+// EffectiveYAML returns the effective configuration of the active cluster
+// context, listing the settings layers it was merged from.
+func (c *Config) EffectiveYAML() (string, error) {
+	var b strings.Builder
+	b.WriteString("# Cluster settings layers, lowest precedence first:\n")
+	for _, l := range c.K9s.Layers() {
+		switch {
+		case l.Path == "":
+			fmt.Fprintf(&b, "#   %-8s built-in\n", l.Name)
+		case l.Exists():
+			fmt.Fprintf(&b, "#   %-8s %s\n", l.Name, l.Path)
+		default:
+			fmt.Fprintf(&b, "#   %-8s %s (missing)\n", l.Name, l.Path)
+		}
+	}
+
+	k := *c.K9s
+	k.Clusters = nil
+	raw, err := yaml.Marshal(struct {
+		K9s     *K9s     `yaml:"k9s"`
+		Cluster *Cluster `yaml:"cluster"`
+	}{
+		K9s:     &k,
+		Cluster: c.K9s.ActiveCluster(),
+	})
+	if err != nil {
+		return "", err
+	}
+	b.Write(raw)
+
+	return b.String(), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func layerName(n string) string {
+	return strings.NewReplacer("/", "_", ":", "_", `\`, "_").Replace(n)
+}
+
+func createLayer(path, kind, name string, raw []byte) error {
+	if _, err := os.Stat(path); err == nil {
+		return nil
+	}
+
+	return writeLayer(path, kind, name, raw)
+}
+
+func writeLayer(path, kind, name string, raw []byte) error {
+	overrides := "global settings"
+	if kind == LayerContext {
+		overrides = "cluster settings"
+	}
+	header := fmt.Sprintf(layerHeader, kind, name, overrides)
+	EnsurePath(path, DefaultDirMod)
+
+	return ioutil.WriteFile(path, append([]byte(header), raw...), DefaultFileMod)
+}
@@ -0,0 +1,154 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestK9sLoadCluster(t *testing.T) {
+	defer useLayers("testdata/layers")()
+
+	uu := map[string]struct {
+		context, cluster string
+		ns, view         string
+		favs             []string
+		nodeShell        bool
+		shellNS          string
+	}{
+		"global": {
+			context:   "blee",
+			cluster:   "blee",
+			ns:        "default",
+			view:      "po",
+			favs:      []string{"default", "kube-system"},
+			nodeShell: true,
+			shellNS:   "default",
+		},
+		"cluster": {
+			context: "blee",
+			cluster: "c1",
+			ns:      "default",
+			view:    "dp",
+			favs:    []string{"default", "kube-system"},
+			shellNS: "default",
+		},
+		"context": {
+			context: "ctx1",
+			cluster: "c1",
+			ns:      "fred",
+			view:    "dp",
+			favs:    []string{"fred"},
+			shellNS: "ops",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := config.NewK9s()
+			c.CurrentContext, c.CurrentCluster = u.context, u.cluster
+			cl, err := c.LoadCluster()
+
+			assert.Nil(t, err)
+			assert.Equal(t, u.ns, cl.Namespace.Active)
+			assert.Equal(t, u.favs, cl.Namespace.Favorites)
+			assert.Equal(t, u.view, cl.View.Active)
+			assert.Equal(t, u.nodeShell, cl.FeatureGates.NodeShell)
+			assert.Equal(t, u.shellNS, cl.ShellPod.Namespace)
+			assert.Equal(t, "alpine:3.11", cl.ShellPod.Image)
+		})
+	}
+}
+
+func TestK9sLoadClusterLegacy(t *testing.T) {
+	defer useLayers("testdata/layers")()
+
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("testdata/k9s.yml"))
+	cfg.K9s.CurrentContext = "ctx1"
+
+	ll := cfg.K9s.Layers()
+	assert.Equal(t, 5, len(ll))
+	assert.Equal(t, config.LayerLegacy, ll[2].Name)
+
+	cl := cfg.K9s.ActiveCluster()
+	assert.Equal(t, "fred", cl.Namespace.Active)
+	assert.Equal(t, "ctx", cl.View.Active)
+	assert.True(t, cl.FeatureGates.NodeShell)
+}
+
+func TestK9sSaveLayers(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), "k9s-test-layers")
+	defer os.RemoveAll(dir)
+	defer useLayers(dir)()
+
+	cfg := config.NewConfig(NewMockKubeSettings())
+	assert.Nil(t, cfg.Load("testdata/k9s.yml"))
+	cfg.K9s.CurrentContext = "arn:aws:eks:us-east-1:1234:cluster/fred"
+	cfg.K9s.CurrentCluster = "fred"
+	cfg.SetActiveView("svc")
+	assert.Nil(t, cfg.K9s.SaveLayers())
+
+	for _, f := range []string{"clusters/fred.yml", "clusters/minikube.yml", "contexts/arn_aws_eks_us-east-1_1234_cluster_fred.yml"} {
+		_, err := os.Stat(filepath.Join(dir, f))
+		assert.Nil(t, err, f)
+	}
+	raw, err := ioutil.ReadFile(config.ContextFile(cfg.K9s.CurrentContext))
+	assert.Nil(t, err)
+	assert.Equal(t, savedContext, string(raw))
+
+	c := config.NewK9s()
+	c.CurrentContext, c.CurrentCluster = "minikube", "minikube"
+	cl, err := c.LoadCluster()
+	assert.Nil(t, err)
+	assert.Equal(t, "kube-system", cl.Namespace.Active)
+}
+
+func TestConfigEffectiveYAML(t *testing.T) {
+	defer useLayers("testdata/layers")()
+
+	cfg := config.NewConfig(NewMockKubeSettings())
+	cfg.K9s.CurrentContext, cfg.K9s.CurrentCluster = "ctx1", "c1"
+	raw, err := cfg.EffectiveYAML()
+
+	assert.Nil(t, err)
+	assert.Contains(t, raw, "#   stock    built-in\n")
+	assert.Contains(t, raw, "#   context  testdata/layers/contexts/ctx1.yml\n")
+	assert.Contains(t, raw, "#   cluster  testdata/layers/clusters/c1.yml\n")
+	assert.Contains(t, raw, "cluster:\n  namespace:\n    active: fred\n")
+	assert.NotContains(t, raw, "clusters:")
+}
+
+// Helpers...
+
+func useLayers(dir string) func() {
+	defaults, clusters, contexts := config.K9sDefaultsFile, config.K9sClustersDir, config.K9sContextsDir
+	config.K9sDefaultsFile = filepath.Join(dir, "defaults.yml")
+	config.K9sClustersDir = filepath.Join(dir, "clusters")
+	config.K9sContextsDir = filepath.Join(dir, "contexts")
+
+	return func() {
+		config.K9sDefaultsFile, config.K9sClustersDir, config.K9sContextsDir = defaults, clusters, contexts
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Test Data...
+
+var savedContext = `# K9s context "arn:aws:eks:us-east-1:1234:cluster/fred" settings. Overrides cluster settings.
+namespace:
+  active: default
+  favorites:
+  - default
+  - kube-public
+  - istio-system
+  - all
+  - kube-system
+view:
+  active: svc
+`
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// K9sLinks manages K9s external links.
+	K9sLinks = filepath.Join(K9sHome, "links.yml")
+	// K9sLinksDir tracks additional K9s links files.
+	K9sLinksDir = filepath.Join(K9sHome, "links.d")
+)
+
+// Links represents a collection of external links.
+type Links struct {
+	Link map[string]Link `yaml:"link"`
+}
+
+// Link describes an external link templated from the selected resource.
+type Link struct {
+	ContextScope `yaml:",inline"`
+
+	Description string   `yaml:"description"`
+	Scopes      []string `yaml:"scopes"`
+	URL         string   `yaml:"url"`
+}
+
+// NewLinks returns a new links collection.
+func NewLinks() Links {
+	return Links{
+		Link: make(map[string]Link),
+	}
+}
+
+// Load K9s links.
+func (l Links) Load() error {
+	for _, f := range ConfigLayers(K9sLinks) {
+		if err := l.LoadLinks(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	for _, d := range ConfigLayers(K9sLinksDir) {
+		if err := l.LoadLinksDir(d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadLinksDir loads links from all yaml files in a given directory.
+func (l Links) LoadLinksDir(dir string) error {
+	ff, err := configFiles(dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range ff {
+		if err := l.LoadLinks(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadLinks loads links from a given file.
+func (l Links) LoadLinks(path string) error {
+	return readIncludes(path, func(raw []byte) error {
+		var ll Links
+		if err := yaml.Unmarshal(raw, &ll); err != nil {
+			return err
+		}
+		for k, v := range ll.Link {
+			l.Link[k] = v
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,28 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinksLoad(t *testing.T) {
+	l := config.NewLinks()
+	assert.Nil(t, l.LoadLinks("testdata/links.yml"))
+
+	assert.Equal(t, 2, len(l.Link))
+
+	k, ok := l.Link["grafana"]
+	assert.True(t, ok)
+	assert.Equal(t, "Grafana Dashboard", k.Description)
+	assert.Equal(t, []string{"apps/v1/deployments", "sts"}, k.Scopes)
+	assert.Equal(t, "https://grafana.example.com/d/k8s?var-cluster=$CLUSTER&var-namespace=$NAMESPACE&var-app=$NAME", k.URL)
+	assert.True(t, k.InContext("fred", "dev-cluster"))
+
+	k, ok = l.Link["runbook"]
+	assert.True(t, ok)
+	assert.Equal(t, []string{"all"}, k.Scopes)
+	assert.True(t, k.InContext("fred", "prod-cluster"))
+	assert.False(t, k.InContext("fred", "dev-cluster"))
+}
@@ -0,0 +1,148 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sMacros manages K9s command macros.
+var K9sMacros = filepath.Join(K9sHome, "macro.yml")
+
+// Macros represents a collection of command macros.
+type Macros struct {
+	Include []string         `yaml:"include,omitempty"`
+	Macro   map[string]Macro `yaml:"macro"`
+}
+
+// Macro describes a named sequence of commands, filters and key actions.
+type Macro struct {
+	Description string      `yaml:"description,omitempty"`
+	Steps       []MacroStep `yaml:"steps"`
+}
+
+// MacroStep represents a single macro step. A step either runs a command,
+// applies or clears a filter or fires a key action on the current view.
+type MacroStep struct {
+	Command     string `yaml:"command,omitempty"`
+	Filter      string `yaml:"filter,omitempty"`
+	ClearFilter bool   `yaml:"clearFilter,omitempty"`
+	Key         string `yaml:"key,omitempty"`
+}
+
+// Validate checks a step specifies exactly one action.
+func (s MacroStep) Validate() error {
+	var count int
+	for _, v := range []string{s.Command, s.Filter, s.Key} {
+		if v != "" {
+			count++
+		}
+	}
+	if s.ClearFilter {
+		count++
+	}
+	if count != 1 {
+		return errors.New("a macro step must specify exactly one of command, filter, clearFilter or key")
+	}
+
+	return nil
+}
+
+// Validate checks the macro steps are valid.
+func (m Macro) Validate() error {
+	if len(m.Steps) == 0 {
+		return errors.New("a macro must specify at least one step")
+	}
+	for i, s := range m.Steps {
+		if err := s.Validate(); err != nil {
+			return fmt.Errorf("step #%d -- %s", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks all macros are valid.
+func (m Macros) Validate() error {
+	for n, mc := range m.Macro {
+		if err := mc.Validate(); err != nil {
+			return fmt.Errorf("macro %s -- %s", n, err)
+		}
+	}
+
+	return nil
+}
+
+// NewMacros returns a new macro collection.
+func NewMacros() Macros {
+	return Macros{
+		Macro: make(map[string]Macro),
+	}
+}
+
+// Load K9s macros.
+func (m Macros) Load() error {
+	for _, f := range ConfigLayers(K9sMacros) {
+		if err := m.LoadMacros(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadMacros loads macros from a given file.
+func (m Macros) LoadMacros(path string) error {
+	return readIncludes(path, func(raw []byte) error {
+		var mm Macros
+		if err := yaml.Unmarshal(raw, &mm); err != nil {
+			return err
+		}
+		for k, v := range mm.Macro {
+			m.Macro[k] = v
+		}
+
+		return nil
+	})
+}
+
+// UserMacros loads the macros defined in a given file only. Includes are left
+// unresolved so the file can be saved back as is.
+func UserMacros(path string) (Macros, error) {
+	m := NewMacros()
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return m, nil
+		}
+		return m, err
+	}
+	if err := yaml.Unmarshal(raw, &m); err != nil {
+		return m, err
+	}
+	if m.Macro == nil {
+		m.Macro = make(map[string]Macro)
+	}
+
+	return m, nil
+}
+
+// Save K9s macros.
+func (m Macros) Save() error {
+	return m.SaveMacros(K9sMacros)
+}
+
+// SaveMacros saves macros to a given file.
+func (m Macros) SaveMacros(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, DefaultFileMod)
+}
@@ -0,0 +1,101 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMacroLoad(t *testing.T) {
+	m := config.NewMacros()
+	assert.Nil(t, m.LoadMacros("testdata/macro.yml"))
+
+	assert.Equal(t, 2, len(m.Macro))
+	mc, ok := m.Macro["incident"]
+	assert.True(t, ok)
+	assert.Equal(t, "Triage payments incident", mc.Description)
+	assert.Equal(t, 3, len(mc.Steps))
+	assert.Equal(t, "pods payments", mc.Steps[0].Command)
+	assert.Equal(t, "api", mc.Steps[1].Filter)
+	assert.Equal(t, "l", mc.Steps[2].Key)
+	assert.Nil(t, mc.Validate())
+}
+
+func TestMacroValidate(t *testing.T) {
+	uu := map[string]struct {
+		m   config.Macro
+		err string
+	}{
+		"ok": {
+			m: config.Macro{Steps: []config.MacroStep{{Command: "po"}, {Key: "l"}}},
+		},
+		"empty": {
+			m:   config.Macro{},
+			err: "a macro must specify at least one step",
+		},
+		"blank": {
+			m:   config.Macro{Steps: []config.MacroStep{{}}},
+			err: "step #1 -- a macro step must specify exactly one of command, filter, clearFilter or key",
+		},
+		"multi": {
+			m:   config.Macro{Steps: []config.MacroStep{{Command: "po"}, {Command: "dp", Key: "d"}}},
+			err: "step #2 -- a macro step must specify exactly one of command, filter, clearFilter or key",
+		},
+		"clear": {
+			m: config.Macro{Steps: []config.MacroStep{{Filter: "api"}, {ClearFilter: true}}},
+		},
+		"clearMulti": {
+			m:   config.Macro{Steps: []config.MacroStep{{Filter: "api", ClearFilter: true}}},
+			err: "step #1 -- a macro step must specify exactly one of command, filter, clearFilter or key",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.m.Validate()
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+func TestMacroSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-macros")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "macro.yml")
+	m := config.NewMacros()
+	m.Macro["fred"] = config.Macro{Steps: []config.MacroStep{{Command: "po"}, {Filter: "blee"}}}
+	assert.Nil(t, m.SaveMacros(path))
+
+	mm := config.NewMacros()
+	assert.Nil(t, mm.LoadMacros(path))
+	assert.Equal(t, m, mm)
+}
+
+func TestUserMacros(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-macros")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "macro.yml")
+	mm, err := config.UserMacros(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(mm.Macro))
+
+	raw := "include:\n- shared.yml\nmacro:\n  fred:\n    steps:\n    - command: po\n"
+	assert.Nil(t, ioutil.WriteFile(path, []byte(raw), 0600))
+	mm, err = config.UserMacros(path)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"shared.yml"}, mm.Include)
+	assert.Equal(t, 1, len(mm.Macro))
+}
@@ -0,0 +1,49 @@
+package config
+
+import "time"
+
+const (
+	defaultMetricsPollInterval = 15
+	defaultMetricsStaleness    = 120
+)
+
+// Metrics tracks metrics-server polling settings.
+type Metrics struct {
+	// PollInterval represents the number of seconds metrics are cached and
+	// shared across views before metrics-server is polled again.
+	PollInterval int `yaml:"pollInterval"`
+	// Staleness represents the number of seconds cached metrics are still
+	// shown when metrics-server can't be reached.
+	Staleness int `yaml:"staleness"`
+}
+
+// NewMetrics returns a new metrics configuration.
+func NewMetrics() *Metrics {
+	m := Metrics{}
+	m.Validate()
+
+	return &m
+}
+
+// Validate sets defaults for unspecified settings.
+func (m *Metrics) Validate() {
+	if m.PollInterval <= 0 {
+		m.PollInterval = defaultMetricsPollInterval
+	}
+	if m.Staleness < m.PollInterval {
+		m.Staleness = defaultMetricsStaleness
+		if m.Staleness < m.PollInterval {
+			m.Staleness = m.PollInterval
+		}
+	}
+}
+
+// Poll returns the metrics polling interval.
+func (m *Metrics) Poll() time.Duration {
+	return time.Duration(m.PollInterval) * time.Second
+}
+
+// MaxStaleness returns how long metrics are shown when they can't be refreshed.
+func (m *Metrics) MaxStaleness() time.Duration {
+	return time.Duration(m.Staleness) * time.Second
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsValidate(t *testing.T) {
+	uu := map[string]struct {
+		m, e Metrics
+	}{
+		"defaults": {
+			e: Metrics{PollInterval: defaultMetricsPollInterval, Staleness: defaultMetricsStaleness},
+		},
+		"custom": {
+			m: Metrics{PollInterval: 30, Staleness: 300},
+			e: Metrics{PollInterval: 30, Staleness: 300},
+		},
+		"tooFresh": {
+			m: Metrics{PollInterval: 30, Staleness: 10},
+			e: Metrics{PollInterval: 30, Staleness: defaultMetricsStaleness},
+		},
+		"slowPoll": {
+			m: Metrics{PollInterval: 600},
+			e: Metrics{PollInterval: 600, Staleness: 600},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.m.Validate()
+			assert.Equal(t, u.e, u.m)
+		})
+	}
+}
+
+func TestMetricsDurations(t *testing.T) {
+	m := NewMetrics()
+
+	assert.Equal(t, 15*time.Second, m.Poll())
+	assert.Equal(t, 2*time.Minute, m.MaxStaleness())
+}
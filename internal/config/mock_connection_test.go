@@ -97,6 +97,21 @@ func (mock *MockConnection) CheckConnectivity() bool {
 	return ret0
 }
 
+func (mock *MockConnection) RefreshCredentials() error {
+	if mock == nil {
+		panic("mock must not be nil. Use myMock := NewMockConnection().")
+	}
+	params := []pegomock.Param{}
+	result := pegomock.GetGenericMockFrom(mock).Invoke("RefreshCredentials", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
+	var ret0 error
+	if len(result) != 0 {
+		if result[0] != nil {
+			ret0 = result[0].(error)
+		}
+	}
+	return ret0
+}
+
 func (mock *MockConnection) DialOrDie() kubernetes.Interface {
 	if mock == nil {
 		panic("mock must not be nil. Use myMock := NewMockConnection().")
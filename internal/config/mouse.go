@@ -0,0 +1,66 @@
+package config
+
+import "github.com/rs/zerolog/log"
+
+const (
+	// MouseNone ignores a mouse event.
+	MouseNone = "none"
+	// MouseSelect selects the row under the pointer.
+	MouseSelect = "select"
+	// MouseDrill selects and drills down into the row under the pointer.
+	MouseDrill = "drill"
+	// MouseMenu pops a context menu of the row actions.
+	MouseMenu = "menu"
+	// MouseScroll scrolls the view content.
+	MouseScroll = "scroll"
+)
+
+// Mouse tracks mouse behavior settings.
+type Mouse struct {
+	Enable bool          `yaml:"enable"`
+	Table  MouseBindings `yaml:"table"`
+	Logs   MouseBindings `yaml:"logs"`
+}
+
+// MouseBindings maps mouse events to behaviors for a given component.
+type MouseBindings struct {
+	Click       string `yaml:"click,omitempty"`
+	DoubleClick string `yaml:"doubleClick,omitempty"`
+	RightClick  string `yaml:"rightClick,omitempty"`
+	Wheel       string `yaml:"wheel,omitempty"`
+}
+
+// NewMouse returns a new mouse configuration.
+func NewMouse() *Mouse {
+	m := Mouse{}
+	m.Validate()
+
+	return &m
+}
+
+// Validate sets defaults for unspecified or invalid behaviors.
+func (m *Mouse) Validate() {
+	clicks := []string{MouseNone, MouseSelect, MouseDrill, MouseMenu}
+	m.Table.Click = mouseBehavior("table click", m.Table.Click, MouseSelect, clicks)
+	m.Table.DoubleClick = mouseBehavior("table doubleClick", m.Table.DoubleClick, MouseDrill, clicks)
+	m.Table.RightClick = mouseBehavior("table rightClick", m.Table.RightClick, MouseMenu, clicks)
+	m.Table.Wheel = mouseBehavior("table wheel", m.Table.Wheel, MouseSelect, []string{MouseNone, MouseSelect, MouseScroll})
+
+	textClicks := []string{MouseNone, MouseMenu}
+	m.Logs.Click = mouseBehavior("logs click", m.Logs.Click, MouseNone, textClicks)
+	m.Logs.DoubleClick = mouseBehavior("logs doubleClick", m.Logs.DoubleClick, MouseNone, textClicks)
+	m.Logs.RightClick = mouseBehavior("logs rightClick", m.Logs.RightClick, MouseMenu, textClicks)
+	m.Logs.Wheel = mouseBehavior("logs wheel", m.Logs.Wheel, MouseScroll, []string{MouseNone, MouseScroll})
+}
+
+func mouseBehavior(kind, v, dflt string, allowed []string) string {
+	if v == "" {
+		return dflt
+	}
+	if !InList(allowed, v) {
+		log.Warn().Msgf("Invalid mouse %s behavior %q. Using %q", kind, v, dflt)
+		return dflt
+	}
+
+	return v
+}
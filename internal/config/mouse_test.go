@@ -0,0 +1,44 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMouseDefaults(t *testing.T) {
+	m := config.NewMouse()
+
+	assert.False(t, m.Enable)
+	assert.Equal(t, config.MouseBindings{
+		Click:       config.MouseSelect,
+		DoubleClick: config.MouseDrill,
+		RightClick:  config.MouseMenu,
+		Wheel:       config.MouseSelect,
+	}, m.Table)
+	assert.Equal(t, config.MouseBindings{
+		Click:       config.MouseNone,
+		DoubleClick: config.MouseNone,
+		RightClick:  config.MouseMenu,
+		Wheel:       config.MouseScroll,
+	}, m.Logs)
+}
+
+func TestMouseValidate(t *testing.T) {
+	m := config.Mouse{
+		Enable: true,
+		Table:  config.MouseBindings{Click: config.MouseDrill, RightClick: "bozo", Wheel: config.MouseScroll},
+		Logs:   config.MouseBindings{Click: config.MouseMenu, DoubleClick: config.MouseDrill, Wheel: config.MouseNone},
+	}
+	m.Validate()
+
+	assert.Equal(t, config.MouseDrill, m.Table.Click)
+	assert.Equal(t, config.MouseDrill, m.Table.DoubleClick)
+	assert.Equal(t, config.MouseMenu, m.Table.RightClick)
+	assert.Equal(t, config.MouseScroll, m.Table.Wheel)
+	assert.Equal(t, config.MouseMenu, m.Logs.Click)
+	assert.Equal(t, config.MouseNone, m.Logs.DoubleClick)
+	assert.Equal(t, config.MouseMenu, m.Logs.RightClick)
+	assert.Equal(t, config.MouseNone, m.Logs.Wheel)
+}
@@ -0,0 +1,77 @@
+package config
+
+import (
+	"github.com/rs/zerolog/log"
+)
+
+// Desktop notification methods.
+const (
+	// NotifyAuto picks notify-send when available or a terminal escape sequence.
+	NotifyAuto = "auto"
+	// NotifySend uses the notify-send command.
+	NotifySend = "notify-send"
+	// NotifyOSC777 uses the OSC 777 terminal escape sequence (urxvt, foot, ...).
+	NotifyOSC777 = "osc777"
+	// NotifyOSC9 uses the OSC 9 terminal escape sequence (iTerm2, WezTerm, ...).
+	NotifyOSC9 = "osc9"
+)
+
+// Desktop notification events.
+const (
+	// NotifyBenchmark fires when a benchmark completes.
+	NotifyBenchmark = "benchmark"
+	// NotifyPortForward fires when a port-forward dies.
+	NotifyPortForward = "portForward"
+	// NotifyAlert fires when an alert rule fires.
+	NotifyAlert = "alert"
+)
+
+// Notify tracks the desktop notifications settings.
+type Notify struct {
+	// Enabled toggles desktop notifications.
+	Enabled bool `yaml:"enabled"`
+
+	// Method represents how notifications are delivered.
+	Method string `yaml:"method"`
+
+	// Events represents the events to notify on. All events are notified
+	// when empty.
+	Events []string `yaml:"events,omitempty"`
+}
+
+// NewNotify returns a new desktop notifications configuration.
+func NewNotify() *Notify {
+	n := Notify{}
+	n.Validate()
+
+	return &n
+}
+
+// Validate sets defaults for unspecified settings.
+func (n *Notify) Validate() {
+	switch n.Method {
+	case NotifyAuto, NotifySend, NotifyOSC777, NotifyOSC9:
+	case "":
+		n.Method = NotifyAuto
+	default:
+		log.Warn().Msgf("Invalid notification method %q. Using %s", n.Method, NotifyAuto)
+		n.Method = NotifyAuto
+	}
+}
+
+// Notifies checks if a given event raises a desktop notification.
+func (n *Notify) Notifies(event string) bool {
+	if !n.Enabled {
+		return false
+	}
+	if len(n.Events) == 0 {
+		return true
+	}
+	for _, e := range n.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
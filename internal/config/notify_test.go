@@ -0,0 +1,67 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotifyValidate(t *testing.T) {
+	uu := map[string]struct {
+		n Notify
+		e string
+	}{
+		"default": {
+			e: NotifyAuto,
+		},
+		"custom": {
+			n: Notify{Method: NotifyOSC9},
+			e: NotifyOSC9,
+		},
+		"invalid": {
+			n: Notify{Method: "smoke-signals"},
+			e: NotifyAuto,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.n.Validate()
+			assert.Equal(t, u.e, u.n.Method)
+		})
+	}
+}
+
+func TestNotifyNotifies(t *testing.T) {
+	uu := map[string]struct {
+		n     Notify
+		event string
+		e     bool
+	}{
+		"disabled": {
+			event: NotifyAlert,
+		},
+		"all": {
+			n:     Notify{Enabled: true},
+			event: NotifyAlert,
+			e:     true,
+		},
+		"listed": {
+			n:     Notify{Enabled: true, Events: []string{NotifyBenchmark, NotifyAlert}},
+			event: NotifyAlert,
+			e:     true,
+		},
+		"unlisted": {
+			n:     Notify{Enabled: true, Events: []string{NotifyBenchmark}},
+			event: NotifyPortForward,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.n.Notifies(u.event))
+		})
+	}
+}
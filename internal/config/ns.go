@@ -1,6 +1,9 @@
 package config
 
 import (
+	"sort"
+	"time"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
 )
@@ -8,14 +11,42 @@ import (
 const (
 	// MaxFavoritesNS number # favorite namespaces to keep in the configuration.
 	MaxFavoritesNS = 9
-	defaultNS      = "default"
-	allNS          = "all"
+	// MaxUsageNS number # namespaces usage to keep in the configuration.
+	MaxUsageNS = 50
+	defaultNS  = "default"
+	allNS      = "all"
 )
 
-// Namespace tracks active and favorites namespaces.
+// Namespace tracks active, favorites and pinned namespaces as well as
+// namespaces usage.
 type Namespace struct {
-	Active    string   `yaml:"active"`
-	Favorites []string `yaml:"favorites"`
+	Active    string              `yaml:"active"`
+	Favorites []string            `yaml:"favorites"`
+	Pinned    []string            `yaml:"pinned,omitempty"`
+	Usage     map[string]*NSUsage `yaml:"usage,omitempty"`
+}
+
+// NSUsage tracks how often and how recently a namespace was used.
+type NSUsage struct {
+	Count    int       `yaml:"count"`
+	LastUsed time.Time `yaml:"lastUsed"`
+}
+
+// Score returns the namespace frecency, favoring recently used namespaces.
+func (u *NSUsage) Score(now time.Time) float64 {
+	var weight float64
+	switch age := now.Sub(u.LastUsed); {
+	case age < time.Hour:
+		weight = 4
+	case age < 24*time.Hour:
+		weight = 2
+	case age < 7*24*time.Hour:
+		weight = 1
+	default:
+		weight = 0.5
+	}
+
+	return float64(u.Count) * weight
 }
 
 // NewNamespace create a new namespace configuration.
@@ -43,6 +74,18 @@ func (n *Namespace) Validate(c client.Connection, ks KubeSettings) {
 			n.rmFavNS(ns)
 		}
 	}
+	pp := make([]string, 0, len(n.Pinned))
+	for _, ns := range n.Pinned {
+		if ns == allNS || InList(nn, ns) {
+			pp = append(pp, ns)
+		}
+	}
+	n.Pinned = pp
+	for ns := range n.Usage {
+		if ns != allNS && !InList(nn, ns) {
+			delete(n.Usage, ns)
+		}
+	}
 }
 
 // SetActive set the active namespace.
@@ -51,10 +94,87 @@ func (n *Namespace) SetActive(ns string, ks KubeSettings) error {
 	n.Active = ns
 	if ns != "" {
 		n.addFavNS(ns)
+		n.recordUsage(ns, time.Now())
 	}
 	return nil
 }
 
+// IsPinned checks if a namespace is pinned.
+func (n *Namespace) IsPinned(ns string) bool {
+	return InList(n.Pinned, ns)
+}
+
+// TogglePin pins or unpins a namespace. Returns true if the namespace is now pinned.
+func (n *Namespace) TogglePin(ns string) bool {
+	for i, p := range n.Pinned {
+		if p == ns {
+			n.Pinned = append(n.Pinned[:i], n.Pinned[i+1:]...)
+			return false
+		}
+	}
+	n.Pinned = append(n.Pinned, ns)
+
+	return true
+}
+
+// Ranked returns pinned namespaces first, followed by the used namespaces
+// ordered by frecency and the remaining favorites.
+func (n *Namespace) Ranked(now time.Time) []string {
+	used := make([]string, 0, len(n.Usage))
+	for ns := range n.Usage {
+		used = append(used, ns)
+	}
+	sort.Slice(used, func(i, j int) bool {
+		ui, uj := n.Usage[used[i]], n.Usage[used[j]]
+		si, sj := ui.Score(now), uj.Score(now)
+		if si != sj {
+			return si > sj
+		}
+		if !ui.LastUsed.Equal(uj.LastUsed) {
+			return ui.LastUsed.After(uj.LastUsed)
+		}
+		return used[i] < used[j]
+	})
+
+	nn := make([]string, 0, len(n.Pinned)+len(used)+len(n.Favorites))
+	for _, l := range [][]string{n.Pinned, used, n.Favorites} {
+		for _, ns := range l {
+			if !InList(nn, ns) {
+				nn = append(nn, ns)
+			}
+		}
+	}
+
+	return nn
+}
+
+func (n *Namespace) recordUsage(ns string, now time.Time) {
+	if n.Usage == nil {
+		n.Usage = make(map[string]*NSUsage)
+	}
+	u, ok := n.Usage[ns]
+	if !ok {
+		u = &NSUsage{}
+		n.Usage[ns] = u
+	}
+	u.Count++
+	u.LastUsed = now
+
+	if len(n.Usage) <= MaxUsageNS {
+		return
+	}
+	var victim string
+	for k, v := range n.Usage {
+		if k == ns {
+			continue
+		}
+		if victim == "" || v.Score(now) < n.Usage[victim].Score(now) {
+			victim = k
+		}
+	}
+	delete(n.Usage, victim)
+}
+
 func (n *Namespace) isAllNamespaces() bool {
 	return n.Active == allNS || n.Active == ""
 }
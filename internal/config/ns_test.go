@@ -3,6 +3,7 @@ package config_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
 	m "github.com/petergtz/pegomock"
@@ -92,3 +93,76 @@ func TestNSValidateRmFavs(t *testing.T) {
 
 	assert.Equal(t, []string{"default"}, ns.Favorites)
 }
+
+func TestNSValidateRmPinnedAndUsage(t *testing.T) {
+	mc := NewMockConnection()
+	m.When(mc.ValidNamespaces()).ThenReturn(namespaces(), nil)
+	mk := NewMockKubeSettings()
+	m.When(mk.NamespaceNames(namespaces())).ThenReturn([]string{"default", "kube-system"})
+
+	ns := config.NewNamespace()
+	ns.Pinned = []string{"fred", "kube-system"}
+	ns.Usage = map[string]*config.NSUsage{"blee": {Count: 1}, "default": {Count: 2}}
+	ns.Validate(mc, mk)
+
+	assert.Equal(t, []string{"kube-system"}, ns.Pinned)
+	assert.Equal(t, map[string]*config.NSUsage{"default": {Count: 2}}, ns.Usage)
+}
+
+func TestNSUsageScore(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		age time.Duration
+		e   float64
+	}{
+		"recent": {age: time.Minute, e: 8},
+		"today":  {age: 2 * time.Hour, e: 4},
+		"week":   {age: 3 * 24 * time.Hour, e: 2},
+		"stale":  {age: 30 * 24 * time.Hour, e: 1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			us := config.NSUsage{Count: 2, LastUsed: now.Add(-u.age)}
+			assert.Equal(t, u.e, us.Score(now))
+		})
+	}
+}
+
+func TestNSRanked(t *testing.T) {
+	now := time.Now()
+	ns := config.NewNamespace()
+	ns.Favorites = []string{"default", "fred", "blee"}
+	ns.Pinned = []string{"kube-system"}
+	ns.Usage = map[string]*config.NSUsage{
+		"blee":   {Count: 1, LastUsed: now.Add(-time.Minute)},
+		"duh":    {Count: 10, LastUsed: now.Add(-30 * 24 * time.Hour)},
+		"zorg":   {Count: 1, LastUsed: now.Add(-2 * time.Minute)},
+		"system": {Count: 1, LastUsed: now.Add(-2 * time.Minute)},
+	}
+
+	assert.Equal(t, []string{"kube-system", "duh", "blee", "system", "zorg", "default", "fred"}, ns.Ranked(now))
+}
+
+func TestNSTogglePin(t *testing.T) {
+	ns := config.NewNamespace()
+
+	assert.True(t, ns.TogglePin("fred"))
+	assert.True(t, ns.TogglePin("blee"))
+	assert.True(t, ns.IsPinned("fred"))
+	assert.False(t, ns.TogglePin("fred"))
+	assert.False(t, ns.IsPinned("fred"))
+	assert.Equal(t, []string{"blee"}, ns.Pinned)
+}
+
+func TestNSUsageCap(t *testing.T) {
+	mk := NewMockKubeSettings()
+	ns := config.NewNamespace()
+	for i := 0; i <= config.MaxUsageNS; i++ {
+		assert.Nil(t, ns.SetActive(fmt.Sprintf("ns%d", i), mk))
+	}
+
+	assert.Equal(t, config.MaxUsageNS, len(ns.Usage))
+	assert.Contains(t, ns.Usage, fmt.Sprintf("ns%d", config.MaxUsageNS))
+}
@@ -0,0 +1,38 @@
+package config
+
+const (
+	defaultPagingThreshold = 5000
+	defaultPageSize        = 500
+)
+
+// Paging tracks large collections listing settings.
+type Paging struct {
+	// Threshold represents the collection size past which listings are
+	// paginated instead of cached. A negative value disables paging.
+	Threshold int `yaml:"threshold"`
+	// PageSize represents the number of items fetched per page.
+	PageSize int `yaml:"pageSize"`
+}
+
+// NewPaging returns a new paging configuration.
+func NewPaging() *Paging {
+	p := Paging{}
+	p.Validate()
+
+	return &p
+}
+
+// Validate sets defaults for unspecified settings.
+func (p *Paging) Validate() {
+	if p.Threshold == 0 {
+		p.Threshold = defaultPagingThreshold
+	}
+	if p.PageSize <= 0 {
+		p.PageSize = defaultPageSize
+	}
+}
+
+// Enabled checks if large listings are paginated.
+func (p *Paging) Enabled() bool {
+	return p.Threshold > 0
+}
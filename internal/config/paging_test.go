@@ -0,0 +1,37 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagingValidate(t *testing.T) {
+	uu := map[string]struct {
+		p, e    Paging
+		enabled bool
+	}{
+		"defaults": {
+			e:       Paging{Threshold: defaultPagingThreshold, PageSize: defaultPageSize},
+			enabled: true,
+		},
+		"custom": {
+			p:       Paging{Threshold: 100, PageSize: 10},
+			e:       Paging{Threshold: 100, PageSize: 10},
+			enabled: true,
+		},
+		"disabled": {
+			p: Paging{Threshold: -1, PageSize: -5},
+			e: Paging{Threshold: -1, PageSize: defaultPageSize},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.p.Validate()
+			assert.Equal(t, u.e, u.p)
+			assert.Equal(t, u.enabled, u.p.Enabled())
+		})
+	}
+}
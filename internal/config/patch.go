@@ -0,0 +1,118 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// K9sPatches manages K9s quick patches.
+	K9sPatches = filepath.Join(K9sHome, "patch.yml")
+	// K9sPatchesDir tracks additional K9s patches files.
+	K9sPatchesDir = filepath.Join(K9sHome, "patches.d")
+)
+
+const (
+	// PatchStrategic represents a strategic merge patch.
+	PatchStrategic = "strategic"
+	// PatchMerge represents a JSON merge patch.
+	PatchMerge = "merge"
+	// PatchJSON represents a JSON patch.
+	PatchJSON = "json"
+)
+
+// Patches represents a collection of quick patches.
+type Patches struct {
+	Patch map[string]Patch `yaml:"patch"`
+}
+
+// Patch describes a named patch applied to the selected resource.
+type Patch struct {
+	ContextScope `yaml:",inline"`
+
+	ShortCut    string        `yaml:"shortCut"`
+	Scopes      []string      `yaml:"scopes"`
+	Description string        `yaml:"description"`
+	Type        string        `yaml:"type"`
+	Patch       string        `yaml:"patch"`
+	Params      []PluginParam `yaml:"params"`
+}
+
+// NewPatches returns a new patches collection.
+func NewPatches() Patches {
+	return Patches{
+		Patch: make(map[string]Patch),
+	}
+}
+
+// Validate checks the patch type and body.
+func (p Patch) Validate() error {
+	switch p.Type {
+	case "", PatchStrategic, PatchMerge, PatchJSON:
+	default:
+		return fmt.Errorf("invalid patch type %q. Must be one of %s, %s or %s", p.Type, PatchStrategic, PatchMerge, PatchJSON)
+	}
+	if p.Patch == "" {
+		return fmt.Errorf("no patch specified for %q", p.Description)
+	}
+
+	return ValidateParams(p.Params)
+}
+
+// PatchType returns the patch type, defaulting to a strategic merge patch.
+func (p Patch) PatchType() string {
+	if p.Type == "" {
+		return PatchStrategic
+	}
+
+	return p.Type
+}
+
+// Load K9s patches.
+func (p Patches) Load() error {
+	for _, f := range ConfigLayers(K9sPatches) {
+		if err := p.LoadPatches(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	for _, d := range ConfigLayers(K9sPatchesDir) {
+		if err := p.LoadPatchesDir(d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadPatchesDir loads patches from all yaml files in a given directory.
+func (p Patches) LoadPatchesDir(dir string) error {
+	ff, err := configFiles(dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range ff {
+		if err := p.LoadPatches(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadPatches loads patches from a given file.
+func (p Patches) LoadPatches(path string) error {
+	return readIncludes(path, func(raw []byte) error {
+		var pp Patches
+		if err := yaml.Unmarshal(raw, &pp); err != nil {
+			return err
+		}
+		for k, v := range pp.Patch {
+			p.Patch[k] = v
+		}
+
+		return nil
+	})
+}
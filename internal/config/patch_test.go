@@ -0,0 +1,64 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPatchLoad(t *testing.T) {
+	p := config.NewPatches()
+	assert.Nil(t, p.LoadPatches("testdata/patch.yml"))
+
+	assert.Equal(t, 2, len(p.Patch))
+	k, ok := p.Patch["debug"]
+	assert.True(t, ok)
+	assert.Equal(t, "Shift-D", k.ShortCut)
+	assert.Equal(t, []string{"dp"}, k.Scopes)
+	assert.Equal(t, config.PatchMerge, k.PatchType())
+	assert.Contains(t, k.Patch, "log-level: $LEVEL")
+	assert.Equal(t, 1, len(k.Params))
+	assert.Nil(t, k.Validate())
+
+	k, ok = p.Patch["suspend"]
+	assert.True(t, ok)
+	assert.Equal(t, config.PatchStrategic, k.PatchType())
+	assert.Nil(t, k.Validate())
+}
+
+func TestPatchLoadDirMissing(t *testing.T) {
+	p := config.NewPatches()
+	assert.Nil(t, p.LoadPatchesDir("testdata/patches.nope"))
+	assert.Equal(t, 0, len(p.Patch))
+}
+
+func TestPatchValidate(t *testing.T) {
+	uu := map[string]struct {
+		p   config.Patch
+		err string
+	}{
+		"default": {p: config.Patch{Patch: "{}"}},
+		"json":    {p: config.Patch{Type: config.PatchJSON, Patch: "[]"}},
+		"badType": {
+			p:   config.Patch{Type: "fred", Patch: "{}"},
+			err: `invalid patch type "fred". Must be one of strategic, merge or json`,
+		},
+		"noPatch": {
+			p:   config.Patch{Description: "blee"},
+			err: `no patch specified for "blee"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.p.Validate()
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
@@ -1,14 +1,23 @@
 package config
 
 import (
-	"io/ioutil"
+	"fmt"
+	"os"
 	"path/filepath"
+	"regexp"
 
 	"gopkg.in/yaml.v2"
 )
 
-// K9sPlugins manages K9s plugins.
-var K9sPlugins = filepath.Join(K9sHome, "plugin.yml")
+var (
+	// K9sPlugins manages K9s plugins.
+	K9sPlugins = filepath.Join(K9sHome, "plugin.yml")
+	// K9sPluginsDir tracks additional K9s plugins files.
+	K9sPluginsDir = filepath.Join(K9sHome, "plugins.d")
+)
+
+// paramNameRX matches param names usable as env vars.
+var paramNameRX = regexp.MustCompile(`^\w+$`)
 
 // Plugins represents a collection of plugins.
 type Plugins struct {
@@ -17,12 +26,60 @@ type Plugins struct {
 
 // Plugin describes a K9s plugin
 type Plugin struct {
-	ShortCut    string   `yaml:"shortCut"`
-	Scopes      []string `yaml:"scopes"`
+	ContextScope `yaml:",inline"`
+
+	ShortCut    string        `yaml:"shortCut"`
+	Scopes      []string      `yaml:"scopes"`
+	Description string        `yaml:"description"`
+	Command     string        `yaml:"command"`
+	Background  bool          `yaml:"background"`
+	Async       bool          `yaml:"async"`
+	Confirm     *bool         `yaml:"confirm,omitempty"`
+	Args        []string      `yaml:"args"`
+	Params      []PluginParam `yaml:"params"`
+}
+
+// Validate checks the plugin is well formed.
+func (p Plugin) Validate() error {
+	return ValidateParams(p.Params)
+}
+
+// ShouldConfirm checks if the expanded command should be previewed before
+// it runs. Defaults to true.
+func (p Plugin) ShouldConfirm() bool {
+	return p.Confirm == nil || *p.Confirm
+}
+
+// PluginParam describes a plugin argument prompted for at invocation time.
+type PluginParam struct {
+	Name        string   `yaml:"name"`
 	Description string   `yaml:"description"`
-	Command     string   `yaml:"command"`
-	Background  bool     `yaml:"background"`
-	Args        []string `yaml:"args"`
+	Default     string   `yaml:"default"`
+	Enum        []string `yaml:"enum"`
+}
+
+// Validate checks the param can be referenced as an env var and its default
+// is one of its enum values.
+func (p PluginParam) Validate() error {
+	if !paramNameRX.MatchString(p.Name) {
+		return fmt.Errorf("invalid param name %q. Must only contain letters, digits or underscores", p.Name)
+	}
+	if len(p.Enum) == 0 || p.Default == "" || InList(p.Enum, p.Default) {
+		return nil
+	}
+
+	return fmt.Errorf("param %q default %q must be one of %v", p.Name, p.Default, p.Enum)
+}
+
+// ValidateParams checks all params are well formed.
+func ValidateParams(pp []PluginParam) error {
+	for _, p := range pp {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // NewPlugins returns a new plugin.
@@ -34,23 +91,46 @@ func NewPlugins() Plugins {
 
 // Load K9s plugins.
 func (p Plugins) Load() error {
-	return p.LoadPlugins(K9sPlugins)
+	for _, f := range ConfigLayers(K9sPlugins) {
+		if err := p.LoadPlugins(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	for _, d := range ConfigLayers(K9sPluginsDir) {
+		if err := p.LoadPluginsDir(d); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-// LoadPlugins loads plugins from a given file.
-func (p Plugins) LoadPlugins(path string) error {
-	f, err := ioutil.ReadFile(path)
+// LoadPluginsDir loads plugins from all yaml files in a given directory.
+func (p Plugins) LoadPluginsDir(dir string) error {
+	ff, err := configFiles(dir)
 	if err != nil {
 		return err
 	}
-
-	var pp Plugins
-	if err := yaml.Unmarshal(f, &pp); err != nil {
-		return err
-	}
-	for k, v := range pp.Plugin {
-		p.Plugin[k] = v
+	for _, f := range ff {
+		if err := p.LoadPlugins(f); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+// LoadPlugins loads plugins from a given file.
+func (p Plugins) LoadPlugins(path string) error {
+	return readIncludes(path, func(raw []byte) error {
+		var pp Plugins
+		if err := yaml.Unmarshal(raw, &pp); err != nil {
+			return err
+		}
+		for k, v := range pp.Plugin {
+			p.Plugin[k] = v
+		}
+
+		return nil
+	})
+}
@@ -11,7 +11,7 @@ func TestPluginLoad(t *testing.T) {
 	p := config.NewPlugins()
 	assert.Nil(t, p.LoadPlugins("testdata/plugin.yml"))
 
-	assert.Equal(t, 1, len(p.Plugin))
+	assert.Equal(t, 3, len(p.Plugin))
 	k, ok := p.Plugin["blah"]
 	assert.True(t, ok)
 	assert.Equal(t, "shift-s", k.ShortCut)
@@ -19,4 +19,72 @@ func TestPluginLoad(t *testing.T) {
 	assert.Equal(t, []string{"po", "dp"}, k.Scopes)
 	assert.Equal(t, "duh", k.Command)
 	assert.Equal(t, []string{"-n", "$NAMESPACE", "-boolean"}, k.Args)
+	assert.False(t, k.Async)
+	assert.True(t, k.ShouldConfirm())
+
+	k, ok = p.Plugin["fred"]
+	assert.True(t, ok)
+	assert.True(t, k.Async)
+	assert.False(t, k.ShouldConfirm())
+	assert.Equal(t, []string{"$NAME"}, k.Args)
+	assert.Equal(t, 0, len(k.Params))
+
+	k, ok = p.Plugin["scale"]
+	assert.True(t, ok)
+	assert.Equal(t, 2, len(k.Params))
+	assert.Equal(t, config.PluginParam{Name: "replicas", Description: "Replica count", Default: "1"}, k.Params[0])
+	assert.Equal(t, "client", k.Params[1].Default)
+	assert.Equal(t, []string{"none", "client", "server"}, k.Params[1].Enum)
+}
+
+func TestPluginParamValidate(t *testing.T) {
+	uu := map[string]struct {
+		p   config.PluginParam
+		err string
+	}{
+		"plain":      {p: config.PluginParam{Name: "replicas", Default: "1"}},
+		"enum":       {p: config.PluginParam{Name: "dry_run", Default: "client", Enum: []string{"none", "client"}}},
+		"enumNoDef":  {p: config.PluginParam{Name: "mode", Enum: []string{"a", "b"}}},
+		"dashedName": {p: config.PluginParam{Name: "dry-run"}, err: `invalid param name "dry-run". Must only contain letters, digits or underscores`},
+		"noName":     {p: config.PluginParam{}, err: `invalid param name "". Must only contain letters, digits or underscores`},
+		"badDefault": {p: config.PluginParam{Name: "mode", Default: "c", Enum: []string{"a", "b"}}, err: `param "mode" default "c" must be one of [a b]`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.p.Validate()
+			if u.err == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.Equal(t, u.err, err.Error())
+		})
+	}
+}
+
+func TestPluginValidate(t *testing.T) {
+	p := config.Plugin{Params: []config.PluginParam{{Name: "ok"}, {Name: "not-ok"}}}
+	assert.NotNil(t, p.Validate())
+
+	p.Params = p.Params[:1]
+	assert.Nil(t, p.Validate())
+}
+
+func TestPluginLoadDir(t *testing.T) {
+	p := config.NewPlugins()
+	assert.Nil(t, p.LoadPluginsDir("testdata/plugins.d"))
+
+	assert.Equal(t, 1, len(p.Plugin))
+	k, ok := p.Plugin["drain"]
+	assert.True(t, ok)
+	assert.Equal(t, []string{"prod"}, k.Contexts)
+	assert.True(t, k.InContext("prod", "fred"))
+	assert.False(t, k.InContext("dev", "fred"))
+}
+
+func TestPluginLoadDirMissing(t *testing.T) {
+	p := config.NewPlugins()
+	assert.Nil(t, p.LoadPluginsDir("testdata/plugins.nope"))
+	assert.Equal(t, 0, len(p.Plugin))
 }
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// LiveRefreshRate represents the refresh interval of live views.
+	LiveRefreshRate = 500 * time.Millisecond
+
+	liveRefresh = "live"
+)
+
+// RefreshRateFor returns the refresh interval of a view given its names,
+// falling back to the global refresh rate. Returns true if the rate was
+// overridden.
+func (k *K9s) RefreshRateFor(names ...string) (time.Duration, bool) {
+	if d, ok := k.RefreshRates.RateFor(names...); ok {
+		return d, true
+	}
+
+	return time.Duration(k.GetRefreshRate()) * time.Second, false
+}
+
+// RefreshRates tracks per resource refresh interval overrides keyed by
+// resource name, alias or gvr. Intervals are either durations, seconds or
+// live.
+type RefreshRates map[string]string
+
+// Validate removes invalid refresh intervals.
+func (r RefreshRates) Validate() {
+	for k, v := range r {
+		if _, err := ParseRefreshRate(v); err != nil {
+			log.Warn().Err(err).Msgf("Invalid refresh rate for %q", k)
+			delete(r, k)
+		}
+	}
+}
+
+// RateFor returns the refresh interval override matching the longest of the
+// given names. Overrides differing only by case are picked in key order so
+// lookups are stable.
+func (r RefreshRates) RateFor(names ...string) (time.Duration, bool) {
+	var (
+		key  string
+		rate time.Duration
+	)
+	for _, n := range names {
+		for k, v := range r {
+			if !strings.EqualFold(k, n) {
+				continue
+			}
+			d, err := ParseRefreshRate(v)
+			if err != nil {
+				continue
+			}
+			if key == "" || len(k) > len(key) || (len(k) == len(key) && k < key) {
+				key, rate = k, d
+			}
+		}
+	}
+
+	return rate, key != ""
+}
+
+// ParseRefreshRate converts a refresh interval to a duration. Plain numbers
+// are seconds. Intervals can't be faster than live.
+func ParseRefreshRate(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == liveRefresh {
+		return LiveRefreshRate, nil
+	}
+
+	var d time.Duration
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		d = time.Duration(n * float64(time.Second))
+	} else if d, err = time.ParseDuration(s); err != nil {
+		return 0, err
+	}
+	if d < LiveRefreshRate {
+		d = LiveRefreshRate
+	}
+
+	return d, nil
+}
@@ -0,0 +1,95 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRefreshRate(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   time.Duration
+		err bool
+	}{
+		"seconds":  {s: "30", e: 30 * time.Second},
+		"fraction": {s: "1.5", e: 1500 * time.Millisecond},
+		"duration": {s: "1m", e: time.Minute},
+		"live":     {s: "live", e: LiveRefreshRate},
+		"tooFast":  {s: "10ms", e: LiveRefreshRate},
+		"toast":    {s: "fred", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, err := ParseRefreshRate(u.s)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
+
+func TestRefreshRatesRateFor(t *testing.T) {
+	r := RefreshRates{
+		"nodes":  "30s",
+		"Events": "live",
+		"bozo":   "fred",
+	}
+	r.Validate()
+	assert.Equal(t, 2, len(r))
+
+	uu := map[string]struct {
+		names []string
+		e     time.Duration
+		ok    bool
+	}{
+		"match":   {names: []string{"no", "node", "nodes"}, e: 30 * time.Second, ok: true},
+		"case":    {names: []string{"v1/events", "events"}, e: LiveRefreshRate, ok: true},
+		"noMatch": {names: []string{"po", "pods"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			d, ok := r.RateFor(u.names...)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, d)
+		})
+	}
+}
+
+func TestRefreshRatesRateForLongest(t *testing.T) {
+	r := RefreshRates{
+		"ev":        "5s",
+		"v1/events": "10s",
+		"Pods":      "20s",
+		"pods":      "30s",
+		"po":        "40s",
+	}
+
+	uu := map[string]struct {
+		names []string
+		e     time.Duration
+	}{
+		"longest":  {names: []string{"ev", "events", "v1/events"}, e: 10 * time.Second},
+		"reversed": {names: []string{"v1/events", "ev"}, e: 10 * time.Second},
+		"case":     {names: []string{"po", "pods"}, e: 20 * time.Second},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			for i := 0; i < 10; i++ {
+				d, ok := r.RateFor(u.names...)
+				assert.True(t, ok)
+				assert.Equal(t, u.e, d)
+			}
+		})
+	}
+}
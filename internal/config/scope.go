@@ -0,0 +1,49 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// ContextScope restricts a configuration entry to a set of contexts and/or clusters.
+type ContextScope struct {
+	Contexts []string `yaml:"contexts,omitempty"`
+	Clusters []string `yaml:"clusters,omitempty"`
+}
+
+// InContext returns true if the entry is available for the given context and cluster.
+// An entry that does not specify any contexts or clusters is available everywhere.
+func (s ContextScope) InContext(context, cluster string) bool {
+	if len(s.Contexts) == 0 && len(s.Clusters) == 0 {
+		return true
+	}
+
+	return InList(s.Contexts, context) || InList(s.Clusters, cluster)
+}
+
+// configFiles returns all yaml files in a given directory sorted by name.
+func configFiles(dir string) ([]string, error) {
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	files := make([]string, 0, len(ff))
+	for _, f := range ff {
+		if f.IsDir() {
+			continue
+		}
+		switch filepath.Ext(f.Name()) {
+		case ".yml", ".yaml":
+			files = append(files, filepath.Join(dir, f.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	return files, nil
+}
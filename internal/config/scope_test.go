@@ -0,0 +1,46 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextScopeInContext(t *testing.T) {
+	uu := map[string]struct {
+		scope   config.ContextScope
+		ctx, cl string
+		e       bool
+	}{
+		"unscoped": {
+			ctx: "fred",
+			cl:  "blee",
+			e:   true,
+		},
+		"context": {
+			scope: config.ContextScope{Contexts: []string{"prod"}},
+			ctx:   "prod",
+			cl:    "blee",
+			e:     true,
+		},
+		"cluster": {
+			scope: config.ContextScope{Clusters: []string{"prod-cluster"}},
+			ctx:   "fred",
+			cl:    "prod-cluster",
+			e:     true,
+		},
+		"miss": {
+			scope: config.ContextScope{Contexts: []string{"prod"}, Clusters: []string{"prod-cluster"}},
+			ctx:   "dev",
+			cl:    "dev-cluster",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.scope.InContext(u.ctx, u.cl))
+		})
+	}
+}
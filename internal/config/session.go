@@ -0,0 +1,113 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sSessions tracks K9s sessions location.
+var K9sSessions = filepath.Join(K9sHome, "sessions.yml")
+
+// Sessions tracks the last navigation session per context.
+type Sessions struct {
+	Contexts map[string]*Session `yaml:"sessions"`
+}
+
+// Session tracks a navigation session.
+type Session struct {
+	Namespace string        `yaml:"namespace"`
+	Views     []SessionView `yaml:"views"`
+}
+
+// SessionView tracks a view state. Views are listed bottom to top.
+type SessionView struct {
+	GVR       string       `yaml:"gvr"`
+	Path      string       `yaml:"path,omitempty"`
+	Filter    string       `yaml:"filter,omitempty"`
+	Selection string       `yaml:"selection,omitempty"`
+	Labels    string       `yaml:"labels,omitempty"`
+	Fields    string       `yaml:"fields,omitempty"`
+	Sort      *SessionSort `yaml:"sort,omitempty"`
+	Logs      *SessionLogs `yaml:"logs,omitempty"`
+}
+
+// SessionSort tracks a table sort column.
+type SessionSort struct {
+	Column   int  `yaml:"column"`
+	ColCount int  `yaml:"colCount"`
+	Asc      bool `yaml:"asc"`
+}
+
+// SessionLogs tracks a log pane.
+type SessionLogs struct {
+	Container string `yaml:"container,omitempty"`
+	Previous  bool   `yaml:"previous,omitempty"`
+}
+
+// NewSessions returns a new sessions tracker.
+func NewSessions() *Sessions {
+	return &Sessions{
+		Contexts: make(map[string]*Session),
+	}
+}
+
+// Load K9s sessions.
+func (s *Sessions) Load() error {
+	if err := s.LoadSessions(K9sSessions); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// LoadSessions loads sessions from a given file.
+func (s *Sessions) LoadSessions(path string) error {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var ss Sessions
+	if err := yaml.Unmarshal(f, &ss); err != nil {
+		return err
+	}
+	for k, v := range ss.Contexts {
+		s.Contexts[k] = v
+	}
+
+	return nil
+}
+
+// Save K9s sessions.
+func (s *Sessions) Save() error {
+	return s.SaveSessions(K9sSessions)
+}
+
+// SaveSessions saves sessions to a given file.
+func (s *Sessions) SaveSessions(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, DefaultFileMod)
+}
+
+// Get returns the session of a given context if any.
+func (s *Sessions) Get(context string) (*Session, bool) {
+	ss, ok := s.Contexts[context]
+	if !ok || ss == nil || len(ss.Views) == 0 {
+		return nil, false
+	}
+
+	return ss, true
+}
+
+// Set records the session of a given context.
+func (s *Sessions) Set(context string, ss *Session) {
+	s.Contexts[context] = ss
+}
@@ -0,0 +1,78 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionsGet(t *testing.T) {
+	s := config.NewSessions()
+	s.Set("ctx1", &config.Session{
+		Namespace: "fred",
+		Views:     []config.SessionView{{GVR: "v1/pods"}},
+	})
+	s.Set("ctx2", &config.Session{Namespace: "blee"})
+
+	uu := map[string]struct {
+		ctx string
+		ok  bool
+	}{
+		"found":   {ctx: "ctx1", ok: true},
+		"noViews": {ctx: "ctx2"},
+		"missing": {ctx: "ctx3"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, ok := s.Get(u.ctx)
+			assert.Equal(t, u.ok, ok)
+		})
+	}
+}
+
+func TestSessionsSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-sessions")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "sessions.yml")
+	s := config.NewSessions()
+	s.Set("ctx1", &config.Session{
+		Namespace: "fred",
+		Views: []config.SessionView{
+			{
+				GVR:    "apps/v1/deployments",
+				Filter: "blee",
+				Sort:   &config.SessionSort{Column: 2, ColCount: 8, Asc: true},
+			},
+			{
+				GVR:    "v1/pods",
+				Labels: "app=blee",
+				Fields: "spec.nodeName=n1",
+			},
+			{
+				GVR:  "v1/pods",
+				Path: "fred/p1",
+				Logs: &config.SessionLogs{Container: "c1", Previous: true},
+			},
+		},
+	})
+	assert.Nil(t, s.SaveSessions(path))
+
+	ss := config.NewSessions()
+	assert.Nil(t, ss.LoadSessions(path))
+	session, ok := ss.Get("ctx1")
+	assert.True(t, ok)
+	assert.Equal(t, s.Contexts["ctx1"], session)
+}
+
+func TestSessionsLoadMissing(t *testing.T) {
+	s := config.NewSessions()
+	assert.True(t, os.IsNotExist(s.LoadSessions("testdata/bozo.yml")))
+}
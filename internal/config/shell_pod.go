@@ -0,0 +1,110 @@
+package config
+
+const (
+	// DefaultShellPodImage represents the default node shell image.
+	DefaultShellPodImage = "busybox:1.31"
+	// DefaultShellPodNamespace represents the default node shell namespace.
+	DefaultShellPodNamespace = "default"
+)
+
+// DefaultShellPodCommand represents the default node shell command. It enters
+// the node root filesystem when mounted, falling back to the pod shell.
+var DefaultShellPodCommand = []string{"sh", "-c", "chroot /host sh 2>/dev/null || sh"}
+
+// ShellPod represents the node shell pod template.
+type ShellPod struct {
+	Image           string            `yaml:"image"`
+	Namespace       string            `yaml:"namespace"`
+	Command         []string          `yaml:"command,omitempty"`
+	HostPID         *bool             `yaml:"hostPID,omitempty"`
+	HostNetwork     *bool             `yaml:"hostNetwork,omitempty"`
+	MountHost       *bool             `yaml:"mountHost,omitempty"`
+	SecurityContext *SecurityContext  `yaml:"securityContext,omitempty"`
+	Tolerations     []Toleration      `yaml:"tolerations,omitempty"`
+	NodeSelector    map[string]string `yaml:"nodeSelector,omitempty"`
+	Resources       *Resources        `yaml:"resources,omitempty"`
+}
+
+// SecurityContext represents the node shell container security settings.
+type SecurityContext struct {
+	Privileged               *bool         `yaml:"privileged,omitempty"`
+	RunAsUser                *int64        `yaml:"runAsUser,omitempty"`
+	RunAsGroup               *int64        `yaml:"runAsGroup,omitempty"`
+	RunAsNonRoot             *bool         `yaml:"runAsNonRoot,omitempty"`
+	AllowPrivilegeEscalation *bool         `yaml:"allowPrivilegeEscalation,omitempty"`
+	ReadOnlyRootFilesystem   *bool         `yaml:"readOnlyRootFilesystem,omitempty"`
+	Capabilities             *Capabilities `yaml:"capabilities,omitempty"`
+	SeccompProfile           string        `yaml:"seccompProfile,omitempty"`
+}
+
+// Capabilities represents linux capabilities to add or drop.
+type Capabilities struct {
+	Add  []string `yaml:"add,omitempty"`
+	Drop []string `yaml:"drop,omitempty"`
+}
+
+// Toleration represents a node shell pod toleration.
+type Toleration struct {
+	Key               string `yaml:"key,omitempty"`
+	Operator          string `yaml:"operator,omitempty"`
+	Value             string `yaml:"value,omitempty"`
+	Effect            string `yaml:"effect,omitempty"`
+	TolerationSeconds *int64 `yaml:"tolerationSeconds,omitempty"`
+}
+
+// Resources represents the node shell container resources.
+type Resources struct {
+	Requests map[string]string `yaml:"requests,omitempty"`
+	Limits   map[string]string `yaml:"limits,omitempty"`
+}
+
+// NewShellPod returns a new node shell pod template.
+func NewShellPod() *ShellPod {
+	return &ShellPod{
+		Image:     DefaultShellPodImage,
+		Namespace: DefaultShellPodNamespace,
+	}
+}
+
+// Validate a node shell pod template.
+func (s *ShellPod) Validate() {
+	if s.Image == "" {
+		s.Image = DefaultShellPodImage
+	}
+	if s.Namespace == "" {
+		s.Namespace = DefaultShellPodNamespace
+	}
+}
+
+// ShellCommand returns the command used to shell into the pod.
+func (s *ShellPod) ShellCommand() []string {
+	if len(s.Command) == 0 {
+		return DefaultShellPodCommand
+	}
+
+	return s.Command
+}
+
+// IsHostPID checks if the pod shares the node process namespace. Defaults to true.
+func (s *ShellPod) IsHostPID() bool {
+	return s.HostPID == nil || *s.HostPID
+}
+
+// IsHostNetwork checks if the pod shares the node network. Defaults to true.
+func (s *ShellPod) IsHostNetwork() bool {
+	return s.HostNetwork == nil || *s.HostNetwork
+}
+
+// IsMountHost checks if the node root filesystem is mounted in the pod. Defaults to true.
+func (s *ShellPod) IsMountHost() bool {
+	return s.MountHost == nil || *s.MountHost
+}
+
+// IsPrivileged checks if the shell container runs privileged. Defaults to true.
+func (s *ShellPod) IsPrivileged() bool {
+	if s.SecurityContext == nil || s.SecurityContext.Privileged == nil {
+		return true
+	}
+
+	return *s.SecurityContext.Privileged
+}
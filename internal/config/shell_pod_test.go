@@ -0,0 +1,70 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestShellPodDefaults(t *testing.T) {
+	var s config.ShellPod
+	s.Validate()
+
+	assert.Equal(t, config.DefaultShellPodImage, s.Image)
+	assert.Equal(t, config.DefaultShellPodNamespace, s.Namespace)
+	assert.Equal(t, config.DefaultShellPodCommand, s.ShellCommand())
+	assert.True(t, s.IsHostPID())
+	assert.True(t, s.IsHostNetwork())
+	assert.True(t, s.IsMountHost())
+	assert.True(t, s.IsPrivileged())
+}
+
+func TestShellPodLoad(t *testing.T) {
+	s := config.NewShellPod()
+	assert.Nil(t, yaml.Unmarshal([]byte(restrictedShellPod), s))
+	s.Validate()
+
+	assert.Equal(t, "fred/shell:1.0", s.Image)
+	assert.Equal(t, config.DefaultShellPodNamespace, s.Namespace)
+	assert.Equal(t, []string{"bash"}, s.ShellCommand())
+	assert.False(t, s.IsHostPID())
+	assert.True(t, s.IsHostNetwork())
+	assert.False(t, s.IsMountHost())
+	assert.False(t, s.IsPrivileged())
+	assert.Equal(t, int64(1000), *s.SecurityContext.RunAsUser)
+	assert.Equal(t, []string{"ALL"}, s.SecurityContext.Capabilities.Drop)
+	assert.Equal(t, "runtime/default", s.SecurityContext.SeccompProfile)
+	assert.Equal(t, []config.Toleration{{Operator: "Exists"}}, s.Tolerations)
+	assert.Equal(t, map[string]string{"pool": "ops"}, s.NodeSelector)
+	assert.Equal(t, "100m", s.Resources.Limits["cpu"])
+}
+
+// ----------------------------------------------------------------------------
+// Test Data...
+
+const restrictedShellPod = `
+image: fred/shell:1.0
+command:
+- bash
+hostPID: false
+mountHost: false
+securityContext:
+  privileged: false
+  runAsUser: 1000
+  runAsNonRoot: true
+  allowPrivilegeEscalation: false
+  seccompProfile: runtime/default
+  capabilities:
+    drop:
+    - ALL
+tolerations:
+- operator: Exists
+nodeSelector:
+  pool: ops
+resources:
+  limits:
+    cpu: 100m
+    memory: 100Mi
+`
@@ -0,0 +1,94 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	// K9sSkinsDir represents the location of named skin files.
+	K9sSkinsDir = filepath.Join(K9sHome, "skins")
+)
+
+// Skins tracks context and cluster specific skins.
+type Skins struct {
+	Contexts map[string]string `yaml:"contexts,omitempty"`
+	Clusters map[string]string `yaml:"clusters,omitempty"`
+}
+
+// SkinFor returns the skin mapped to a given context or cluster if any.
+// Context mappings take precedence over cluster mappings.
+func (s *Skins) SkinFor(context, cluster string) (string, bool) {
+	if s == nil {
+		return "", false
+	}
+	if skin, ok := s.Contexts[context]; ok && skin != "" {
+		return skin, true
+	}
+	if skin, ok := s.Clusters[cluster]; ok && skin != "" {
+		return skin, true
+	}
+
+	return "", false
+}
+
+// Files returns the locations of all mapped skins.
+func (s *Skins) Files() []string {
+	if s == nil {
+		return nil
+	}
+	ff := make([]string, 0, len(s.Contexts)+len(s.Clusters))
+	for _, mm := range []map[string]string{s.Contexts, s.Clusters} {
+		for _, skin := range mm {
+			if skin == "" {
+				continue
+			}
+			if f := SkinFile(skin); !InList(ff, f) {
+				ff = append(ff, f)
+			}
+		}
+	}
+	sort.Strings(ff)
+
+	return ff
+}
+
+// SkinFile returns the location of a named skin. A name with a yaml extension
+// is treated as a file path, relative paths being resolved against K9s home.
+func SkinFile(name string) string {
+	switch filepath.Ext(name) {
+	case ".yml", ".yaml":
+		if filepath.IsAbs(name) {
+			return name
+		}
+		return filepath.Join(K9sHome, name)
+	default:
+		return filepath.Join(K9sSkinsDir, name+".yml")
+	}
+}
+
+// ListSkins returns the names of all the skins available in a given directory.
+func ListSkins(dir string) ([]string, error) {
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	ss := make([]string, 0, len(ff))
+	for _, f := range ff {
+		ext := filepath.Ext(f.Name())
+		if f.IsDir() || (ext != ".yml" && ext != ".yaml") {
+			continue
+		}
+		ss = append(ss, strings.TrimSuffix(f.Name(), ext))
+	}
+	sort.Strings(ss)
+
+	return ss, nil
+}
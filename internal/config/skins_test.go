@@ -0,0 +1,105 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkinsSkinFor(t *testing.T) {
+	s := config.Skins{
+		Contexts: map[string]string{"prod": "red"},
+		Clusters: map[string]string{"c1": "blue", "c2": ""},
+	}
+
+	uu := map[string]struct {
+		skins            *config.Skins
+		context, cluster string
+		e                string
+		ok               bool
+	}{
+		"none":    {skins: nil, context: "prod", cluster: "c1"},
+		"context": {skins: &s, context: "prod", cluster: "c1", e: "red", ok: true},
+		"cluster": {skins: &s, context: "dev", cluster: "c1", e: "blue", ok: true},
+		"blank":   {skins: &s, context: "dev", cluster: "c2"},
+		"miss":    {skins: &s, context: "dev", cluster: "c3"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			skin, ok := u.skins.SkinFor(u.context, u.cluster)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, skin)
+		})
+	}
+}
+
+func TestSkinFile(t *testing.T) {
+	config.K9sHome = "/tmp/blee"
+	config.K9sSkinsDir = "/tmp/blee/skins"
+
+	uu := map[string]struct {
+		name, e string
+	}{
+		"named":    {name: "red", e: "/tmp/blee/skins/red.yml"},
+		"relative": {name: "prod_skin.yml", e: "/tmp/blee/prod_skin.yml"},
+		"absolute": {name: "/etc/k9s/prod.yaml", e: "/etc/k9s/prod.yaml"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.SkinFile(u.name))
+		})
+	}
+}
+
+func TestSkinsFiles(t *testing.T) {
+	config.K9sHome = "/tmp/blee"
+	config.K9sSkinsDir = "/tmp/blee/skins"
+
+	s := config.Skins{
+		Contexts: map[string]string{"prod": "/etc/k9s/prod.yaml", "dev": "red", "test": ""},
+		Clusters: map[string]string{"c1": "red"},
+	}
+	assert.Equal(t, []string{"/etc/k9s/prod.yaml", "/tmp/blee/skins/red.yml"}, s.Files())
+
+	var none *config.Skins
+	assert.Nil(t, none.Files())
+}
+
+func TestK9sActiveSkin(t *testing.T) {
+	config.K9sSkinsDir = "/tmp/blee/skins"
+	k := config.NewK9s()
+	k.CurrentContext, k.CurrentCluster = "prod", "c1"
+
+	_, ok := k.ActiveSkin()
+	assert.False(t, ok)
+
+	k.Skins = &config.Skins{Clusters: map[string]string{"c1": "red"}}
+	skin, ok := k.ActiveSkin()
+	assert.True(t, ok)
+	assert.Equal(t, "/tmp/blee/skins/red.yml", skin)
+}
+
+func TestListSkins(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), "k9s-test-skins")
+	assert.Nil(t, os.MkdirAll(filepath.Join(dir, "blee.yml"), 0700))
+	defer os.RemoveAll(dir)
+	for _, f := range []string{"zorg.yml", "fred.yaml", "notes.txt"} {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, f), []byte{}, 0600))
+	}
+
+	ss, err := config.ListSkins(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"fred", "zorg"}, ss)
+
+	ss, err = config.ListSkins(filepath.Join(dir, "toast"))
+	assert.Nil(t, err)
+	assert.Empty(t, ss)
+}
@@ -1,8 +1,11 @@
 package config
 
 import (
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
+	"reflect"
+	"strings"
 
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
@@ -467,3 +470,82 @@ func (s *Styles) Update() {
 	tview.Styles.FocusColor = s.K9s.Frame.Border.FocusColor.Color()
 	s.fireStylesChanged()
 }
+
+// Save writes out the styles to a skin file.
+func (s *Styles) Save(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, DefaultFileMod)
+}
+
+// ColorKeys returns the dotted paths of all the skin individual colors,
+// ie frame.status.errorColor.
+func (s *Styles) ColorKeys() []string {
+	var kk []string
+	walkColors(reflect.ValueOf(&s.K9s).Elem(), "", func(k string, _ reflect.Value) {
+		kk = append(kk, k)
+	})
+
+	return kk
+}
+
+// GetColor returns the color for a given key.
+func (s *Styles) GetColor(key string) (Color, bool) {
+	v, ok := s.colorValue(key)
+	if !ok {
+		return "", false
+	}
+
+	return v.Interface().(Color), true
+}
+
+// SetColor sets the color for a given key.
+func (s *Styles) SetColor(key string, c Color) error {
+	v, ok := s.colorValue(key)
+	if !ok {
+		return fmt.Errorf("invalid skin color key %q", key)
+	}
+	v.Set(reflect.ValueOf(c))
+
+	return nil
+}
+
+func (s *Styles) colorValue(key string) (reflect.Value, bool) {
+	var (
+		val reflect.Value
+		ok  bool
+	)
+	walkColors(reflect.ValueOf(&s.K9s).Elem(), "", func(k string, v reflect.Value) {
+		if k == key {
+			val, ok = v, true
+		}
+	})
+
+	return val, ok
+}
+
+var colorType = reflect.TypeOf(Color(""))
+
+func walkColors(v reflect.Value, prefix string, f func(string, reflect.Value)) {
+	for i := 0; i < v.NumField(); i++ {
+		tag := strings.Split(v.Type().Field(i).Tag.Get("yaml"), ",")[0]
+		if tag == "" {
+			continue
+		}
+		key := tag
+		if prefix != "" {
+			key = prefix + "." + tag
+		}
+		field := v.Field(i)
+		switch {
+		case field.Type() == colorType:
+			f(key, field)
+		case field.Kind() == reflect.Struct:
+			walkColors(field, key, f)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package config_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/derailed/k9s/internal/config"
@@ -60,3 +62,71 @@ func TestSkinBoarked(t *testing.T) {
 	s := config.NewStyles()
 	assert.NotNil(t, s.Load("testdata/skin_boarked.yml"))
 }
+
+func TestSkinColorKeys(t *testing.T) {
+	s := config.NewStyles()
+	kk := s.ColorKeys()
+
+	assert.Equal(t, "body.fgColor", kk[0])
+	assert.Contains(t, kk, "frame.status.errorColor")
+	assert.Contains(t, kk, "views.table.header.sorterColor")
+	assert.NotContains(t, kk, "views.xray.showIcons")
+	assert.NotContains(t, kk, "views.charts.defaultDialColors")
+}
+
+func TestSkinColor(t *testing.T) {
+	uu := map[string]struct {
+		key   string
+		color config.Color
+		err   bool
+	}{
+		"body": {
+			key:   "body.bgColor",
+			color: "navy",
+		},
+		"nested": {
+			key:   "views.table.header.fgColor",
+			color: "#ff0000",
+		},
+		"section": {
+			key: "frame.status",
+			err: true,
+		},
+		"toast": {
+			key: "body.blee",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := config.NewStyles()
+			err := s.SetColor(u.key, u.color)
+			if u.err {
+				assert.NotNil(t, err)
+				_, ok := s.GetColor(u.key)
+				assert.False(t, ok)
+				return
+			}
+			assert.Nil(t, err)
+			c, ok := s.GetColor(u.key)
+			assert.True(t, ok)
+			assert.Equal(t, u.color, c)
+		})
+	}
+}
+
+func TestSkinSave(t *testing.T) {
+	path := filepath.Join(os.TempDir(), "k9s-test", "skins", "fred.yml")
+	defer os.RemoveAll(filepath.Dir(filepath.Dir(path)))
+
+	s := config.NewStyles()
+	assert.Nil(t, s.SetColor("frame.title.fgColor", "hotpink"))
+	assert.Nil(t, s.Save(path))
+
+	l := config.NewStyles()
+	assert.Nil(t, l.Load(path))
+	assert.Equal(t, s.Frame(), l.Frame())
+	assert.Equal(t, s.Table(), l.Table())
+}
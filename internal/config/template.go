@@ -0,0 +1,281 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	// K9sTemplates manages K9s resource templates.
+	K9sTemplates = filepath.Join(K9sHome, "templates.yml")
+	// K9sTemplatesDir tracks additional K9s templates files.
+	K9sTemplatesDir = filepath.Join(K9sHome, "templates.d")
+)
+
+// templateVarRX matches $XXX or ${XXX} template placeholders.
+var templateVarRX = regexp.MustCompile(`\$\{(\w+)\}|\$(\w+)`)
+
+// Templates represents a collection of resource templates.
+type Templates struct {
+	Template map[string]Template `yaml:"template"`
+}
+
+// Template describes a manifest used to create a new resource.
+type Template struct {
+	ContextScope `yaml:",inline"`
+
+	Description string   `yaml:"description"`
+	Scopes      []string `yaml:"scopes"`
+	Manifest    string   `yaml:"manifest"`
+}
+
+// NewTemplates returns a new templates collection.
+func NewTemplates() Templates {
+	return Templates{
+		Template: make(map[string]Template),
+	}
+}
+
+// Validate checks the template manifest.
+func (t Template) Validate() error {
+	if len(t.Scopes) == 0 {
+		return fmt.Errorf("no scopes specified for %q", t.Description)
+	}
+	if strings.TrimSpace(t.Manifest) == "" {
+		return fmt.Errorf("no manifest specified for %q", t.Description)
+	}
+
+	return nil
+}
+
+// Render substitutes the known placeholders in the template manifest.
+// Unknown placeholders are left untouched so scripts embedded in a manifest
+// remain intact.
+func (t Template) Render(vars map[string]string) string {
+	return templateVarRX.ReplaceAllStringFunc(t.Manifest, func(m string) string {
+		mm := templateVarRX.FindStringSubmatch(m)
+		k := mm[1]
+		if k == "" {
+			k = mm[2]
+		}
+		if v, ok := vars[k]; ok {
+			return v
+		}
+		return m
+	})
+}
+
+// Load K9s templates. Built-in starters are loaded first and may be
+// overridden by name.
+func (t Templates) Load() error {
+	for k, v := range starterTemplates {
+		t.Template[k] = v
+	}
+	for _, f := range ConfigLayers(K9sTemplates) {
+		if err := t.LoadTemplates(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	for _, d := range ConfigLayers(K9sTemplatesDir) {
+		if err := t.LoadTemplatesDir(d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadTemplatesDir loads templates from all yaml files in a given directory.
+func (t Templates) LoadTemplatesDir(dir string) error {
+	ff, err := configFiles(dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range ff {
+		if err := t.LoadTemplates(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadTemplates loads templates from a given file.
+func (t Templates) LoadTemplates(path string) error {
+	return readIncludes(path, func(raw []byte) error {
+		var tt Templates
+		if err := yaml.Unmarshal(raw, &tt); err != nil {
+			return err
+		}
+		for k, v := range tt.Template {
+			t.Template[k] = v
+		}
+
+		return nil
+	})
+}
+
+// SkeletonTemplate returns a bare template for resources without starters.
+func SkeletonTemplate(apiVersion, kind string, namespaced bool) Template {
+	m := fmt.Sprintf("apiVersion: %s\nkind: %s\nmetadata:\n  name: $NAME\n", apiVersion, kind)
+	if namespaced {
+		m += "  namespace: $NAMESPACE\n"
+	}
+
+	return Template{
+		Description: kind,
+		Manifest:    m,
+	}
+}
+
+// starterTemplates tracks the built-in resource templates.
+var starterTemplates = map[string]Template{
+	"k9s-pod": {
+		Description: "Pod",
+		Scopes:      []string{"v1/pods"},
+		Manifest: `apiVersion: v1
+kind: Pod
+metadata:
+  name: $NAME
+  namespace: $NAMESPACE
+spec:
+  containers:
+  - name: $NAME
+    image: busybox
+    command: ["sleep", "3600"]
+`,
+	},
+	"k9s-deployment": {
+		Description: "Deployment",
+		Scopes:      []string{"apps/v1/deployments"},
+		Manifest: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: $NAME
+  namespace: $NAMESPACE
+  labels:
+    app: $NAME
+spec:
+  replicas: 1
+  selector:
+    matchLabels:
+      app: $NAME
+  template:
+    metadata:
+      labels:
+        app: $NAME
+    spec:
+      containers:
+      - name: $NAME
+        image: nginx
+        ports:
+        - containerPort: 80
+`,
+	},
+	"k9s-service": {
+		Description: "Service",
+		Scopes:      []string{"v1/services"},
+		Manifest: `apiVersion: v1
+kind: Service
+metadata:
+  name: $NAME
+  namespace: $NAMESPACE
+spec:
+  selector:
+    app: $NAME
+  ports:
+  - name: http
+    port: 80
+    targetPort: 80
+`,
+	},
+	"k9s-configmap": {
+		Description: "ConfigMap",
+		Scopes:      []string{"v1/configmaps"},
+		Manifest: `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: $NAME
+  namespace: $NAMESPACE
+data:
+  key: value
+`,
+	},
+	"k9s-secret": {
+		Description: "Secret",
+		Scopes:      []string{"v1/secrets"},
+		Manifest: `apiVersion: v1
+kind: Secret
+metadata:
+  name: $NAME
+  namespace: $NAMESPACE
+type: Opaque
+stringData:
+  key: value
+`,
+	},
+	"k9s-job": {
+		Description: "Job",
+		Scopes:      []string{"batch/v1/jobs"},
+		Manifest: `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: $NAME
+  namespace: $NAMESPACE
+spec:
+  backoffLimit: 2
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: $NAME
+        image: busybox
+        command: ["echo", "hello"]
+`,
+	},
+	"k9s-cronjob": {
+		Description: "CronJob",
+		Scopes:      []string{"batch/v1beta1/cronjobs"},
+		Manifest: `apiVersion: batch/v1beta1
+kind: CronJob
+metadata:
+  name: $NAME
+  namespace: $NAMESPACE
+spec:
+  schedule: "*/5 * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          restartPolicy: OnFailure
+          containers:
+          - name: $NAME
+            image: busybox
+            command: ["echo", "hello"]
+`,
+	},
+	"k9s-namespace": {
+		Description: "Namespace",
+		Scopes:      []string{"v1/namespaces"},
+		Manifest: `apiVersion: v1
+kind: Namespace
+metadata:
+  name: $NAME
+`,
+	},
+	"k9s-serviceaccount": {
+		Description: "ServiceAccount",
+		Scopes:      []string{"v1/serviceaccounts"},
+		Manifest: `apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: $NAME
+  namespace: $NAMESPACE
+`,
+	},
+}
@@ -0,0 +1,82 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateLoad(t *testing.T) {
+	tt := config.NewTemplates()
+	assert.Nil(t, tt.LoadTemplates("testdata/template.yml"))
+
+	assert.Equal(t, 2, len(tt.Template))
+	tpl, ok := tt.Template["web"]
+	assert.True(t, ok)
+	assert.Equal(t, []string{"dp"}, tpl.Scopes)
+	assert.Equal(t, []string{"dev"}, tpl.Contexts)
+	assert.Contains(t, tpl.Manifest, "kind: Deployment")
+	assert.Nil(t, tpl.Validate())
+
+	tpl, ok = tt.Template["empty"]
+	assert.True(t, ok)
+	assert.Equal(t, `no manifest specified for "Empty"`, tpl.Validate().Error())
+}
+
+func TestTemplateLoadDirMissing(t *testing.T) {
+	tt := config.NewTemplates()
+	assert.Nil(t, tt.LoadTemplatesDir("testdata/templates.nope"))
+	assert.Equal(t, 0, len(tt.Template))
+}
+
+func TestTemplateRender(t *testing.T) {
+	uu := map[string]struct {
+		manifest, e string
+	}{
+		"plain": {
+			manifest: "name: $NAME",
+			e:        "name: fred",
+		},
+		"braces": {
+			manifest: "namespace: ${NAMESPACE}-ns",
+			e:        "namespace: blee-ns",
+		},
+		"unknown": {
+			manifest: `args: ["echo $HOME", "$NAME"]`,
+			e:        `args: ["echo $HOME", "fred"]`,
+		},
+	}
+
+	vars := map[string]string{"NAME": "fred", "NAMESPACE": "blee"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, config.Template{Manifest: u.manifest}.Render(vars))
+		})
+	}
+}
+
+func TestSkeletonTemplate(t *testing.T) {
+	uu := map[string]struct {
+		namespaced bool
+		e          string
+	}{
+		"namespaced": {
+			namespaced: true,
+			e:          "apiVersion: fred.io/v1\nkind: Fred\nmetadata:\n  name: $NAME\n  namespace: $NAMESPACE\n",
+		},
+		"cluster": {
+			e: "apiVersion: fred.io/v1\nkind: Fred\nmetadata:\n  name: $NAME\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tpl := config.SkeletonTemplate("fred.io/v1", "Fred", u.namespaced)
+			assert.Equal(t, "Fred", tpl.Description)
+			assert.Equal(t, u.e, tpl.Manifest)
+		})
+	}
+}
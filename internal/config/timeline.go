@@ -0,0 +1,42 @@
+package config
+
+import "path/filepath"
+
+const defaultTimelineRevisions = 50
+
+// K9sTimelineDir tracks the persisted resources change timelines.
+var K9sTimelineDir = filepath.Join(K9sHome, "timeline")
+
+// Timeline tracks the resources change timeline settings.
+type Timeline struct {
+	// MaxRevisions represents the number of revisions kept per object.
+	MaxRevisions int `yaml:"maxRevisions"`
+
+	// Persist saves the recorded revisions so they outlive a K9s session.
+	Persist bool `yaml:"persist"`
+}
+
+// NewTimeline returns a new timeline configuration.
+func NewTimeline() *Timeline {
+	t := Timeline{}
+	t.Validate()
+
+	return &t
+}
+
+// Validate sets defaults for unspecified settings.
+func (t *Timeline) Validate() {
+	if t.MaxRevisions <= 0 {
+		t.MaxRevisions = defaultTimelineRevisions
+	}
+}
+
+// Dir returns the directory timelines are persisted to for a given cluster
+// or an empty string if timelines are not persisted.
+func (t *Timeline) Dir(cluster string) string {
+	if !t.Persist {
+		return ""
+	}
+
+	return filepath.Join(K9sTimelineDir, cluster)
+}
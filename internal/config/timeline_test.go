@@ -0,0 +1,42 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineValidate(t *testing.T) {
+	uu := map[string]struct {
+		t, e Timeline
+	}{
+		"defaults": {
+			e: Timeline{MaxRevisions: defaultTimelineRevisions},
+		},
+		"custom": {
+			t: Timeline{MaxRevisions: 10, Persist: true},
+			e: Timeline{MaxRevisions: 10, Persist: true},
+		},
+		"negative": {
+			t: Timeline{MaxRevisions: -1},
+			e: Timeline{MaxRevisions: defaultTimelineRevisions},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.t.Validate()
+			assert.Equal(t, u.e, u.t)
+		})
+	}
+}
+
+func TestTimelineDir(t *testing.T) {
+	tl := NewTimeline()
+	assert.Equal(t, "", tl.Dir("fred"))
+
+	tl.Persist = true
+	assert.Equal(t, filepath.Join(K9sTimelineDir, "fred"), tl.Dir("fred"))
+}
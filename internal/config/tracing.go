@@ -0,0 +1,39 @@
+package config
+
+import (
+	"net/url"
+
+	"github.com/rs/zerolog/log"
+)
+
+// Tracing tracks the OpenTelemetry traces export settings.
+type Tracing struct {
+	// Endpoint represents an OTLP/HTTP collector url, ie http://localhost:4318.
+	// An empty endpoint disables tracing.
+	Endpoint string `yaml:"endpoint"`
+
+	// Headers represents extra headers sent along with exported traces.
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+// NewTracing returns a new tracing configuration.
+func NewTracing() *Tracing {
+	return &Tracing{}
+}
+
+// Validate disables tracing when the endpoint is not a valid http url.
+func (t *Tracing) Validate() {
+	if t.Endpoint == "" {
+		return
+	}
+	u, err := url.Parse(t.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		log.Warn().Msgf("Invalid tracing endpoint %q. Tracing disabled", t.Endpoint)
+		t.Endpoint = ""
+	}
+}
+
+// Enabled checks if traces are exported.
+func (t *Tracing) Enabled() bool {
+	return t.Endpoint != ""
+}
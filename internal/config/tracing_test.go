@@ -0,0 +1,31 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracingValidate(t *testing.T) {
+	uu := map[string]struct {
+		endpoint, e string
+	}{
+		"none":    {},
+		"http":    {endpoint: "http://localhost:4318", e: "http://localhost:4318"},
+		"https":   {endpoint: "https://otel.example.com/v1/traces", e: "https://otel.example.com/v1/traces"},
+		"grpc":    {endpoint: "grpc://localhost:4317"},
+		"noHost":  {endpoint: "http://"},
+		"toast":   {endpoint: "localhost:4318"},
+		"garbage": {endpoint: "%zz"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tr := Tracing{Endpoint: u.endpoint}
+			tr.Validate()
+			assert.Equal(t, u.e, tr.Endpoint)
+			assert.Equal(t, u.e != "", tr.Enabled())
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Tunnel represents a tunnel used to reach a cluster api server, either
+// through an SSH bastion or an HTTP CONNECT proxy.
+type Tunnel struct {
+	Kind           string `yaml:"kind"`
+	Address        string `yaml:"address"`
+	User           string `yaml:"user,omitempty"`
+	IdentityFile   string `yaml:"identityFile,omitempty"`
+	KnownHostsFile string `yaml:"knownHostsFile,omitempty"`
+}
+
+// Spec returns the tunnel connection spec or an error if the tunnel is misconfigured.
+func (t *Tunnel) Spec() (*client.TunnelSpec, error) {
+	if t.Address == "" {
+		return nil, fmt.Errorf("%s tunnel address must be specified", t.Kind)
+	}
+	spec := client.TunnelSpec{
+		Kind:           t.Kind,
+		Address:        t.Address,
+		User:           t.User,
+		IdentityFile:   expandHome(t.IdentityFile),
+		KnownHostsFile: expandHome(t.KnownHostsFile),
+	}
+	switch t.Kind {
+	case client.TunnelSSH:
+		spec.Address = withPort(t.Address, "22")
+		if spec.User == "" {
+			spec.User = MustK9sUser()
+		}
+		if spec.KnownHostsFile == "" {
+			spec.KnownHostsFile = filepath.Join(mustK9sHome(), ".ssh", "known_hosts")
+		}
+	case client.TunnelHTTP:
+		spec.Address = withPort(t.Address, "3128")
+	default:
+		return nil, fmt.Errorf("invalid tunnel kind %q. Must be one of %s|%s", t.Kind, client.TunnelSSH, client.TunnelHTTP)
+	}
+
+	return &spec, nil
+}
+
+// TunnelSpecs returns a lookup of the api server tunnels configured for
+// kubeconfig contexts.
+func (k *K9s) TunnelSpecs(cfg *client.Config) client.TunnelSpecFunc {
+	return func(context string) (*client.TunnelSpec, error) {
+		cluster, err := cfg.ClusterNameFromContext(context)
+		if err != nil {
+			return nil, err
+		}
+		cl, err := k.ContextCluster(context, cluster)
+		if err != nil {
+			return nil, err
+		}
+		if cl.Tunnel == nil {
+			return nil, nil
+		}
+
+		return cl.Tunnel.Spec()
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func withPort(addr, port string) string {
+	if _, _, err := net.SplitHostPort(addr); err == nil {
+		return addr
+	}
+
+	return net.JoinHostPort(addr, port)
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	return filepath.Join(mustK9sHome(), path[2:])
+}
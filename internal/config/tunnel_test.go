@@ -0,0 +1,73 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTunnelSpec(t *testing.T) {
+	uu := map[string]struct {
+		tunnel config.Tunnel
+		addr   string
+		err    bool
+	}{
+		"ssh": {
+			tunnel: config.Tunnel{Kind: client.TunnelSSH, Address: "bastion.fred.io", User: "fred"},
+			addr:   "bastion.fred.io:22",
+		},
+		"sshPort": {
+			tunnel: config.Tunnel{Kind: client.TunnelSSH, Address: "bastion.fred.io:2222", User: "fred"},
+			addr:   "bastion.fred.io:2222",
+		},
+		"http": {
+			tunnel: config.Tunnel{Kind: client.TunnelHTTP, Address: "proxy.fred.io"},
+			addr:   "proxy.fred.io:3128",
+		},
+		"noAddress": {
+			tunnel: config.Tunnel{Kind: client.TunnelHTTP},
+			err:    true,
+		},
+		"badKind": {
+			tunnel: config.Tunnel{Kind: "socks", Address: "proxy.fred.io"},
+			err:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			spec, err := u.tunnel.Spec()
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.tunnel.Kind, spec.Kind)
+			assert.Equal(t, u.addr, spec.Address)
+		})
+	}
+}
+
+func TestK9sContextClusterTunnel(t *testing.T) {
+	defer useLayers("testdata/layers")()
+
+	c := config.NewK9s()
+	c.CurrentContext, c.CurrentCluster = "ctx1", "c1"
+	cl, err := c.ContextCluster("bastion", "c1")
+	assert.Nil(t, err)
+	assert.Equal(t, "ops", cl.Namespace.Active)
+	assert.Equal(t, "dp", cl.View.Active)
+
+	spec, err := cl.Tunnel.Spec()
+	assert.Nil(t, err)
+	assert.Equal(t, "bastion.fred.io:22", spec.Address)
+	assert.Equal(t, "fred", spec.User)
+	assert.Equal(t, "/home/fred/.ssh/id_ed25519", spec.IdentityFile)
+
+	cl, err = c.ContextCluster("ctx1", "c1")
+	assert.Nil(t, err)
+	assert.Nil(t, cl.Tunnel)
+}
@@ -5,6 +5,21 @@ const defaultView = "po"
 // View tracks view configuration options.
 type View struct {
 	Active string `yaml:"active"`
+
+	// Columns tracks custom column layouts keyed by resource.
+	Columns map[string]*ColumnLayout `yaml:"columns,omitempty"`
+}
+
+// ColumnLayout tracks a view custom column widths and whether the name column
+// is frozen while scrolling horizontally.
+type ColumnLayout struct {
+	Widths map[string]int `yaml:"widths,omitempty"`
+	Freeze bool           `yaml:"freeze,omitempty"`
+}
+
+// IsEmpty checks if the layout holds no customizations.
+func (l ColumnLayout) IsEmpty() bool {
+	return len(l.Widths) == 0 && !l.Freeze
 }
 
 // NewView creates a new view configuration.
@@ -17,4 +32,40 @@ func (v *View) Validate() {
 	if len(v.Active) == 0 {
 		v.Active = defaultView
 	}
+	for gvr, l := range v.Columns {
+		if l == nil {
+			delete(v.Columns, gvr)
+			continue
+		}
+		for col, w := range l.Widths {
+			if w <= 0 {
+				delete(l.Widths, col)
+			}
+		}
+		if l.IsEmpty() {
+			delete(v.Columns, gvr)
+		}
+	}
+}
+
+// Layout returns the column layout of a given resource view.
+func (v *View) Layout(gvr string) ColumnLayout {
+	if l, ok := v.Columns[gvr]; ok && l != nil {
+		return *l
+	}
+
+	return ColumnLayout{}
+}
+
+// SetLayout saves the column layout of a given resource view. Empty layouts
+// are dropped.
+func (v *View) SetLayout(gvr string, l ColumnLayout) {
+	if l.IsEmpty() {
+		delete(v.Columns, gvr)
+		return
+	}
+	if v.Columns == nil {
+		v.Columns = make(map[string]*ColumnLayout)
+	}
+	v.Columns[gvr] = &l
 }
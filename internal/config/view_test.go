@@ -23,3 +23,31 @@ func TestViewValidateBlank(t *testing.T) {
 	v.Validate()
 	assert.Equal(t, "po", v.Active)
 }
+
+func TestViewValidateColumns(t *testing.T) {
+	v := config.NewView()
+	v.Columns = map[string]*config.ColumnLayout{
+		"v1/pods":     {Widths: map[string]int{"NAME": 20, "IP": 0}},
+		"v1/services": {Widths: map[string]int{"NAME": -1}},
+		"v1/nodes":    nil,
+	}
+	v.Validate()
+
+	assert.Equal(t, map[string]*config.ColumnLayout{
+		"v1/pods": {Widths: map[string]int{"NAME": 20}},
+	}, v.Columns)
+}
+
+func TestViewLayout(t *testing.T) {
+	v := config.NewView()
+	assert.True(t, v.Layout("v1/pods").IsEmpty())
+
+	v.SetLayout("v1/pods", config.ColumnLayout{Widths: map[string]int{"IMAGE": 30}, Freeze: true})
+	l := v.Layout("v1/pods")
+	assert.Equal(t, 30, l.Widths["IMAGE"])
+	assert.True(t, l.Freeze)
+
+	v.SetLayout("v1/pods", config.ColumnLayout{})
+	assert.True(t, v.Layout("v1/pods").IsEmpty())
+	assert.Equal(t, 0, len(v.Columns))
+}
@@ -0,0 +1,127 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sWatchlist tracks K9s watchlist location.
+var K9sWatchlist = filepath.Join(K9sHome, "watchlist.yml")
+
+const watchIDSep = "|"
+
+// Watchlist tracks pinned resources per context.
+type Watchlist struct {
+	Contexts map[string][]WatchItem `yaml:"watchlist"`
+}
+
+// WatchItem tracks a pinned resource.
+type WatchItem struct {
+	GVR  string `yaml:"gvr"`
+	Path string `yaml:"path"`
+}
+
+// ID returns a pinned resource unique id.
+func (w WatchItem) ID() string {
+	return w.GVR + watchIDSep + w.Path
+}
+
+// WatchItemFor returns a pinned resource given its id.
+func WatchItemFor(id string) (WatchItem, bool) {
+	tokens := strings.SplitN(id, watchIDSep, 2)
+	if len(tokens) != 2 || tokens[0] == "" || tokens[1] == "" {
+		return WatchItem{}, false
+	}
+
+	return WatchItem{GVR: tokens[0], Path: tokens[1]}, true
+}
+
+// NewWatchlist returns a new watchlist.
+func NewWatchlist() *Watchlist {
+	return &Watchlist{
+		Contexts: make(map[string][]WatchItem),
+	}
+}
+
+// Load K9s watchlist.
+func (w *Watchlist) Load() error {
+	if err := w.LoadWatchlist(K9sWatchlist); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// LoadWatchlist loads a watchlist from a given file.
+func (w *Watchlist) LoadWatchlist(path string) error {
+	f, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var ww Watchlist
+	if err := yaml.Unmarshal(f, &ww); err != nil {
+		return err
+	}
+	for k, v := range ww.Contexts {
+		w.Contexts[k] = v
+	}
+
+	return nil
+}
+
+// Save K9s watchlist.
+func (w *Watchlist) Save() error {
+	return w.SaveWatchlist(K9sWatchlist)
+}
+
+// SaveWatchlist saves a watchlist to a given file.
+func (w *Watchlist) SaveWatchlist(path string) error {
+	EnsurePath(path, DefaultDirMod)
+	raw, err := yaml.Marshal(w)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, DefaultFileMod)
+}
+
+// Items returns a copy of the pinned resources of a given context.
+func (w *Watchlist) Items(context string) []WatchItem {
+	ii := make([]WatchItem, len(w.Contexts[context]))
+	copy(ii, w.Contexts[context])
+
+	return ii
+}
+
+// IsPinned checks if a resource is pinned.
+func (w *Watchlist) IsPinned(context string, item WatchItem) bool {
+	for _, i := range w.Contexts[context] {
+		if i == item {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Toggle pins or unpins a resource. Returns true if the resource is now pinned.
+func (w *Watchlist) Toggle(context string, item WatchItem) bool {
+	ii := w.Contexts[context]
+	for i, it := range ii {
+		if it == item {
+			w.Contexts[context] = append(ii[:i], ii[i+1:]...)
+			if len(w.Contexts[context]) == 0 {
+				delete(w.Contexts, context)
+			}
+			return false
+		}
+	}
+	w.Contexts[context] = append(ii, item)
+
+	return true
+}
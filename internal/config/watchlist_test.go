@@ -0,0 +1,83 @@
+package config_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchlistToggle(t *testing.T) {
+	w := config.NewWatchlist()
+	dp := config.WatchItem{GVR: "apps/v1/deployments", Path: "default/nginx"}
+	no := config.WatchItem{GVR: "v1/nodes", Path: "n1"}
+
+	assert.True(t, w.Toggle("ctx1", dp))
+	assert.True(t, w.Toggle("ctx1", no))
+	assert.True(t, w.IsPinned("ctx1", dp))
+	assert.False(t, w.IsPinned("ctx2", dp))
+	assert.Equal(t, []config.WatchItem{dp, no}, w.Items("ctx1"))
+
+	assert.False(t, w.Toggle("ctx1", dp))
+	assert.False(t, w.IsPinned("ctx1", dp))
+	assert.Equal(t, []config.WatchItem{no}, w.Items("ctx1"))
+
+	assert.False(t, w.Toggle("ctx1", no))
+	assert.Equal(t, 0, len(w.Items("ctx1")))
+	assert.Equal(t, 0, len(w.Contexts))
+}
+
+func TestWatchItemFor(t *testing.T) {
+	uu := map[string]struct {
+		id   string
+		item config.WatchItem
+		ok   bool
+	}{
+		"namespaced": {
+			id:   "apps/v1/deployments|default/nginx",
+			item: config.WatchItem{GVR: "apps/v1/deployments", Path: "default/nginx"},
+			ok:   true,
+		},
+		"clusterScoped": {
+			id:   "v1/nodes|n1",
+			item: config.WatchItem{GVR: "v1/nodes", Path: "n1"},
+			ok:   true,
+		},
+		"noPath": {
+			id: "v1/nodes|",
+		},
+		"invalid": {
+			id: "v1/nodes",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			item, ok := config.WatchItemFor(u.id)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.item, item)
+			if ok {
+				assert.Equal(t, u.id, item.ID())
+			}
+		})
+	}
+}
+
+func TestWatchlistSave(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-watchlist")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "watchlist.yml")
+	w := config.NewWatchlist()
+	w.Toggle("ctx1", config.WatchItem{GVR: "v1/persistentvolumeclaims", Path: "fred/pvc1"})
+	assert.Nil(t, w.SaveWatchlist(path))
+
+	w1 := config.NewWatchlist()
+	assert.Nil(t, w1.LoadWatchlist(path))
+	assert.Equal(t, w.Contexts, w1.Contexts)
+}
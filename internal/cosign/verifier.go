@@ -0,0 +1,195 @@
+package cosign
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const (
+	// Pending represents a verification in progress.
+	Pending Status = "pending"
+	// Passed represents a successful verification.
+	Passed Status = "passed"
+	// Failed represents a rejected verification.
+	Failed Status = "failed"
+	// Errored represents a verification that could not be carried out.
+	Errored Status = "error"
+	// Unverifiable represents an image that is not pinned to a digest, whose
+	// signature can't be tied to the image actually run.
+	Unverifiable Status = "unverifiable"
+
+	dockerPullable = "docker-pullable://"
+	digestSep      = "@sha256:"
+
+	verifyTimeout = 1 * time.Minute
+	maxVerifiers  = 4
+)
+
+// Status represents a verification status.
+type Status string
+
+// Check represents the outcome of a verification.
+type Check struct {
+	Status  Status `json:"status" yaml:"status"`
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// Result represents an image signature and SBOM attestation verification.
+type Result struct {
+	Image     string    `json:"image" yaml:"image"`
+	Signature Check     `json:"signature" yaml:"signature"`
+	SBOM      Check     `json:"sbom" yaml:"sbom"`
+	CheckedAt time.Time `json:"checkedAt,omitempty" yaml:"checkedAt,omitempty"`
+}
+
+// IsPending checks if the verification is still in progress.
+func (r Result) IsPending() bool {
+	return r.Signature.Status == Pending
+}
+
+// Runner runs a cosign command and returns its combined output.
+type Runner func(ctx context.Context, bin string, args ...string) ([]byte, error)
+
+// Verifier verifies images signatures and SBOM attestations via cosign.
+// Verifications run in the background and their results are cached.
+type Verifier struct {
+	cfg   *config.ImageVerify
+	run   Runner
+	ttl   time.Duration
+	sem   chan struct{}
+	mx    sync.RWMutex
+	cache map[string]Result
+}
+
+// NewVerifier returns a new verifier.
+func NewVerifier(cfg *config.ImageVerify) *Verifier {
+	return &Verifier{
+		cfg: cfg,
+		run: func(ctx context.Context, bin string, args ...string) ([]byte, error) {
+			return exec.CommandContext(ctx, bin, args...).CombinedOutput()
+		},
+		ttl:   time.Duration(cfg.TTL) * time.Second,
+		sem:   make(chan struct{}, maxVerifiers),
+		cache: make(map[string]Result),
+	}
+}
+
+// Result returns an image verification result. Missing or expired results
+// are reported as pending while the image gets verified in the background.
+func (v *Verifier) Result(image string) Result {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	if r, ok := v.cache[image]; ok && (r.IsPending() || time.Since(r.CheckedAt) < v.ttl) {
+		return r
+	}
+	r := Result{Image: image, Signature: Check{Status: Pending}, SBOM: Check{Status: Pending}}
+	v.cache[image] = r
+	go v.verify(image)
+
+	return r
+}
+
+// Forget evicts an image verification result so it gets verified again.
+func (v *Verifier) Forget(image string) {
+	v.mx.Lock()
+	defer v.mx.Unlock()
+
+	if r, ok := v.cache[image]; ok && !r.IsPending() {
+		delete(v.cache, image)
+	}
+}
+
+func (v *Verifier) verify(image string) {
+	if !strings.Contains(image, digestSep) {
+		c := Check{Status: Unverifiable, Message: "image is not pinned to a digest"}
+		v.mx.Lock()
+		v.cache[image] = Result{Image: image, Signature: c, SBOM: c, CheckedAt: time.Now()}
+		v.mx.Unlock()
+		return
+	}
+	v.sem <- struct{}{}
+	defer func() { <-v.sem }()
+
+	r := Result{
+		Image:     image,
+		Signature: v.check(verifyArgs(v.cfg, image)),
+		SBOM:      Check{Status: Failed, Message: "no SBOM attestation found"},
+	}
+	for _, t := range v.cfg.Attestations {
+		c := v.check(attestArgs(v.cfg, t, image))
+		if c.Status == Passed {
+			r.SBOM = c
+			break
+		}
+		if c.Status == Errored {
+			r.SBOM = c
+		}
+	}
+	r.CheckedAt = time.Now()
+
+	v.mx.Lock()
+	v.cache[image] = r
+	v.mx.Unlock()
+}
+
+func (v *Verifier) check(args []string) Check {
+	ctx, cancel := context.WithTimeout(context.Background(), verifyTimeout)
+	defer cancel()
+
+	out, err := v.run(ctx, v.cfg.Binary, args...)
+	if err == nil {
+		return Check{Status: Passed}
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return Check{Status: Failed, Message: lastLine(out)}
+	}
+
+	return Check{Status: Errored, Message: err.Error()}
+}
+
+// ImageRef returns an image reference pinned to the digest the container
+// runs, when known.
+func ImageRef(image, imageID string) string {
+	if strings.Contains(image, digestSep) {
+		return image
+	}
+	id := strings.TrimPrefix(imageID, dockerPullable)
+	if strings.Contains(id, digestSep) {
+		return id
+	}
+
+	return image
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func verifyArgs(cfg *config.ImageVerify, image string) []string {
+	return append(append([]string{"verify"}, identityArgs(cfg)...), "--", image)
+}
+
+func attestArgs(cfg *config.ImageVerify, typ, image string) []string {
+	return append(append([]string{"verify-attestation", "--type", typ}, identityArgs(cfg)...), "--", image)
+}
+
+func identityArgs(cfg *config.ImageVerify) []string {
+	if cfg.Key != "" {
+		return []string{"--key", cfg.Key}
+	}
+
+	return []string{"--certificate-identity", cfg.Identity, "--certificate-oidc-issuer", cfg.Issuer}
+}
+
+func lastLine(out []byte) string {
+	ll := strings.Split(strings.TrimSpace(string(out)), "\n")
+
+	return strings.TrimSpace(ll[len(ll)-1])
+}
@@ -0,0 +1,128 @@
+package cosign
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageRef(t *testing.T) {
+	uu := map[string]struct {
+		image, imageID, e string
+	}{
+		"pullable": {
+			image:   "nginx:1.19",
+			imageID: "docker-pullable://nginx@sha256:abc",
+			e:       "nginx@sha256:abc",
+		},
+		"containerd": {
+			image:   "docker.io/library/nginx:1.19",
+			imageID: "docker.io/library/nginx@sha256:abc",
+			e:       "docker.io/library/nginx@sha256:abc",
+		},
+		"localID": {
+			image:   "nginx:1.19",
+			imageID: "sha256:abc",
+			e:       "nginx:1.19",
+		},
+		"pinned": {
+			image:   "nginx@sha256:abc",
+			imageID: "docker-pullable://nginx@sha256:def",
+			e:       "nginx@sha256:abc",
+		},
+		"none": {
+			image: "nginx:1.19",
+			e:     "nginx:1.19",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ImageRef(u.image, u.imageID))
+		})
+	}
+}
+
+func TestIdentityArgs(t *testing.T) {
+	uu := map[string]struct {
+		cfg config.ImageVerify
+		e   []string
+	}{
+		"key": {
+			cfg: config.ImageVerify{Key: "cosign.pub", Identity: "fred"},
+			e:   []string{"verify", "--key", "cosign.pub", "--", "nginx@sha256:abc"},
+		},
+		"keyless": {
+			cfg: config.ImageVerify{Identity: "fred@blee.io", Issuer: "https://accounts.google.com"},
+			e:   []string{"verify", "--certificate-identity", "fred@blee.io", "--certificate-oidc-issuer", "https://accounts.google.com", "--", "nginx@sha256:abc"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, verifyArgs(&u.cfg, "nginx@sha256:abc"))
+		})
+	}
+}
+
+func TestVerifierResult(t *testing.T) {
+	cfg := config.NewImageVerify()
+	cfg.Key = "cosign.pub"
+	v := NewVerifier(cfg)
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+	exitErr := exec.Command("false").Run()
+	v.run = func(_ context.Context, _ string, args ...string) ([]byte, error) {
+		defer wg.Done()
+		switch {
+		case args[0] == "verify":
+			return nil, nil
+		case strings.Contains(strings.Join(args, " "), "cyclonedx"):
+			return nil, errors.New("signal: killed")
+		default:
+			return []byte("Error: none of the attestations matched the predicate type\nmain.go:69: error during command execution: no matching attestations"), exitErr
+		}
+	}
+
+	r := v.Result("nginx@sha256:abc")
+	assert.True(t, r.IsPending())
+	wg.Wait()
+	for v.Result("nginx@sha256:abc").IsPending() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	r = v.Result("nginx@sha256:abc")
+	assert.Equal(t, Check{Status: Passed}, r.Signature)
+	assert.Equal(t, Check{Status: Errored, Message: "signal: killed"}, r.SBOM)
+	assert.Equal(t, "main.go:69: error during command execution: no matching attestations", lastLine([]byte("Error: none\nmain.go:69: error during command execution: no matching attestations\n")))
+}
+
+func TestVerifierUnpinned(t *testing.T) {
+	cfg := config.NewImageVerify()
+	cfg.Key = "cosign.pub"
+	v := NewVerifier(cfg)
+	v.run = func(context.Context, string, ...string) ([]byte, error) {
+		t.Fatal("unpinned images must not be verified")
+		return nil, nil
+	}
+
+	v.Result("nginx:1.19")
+	for v.Result("nginx:1.19").IsPending() {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	r := v.Result("nginx:1.19")
+	e := Check{Status: Unverifiable, Message: "image is not pinned to a digest"}
+	assert.Equal(t, e, r.Signature)
+	assert.Equal(t, e, r.SBOM)
+}
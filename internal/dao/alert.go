@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/alert"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Alert)(nil)
+
+// Alert represents fired alerts.
+type Alert struct {
+	NonResource
+}
+
+// List returns a collection of fired alerts.
+func (a *Alert) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	b, ok := ctx.Value(internal.KeyAlerts).(*alert.Board)
+	if !ok {
+		return nil, errors.New("no alert board found in context")
+	}
+
+	aa := b.List()
+	oo := make([]runtime.Object, len(aa))
+	for i, a := range aa {
+		oo[i] = render.AlertRes{Alert: a}
+	}
+
+	return oo, nil
+}
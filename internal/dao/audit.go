@@ -0,0 +1,38 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Audit)(nil)
+
+// Audit represents the audit log entries.
+type Audit struct {
+	NonResource
+}
+
+// List returns a collection of audit entries.
+func (a *Audit) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no audit log found in context")
+	}
+
+	ee, err := config.LoadAudit(path)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, len(ee))
+	for i, e := range ee {
+		oo[i] = render.AuditRes{Entry: e, Line: i + 1}
+	}
+
+	return oo, nil
+}
@@ -0,0 +1,35 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/auditlog"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*AuditEvent)(nil)
+
+// AuditEvent represents streamed Kubernetes audit events.
+type AuditEvent struct {
+	NonResource
+}
+
+// List returns the most recent audit events matching the context filter.
+func (a *AuditEvent) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	s, ok := ctx.Value(internal.KeyAuditEvents).(*auditlog.Stream)
+	if !ok || s == nil {
+		return nil, errors.New("audit events are not enabled. Check the auditEvents section of your K9s config")
+	}
+	f, _ := ctx.Value(internal.KeyAuditFilter).(auditlog.Filter)
+
+	ee := s.List(f)
+	oo := make([]runtime.Object, len(ee))
+	for i, e := range ee {
+		oo[i] = render.AuditEventRes{Event: e}
+	}
+
+	return oo, nil
+}
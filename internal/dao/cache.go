@@ -0,0 +1,32 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Cache)(nil)
+
+// Cache represents the informer caches usage.
+type Cache struct {
+	NonResource
+}
+
+// List returns a collection of informer caches usage.
+func (c *Cache) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	s, ok := c.Factory.(CacheStatter)
+	if !ok {
+		return nil, errors.New("factory does not track caches usage")
+	}
+
+	ss := s.CacheStats()
+	oo := make([]runtime.Object, len(ss))
+	for i, st := range ss {
+		oo[i] = render.CacheRes{CacheStat: st}
+	}
+
+	return oo, nil
+}
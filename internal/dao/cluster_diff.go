@@ -0,0 +1,228 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/pmezard/go-difflib/difflib"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+var _ Accessor = (*ClusterDiff)(nil)
+
+// DiffIgnoredFields lists server managed fields dropped prior to comparing
+// objects across clusters.
+var DiffIgnoredFields = [][]string{
+	{"status"},
+	{"metadata", "uid"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "selfLink"},
+	{"metadata", "managedFields"},
+	{"metadata", "ownerReferences"},
+	{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+	{"metadata", "annotations", "deployment.kubernetes.io/revision"},
+	{"spec", "clusterIP"},
+	{"spec", "healthCheckNodePort"},
+}
+
+// DiffIgnoredResourceFields lists server managed fields dropped prior to
+// comparing objects of a given resource across clusters.
+var DiffIgnoredResourceFields = map[string][][]string{
+	"v1/serviceaccounts": {{"secrets"}},
+}
+
+// DiffTarget represents a context to compare.
+type DiffTarget struct {
+	Context string
+	Factory Factory
+}
+
+// DiffSpec represents resources to compare between two contexts.
+type DiffSpec struct {
+	Left, Right DiffTarget
+	GVRs        []string
+}
+
+// ClusterDiff represents objects differing between two contexts.
+type ClusterDiff struct {
+	NonResource
+}
+
+// List returns the objects differing between the two contexts.
+func (c *ClusterDiff) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	spec, ok := ctx.Value(internal.KeyDiff).(*DiffSpec)
+	if !ok {
+		return nil, errors.New("no diff spec found in context")
+	}
+
+	var oo []runtime.Object
+	for _, gvr := range spec.GVRs {
+		rns := ns
+		if meta, err := MetaAccess.MetaFor(client.NewGVR(gvr)); err == nil && !meta.Namespaced {
+			rns = client.ClusterScope
+		}
+		left, err := normalizedObjects(spec.Left, gvr, rns)
+		if err != nil {
+			return nil, err
+		}
+		right, err := normalizedObjects(spec.Right, gvr, rns)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range DiffObjects(gvr, left, right) {
+			oo = append(oo, d)
+		}
+	}
+
+	return oo, nil
+}
+
+// Describe returns a unified diff of a given object across both contexts.
+func (c *ClusterDiff) Describe(spec *DiffSpec, gvr, path string) (string, error) {
+	left, err := normalizedYAML(spec.Left, gvr, path)
+	if err != nil {
+		return "", err
+	}
+	right, err := normalizedYAML(spec.Right, gvr, path)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(left),
+		B:        difflib.SplitLines(right),
+		FromFile: spec.Left.Context,
+		ToFile:   spec.Right.Context,
+		Context:  3,
+	})
+}
+
+// DiffObjects compares normalized objects keyed by path and returns the
+// missing, extra and changed ones.
+func DiffObjects(gvr string, left, right map[string]map[string]interface{}) []render.ObjectDiff {
+	var dd []render.ObjectDiff
+	for path, l := range left {
+		r, ok := right[path]
+		if !ok {
+			dd = append(dd, render.ObjectDiff{GVR: gvr, Path: path, Status: render.DiffMissing})
+			continue
+		}
+		if ff := DiffFields(l, r); len(ff) > 0 {
+			dd = append(dd, render.ObjectDiff{GVR: gvr, Path: path, Status: render.DiffChanged, Fields: ff})
+		}
+	}
+	for path := range right {
+		if _, ok := left[path]; !ok {
+			dd = append(dd, render.ObjectDiff{GVR: gvr, Path: path, Status: render.DiffExtra})
+		}
+	}
+	sort.Slice(dd, func(i, j int) bool {
+		return dd[i].Path < dd[j].Path
+	})
+
+	return dd
+}
+
+// DiffFields returns the paths of the fields differing between two objects.
+func DiffFields(l, r map[string]interface{}) []string {
+	var ff []string
+	diffFields("", l, r, &ff)
+	sort.Strings(ff)
+
+	return ff
+}
+
+// NormalizeObject drops the server managed fields of an object.
+func NormalizeObject(gvr string, o map[string]interface{}) map[string]interface{} {
+	n := runtime.DeepCopyJSON(o)
+	for _, f := range DiffIgnoredFields {
+		unstructured.RemoveNestedField(n, f...)
+	}
+	for _, f := range DiffIgnoredResourceFields[gvr] {
+		unstructured.RemoveNestedField(n, f...)
+	}
+	for _, k := range []string{"annotations", "labels"} {
+		if m, ok, _ := unstructured.NestedMap(n, "metadata", k); ok && len(m) == 0 {
+			unstructured.RemoveNestedField(n, "metadata", k)
+		}
+	}
+
+	return n
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func diffFields(prefix string, l, r map[string]interface{}, ff *[]string) {
+	keys := make(map[string]struct{}, len(l)+len(r))
+	for k := range l {
+		keys[k] = struct{}{}
+	}
+	for k := range r {
+		keys[k] = struct{}{}
+	}
+	for k := range keys {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		lm, lok := l[k].(map[string]interface{})
+		rm, rok := r[k].(map[string]interface{})
+		if lok && rok {
+			diffFields(path, lm, rm, ff)
+			continue
+		}
+		if !reflect.DeepEqual(l[k], r[k]) {
+			*ff = append(*ff, path)
+		}
+	}
+}
+
+func normalizedObjects(t DiffTarget, gvr, ns string) (map[string]map[string]interface{}, error) {
+	oo, err := t.Factory.List(gvr, ns, true, labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("%s -- %s", t.Context, err)
+	}
+	mm := make(map[string]map[string]interface{}, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		mm[client.FQN(u.GetNamespace(), u.GetName())] = NormalizeObject(gvr, u.Object)
+	}
+
+	return mm, nil
+}
+
+func normalizedYAML(t DiffTarget, gvr, path string) (string, error) {
+	o, err := t.Factory.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s -- %s", t.Context, err)
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	raw, err := yaml.Marshal(NormalizeObject(gvr, u.Object))
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
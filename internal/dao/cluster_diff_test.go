@@ -0,0 +1,123 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeObject(t *testing.T) {
+	o := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "fred",
+			"uid":               "1234",
+			"resourceVersion":   "10",
+			"creationTimestamp": "2020-01-01T00:00:00Z",
+			"annotations": map[string]interface{}{
+				"kubectl.kubernetes.io/last-applied-configuration": "{}",
+			},
+			"labels": map[string]interface{}{"app": "fred"},
+		},
+		"spec":   map[string]interface{}{"replicas": int64(1)},
+		"status": map[string]interface{}{"readyReplicas": int64(1)},
+	}
+
+	n := dao.NormalizeObject("apps/v1/deployments", o)
+	assert.Equal(t, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   "fred",
+			"labels": map[string]interface{}{"app": "fred"},
+		},
+		"spec": map[string]interface{}{"replicas": int64(1)},
+	}, n)
+	assert.Equal(t, "1234", o["metadata"].(map[string]interface{})["uid"])
+}
+
+func TestNormalizeObjectResource(t *testing.T) {
+	uu := map[string]struct {
+		gvr string
+		e   map[string]interface{}
+	}{
+		"sa": {
+			gvr: "v1/serviceaccounts",
+			e:   map[string]interface{}{"kind": "ServiceAccount"},
+		},
+		"other": {
+			gvr: "fred.example.com/v1/blees",
+			e: map[string]interface{}{
+				"kind":    "ServiceAccount",
+				"secrets": []interface{}{map[string]interface{}{"name": "fred-token-x1z2"}},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := map[string]interface{}{
+				"kind":    "ServiceAccount",
+				"secrets": []interface{}{map[string]interface{}{"name": "fred-token-x1z2"}},
+			}
+			assert.Equal(t, u.e, dao.NormalizeObject(u.gvr, o))
+		})
+	}
+}
+
+func TestDiffFields(t *testing.T) {
+	uu := map[string]struct {
+		l, r map[string]interface{}
+		e    []string
+	}{
+		"same": {
+			l: map[string]interface{}{"data": map[string]interface{}{"a": "1"}},
+			r: map[string]interface{}{"data": map[string]interface{}{"a": "1"}},
+		},
+		"changed": {
+			l: map[string]interface{}{"data": map[string]interface{}{"a": "1", "b": "2"}},
+			r: map[string]interface{}{"data": map[string]interface{}{"a": "2", "b": "2"}},
+			e: []string{"data.a"},
+		},
+		"added": {
+			l: map[string]interface{}{"data": map[string]interface{}{"a": "1"}},
+			r: map[string]interface{}{"data": map[string]interface{}{"a": "1", "b": "2"}, "kind": "ConfigMap"},
+			e: []string{"data.b", "kind"},
+		},
+		"list": {
+			l: map[string]interface{}{"args": []interface{}{"-v"}},
+			r: map[string]interface{}{"args": []interface{}{"-v", "-x"}},
+			e: []string{"args"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.DiffFields(u.l, u.r))
+		})
+	}
+}
+
+func TestDiffObjects(t *testing.T) {
+	cm := func(v string) map[string]interface{} {
+		return map[string]interface{}{"data": map[string]interface{}{"a": v}}
+	}
+	left := map[string]map[string]interface{}{
+		"default/same":    cm("1"),
+		"default/changed": cm("1"),
+		"default/missing": cm("1"),
+	}
+	right := map[string]map[string]interface{}{
+		"default/same":    cm("1"),
+		"default/changed": cm("2"),
+		"default/extra":   cm("1"),
+	}
+
+	dd := dao.DiffObjects("v1/configmaps", left, right)
+	assert.Equal(t, []render.ObjectDiff{
+		{GVR: "v1/configmaps", Path: "default/changed", Status: render.DiffChanged, Fields: []string{"data.a"}},
+		{GVR: "v1/configmaps", Path: "default/extra", Status: render.DiffExtra},
+		{GVR: "v1/configmaps", Path: "default/missing", Status: render.DiffMissing},
+	}, dd)
+}
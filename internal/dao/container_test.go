@@ -51,6 +51,7 @@ func (c *conn) MXDial() (*versioned.Clientset, error)             { return nil,
 func (c *conn) DynDialOrDie() dynamic.Interface                   { return nil }
 func (c *conn) HasMetrics() bool                                  { return false }
 func (c *conn) CheckConnectivity() bool                           { return false }
+func (c *conn) RefreshCredentials() error                         { return nil }
 func (c *conn) IsNamespaced(n string) bool                        { return false }
 func (c *conn) SupportsResource(group string) bool                { return false }
 func (c *conn) ValidNamespaces() ([]v1.Namespace, error)          { return nil, nil }
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
@@ -34,14 +35,23 @@ func (c *Context) Get(ctx context.Context, path string) (runtime.Object, error)
 }
 
 // List all Contexts on the current cluster.
-func (c *Context) List(_ context.Context, _ string) ([]runtime.Object, error) {
+func (c *Context) List(ctx context.Context, _ string) ([]runtime.Object, error) {
 	ctxs, err := c.config().Contexts()
 	if err != nil {
 		return nil, err
 	}
+	probes, _ := ctx.Value(internal.KeyProbes).(*client.Probes)
+	favs, _ := ctx.Value(internal.KeyFavorites).([]string)
 	cc := make([]runtime.Object, 0, len(ctxs))
 	for k, v := range ctxs {
-		cc = append(cc, render.NewNamedContext(c.config(), k, v))
+		nc := render.NewNamedContext(c.config(), k, v)
+		nc.Favorite = in(favs, k)
+		if probes != nil {
+			if p, ok := probes.Get(k); ok {
+				nc.Probe = &p
+			}
+		}
+		cc = append(cc, nc)
 	}
 
 	return cc, nil
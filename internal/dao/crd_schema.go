@@ -0,0 +1,227 @@
+package dao
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// MaxFormFields represents the maximum number of fields of a custom resource form.
+	MaxFormFields = 20
+
+	maxFormDepth = 4
+	crdGVR       = "apiextensions.k8s.io/v1beta1/customresourcedefinitions"
+)
+
+// Form fields types.
+const (
+	FormString      = "string"
+	FormInteger     = "integer"
+	FormNumber      = "number"
+	FormBoolean     = "boolean"
+	FormIntOrString = "int-or-string"
+)
+
+// formIgnoredFields lists the root fields not offered in forms.
+var formIgnoredFields = []string{"apiVersion", "kind", "metadata", "status"}
+
+// FormField represents a scalar field of a custom resource form.
+type FormField struct {
+	Path        []string
+	Type        string
+	Required    bool
+	Enum        []string
+	Default     string
+	Description string
+}
+
+// Key returns the field path.
+func (f FormField) Key() string {
+	return strings.Join(f.Path, ".")
+}
+
+// Value converts a form value to the field type.
+func (f FormField) Value(s string) (interface{}, error) {
+	switch f.Type {
+	case FormInteger:
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an integer", f.Key())
+		}
+		return i, nil
+	case FormNumber:
+		n, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be a number", f.Key())
+		}
+		return n, nil
+	case FormBoolean:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be true or false", f.Key())
+		}
+		return b, nil
+	case FormIntOrString:
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i, nil
+		}
+		return s, nil
+	default:
+		return s, nil
+	}
+}
+
+// CRDSchema returns the OpenAPI schema of a custom resource.
+func CRDSchema(f Factory, meta metav1.APIResource) (map[string]interface{}, error) {
+	path := client.FQN(client.ClusterScope, meta.Name+"."+meta.Group)
+	o, err := f.Get(crdGVR, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return crdSchema(u.Object, meta.Version)
+}
+
+// FormFields returns the fields of a custom resource form, ie the required
+// fields along with the ones sporting an enum or a default. The resource is
+// reported as unsupported when one of its required fields can't be expressed
+// as a scalar.
+func FormFields(schema map[string]interface{}) ([]FormField, bool) {
+	var ff []FormField
+	unsupported := walkSchema(schema, nil, true, 0, &ff)
+
+	return ff, unsupported
+}
+
+// NewCustomResource builds a custom resource from form values keyed by field path.
+func NewCustomResource(meta metav1.APIResource, name, ns string, ff []FormField, values map[string]string) (*unstructured.Unstructured, error) {
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+	var o unstructured.Unstructured
+	o.SetAPIVersion(client.NewGVRFromMeta(meta).GV().String())
+	o.SetKind(meta.Kind)
+	o.SetName(name)
+	if meta.Namespaced {
+		o.SetNamespace(ns)
+	}
+	for _, f := range ff {
+		s := strings.TrimSpace(values[f.Key()])
+		if s == "" {
+			if f.Required {
+				return nil, fmt.Errorf("%s is required", f.Key())
+			}
+			continue
+		}
+		v, err := f.Value(s)
+		if err != nil {
+			return nil, err
+		}
+		if err := unstructured.SetNestedField(o.Object, v, f.Path...); err != nil {
+			return nil, err
+		}
+	}
+
+	return &o, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// crdSchema returns a CRD schema for a given version, falling back to the
+// CRD wide validation schema.
+func crdSchema(crd map[string]interface{}, version string) (map[string]interface{}, error) {
+	vv, _, _ := unstructured.NestedSlice(crd, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok || m["name"] != version {
+			continue
+		}
+		if s, ok, _ := unstructured.NestedMap(m, "schema", "openAPIV3Schema"); ok {
+			return s, nil
+		}
+	}
+	if s, ok, _ := unstructured.NestedMap(crd, "spec", "validation", "openAPIV3Schema"); ok {
+		return s, nil
+	}
+
+	return nil, errors.New("no schema defined")
+}
+
+func walkSchema(s map[string]interface{}, path []string, required bool, depth int, ff *[]FormField) bool {
+	props, _ := s["properties"].(map[string]interface{})
+	reqs := toStrings(s["required"])
+	kk := make([]string, 0, len(props))
+	for k := range props {
+		if depth == 0 && in(formIgnoredFields, k) {
+			continue
+		}
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	var unsupported bool
+	for _, k := range kk {
+		p, ok := props[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		req := required && in(reqs, k)
+		fpath := append(append([]string{}, path...), k)
+		t, _ := p["type"].(string)
+		if t == "" && p["x-kubernetes-int-or-string"] == true {
+			t = FormIntOrString
+		}
+		switch t {
+		case FormString, FormInteger, FormNumber, FormBoolean, FormIntOrString:
+			f := FormField{
+				Path:     fpath,
+				Type:     t,
+				Required: req,
+				Enum:     toStrings(p["enum"]),
+			}
+			f.Description, _ = p["description"].(string)
+			if d, ok := p["default"]; ok {
+				f.Default = fmt.Sprintf("%v", d)
+			}
+			if req || len(f.Enum) > 0 || f.Default != "" {
+				*ff = append(*ff, f)
+			}
+		case "object":
+			if _, ok := p["properties"]; ok && depth < maxFormDepth {
+				unsupported = walkSchema(p, fpath, req, depth+1, ff) || unsupported
+				continue
+			}
+			unsupported = unsupported || req
+		default:
+			unsupported = unsupported || req
+		}
+	}
+
+	return unsupported
+}
+
+func toStrings(o interface{}) []string {
+	ii, _ := o.([]interface{})
+	if len(ii) == 0 {
+		return nil
+	}
+	ss := make([]string, 0, len(ii))
+	for _, i := range ii {
+		ss = append(ss, fmt.Sprintf("%v", i))
+	}
+
+	return ss
+}
@@ -0,0 +1,163 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFormFields(t *testing.T) {
+	uu := map[string]struct {
+		schema      map[string]interface{}
+		keys        []string
+		unsupported bool
+	}{
+		"empty": {
+			schema: map[string]interface{}{},
+		},
+		"scalars": {
+			schema: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"kind":     map[string]interface{}{"type": "string"},
+					"metadata": map[string]interface{}{"type": "object"},
+					"spec": map[string]interface{}{
+						"type":     "object",
+						"required": []interface{}{"size"},
+						"properties": map[string]interface{}{
+							"size":    map[string]interface{}{"type": "integer"},
+							"mode":    map[string]interface{}{"type": "string", "enum": []interface{}{"fast", "slow"}},
+							"debug":   map[string]interface{}{"type": "boolean", "default": false},
+							"comment": map[string]interface{}{"type": "string"},
+						},
+					},
+				},
+				"required": []interface{}{"spec"},
+			},
+			keys: []string{"spec.debug", "spec.mode", "spec.size"},
+		},
+		"required-list": {
+			schema: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"type":     "object",
+						"required": []interface{}{"hosts"},
+						"properties": map[string]interface{}{
+							"hosts": map[string]interface{}{"type": "array"},
+						},
+					},
+				},
+				"required": []interface{}{"spec"},
+			},
+			unsupported: true,
+		},
+		"optional-list": {
+			schema: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"spec": map[string]interface{}{
+						"type":     "object",
+						"required": []interface{}{"port"},
+						"properties": map[string]interface{}{
+							"hosts": map[string]interface{}{"type": "array"},
+							"port":  map[string]interface{}{"x-kubernetes-int-or-string": true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ff, unsupported := dao.FormFields(u.schema)
+			assert.Equal(t, u.unsupported, unsupported)
+			var kk []string
+			for _, f := range ff {
+				kk = append(kk, f.Key())
+			}
+			assert.Equal(t, u.keys, kk)
+		})
+	}
+}
+
+func TestFormFieldValue(t *testing.T) {
+	uu := map[string]struct {
+		field dao.FormField
+		s     string
+		e     interface{}
+		err   string
+	}{
+		"string":     {field: dao.FormField{Type: dao.FormString}, s: "10", e: "10"},
+		"integer":    {field: dao.FormField{Type: dao.FormInteger}, s: "10", e: int64(10)},
+		"number":     {field: dao.FormField{Type: dao.FormNumber}, s: "1.5", e: 1.5},
+		"bool":       {field: dao.FormField{Type: dao.FormBoolean}, s: "true", e: true},
+		"int-string": {field: dao.FormField{Type: dao.FormIntOrString}, s: "50%", e: "50%"},
+		"int-int":    {field: dao.FormField{Type: dao.FormIntOrString}, s: "50", e: int64(50)},
+		"toast": {
+			field: dao.FormField{Path: []string{"spec", "size"}, Type: dao.FormInteger},
+			s:     "big",
+			err:   "spec.size must be an integer",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, err := u.field.Value(u.s)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
+
+func TestNewCustomResource(t *testing.T) {
+	meta := metav1.APIResource{Group: "fred.io", Version: "v1", Kind: "Fred", Namespaced: true}
+	ff := []dao.FormField{
+		{Path: []string{"spec", "size"}, Type: dao.FormInteger, Required: true},
+		{Path: []string{"spec", "mode"}, Type: dao.FormString},
+	}
+
+	uu := map[string]struct {
+		name   string
+		values map[string]string
+		e      map[string]interface{}
+		err    string
+	}{
+		"happy": {
+			name:   "f1",
+			values: map[string]string{"spec.size": "3", "spec.mode": " "},
+			e:      map[string]interface{}{"size": int64(3)},
+		},
+		"no-name": {
+			values: map[string]string{"spec.size": "3"},
+			err:    "name is required",
+		},
+		"missing": {
+			name:   "f1",
+			values: map[string]string{"spec.mode": "fast"},
+			err:    "spec.size is required",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o, err := dao.NewCustomResource(meta, u.name, "ns1", ff, u.values)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, "fred.io/v1", o.GetAPIVersion())
+			assert.Equal(t, "Fred", o.GetKind())
+			assert.Equal(t, "ns1", o.GetNamespace())
+			assert.Equal(t, u.e, o.Object["spec"])
+		})
+	}
+}
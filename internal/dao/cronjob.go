@@ -5,15 +5,20 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
-const maxJobNameSize = 42
+const (
+	maxJobNameSize = 42
+	jobNameLabel   = "job-name"
+)
 
 var (
-	_ Accessor = (*CronJob)(nil)
-	_ Runnable = (*CronJob)(nil)
+	_ Accessor  = (*CronJob)(nil)
+	_ Runnable  = (*CronJob)(nil)
+	_ JobRunner = (*CronJob)(nil)
 )
 
 // CronJob represents a cronjob K8s resource.
@@ -23,19 +28,26 @@ type CronJob struct {
 
 // Run a CronJob.
 func (c *CronJob) Run(path string) error {
+	_, err := c.RunJob(path)
+
+	return err
+}
+
+// RunJob triggers a CronJob and returns the path of the created Job.
+func (c *CronJob) RunJob(path string) (string, error) {
 	ns, n := client.Namespaced(path)
 	auth, err := c.Client().CanI(ns, "batch/v1beta1/cronjobs", []string{client.GetVerb, client.CreateVerb})
 	if err != nil {
-		return err
+		return "", err
 	}
 	if !auth {
-		return fmt.Errorf("user is not authorize to run cronjobs")
+		return "", fmt.Errorf("user is not authorize to run cronjobs")
 	}
 
 	// BOZO!! Factory resource??
 	cj, err := c.Client().DialOrDie().BatchV1beta1().CronJobs(ns).Get(n, metav1.GetOptions{})
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	var jobName = cj.Name
@@ -51,7 +63,54 @@ func (c *CronJob) Run(path string) error {
 		},
 		Spec: cj.Spec.JobTemplate.Spec,
 	}
-	_, err = c.Client().DialOrDie().BatchV1().Jobs(ns).Create(job)
+	if _, err = c.Client().DialOrDie().BatchV1().Jobs(ns).Create(job); err != nil {
+		return "", err
+	}
 
-	return err
+	return client.FQN(ns, job.Name), nil
+}
+
+// JobPod returns the path of the latest pod of a Job having started a container.
+func (c *CronJob) JobPod(path string) (string, bool, error) {
+	ns, n := client.Namespaced(path)
+	pods, err := c.Client().DialOrDie().CoreV1().Pods(ns).List(metav1.ListOptions{
+		LabelSelector: jobNameLabel + "=" + n,
+	})
+	if err != nil {
+		return "", false, err
+	}
+	po := startedPod(pods.Items)
+	if po == nil {
+		return "", false, nil
+	}
+
+	return client.FQN(po.Namespace, po.Name), true, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// startedPod returns the most recent pod having a running or terminated container.
+func startedPod(pp []v1.Pod) *v1.Pod {
+	var latest *v1.Pod
+	for i := range pp {
+		if !containerStarted(pp[i].Status.InitContainerStatuses) && !containerStarted(pp[i].Status.ContainerStatuses) {
+			continue
+		}
+		if latest == nil || latest.CreationTimestamp.Before(&pp[i].CreationTimestamp) {
+			latest = &pp[i]
+		}
+	}
+
+	return latest
+}
+
+func containerStarted(ss []v1.ContainerStatus) bool {
+	for _, s := range ss {
+		if s.State.Running != nil || s.State.Terminated != nil {
+			return true
+		}
+	}
+
+	return false
 }
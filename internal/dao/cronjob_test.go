@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestStartedPod(t *testing.T) {
+	at := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	done := v1.ContainerState{Terminated: &v1.ContainerStateTerminated{}}
+	waiting := v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}}
+
+	uu := map[string]struct {
+		pods []v1.Pod
+		e    string
+	}{
+		"none": {},
+		"waiting": {
+			pods: []v1.Pod{makeJobPod("p1", at, waiting)},
+		},
+		"running": {
+			pods: []v1.Pod{makeJobPod("p1", at, running)},
+			e:    "p1",
+		},
+		"latest": {
+			pods: []v1.Pod{
+				makeJobPod("p1", at, done),
+				makeJobPod("p2", at.Add(time.Minute), running),
+				makeJobPod("p3", at.Add(2*time.Minute), waiting),
+			},
+			e: "p2",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			po := startedPod(u.pods)
+			if u.e == "" {
+				assert.Nil(t, po)
+				return
+			}
+			assert.Equal(t, u.e, po.Name)
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeJobPod(n string, at time.Time, s v1.ContainerState) v1.Pod {
+	return v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              n,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(at),
+		},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{{Name: "c1", State: s}},
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/errlog"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*ErrorLog)(nil)
+
+// ErrorLog represents recent errors.
+type ErrorLog struct {
+	NonResource
+}
+
+// List returns a collection of recent errors.
+func (e *ErrorLog) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	l, ok := ctx.Value(internal.KeyErrors).(*errlog.Log)
+	if !ok {
+		return nil, errors.New("no error log found in context")
+	}
+
+	ee := l.List()
+	oo := make([]runtime.Object, len(ee))
+	for i, e := range ee {
+		oo[i] = render.ErrorRes{Entry: e}
+	}
+
+	return oo, nil
+}
@@ -11,10 +11,15 @@ import (
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 )
 
-var _ Describer = (*Generic)(nil)
+var (
+	_ Describer = (*Generic)(nil)
+	_ Patchable = (*Generic)(nil)
+	_ Creatable = (*Generic)(nil)
+)
 
 var defaultKillGrace int64
 
@@ -33,15 +38,17 @@ func (g *Generic) List(ctx context.Context, ns string) ([]runtime.Object, error)
 	if client.IsAllNamespace(ns) {
 		ns = client.AllNamespaces
 	}
+	fieldSel, _ := ctx.Value(internal.KeyFields).(string)
+	opts := metav1.ListOptions{LabelSelector: labelSel, FieldSelector: fieldSel}
 
 	var (
 		ll  *unstructured.UnstructuredList
 		err error
 	)
 	if client.IsClusterScoped(ns) {
-		ll, err = g.dynClient().List(metav1.ListOptions{LabelSelector: labelSel})
+		ll, err = g.dynClient().List(opts)
 	} else {
-		ll, err = g.dynClient().Namespace(ns).List(metav1.ListOptions{LabelSelector: labelSel})
+		ll, err = g.dynClient().Namespace(ns).List(opts)
 	}
 	if err != nil {
 		return nil, err
@@ -118,6 +125,49 @@ func (g *Generic) Delete(path string, cascade, force bool) error {
 	return g.dynClient().Namespace(ns).Delete(n, &opts)
 }
 
+// Patch patches a resource, optionally as a server side dry run.
+func (g *Generic) Patch(path string, pt types.PatchType, data []byte, dryRun bool) (runtime.Object, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	var opts metav1.PatchOptions
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	if client.IsClusterScoped(ns) {
+		return g.dynClient().Patch(n, pt, data, opts)
+	}
+
+	return g.dynClient().Namespace(ns).Patch(n, pt, data, opts)
+}
+
+// Create creates a resource, optionally as a server side dry run.
+func (g *Generic) Create(ns string, o *unstructured.Unstructured, dryRun bool) (*unstructured.Unstructured, error) {
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.CreateVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to create %s", g.gvr)
+	}
+
+	var opts metav1.CreateOptions
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	if client.IsClusterScoped(ns) {
+		return g.dynClient().Create(o, opts)
+	}
+
+	return g.dynClient().Namespace(ns).Create(o, opts)
+}
+
 func (g *Generic) dynClient() dynamic.NamespaceableResourceInterface {
 	return g.Client().DynDialOrDie().Resource(g.gvr.GVR())
 }
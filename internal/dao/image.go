@@ -0,0 +1,102 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/cosign"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Image)(nil)
+
+// Image represents the container images run by pods along with their
+// signature verification.
+type Image struct {
+	NonResource
+}
+
+// List returns the images of running containers. When a pod path is in
+// context, only its images are listed.
+func (i *Image) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	v, ok := ctx.Value(internal.KeyImageVerifier).(*cosign.Verifier)
+	if !ok || v == nil {
+		return nil, errors.New("image verification is not enabled. Check the imageVerify section of your K9s config")
+	}
+
+	var oo []runtime.Object
+	if path, ok := ctx.Value(internal.KeyPath).(string); ok && path != "" {
+		o, err := i.Factory.Get("v1/pods", path, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		oo = []runtime.Object{o}
+	} else {
+		var err error
+		if oo, err = i.Factory.List("v1/pods", ns, true, labels.Everything()); err != nil {
+			return nil, err
+		}
+	}
+
+	pp := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var po v1.Pod
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &po); err != nil {
+			return nil, err
+		}
+		pp = append(pp, po)
+	}
+
+	ii := PodImages(pp)
+	res := make([]runtime.Object, 0, len(ii))
+	for _, img := range ii {
+		img.Result = v.Result(img.Image)
+		res = append(res, img)
+	}
+
+	return res, nil
+}
+
+// PodImages returns the images run by the pods running containers, pinned
+// to their digest when known.
+func PodImages(pp []v1.Pod) []render.ImageRes {
+	idx := make(map[string]int)
+	var ii []render.ImageRes
+	for _, po := range pp {
+		fqn := client.FQN(po.Namespace, po.Name)
+		for _, ss := range [][]v1.ContainerStatus{po.Status.InitContainerStatuses, po.Status.ContainerStatuses} {
+			for _, cs := range ss {
+				if cs.State.Running == nil {
+					continue
+				}
+				ref := cosign.ImageRef(cs.Image, cs.ImageID)
+				i, ok := idx[ref]
+				if !ok {
+					i = len(ii)
+					idx[ref] = i
+					ii = append(ii, render.ImageRes{Image: ref})
+				}
+				if !in(ii[i].Pods, fqn) {
+					ii[i].Pods = append(ii[i].Pods, fqn)
+				}
+			}
+		}
+	}
+	sort.Slice(ii, func(i, j int) bool {
+		return ii[i].Image < ii[j].Image
+	})
+
+	return ii
+}
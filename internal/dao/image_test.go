@@ -0,0 +1,43 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodImages(t *testing.T) {
+	running := v1.ContainerState{Running: &v1.ContainerStateRunning{}}
+	pp := []v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "fred"},
+			Status: v1.PodStatus{
+				InitContainerStatuses: []v1.ContainerStatus{
+					{Image: "busybox:1.31", ImageID: "docker-pullable://busybox@sha256:abc"},
+				},
+				ContainerStatuses: []v1.ContainerStatus{
+					{Image: "nginx:1.19", ImageID: "docker-pullable://nginx@sha256:def", State: running},
+					{Image: "envoy:1.14", ImageID: "sha256:ghi", State: running},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "blee"},
+			Status: v1.PodStatus{
+				ContainerStatuses: []v1.ContainerStatus{
+					{Image: "nginx:1.19", ImageID: "docker-pullable://nginx@sha256:def", State: running},
+					{Image: "nginx:1.19", ImageID: "docker-pullable://nginx@sha256:def", State: running},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, []render.ImageRes{
+		{Image: "envoy:1.14", Pods: []string{"default/fred"}},
+		{Image: "nginx@sha256:def", Pods: []string{"default/fred", "default/blee"}},
+	}, dao.PodImages(pp))
+}
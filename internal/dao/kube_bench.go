@@ -0,0 +1,196 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/cis"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	kubeBenchName = "kube-bench"
+	kubeBenchPoll = 2 * time.Second
+)
+
+// kubeBenchMounts tracks the host paths inspected by kube-bench.
+var kubeBenchMounts = []struct {
+	name, hostPath, mountPath string
+}{
+	{name: "var-lib-etcd", hostPath: "/var/lib/etcd", mountPath: "/var/lib/etcd"},
+	{name: "var-lib-kubelet", hostPath: "/var/lib/kubelet", mountPath: "/var/lib/kubelet"},
+	{name: "etc-systemd", hostPath: "/etc/systemd", mountPath: "/etc/systemd"},
+	{name: "etc-kubernetes", hostPath: "/etc/kubernetes", mountPath: "/etc/kubernetes"},
+	{name: "usr-bin", hostPath: "/usr/bin", mountPath: "/usr/local/mount-from-host/bin"},
+}
+
+var (
+	_ Accessor = (*KubeBench)(nil)
+	_ Accessor = (*KubeBenchCheck)(nil)
+)
+
+// KubeBench represents kube-bench CIS benchmark sections.
+type KubeBench struct {
+	NonResource
+}
+
+// List returns the benchmark sections of a kube-bench report.
+func (k *KubeBench) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	r, err := kubeBenchReport(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var oo []runtime.Object
+	for _, c := range r.Controls {
+		for _, g := range c.Groups {
+			oo = append(oo, render.KubeBenchRes{Controls: c.Text, NodeType: c.NodeType, Group: g})
+		}
+	}
+
+	return oo, nil
+}
+
+// Run runs a kube-bench image as a job in the given namespace and returns its results.
+func (k *KubeBench) Run(ctx context.Context, ns, image string) ([]byte, error) {
+	auth, err := k.Client().CanI(ns, "batch/v1/jobs", []string{client.CreateVerb, client.DeleteVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to run jobs in namespace %s", ns)
+	}
+
+	dial := k.Client().DialOrDie()
+	job, err := dial.BatchV1().Jobs(ns).Create(KubeBenchJob(ns, image))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		p := metav1.DeletePropagationBackground
+		if err := dial.BatchV1().Jobs(ns).Delete(job.Name, &metav1.DeleteOptions{PropagationPolicy: &p}); err != nil {
+			log.Error().Err(err).Msgf("Deleting kube-bench job %s", job.Name)
+		}
+	}()
+
+	if err := k.waitForJob(ctx, ns, job.Name); err != nil {
+		return nil, err
+	}
+	pods, err := dial.CoreV1().Pods(ns).List(metav1.ListOptions{LabelSelector: "job-name=" + job.Name})
+	if err != nil {
+		return nil, err
+	}
+	if len(pods.Items) == 0 {
+		return nil, fmt.Errorf("no pods found for job %s", job.Name)
+	}
+
+	return dial.CoreV1().Pods(ns).GetLogs(pods.Items[0].Name, &v1.PodLogOptions{Container: kubeBenchName}).DoRaw()
+}
+
+func (k *KubeBench) waitForJob(ctx context.Context, ns, n string) error {
+	ticker := time.NewTicker(kubeBenchPoll)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("kube-bench job %s did not complete -- %s", n, ctx.Err())
+		case <-ticker.C:
+		}
+		job, err := k.Client().DialOrDie().BatchV1().Jobs(ns).Get(n, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if job.Status.Succeeded > 0 {
+			return nil
+		}
+		if job.Status.Failed > 0 {
+			return fmt.Errorf("kube-bench job %s failed", n)
+		}
+	}
+}
+
+// KubeBenchJob returns a kube-bench job manifest inspecting the node it lands on.
+func KubeBenchJob(ns, image string) *batchv1.Job {
+	var backoff int32
+	co := v1.Container{
+		Name:    kubeBenchName,
+		Image:   image,
+		Command: []string{"kube-bench", "--json"},
+	}
+	var vv []v1.Volume
+	for _, m := range kubeBenchMounts {
+		vv = append(vv, v1.Volume{
+			Name:         m.name,
+			VolumeSource: v1.VolumeSource{HostPath: &v1.HostPathVolumeSource{Path: m.hostPath}},
+		})
+		co.VolumeMounts = append(co.VolumeMounts, v1.VolumeMount{Name: m.name, MountPath: m.mountPath, ReadOnly: true})
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: kubeBenchName + "-",
+			Namespace:    ns,
+			Labels:       map[string]string{"app.kubernetes.io/managed-by": "k9s"},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: &backoff,
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					HostPID:       true,
+					RestartPolicy: v1.RestartPolicyNever,
+					Containers:    []v1.Container{co},
+					Volumes:       vv,
+				},
+			},
+		},
+	}
+}
+
+// KubeBenchCheck represents the checks of a kube-bench CIS benchmark section.
+type KubeBenchCheck struct {
+	NonResource
+}
+
+// List returns the checks of a benchmark section.
+func (k *KubeBenchCheck) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	r, err := kubeBenchReport(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, ok := ctx.Value(internal.KeySubject).(string)
+	if !ok {
+		return nil, errors.New("no kube-bench section found in context")
+	}
+	g, ok := r.Group(id)
+	if !ok {
+		return nil, fmt.Errorf("no kube-bench section %q found", id)
+	}
+
+	oo := make([]runtime.Object, 0, len(g.Checks))
+	for _, c := range g.Checks {
+		oo = append(oo, render.KubeBenchCheckRes{Check: c})
+	}
+
+	return oo, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func kubeBenchReport(ctx context.Context) (*cis.Report, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no kube-bench results found in context")
+	}
+
+	return cis.Load(path)
+}
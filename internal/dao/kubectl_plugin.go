@@ -0,0 +1,184 @@
+package dao
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const kubectlPluginPrefix = "kubectl-"
+
+// KubectlPlugin represents a kubectl plugin invocation for a resource.
+type KubectlPlugin struct {
+	Name        string
+	Description string
+	Bin         string
+	Args        []string
+	// Reveals indicates the plugin prints secret values.
+	Reveals bool
+}
+
+type kubectlPluginArgsFunc func(gvr client.GVR, ns, n, ctx string) []string
+
+type kubectlPluginSpec struct {
+	description string
+	// gvrs restricts the plugin to the given resources. Any resource when empty.
+	gvrs []string
+	// dumps indicates the plugin prints the resource content.
+	dumps bool
+	args  kubectlPluginArgsFunc
+}
+
+// kubectlPlugins tracks well known kubectl plugins that act on a resource.
+var kubectlPlugins = map[string]kubectlPluginSpec{
+	"neat": {
+		description: "Neat YAML",
+		dumps:       true,
+		args: func(gvr client.GVR, ns, n, ctx string) []string {
+			return append([]string{"get", "--", KubectlResource(gvr), n, "-o", "yaml"}, kubectlFlags(ns, ctx)...)
+		},
+	},
+	"tree": {
+		description: "Ownership Tree",
+		args: func(gvr client.GVR, ns, n, ctx string) []string {
+			return append([]string{KubectlResource(gvr), n}, kubectlFlags(ns, ctx)...)
+		},
+	},
+	"df-pv": {
+		description: "Volumes Usage",
+		gvrs:        []string{"v1/persistentvolumeclaims", "v1/persistentvolumes", "v1/nodes"},
+		args: func(_ client.GVR, ns, _, ctx string) []string {
+			return kubectlFlags(ns, ctx)
+		},
+	},
+	"view-secret": {
+		description: "View Secret",
+		gvrs:        []string{"v1/secrets"},
+		dumps:       true,
+		args: func(_ client.GVR, ns, n, ctx string) []string {
+			return append([]string{n, "--all"}, kubectlFlags(ns, ctx)...)
+		},
+	},
+	"access-matrix": {
+		description: "Access Matrix",
+		gvrs:        []string{"v1/serviceaccounts"},
+		args: func(_ client.GVR, ns, n, ctx string) []string {
+			return append([]string{"--sa", ns + ":" + n}, kubectlFlags("", ctx)...)
+		},
+	},
+	"get-all": {
+		description: "All Resources",
+		gvrs:        []string{"v1/namespaces"},
+		args: func(_ client.GVR, _, n, ctx string) []string {
+			return kubectlFlags(n, ctx)
+		},
+	},
+}
+
+// InstalledKubectlPlugins returns the kubectl plugins found on a given search
+// path keyed by plugin name. As with kubectl, the first match on the path wins.
+func InstalledKubectlPlugins(path string) map[string]string {
+	pp := make(map[string]string)
+	for _, dir := range filepath.SplitList(path) {
+		ff, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, f := range ff {
+			if f.IsDir() || !strings.HasPrefix(f.Name(), kubectlPluginPrefix) || !isExecutable(f) {
+				continue
+			}
+			name := kubectlPluginName(f.Name())
+			if _, ok := pp[name]; ok || name == "" {
+				continue
+			}
+			pp[name] = filepath.Join(dir, f.Name())
+		}
+	}
+
+	return pp
+}
+
+// KubectlPluginsFor returns the installed plugins applicable to a resource.
+func KubectlPluginsFor(installed map[string]string, gvr client.GVR, path, ctx string) []KubectlPlugin {
+	ns, n := client.Namespaced(path)
+	var pp []KubectlPlugin
+	for name, spec := range kubectlPlugins {
+		bin, ok := installed[name]
+		if !ok || !spec.appliesTo(gvr) {
+			continue
+		}
+		pp = append(pp, KubectlPlugin{
+			Name:        name,
+			Description: spec.description,
+			Bin:         bin,
+			Args:        spec.args(gvr, ns, n, ctx),
+			Reveals:     spec.dumps && gvr.String() == "v1/secrets",
+		})
+	}
+	sort.Slice(pp, func(i, j int) bool {
+		return pp[i].Name < pp[j].Name
+	})
+
+	return pp
+}
+
+func (s kubectlPluginSpec) appliesTo(gvr client.GVR) bool {
+	if len(s.gvrs) == 0 {
+		return true
+	}
+	for _, g := range s.gvrs {
+		if g == gvr.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// KubectlResource returns a resource descriptor as understood by kubectl.
+func KubectlResource(gvr client.GVR) string {
+	if gvr.G() == "" {
+		return gvr.R()
+	}
+
+	return gvr.R() + "." + gvr.G()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// kubectlPluginName converts a plugin binary name to a plugin name, ie
+// kubectl-df_pv is invoked as kubectl df-pv.
+func kubectlPluginName(bin string) string {
+	name := strings.TrimPrefix(bin, kubectlPluginPrefix)
+	if ext := filepath.Ext(name); ext == ".exe" {
+		name = strings.TrimSuffix(name, ext)
+	}
+
+	return strings.Replace(name, "_", "-", -1)
+}
+
+func kubectlFlags(ns, ctx string) []string {
+	var ff []string
+	if ns != "" {
+		ff = append(ff, "--namespace", ns)
+	}
+	if ctx != "" {
+		ff = append(ff, "--context", ctx)
+	}
+
+	return ff
+}
+
+func isExecutable(f os.FileInfo) bool {
+	if filepath.Ext(f.Name()) == ".exe" {
+		return true
+	}
+
+	return f.Mode()&0111 != 0
+}
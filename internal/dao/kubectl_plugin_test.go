@@ -0,0 +1,102 @@
+package dao
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstalledKubectlPlugins(t *testing.T) {
+	dir1, err := ioutil.TempDir("", "k9s-plugins")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir1)
+	dir2, err := ioutil.TempDir("", "k9s-plugins")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir2)
+
+	touch := func(dir, name string, mode os.FileMode) {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, name), []byte("#!/bin/sh\n"), mode))
+	}
+	touch(dir1, "kubectl-tree", 0755)
+	touch(dir1, "kubectl-df_pv", 0755)
+	touch(dir1, "kubectl-noexec", 0644)
+	touch(dir1, "kubectx", 0755)
+	touch(dir2, "kubectl-tree", 0755)
+	touch(dir2, "kubectl-neat", 0755)
+
+	pp := InstalledKubectlPlugins(dir1 + string(os.PathListSeparator) + "/blee" + string(os.PathListSeparator) + dir2)
+	assert.Equal(t, map[string]string{
+		"tree":  filepath.Join(dir1, "kubectl-tree"),
+		"df-pv": filepath.Join(dir1, "kubectl-df_pv"),
+		"neat":  filepath.Join(dir2, "kubectl-neat"),
+	}, pp)
+}
+
+func TestKubectlPluginsFor(t *testing.T) {
+	installed := map[string]string{
+		"neat":        "/bin/kubectl-neat",
+		"tree":        "/bin/kubectl-tree",
+		"view-secret": "/bin/kubectl-view_secret",
+		"get-all":     "/bin/kubectl-get_all",
+		"fred":        "/bin/kubectl-fred",
+	}
+
+	uu := map[string]struct {
+		gvr, path string
+		e         []KubectlPlugin
+	}{
+		"deployment": {
+			gvr:  "apps/v1/deployments",
+			path: "default/fred",
+			e: []KubectlPlugin{
+				{Name: "neat", Description: "Neat YAML", Bin: "/bin/kubectl-neat", Args: []string{"get", "--", "deployments.apps", "fred", "-o", "yaml", "--namespace", "default", "--context", "ctx1"}},
+				{Name: "tree", Description: "Ownership Tree", Bin: "/bin/kubectl-tree", Args: []string{"deployments.apps", "fred", "--namespace", "default", "--context", "ctx1"}},
+			},
+		},
+		"secret": {
+			gvr:  "v1/secrets",
+			path: "default/fred",
+			e: []KubectlPlugin{
+				{Name: "neat", Description: "Neat YAML", Bin: "/bin/kubectl-neat", Args: []string{"get", "--", "secrets", "fred", "-o", "yaml", "--namespace", "default", "--context", "ctx1"}, Reveals: true},
+				{Name: "tree", Description: "Ownership Tree", Bin: "/bin/kubectl-tree", Args: []string{"secrets", "fred", "--namespace", "default", "--context", "ctx1"}},
+				{Name: "view-secret", Description: "View Secret", Bin: "/bin/kubectl-view_secret", Args: []string{"fred", "--all", "--namespace", "default", "--context", "ctx1"}, Reveals: true},
+			},
+		},
+		"namespace": {
+			gvr:  "v1/namespaces",
+			path: "kube-system",
+			e: []KubectlPlugin{
+				{Name: "get-all", Description: "All Resources", Bin: "/bin/kubectl-get_all", Args: []string{"--namespace", "kube-system", "--context", "ctx1"}},
+				{Name: "neat", Description: "Neat YAML", Bin: "/bin/kubectl-neat", Args: []string{"get", "--", "namespaces", "kube-system", "-o", "yaml", "--context", "ctx1"}},
+				{Name: "tree", Description: "Ownership Tree", Bin: "/bin/kubectl-tree", Args: []string{"namespaces", "kube-system", "--context", "ctx1"}},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, KubectlPluginsFor(installed, client.NewGVR(u.gvr), u.path, "ctx1"))
+		})
+	}
+}
+
+func TestKubectlPluginName(t *testing.T) {
+	uu := map[string]string{
+		"kubectl-tree":        "tree",
+		"kubectl-df_pv":       "df-pv",
+		"kubectl-view_secret": "view-secret",
+		"kubectl-neat.exe":    "neat",
+	}
+
+	for k := range uu {
+		bin, e := k, uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, e, kubectlPluginName(bin))
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package dao
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	// ShellContainer represents the node shell container name.
+	ShellContainer = "k9s-shell"
+
+	shellPodPrefix   = "k9s-shell-"
+	shellHostVolume  = "host-root"
+	shellHostMount   = "/host"
+	maxShellPodName  = 63
+	seccompAnnotPrfx = "container.seccomp.security.alpha.kubernetes.io/"
+)
+
+var _ NodeShell = (*Node)(nil)
+
+// LaunchShell starts a node shell pod.
+func (n *Node) LaunchShell(node string, cfg *config.ShellPod) (string, error) {
+	auth, err := n.Client().CanI(cfg.Namespace, "v1/pods", []string{client.CreateVerb})
+	if err != nil {
+		return "", err
+	}
+	if !auth {
+		return "", fmt.Errorf("user is not authorized to create pods in namespace %s", cfg.Namespace)
+	}
+
+	po, err := ShellPod(node, cfg)
+	if err != nil {
+		return "", err
+	}
+	if _, err := n.Client().DialOrDie().CoreV1().Pods(po.Namespace).Create(po); err != nil {
+		return "", err
+	}
+
+	return client.FQN(po.Namespace, po.Name), nil
+}
+
+// ShellReady checks if a node shell pod is running.
+func (n *Node) ShellReady(path string) (bool, error) {
+	ns, name := client.Namespaced(path)
+	po, err := n.Client().DialOrDie().CoreV1().Pods(ns).Get(name, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	switch po.Status.Phase {
+	case v1.PodRunning:
+		return true, nil
+	case v1.PodFailed, v1.PodSucceeded:
+		return false, fmt.Errorf("node shell pod %s exited with phase %s", path, po.Status.Phase)
+	default:
+		return false, nil
+	}
+}
+
+// DeleteShell terminates a node shell pod.
+func (n *Node) DeleteShell(path string) error {
+	ns, name := client.Namespaced(path)
+
+	return n.Client().DialOrDie().CoreV1().Pods(ns).Delete(name, shellDeleteOptions())
+}
+
+// ShellPodName returns a unique shell pod name for a given node.
+func ShellPodName(node string) string {
+	const suffixSize = 6
+	name := shellPodPrefix + node
+	if len(name) > maxShellPodName-suffixSize {
+		name = name[:maxShellPodName-suffixSize]
+	}
+
+	return strings.TrimRight(name, "-.") + "-" + rand.String(suffixSize-1)
+}
+
+// ShellPod returns a node shell pod manifest based on the given template.
+func ShellPod(node string, cfg *config.ShellPod) (*v1.Pod, error) {
+	rr, err := shellResources(cfg.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	var grace int64
+	co := v1.Container{
+		Name:            ShellContainer,
+		Image:           cfg.Image,
+		Stdin:           true,
+		TTY:             true,
+		Resources:       rr,
+		SecurityContext: shellSecurityContext(cfg),
+	}
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ShellPodName(node),
+			Namespace: cfg.Namespace,
+			Labels:    map[string]string{"app.kubernetes.io/managed-by": "k9s"},
+		},
+		Spec: v1.PodSpec{
+			NodeName:                      node,
+			RestartPolicy:                 v1.RestartPolicyNever,
+			HostPID:                       cfg.IsHostPID(),
+			HostNetwork:                   cfg.IsHostNetwork(),
+			NodeSelector:                  cfg.NodeSelector,
+			Tolerations:                   shellTolerations(cfg.Tolerations),
+			TerminationGracePeriodSeconds: &grace,
+		},
+	}
+	if cfg.IsMountHost() {
+		po.Spec.Volumes = []v1.Volume{
+			{
+				Name: shellHostVolume,
+				VolumeSource: v1.VolumeSource{
+					HostPath: &v1.HostPathVolumeSource{Path: "/"},
+				},
+			},
+		}
+		co.VolumeMounts = []v1.VolumeMount{
+			{Name: shellHostVolume, MountPath: shellHostMount},
+		}
+	}
+	if sc := cfg.SecurityContext; sc != nil && sc.SeccompProfile != "" {
+		po.Annotations = map[string]string{seccompAnnotPrfx + ShellContainer: sc.SeccompProfile}
+	}
+	po.Spec.Containers = []v1.Container{co}
+
+	return &po, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func shellDeleteOptions() *metav1.DeleteOptions {
+	var grace int64
+	return &metav1.DeleteOptions{GracePeriodSeconds: &grace}
+}
+
+func shellSecurityContext(cfg *config.ShellPod) *v1.SecurityContext {
+	priv := cfg.IsPrivileged()
+	sc := v1.SecurityContext{Privileged: &priv}
+	c := cfg.SecurityContext
+	if c == nil {
+		return &sc
+	}
+
+	sc.RunAsUser, sc.RunAsGroup, sc.RunAsNonRoot = c.RunAsUser, c.RunAsGroup, c.RunAsNonRoot
+	sc.AllowPrivilegeEscalation, sc.ReadOnlyRootFilesystem = c.AllowPrivilegeEscalation, c.ReadOnlyRootFilesystem
+	if c.Capabilities != nil {
+		sc.Capabilities = &v1.Capabilities{}
+		for _, a := range c.Capabilities.Add {
+			sc.Capabilities.Add = append(sc.Capabilities.Add, v1.Capability(a))
+		}
+		for _, d := range c.Capabilities.Drop {
+			sc.Capabilities.Drop = append(sc.Capabilities.Drop, v1.Capability(d))
+		}
+	}
+
+	return &sc
+}
+
+func shellTolerations(tt []config.Toleration) []v1.Toleration {
+	if len(tt) == 0 {
+		return nil
+	}
+
+	vv := make([]v1.Toleration, 0, len(tt))
+	for _, t := range tt {
+		vv = append(vv, v1.Toleration{
+			Key:               t.Key,
+			Operator:          v1.TolerationOperator(t.Operator),
+			Value:             t.Value,
+			Effect:            v1.TaintEffect(t.Effect),
+			TolerationSeconds: t.TolerationSeconds,
+		})
+	}
+
+	return vv
+}
+
+func shellResources(r *config.Resources) (v1.ResourceRequirements, error) {
+	var rr v1.ResourceRequirements
+	if r == nil {
+		return rr, nil
+	}
+
+	var err error
+	if rr.Requests, err = resourceList(r.Requests); err != nil {
+		return rr, err
+	}
+	rr.Limits, err = resourceList(r.Limits)
+
+	return rr, err
+}
+
+func resourceList(m map[string]string) (v1.ResourceList, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+
+	l := make(v1.ResourceList, len(m))
+	for k, v := range m {
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid shell pod %s quantity %q", k, v)
+		}
+		l[v1.ResourceName(k)] = q
+	}
+
+	return l, nil
+}
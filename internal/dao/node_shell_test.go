@@ -0,0 +1,86 @@
+package dao_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestShellPodName(t *testing.T) {
+	uu := map[string]struct {
+		node, prefix string
+	}{
+		"short": {node: "n1", prefix: "k9s-shell-n1-"},
+		"long":  {node: strings.Repeat("n", 80), prefix: "k9s-shell-" + strings.Repeat("n", 47) + "-"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n := dao.ShellPodName(u.node)
+			assert.True(t, strings.HasPrefix(n, u.prefix))
+			assert.True(t, len(n) <= 63)
+		})
+	}
+}
+
+func TestShellPodDefault(t *testing.T) {
+	po, err := dao.ShellPod("n1", config.NewShellPod())
+	assert.Nil(t, err)
+
+	assert.Equal(t, config.DefaultShellPodNamespace, po.Namespace)
+	assert.Equal(t, "n1", po.Spec.NodeName)
+	assert.True(t, po.Spec.HostPID)
+	assert.True(t, po.Spec.HostNetwork)
+	assert.Equal(t, 1, len(po.Spec.Volumes))
+	co := po.Spec.Containers[0]
+	assert.Equal(t, config.DefaultShellPodImage, co.Image)
+	assert.True(t, *co.SecurityContext.Privileged)
+	assert.Equal(t, "/host", co.VolumeMounts[0].MountPath)
+}
+
+func TestShellPodRestricted(t *testing.T) {
+	f, uid := false, int64(1000)
+	cfg := config.ShellPod{
+		Image:       "fred/shell:1.0",
+		Namespace:   "ops",
+		HostPID:     &f,
+		HostNetwork: &f,
+		MountHost:   &f,
+		SecurityContext: &config.SecurityContext{
+			Privileged:     &f,
+			RunAsUser:      &uid,
+			Capabilities:   &config.Capabilities{Drop: []string{"ALL"}},
+			SeccompProfile: "runtime/default",
+		},
+		Tolerations:  []config.Toleration{{Operator: "Exists"}},
+		NodeSelector: map[string]string{"pool": "ops"},
+		Resources:    &config.Resources{Limits: map[string]string{"cpu": "100m"}},
+	}
+	po, err := dao.ShellPod("n1", &cfg)
+	assert.Nil(t, err)
+
+	assert.Equal(t, "ops", po.Namespace)
+	assert.False(t, po.Spec.HostPID)
+	assert.False(t, po.Spec.HostNetwork)
+	assert.Equal(t, 0, len(po.Spec.Volumes))
+	assert.Equal(t, []v1.Toleration{{Operator: v1.TolerationOpExists}}, po.Spec.Tolerations)
+	assert.Equal(t, "runtime/default", po.Annotations["container.seccomp.security.alpha.kubernetes.io/k9s-shell"])
+	co := po.Spec.Containers[0]
+	assert.False(t, *co.SecurityContext.Privileged)
+	assert.Equal(t, uid, *co.SecurityContext.RunAsUser)
+	assert.Equal(t, []v1.Capability{"ALL"}, co.SecurityContext.Capabilities.Drop)
+	assert.Equal(t, "100m", co.Resources.Limits.Cpu().String())
+}
+
+func TestShellPodInvalidQuantity(t *testing.T) {
+	cfg := config.NewShellPod()
+	cfg.Resources = &config.Resources{Limits: map[string]string{"cpu": "fred"}}
+
+	_, err := dao.ShellPod("n1", cfg)
+	assert.EqualError(t, err, `invalid shell pod cpu quantity "fred"`)
+}
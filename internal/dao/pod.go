@@ -72,15 +72,14 @@ func (p *Pod) Get(ctx context.Context, path string) (runtime.Object, error) {
 
 // List returns a collection of nodes.
 func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
-	sel, ok := ctx.Value(internal.KeyFields).(string)
-	if !ok {
+	if _, ok := ctx.Value(internal.KeyFields).(string); !ok {
 		return nil, fmt.Errorf("expecting a fieldSelector in context")
 	}
-	fsel, err := labels.ConvertSelectorToLabelsMap(sel)
+	_, fsel, err := selectors(ctx)
 	if err != nil {
 		return nil, err
 	}
-	nodeName := fsel["spec.nodeName"]
+	nodeName, _ := fsel.RequiresExactMatch("spec.nodeName")
 
 	oo, err := p.Resource.List(ctx, ns)
 	if err != nil {
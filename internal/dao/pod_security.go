@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/pss"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type podTemplateSpec struct {
+	gvr  string
+	path []string
+}
+
+// podTemplates tracks where workloads hold their pod template.
+var podTemplates = []podTemplateSpec{
+	{gvr: "apps/v1/deployments", path: []string{"spec", "template"}},
+	{gvr: "apps/v1/statefulsets", path: []string{"spec", "template"}},
+	{gvr: "apps/v1/daemonsets", path: []string{"spec", "template"}},
+	{gvr: "apps/v1/replicasets", path: []string{"spec", "template"}},
+	{gvr: "batch/v1beta1/cronjobs", path: []string{"spec", "jobTemplate", "spec", "template"}},
+	{gvr: "batch/v1/jobs", path: []string{"spec", "template"}},
+	{gvr: "v1/pods"},
+}
+
+var _ Accessor = (*PodSecurity)(nil)
+
+// PodSecurity represents workloads evaluated against the Pod Security Standards.
+type PodSecurity struct {
+	NonResource
+}
+
+// List evaluates all workloads. Workloads managed by a controller are
+// evaluated via their controller.
+func (p *PodSecurity) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	var oo []runtime.Object
+	for _, spec := range podTemplates {
+		if _, err := MetaAccess.MetaFor(client.NewGVR(spec.gvr)); err != nil {
+			continue
+		}
+		ll, err := p.Factory.List(spec.gvr, ns, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to list %s", spec.gvr)
+			continue
+		}
+		for _, o := range ll {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting unstructured but got %T", o)
+			}
+			if isControlled(u) {
+				continue
+			}
+			vv, err := EvaluatePodSecurity(spec.gvr, u)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Unable to evaluate %s %s", spec.gvr, u.GetName())
+				continue
+			}
+			oo = append(oo, render.PodSecurityRes{
+				GVR:        spec.gvr,
+				Path:       client.FQN(u.GetNamespace(), u.GetName()),
+				Violations: vv,
+			})
+		}
+	}
+
+	return oo, nil
+}
+
+// Violations returns the Pod Security Standards violations of a workload.
+func (p *PodSecurity) Violations(gvr, path string) (pss.Violations, error) {
+	o, err := p.Factory.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return EvaluatePodSecurity(gvr, u)
+}
+
+// EvaluatePodSecurity evaluates a workload pod template.
+func EvaluatePodSecurity(gvr string, u *unstructured.Unstructured) (pss.Violations, error) {
+	var spec *podTemplateSpec
+	for i := range podTemplates {
+		if podTemplates[i].gvr == gvr {
+			spec = &podTemplates[i]
+			break
+		}
+	}
+	if spec == nil {
+		return nil, fmt.Errorf("no pod template for %s", gvr)
+	}
+
+	m := map[string]interface{}{
+		"metadata": u.Object["metadata"],
+		"spec":     u.Object["spec"],
+	}
+	if len(spec.path) > 0 {
+		tm, ok, err := unstructured.NestedMap(u.Object, spec.path...)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("no pod template found at %s", strings.Join(spec.path, "."))
+		}
+		m = tm
+	}
+	var tpl v1.PodTemplateSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &tpl); err != nil {
+		return nil, err
+	}
+
+	return pss.Evaluate(tpl, strings.Join(spec.path, ".")), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isControlled(u *unstructured.Unstructured) bool {
+	for _, r := range u.GetOwnerReferences() {
+		if r.Controller != nil && *r.Controller {
+			return true
+		}
+	}
+
+	return false
+}
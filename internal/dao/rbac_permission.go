@@ -0,0 +1,241 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	allAuthenticated   = "system:authenticated"
+	allServiceAccounts = "system:serviceaccounts"
+)
+
+var _ Accessor = (*Permission)(nil)
+
+// Permission represents the effective permissions of a user, group or service account.
+type Permission struct {
+	NonResource
+}
+
+// List returns the deduplicated permissions granted to a subject.
+func (p *Permission) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	kind, ok := ctx.Value(internal.KeySubjectKind).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context subject kind")
+	}
+	name, ok := ctx.Value(internal.KeySubjectName).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context subject name")
+	}
+
+	var pol Policy
+	pol.Init(p.Factory, client.NewGVR("policy"))
+	crbs, err := fetchClusterRoleBindings(p.Factory)
+	if err != nil {
+		return nil, err
+	}
+	rbs, err := fetchRoleBindings(p.Factory)
+	if err != nil {
+		return nil, err
+	}
+	crs, err := pol.fetchClusterRoles()
+	if err != nil {
+		return nil, err
+	}
+	ros, err := pol.fetchRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	pp := EffectivePermissions(NewSubject(kind, name), crbs, rbs, crs, ros)
+	oo := make([]runtime.Object, 0, len(pp))
+	for _, p := range pp {
+		oo = append(oo, p)
+	}
+
+	return oo, nil
+}
+
+// NewSubject returns a rbac subject. Service accounts are named ns/name.
+func NewSubject(kind, name string) rbacv1.Subject {
+	s := rbacv1.Subject{Kind: kind, Name: name}
+	if kind == rbacv1.ServiceAccountKind {
+		s.Namespace, s.Name = client.Namespaced(name)
+	}
+
+	return s
+}
+
+// EffectivePermissions aggregates all the rules bound to a subject, either
+// directly or via its implicit groups, into a deduplicated set of permissions.
+func EffectivePermissions(s rbacv1.Subject, crbs []rbacv1.ClusterRoleBinding, rbs []rbacv1.RoleBinding, crs []rbacv1.ClusterRole, ros []rbacv1.Role) render.Permissions {
+	cRules := make(map[string][]rbacv1.PolicyRule, len(crs))
+	for _, cr := range crs {
+		cRules[cr.Name] = cr.Rules
+	}
+	rRules := make(map[string][]rbacv1.PolicyRule, len(ros))
+	for _, ro := range ros {
+		rRules[client.FQN(ro.Namespace, ro.Name)] = ro.Rules
+	}
+
+	acc := newPermAccumulator()
+	for _, crb := range crbs {
+		if !isBound(crb.Subjects, s) || crb.RoleRef.Kind != "ClusterRole" {
+			continue
+		}
+		acc.add(render.ClusterWide, "CRB:"+crb.Name, cRules[crb.RoleRef.Name], true)
+	}
+	for _, rb := range rbs {
+		if !isBound(rb.Subjects, s) {
+			continue
+		}
+		binding := "RB:" + client.FQN(rb.Namespace, rb.Name)
+		switch rb.RoleRef.Kind {
+		case "ClusterRole":
+			acc.add(rb.Namespace, binding, cRules[rb.RoleRef.Name], false)
+		case "Role":
+			acc.add(rb.Namespace, binding, rRules[client.FQN(rb.Namespace, rb.RoleRef.Name)], false)
+		}
+	}
+
+	return acc.permissions()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type permAccumulator struct {
+	perms map[string]*render.PermissionRes
+}
+
+func newPermAccumulator() *permAccumulator {
+	return &permAccumulator{perms: make(map[string]*render.PermissionRes)}
+}
+
+func (a *permAccumulator) add(ns, binding string, rules []rbacv1.PolicyRule, clusterWide bool) {
+	for _, r := range rules {
+		names := append([]string(nil), r.ResourceNames...)
+		sort.Strings(names)
+		for _, g := range r.APIGroups {
+			for _, res := range r.Resources {
+				a.upsert(render.PermissionRes{Namespace: ns, Group: g, Resource: res, Names: names}, binding, r.Verbs)
+			}
+		}
+		// Non resource urls are only honored via cluster role bindings.
+		if !clusterWide {
+			continue
+		}
+		for _, u := range r.NonResourceURLs {
+			if !strings.HasPrefix(u, "/") {
+				u = "/" + u
+			}
+			a.upsert(render.PermissionRes{Namespace: ns, Resource: u}, binding, r.Verbs)
+		}
+	}
+}
+
+func (a *permAccumulator) upsert(p render.PermissionRes, binding string, verbs []string) {
+	id := p.ID()
+	if e, ok := a.perms[id]; ok {
+		p = *e
+	}
+	p.Verbs = mergeVerbs(p.Verbs, verbs)
+	if !in(p.Bindings, binding) {
+		p.Bindings = append(p.Bindings, binding)
+	}
+	a.perms[id] = &p
+}
+
+// permissions returns the accumulated permissions, omitting namespaced grants
+// already covered cluster wide.
+func (a *permAccumulator) permissions() render.Permissions {
+	pp := make(render.Permissions, 0, len(a.perms))
+	for _, p := range a.perms {
+		if p.Namespace != render.ClusterWide {
+			c := *p
+			c.Namespace = render.ClusterWide
+			if cp, ok := a.perms[c.ID()]; ok && coversVerbs(cp.Verbs, p.Verbs) {
+				continue
+			}
+		}
+		pp = append(pp, *p)
+	}
+	sort.Slice(pp, func(i, j int) bool {
+		if pp[i].Namespace != pp[j].Namespace {
+			return pp[i].Namespace < pp[j].Namespace
+		}
+		if pp[i].Group != pp[j].Group {
+			return pp[i].Group < pp[j].Group
+		}
+		if pp[i].Resource != pp[j].Resource {
+			return pp[i].Resource < pp[j].Resource
+		}
+		return strings.Join(pp[i].Names, ",") < strings.Join(pp[j].Names, ",")
+	})
+
+	return pp
+}
+
+func isBound(ss []rbacv1.Subject, s rbacv1.Subject) bool {
+	groups := implicitGroups(s)
+	for _, b := range ss {
+		switch {
+		case b.Kind == s.Kind && b.Name == s.Name && b.Namespace == s.Namespace:
+			return true
+		case b.Kind == s.Kind && b.Name == s.Name && s.Kind != rbacv1.ServiceAccountKind:
+			return true
+		case b.Kind == rbacv1.GroupKind && in(groups, b.Name):
+			return true
+		}
+	}
+
+	return false
+}
+
+// implicitGroups returns the groups a subject belongs to by virtue of its kind.
+func implicitGroups(s rbacv1.Subject) []string {
+	switch s.Kind {
+	case rbacv1.ServiceAccountKind:
+		return []string{allAuthenticated, allServiceAccounts, allServiceAccounts + ":" + s.Namespace}
+	case rbacv1.UserKind:
+		return []string{allAuthenticated}
+	default:
+		return nil
+	}
+}
+
+func mergeVerbs(vv1, vv2 []string) []string {
+	if in(vv1, rbacv1.VerbAll) || in(vv2, rbacv1.VerbAll) {
+		return []string{rbacv1.VerbAll}
+	}
+	vv := append([]string(nil), vv1...)
+	for _, v := range vv2 {
+		if !in(vv, v) {
+			vv = append(vv, v)
+		}
+	}
+	sort.Strings(vv)
+
+	return vv
+}
+
+func coversVerbs(all, vv []string) bool {
+	if in(all, rbacv1.VerbAll) {
+		return true
+	}
+	for _, v := range vv {
+		if !in(all, v) {
+			return false
+		}
+	}
+
+	return true
+}
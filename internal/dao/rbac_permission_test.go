@@ -0,0 +1,101 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewSubject(t *testing.T) {
+	uu := map[string]struct {
+		kind, name string
+		e          rbacv1.Subject
+	}{
+		"user": {
+			kind: "User",
+			name: "fred",
+			e:    rbacv1.Subject{Kind: "User", Name: "fred"},
+		},
+		"sa": {
+			kind: "ServiceAccount",
+			name: "ci/deployer",
+			e:    rbacv1.Subject{Kind: "ServiceAccount", Namespace: "ci", Name: "deployer"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.NewSubject(u.kind, u.name))
+		})
+	}
+}
+
+func TestEffectivePermissions(t *testing.T) {
+	sa := dao.NewSubject("ServiceAccount", "ci/deployer")
+	crs := []rbacv1.ClusterRole{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "view"},
+			Rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get", "list"}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "discovery"},
+			Rules: []rbacv1.PolicyRule{
+				{NonResourceURLs: []string{"api"}, Verbs: []string{"get"}},
+			},
+		},
+	}
+	ros := []rbacv1.Role{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ci", Name: "deployer"},
+			Rules: []rbacv1.PolicyRule{
+				{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"patch", "update"}},
+				{APIGroups: []string{"apps"}, Resources: []string{"deployments"}, Verbs: []string{"get"}},
+				{APIGroups: []string{""}, Resources: []string{"secrets"}, ResourceNames: []string{"token"}, Verbs: []string{"get"}},
+			},
+		},
+	}
+	crbs := []rbacv1.ClusterRoleBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "sa-view"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "view"},
+			Subjects:   []rbacv1.Subject{{Kind: "Group", Name: "system:serviceaccounts:ci"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "discovery"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "discovery"},
+			Subjects:   []rbacv1.Subject{{Kind: "Group", Name: "system:authenticated"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "view"},
+			Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Namespace: "default", Name: "deployer"}},
+		},
+	}
+	rbs := []rbacv1.RoleBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ci", Name: "deploy"},
+			RoleRef:    rbacv1.RoleRef{Kind: "Role", Name: "deployer"},
+			Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Namespace: "ci", Name: "deployer"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: "view"},
+			RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: "view"},
+			Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Namespace: "ci", Name: "deployer"}},
+		},
+	}
+
+	e := render.Permissions{
+		{Namespace: "*", Group: "", Resource: "/api", Verbs: []string{"get"}, Bindings: []string{"CRB:discovery"}},
+		{Namespace: "*", Group: "apps", Resource: "deployments", Verbs: []string{"get", "list"}, Bindings: []string{"CRB:sa-view"}},
+		{Namespace: "ci", Group: "", Resource: "secrets", Names: []string{"token"}, Verbs: []string{"get"}, Bindings: []string{"RB:ci/deploy"}},
+		{Namespace: "ci", Group: "apps", Resource: "deployments", Verbs: []string{"get", "patch", "update"}, Bindings: []string{"RB:ci/deploy"}},
+	}
+	assert.Equal(t, e, dao.EffectivePermissions(sa, crbs, rbs, crs, ros))
+}
@@ -37,6 +37,18 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("containers"):                    &Container{},
 		client.NewGVR("screendumps"):                   &ScreenDump{},
 		client.NewGVR("benchmarks"):                    &Benchmark{},
+		client.NewGVR("audit"):                         &Audit{},
+		client.NewGVR("clusterdiffs"):                  &ClusterDiff{},
+		client.NewGVR("alerts"):                        &Alert{},
+		client.NewGVR("auditevents"):                   &AuditEvent{},
+		client.NewGVR("caches"):                        &Cache{},
+		client.NewGVR("tlscerts"):                      &TLSCert{},
+		client.NewGVR("podsecurity"):                   &PodSecurity{},
+		client.NewGVR("violations"):                    &Violation{},
+		client.NewGVR("images"):                        &Image{},
+		client.NewGVR("timelines"):                     &Timeline{},
+		client.NewGVR("watchlist"):                     &Watchlist{},
+		client.NewGVR("errors"):                        &ErrorLog{},
 		client.NewGVR("portforwards"):                  &PortForward{},
 		client.NewGVR("v1/services"):                   &Service{},
 		client.NewGVR("v1/pods"):                       &Pod{},
@@ -48,6 +60,7 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("batch/v1/jobs"):                 &Job{},
 		client.NewGVR("charts"):                        &Chart{},
 		client.NewGVR("openfaas"):                      &OpenFaas{},
+		client.NewGVR(RolloutGVR):                      &Rollout{},
 	}
 
 	r, ok := m[gvr]
@@ -186,6 +199,120 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{"delete"},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("audit")] = metav1.APIResource{
+		Name:         "audit",
+		Kind:         "Audit",
+		SingularName: "audit",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("clusterdiffs")] = metav1.APIResource{
+		Name:         "clusterdiffs",
+		Kind:         "ClusterDiff",
+		SingularName: "clusterdiff",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("alerts")] = metav1.APIResource{
+		Name:         "alerts",
+		Kind:         "Alerts",
+		SingularName: "alert",
+		ShortNames:   []string{"al"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("auditevents")] = metav1.APIResource{
+		Name:         "auditevents",
+		Kind:         "AuditEvents",
+		SingularName: "auditevent",
+		ShortNames:   []string{"ae"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("kubebench")] = metav1.APIResource{
+		Name:       "kubebench",
+		Kind:       "KubeBench",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("kubebenchchecks")] = metav1.APIResource{
+		Name:       "kubebenchchecks",
+		Kind:       "KubeBenchChecks",
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("violations")] = metav1.APIResource{
+		Name:         "violations",
+		Kind:         "Violations",
+		SingularName: "violation",
+		ShortNames:   []string{"viol"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("violationobjects")] = metav1.APIResource{
+		Name:       "violationobjects",
+		Kind:       "ViolationObjects",
+		Namespaced: true,
+		Categories: []string{"k9s"},
+	}
+	m[client.NewGVR("caches")] = metav1.APIResource{
+		Name:         "caches",
+		Kind:         "Caches",
+		SingularName: "cache",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("tlscerts")] = metav1.APIResource{
+		Name:         "tlscerts",
+		Kind:         "TLSCerts",
+		SingularName: "tlscert",
+		ShortNames:   []string{"tls"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("podsecurity")] = metav1.APIResource{
+		Name:         "podsecurity",
+		Kind:         "PodSecurity",
+		SingularName: "podsecurity",
+		ShortNames:   []string{"pss", "psa"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("images")] = metav1.APIResource{
+		Name:         "images",
+		Kind:         "Images",
+		SingularName: "image",
+		ShortNames:   []string{"img"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("timelines")] = metav1.APIResource{
+		Name:         "timelines",
+		Kind:         "Timeline",
+		SingularName: "timeline",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("watchlist")] = metav1.APIResource{
+		Name:         "watchlist",
+		Kind:         "Watchlist",
+		SingularName: "watchlist",
+		ShortNames:   []string{"wl", "pins"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("errors")] = metav1.APIResource{
+		Name:         "errors",
+		Kind:         "Errors",
+		SingularName: "error",
+		ShortNames:   []string{"err"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("portforwards")] = metav1.APIResource{
 		Name:         "portforwards",
 		Namespaced:   true,
@@ -237,6 +364,11 @@ func loadRBAC(m ResourceMetas) {
 		Namespaced: true,
 		Categories: []string{"k9s"},
 	}
+	m[client.NewGVR("permissions")] = metav1.APIResource{
+		Name:       "permissions",
+		Kind:       "Permissions",
+		Categories: []string{"k9s"},
+	}
 	m[client.NewGVR("users")] = metav1.APIResource{
 		Name:       "users",
 		Kind:       "User",
@@ -269,7 +401,6 @@ func loadPreferred(f Factory, m ResourceMetas) error {
 }
 
 func loadCRDs(f Factory, m ResourceMetas) {
-	const crdGVR = "apiextensions.k8s.io/v1beta1/customresourcedefinitions"
 	oo, err := f.List(crdGVR, "", true, labels.Everything())
 	if err != nil {
 		log.Warn().Err(err).Msgf("Fail CRDs load")
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/watch"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -13,6 +13,9 @@ var (
 	_ Accessor  = (*Resource)(nil)
 	_ Describer = (*Resource)(nil)
 	_ Nuker     = (*Resource)(nil)
+
+	_ SelectorLister = (*watch.Factory)(nil)
+	_ CacheStatter   = (*watch.Factory)(nil)
 )
 
 // Resource represents an informer based resource.
@@ -20,12 +23,15 @@ type Resource struct {
 	Generic
 }
 
-// List returns a collection of resources.
+// List returns a collection of resources. Selectors are evaluated by the api
+// server when the factory supports it.
 func (r *Resource) List(ctx context.Context, ns string) ([]runtime.Object, error) {
-	strLabel, ok := ctx.Value(internal.KeyLabels).(string)
-	lsel := labels.Everything()
-	if sel, err := labels.ConvertSelectorToLabelsMap(strLabel); ok && err == nil {
-		lsel = sel.AsSelector()
+	lsel, fsel, err := selectors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if l, ok := r.Factory.(SelectorLister); ok {
+		return l.ListSelected(r.gvr.String(), ns, false, lsel, fsel)
 	}
 
 	return r.Factory.List(r.gvr.String(), ns, false, lsel)
@@ -36,9 +42,10 @@ func (r *Resource) Get(ctx context.Context, path string) (runtime.Object, error)
 	return r.Factory.Get(r.gvr.String(), path, true, labels.Everything())
 }
 
-// ToYAML returns a resource yaml.
+// ToYAML returns a resource yaml. The live resource is fetched as cached
+// resources are stripped of managed fields and last applied configurations.
 func (r *Resource) ToYAML(path string) (string, error) {
-	o, err := r.Get(context.Background(), path)
+	o, err := r.Generic.Get(context.Background(), path)
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,203 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// RolloutGVR represents the Argo Rollouts resource.
+const RolloutGVR = "argoproj.io/v1alpha1/rollouts"
+
+const (
+	rolloutUnpausePatch   = `{"spec":{"paused":false}}`
+	rolloutResumePatch    = `{"status":{"pauseConditions":null}}`
+	rolloutStepPatchFmt   = `{"status":{"pauseConditions":null,"currentStepIndex":%d}}`
+	rolloutFullPatch      = `{"status":{"promoteFull":true}}`
+	rolloutAbortPatch     = `{"status":{"abort":true}}`
+	rolloutRetryPatch     = `{"status":{"abort":false}}`
+	rolloutStatusResource = "status"
+)
+
+var (
+	_ Accessor   = (*Rollout)(nil)
+	_ Nuker      = (*Rollout)(nil)
+	_ Loggable   = (*Rollout)(nil)
+	_ Controller = (*Rollout)(nil)
+)
+
+// Rollout represents an Argo Rollouts rollout.
+type Rollout struct {
+	Resource
+}
+
+// Promote moves a paused rollout to its next step. A full promotion skips
+// the remaining steps and analysis.
+func (r *Rollout) Promote(path string, full bool) error {
+	o, err := r.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	spec, status, err := rolloutPromotePatches(o.Object, full)
+	if err != nil {
+		return err
+	}
+	if spec != "" {
+		if err := r.patch(path, spec); err != nil {
+			return err
+		}
+	}
+	if status != "" {
+		return r.patchStatus(path, status)
+	}
+
+	return nil
+}
+
+// Abort aborts a rollout, sending all traffic back to the stable version.
+func (r *Rollout) Abort(path string) error {
+	return r.patchStatus(path, rolloutAbortPatch)
+}
+
+// Retry resumes an aborted rollout.
+func (r *Rollout) Retry(path string) error {
+	o, err := r.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	if !render.NewRolloutStatus(o.Object).Aborted {
+		return fmt.Errorf("rollout %s is not aborted", path)
+	}
+
+	return r.patchStatus(path, rolloutRetryPatch)
+}
+
+// Status returns a rollout progress.
+func (r *Rollout) Status(path string) (render.RolloutStatus, error) {
+	o, err := r.GetInstance(path)
+	if err != nil {
+		return render.RolloutStatus{}, err
+	}
+
+	return render.NewRolloutStatus(o.Object), nil
+}
+
+// TailLogs tail logs for all pods represented by this Rollout.
+func (r *Rollout) TailLogs(ctx context.Context, c chan<- []byte, opts LogOptions) error {
+	sel, err := r.selector(opts.Path)
+	if err != nil {
+		return err
+	}
+
+	return podLogs(ctx, c, sel, opts)
+}
+
+// Pod returns a pod victim by name.
+func (r *Rollout) Pod(fqn string) (string, error) {
+	sel, err := r.selector(fqn)
+	if err != nil {
+		return "", err
+	}
+	ns, _ := client.Namespaced(fqn)
+
+	return podFromSelector(r.Factory, ns, sel)
+}
+
+// Selector returns a rollout pod selector.
+func (r *Rollout) Selector(path string) (*metav1.LabelSelector, error) {
+	sel, err := r.selector(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metav1.LabelSelector{MatchLabels: sel}, nil
+}
+
+// GetInstance returns a rollout instance.
+func (r *Rollout) GetInstance(fqn string) (*unstructured.Unstructured, error) {
+	o, err := r.Factory.Get(r.gvr.String(), fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errors.New("expecting Rollout resource")
+	}
+
+	return u, nil
+}
+
+func (r *Rollout) selector(path string) (map[string]string, error) {
+	o, err := r.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+	sel, _, _ := unstructured.NestedStringMap(o.Object, "spec", "selector", "matchLabels")
+	if len(sel) == 0 {
+		return nil, fmt.Errorf("No valid selector found on Rollout %s", path)
+	}
+
+	return sel, nil
+}
+
+func (r *Rollout) patch(path, data string, subresources ...string) error {
+	ns, n := client.Namespaced(path)
+	auth, err := r.Client().CanI(ns, r.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch rollout %s", path)
+	}
+	_, err = r.dynClient().Namespace(ns).Patch(n, types.MergePatchType, []byte(data), metav1.PatchOptions{}, subresources...)
+
+	return err
+}
+
+// patchStatus patches a rollout status, falling back to the rollout itself
+// for older controllers lacking a status subresource.
+func (r *Rollout) patchStatus(path, data string) error {
+	err := r.patch(path, data, rolloutStatusResource)
+	if kerrors.IsNotFound(err) {
+		return r.patch(path, data)
+	}
+
+	return err
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// rolloutPromotePatches returns the spec and status patches promoting a rollout.
+func rolloutPromotePatches(o map[string]interface{}, full bool) (string, string, error) {
+	var spec, status string
+	if paused, _, _ := unstructured.NestedBool(o, "spec", "paused"); paused {
+		spec = rolloutUnpausePatch
+	}
+	if full {
+		return spec, rolloutFullPatch, nil
+	}
+
+	s := render.NewRolloutStatus(o)
+	cc, _, _ := unstructured.NestedSlice(o, "status", "pauseConditions")
+	switch {
+	case len(cc) > 0:
+		status = rolloutResumePatch
+	case s.Strategy == render.RolloutCanary && !s.IsCompleted():
+		status = fmt.Sprintf(rolloutStepPatchFmt, s.Step+1)
+	}
+	if spec == "" && status == "" {
+		return "", "", fmt.Errorf("rollout is not paused -- %s", strings.ToLower(s.Phase))
+	}
+
+	return spec, status, nil
+}
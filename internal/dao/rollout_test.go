@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutPromotePatches(t *testing.T) {
+	canary := func(spec, status map[string]interface{}) map[string]interface{} {
+		spec["strategy"] = map[string]interface{}{
+			"canary": map[string]interface{}{
+				"steps": []interface{}{
+					map[string]interface{}{"setWeight": int64(20)},
+					map[string]interface{}{"analysis": map[string]interface{}{}},
+					map[string]interface{}{"pause": map[string]interface{}{}},
+				},
+			},
+		}
+		return map[string]interface{}{"spec": spec, "status": status}
+	}
+
+	uu := map[string]struct {
+		o            map[string]interface{}
+		full         bool
+		spec, status string
+		err          string
+	}{
+		"pauseStep": {
+			o: canary(map[string]interface{}{}, map[string]interface{}{
+				"currentStepIndex": int64(2),
+				"pauseConditions":  []interface{}{map[string]interface{}{"reason": "CanaryPauseStep"}},
+			}),
+			status: rolloutResumePatch,
+		},
+		"skipStep": {
+			o:      canary(map[string]interface{}{}, map[string]interface{}{"currentStepIndex": int64(1)}),
+			status: `{"status":{"pauseConditions":null,"currentStepIndex":2}}`,
+		},
+		"userPaused": {
+			o:    canary(map[string]interface{}{"paused": true}, map[string]interface{}{"currentStepIndex": int64(3)}),
+			spec: rolloutUnpausePatch,
+		},
+		"full": {
+			o:      canary(map[string]interface{}{"paused": true}, map[string]interface{}{"currentStepIndex": int64(0)}),
+			full:   true,
+			spec:   rolloutUnpausePatch,
+			status: rolloutFullPatch,
+		},
+		"completed": {
+			o:   canary(map[string]interface{}{}, map[string]interface{}{"currentStepIndex": int64(3)}),
+			err: "rollout is not paused -- progressing",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			spec, status, err := rolloutPromotePatches(u.o, u.full)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.spec, spec)
+			assert.Equal(t, u.status, status)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// selectors returns the label and field selectors of a listing context.
+func selectors(ctx context.Context) (labels.Selector, fields.Selector, error) {
+	lsel, fsel := labels.Everything(), fields.Everything()
+	if s, ok := ctx.Value(internal.KeyLabels).(string); ok && s != "" {
+		sel, err := labels.Parse(s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid label selector %q -- %s", s, err)
+		}
+		lsel = sel
+	}
+	if s, ok := ctx.Value(internal.KeyFields).(string); ok && s != "" {
+		sel, err := fields.ParseSelector(s)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid field selector %q -- %s", s, err)
+		}
+		fsel = sel
+	}
+
+	return lsel, fsel, nil
+}
@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectors(t *testing.T) {
+	uu := map[string]struct {
+		labels, fields string
+		el, ef         string
+		err            bool
+	}{
+		"none":     {},
+		"labels":   {labels: "app in (fred,blee),env!=prod", el: "app in (blee,fred),env!=prod"},
+		"fields":   {fields: "spec.nodeName=n1", ef: "spec.nodeName=n1"},
+		"both":     {labels: "app=fred", fields: "status.phase!=Running", el: "app=fred", ef: "status.phase!=Running"},
+		"badLabel": {labels: "app in fred", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx := context.WithValue(context.Background(), internal.KeyLabels, u.labels)
+			ctx = context.WithValue(ctx, internal.KeyFields, u.fields)
+			lsel, fsel, err := selectors(ctx)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, u.el, lsel.String())
+			assert.Equal(t, u.ef, fsel.String())
+		})
+	}
+}
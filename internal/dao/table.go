@@ -36,6 +36,7 @@ func (t *Table) Get(ctx context.Context, path string) (runtime.Object, error) {
 		Name(n).
 		Resource(t.gvr.R()).
 		VersionedParams(&metav1beta1.TableOptions{}, codec).
+		Context(ctx).
 		Do().Get()
 	if err != nil {
 		return nil, err
@@ -50,6 +51,7 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	if !ok {
 		log.Debug().Msgf("No label selector found in context. Listing all resources")
 	}
+	fieldSel, _ := ctx.Value(internal.KeyFields).(string)
 
 	a := fmt.Sprintf(gvFmt, metav1beta1.SchemeGroupVersion.Version, metav1beta1.GroupName)
 	_, codec := t.codec()
@@ -62,7 +64,8 @@ func (t *Table) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		SetHeader("Accept", a).
 		Namespace(ns).
 		Resource(t.gvr.R()).
-		VersionedParams(&metav1.ListOptions{LabelSelector: labelSel}, codec).
+		VersionedParams(&metav1.ListOptions{LabelSelector: labelSel, FieldSelector: fieldSel}, codec).
+		Context(ctx).
 		Do().Get()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,94 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+var _ Accessor = (*Timeline)(nil)
+
+// Timeline represents the recorded revisions of an object.
+type Timeline struct {
+	NonResource
+}
+
+// List returns the revisions of the object in context along with the fields
+// changed by each revision.
+func (t *Timeline) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	tl, ok := ctx.Value(internal.KeyTimeline).(*watch.Timeline)
+	if !ok || tl == nil {
+		return nil, errors.New("no timeline found in context")
+	}
+	gvr, _ := ctx.Value(internal.KeyTimelineGVR).(string)
+	path, _ := ctx.Value(internal.KeyPath).(string)
+	if gvr == "" || path == "" {
+		return nil, errors.New("no resource specified for timeline")
+	}
+
+	// Keeps the resource informer running while the timeline is shown.
+	ns, _ := client.Namespaced(path)
+	if active, _ := ctx.Value(internal.KeyNamespace).(string); ns == "" || client.IsAllNamespaces(active) {
+		ns = client.AllNamespaces
+	}
+	t.Factory.ForResource(ns, gvr)
+
+	rr := tl.Revisions(gvr, path)
+	oo := make([]runtime.Object, 0, len(rr))
+	for i, r := range rr {
+		res := render.RevisionRes{Index: i, Revision: r}
+		if i > 0 && r.Op == watch.RevisionUpdated {
+			res.Fields = DiffFields(rr[i-1].Object, r.Object)
+		}
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// RevisionDiff returns a unified diff between a revision and its predecessor.
+func RevisionDiff(rr []watch.Revision, i int) (string, error) {
+	if i < 0 || i >= len(rr) {
+		return "", fmt.Errorf("no revision %d found", i)
+	}
+
+	var before, from string
+	if i > 0 {
+		raw, err := yaml.Marshal(rr[i-1].Object)
+		if err != nil {
+			return "", err
+		}
+		before, from = string(raw), revisionLabel(i-1, rr[i-1])
+	}
+	var after string
+	if rr[i].Op != watch.RevisionDeleted {
+		raw, err := yaml.Marshal(rr[i].Object)
+		if err != nil {
+			return "", err
+		}
+		after = string(raw)
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(before),
+		B:        difflib.SplitLines(after),
+		FromFile: from,
+		ToFile:   revisionLabel(i, rr[i]),
+		Context:  3,
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func revisionLabel(i int, r watch.Revision) string {
+	return fmt.Sprintf("revision %d (%s %s)", i, r.Op, r.Time.Format("15:04:05"))
+}
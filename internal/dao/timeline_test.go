@@ -0,0 +1,56 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevisionDiff(t *testing.T) {
+	at := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	rr := []watch.Revision{
+		{Version: "1", Op: watch.RevisionInitial, Time: at, Object: map[string]interface{}{"data": map[string]interface{}{"a": "1"}}},
+		{Version: "2", Op: watch.RevisionUpdated, Time: at, Object: map[string]interface{}{"data": map[string]interface{}{"a": "2"}}},
+		{Version: "2", Op: watch.RevisionDeleted, Time: at, Object: map[string]interface{}{"data": map[string]interface{}{"a": "2"}}},
+	}
+
+	uu := map[string]struct {
+		index int
+		ee    []string
+		err   string
+	}{
+		"initial": {
+			ee: []string{"+++ revision 0 (INITIAL 10:00:00)", "+data:", "+  a: \"1\""},
+		},
+		"updated": {
+			index: 1,
+			ee:    []string{"--- revision 0 (INITIAL 10:00:00)", "+++ revision 1 (UPDATED 10:00:00)", "-  a: \"1\"", "+  a: \"2\""},
+		},
+		"deleted": {
+			index: 2,
+			ee:    []string{"+++ revision 2 (DELETED 10:00:00)", "-data:", "-  a: \"2\""},
+		},
+		"missing": {
+			index: 3,
+			err:   "no revision 3 found",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := dao.RevisionDiff(rr, u.index)
+			if u.err != "" {
+				assert.Equal(t, u.err, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			for _, e := range u.ee {
+				assert.Contains(t, s, e)
+			}
+		})
+	}
+}
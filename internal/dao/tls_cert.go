@@ -0,0 +1,187 @@
+package dao
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	tlsSecretType = "kubernetes.io/tls"
+	tlsCertKey    = "tls.crt"
+
+	// CertSourceSecret represents a certificate held in a TLS secret.
+	CertSourceSecret = "Secret"
+	// CertSourceCertificate represents a cert-manager certificate.
+	CertSourceCertificate = "Certificate"
+)
+
+// certManagerGVRs tracks known cert-manager certificate versions, newest first.
+var certManagerGVRs = []string{
+	"cert-manager.io/v1/certificates",
+	"cert-manager.io/v1alpha3/certificates",
+	"cert-manager.io/v1alpha2/certificates",
+	"certmanager.k8s.io/v1alpha1/certificates",
+}
+
+var _ Accessor = (*TLSCert)(nil)
+
+// TLSCert represents TLS certificates found in secrets and cert-manager
+// certificates.
+type TLSCert struct {
+	NonResource
+}
+
+// List returns a collection of TLS certificates.
+func (t *TLSCert) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	ss, err := t.Factory.List("v1/secrets", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(ss))
+	seen := make(map[string]struct{}, len(ss))
+	for _, o := range ss {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		if typ, _, _ := unstructured.NestedString(u.Object, "type"); typ != tlsSecretType {
+			continue
+		}
+		c := SecretCert(u)
+		seen[c.ID()] = struct{}{}
+		oo = append(oo, c)
+	}
+
+	gvr, ok := CertManagerGVR()
+	if !ok {
+		return oo, nil
+	}
+	cc, err := t.Factory.List(gvr, ns, true, labels.Everything())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to list cert-manager certificates")
+		return oo, nil
+	}
+	for _, o := range cc {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		secret, _, _ := unstructured.NestedString(u.Object, "spec", "secretName")
+		if _, ok := seen[client.FQN(u.GetNamespace(), secret)]; ok {
+			continue
+		}
+		c := ManagedCert(u)
+		if _, ok := seen[c.ID()]; ok {
+			continue
+		}
+		oo = append(oo, c)
+	}
+
+	return oo, nil
+}
+
+// SecretCert returns the leaf certificate held in a TLS secret.
+func SecretCert(u *unstructured.Unstructured) render.TLSCertRes {
+	c := render.TLSCertRes{
+		Namespace: u.GetNamespace(),
+		Name:      u.GetName(),
+		Source:    CertSourceSecret,
+	}
+	enc, _, _ := unstructured.NestedString(u.Object, "data", tlsCertKey)
+	raw, err := base64.StdEncoding.DecodeString(enc)
+	if err != nil {
+		c.Error = fmt.Sprintf("invalid %s encoding", tlsCertKey)
+		return c
+	}
+	cert, err := ParseLeafCert(raw)
+	if err != nil {
+		c.Error = err.Error()
+		return c
+	}
+	c.Subject, c.Issuer = cert.Subject.CommonName, cert.Issuer.CommonName
+	if c.Subject == "" {
+		c.Subject = cert.Subject.String()
+	}
+	if c.Issuer == "" {
+		c.Issuer = cert.Issuer.String()
+	}
+	c.SANs, c.NotAfter = certSANs(cert), cert.NotAfter
+
+	return c
+}
+
+// ManagedCert returns a cert-manager certificate. The expiry is reported
+// once the certificate is issued.
+func ManagedCert(u *unstructured.Unstructured) render.TLSCertRes {
+	c := render.TLSCertRes{
+		Namespace: u.GetNamespace(),
+		Name:      u.GetName(),
+		Source:    CertSourceCertificate,
+	}
+	c.Subject, _, _ = unstructured.NestedString(u.Object, "spec", "commonName")
+	c.SANs, _, _ = unstructured.NestedStringSlice(u.Object, "spec", "dnsNames")
+	ips, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "ipAddresses")
+	c.SANs = append(c.SANs, ips...)
+	c.Issuer, _, _ = unstructured.NestedString(u.Object, "spec", "issuerRef", "name")
+	if na, _, _ := unstructured.NestedString(u.Object, "status", "notAfter"); na != "" {
+		t, err := time.Parse(time.RFC3339, na)
+		if err != nil {
+			c.Error = fmt.Sprintf("invalid expiry %q", na)
+			return c
+		}
+		c.NotAfter = t
+	}
+
+	return c
+}
+
+// ParseLeafCert parses the first certificate of a PEM encoded chain.
+func ParseLeafCert(raw []byte) (*x509.Certificate, error) {
+	for {
+		var b *pem.Block
+		b, raw = pem.Decode(raw)
+		if b == nil {
+			return nil, errors.New("no certificate found")
+		}
+		if b.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(b.Bytes)
+		}
+	}
+}
+
+// CertManagerGVR returns the served cert-manager certificates resource if any.
+func CertManagerGVR() (string, bool) {
+	for _, gvr := range certManagerGVRs {
+		if _, err := MetaAccess.MetaFor(client.NewGVR(gvr)); err == nil {
+			return gvr, true
+		}
+	}
+
+	return "", false
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func certSANs(cert *x509.Certificate) []string {
+	ss := make([]string, 0, len(cert.DNSNames)+len(cert.IPAddresses)+len(cert.EmailAddresses))
+	ss = append(ss, cert.DNSNames...)
+	for _, ip := range cert.IPAddresses {
+		ss = append(ss, ip.String())
+	}
+
+	return append(ss, cert.EmailAddresses...)
+}
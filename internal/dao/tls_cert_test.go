@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSecretCert(t *testing.T) {
+	notAfter := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	raw := makeCert(t, notAfter)
+
+	uu := map[string]struct {
+		data                 string
+		subject, issuer, err string
+		sans                 []string
+		notAfter             time.Time
+	}{
+		"valid": {
+			data:     base64.StdEncoding.EncodeToString(raw),
+			subject:  "fred.example.com",
+			issuer:   "fred.example.com",
+			sans:     []string{"fred.example.com", "blee.example.com", "10.0.0.1"},
+			notAfter: notAfter,
+		},
+		"badEncoding": {
+			data: "!!",
+			err:  "invalid tls.crt encoding",
+		},
+		"noCert": {
+			data: base64.StdEncoding.EncodeToString([]byte("blee")),
+			err:  "no certificate found",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"namespace": "default", "name": "fred-tls"},
+				"type":     tlsSecretType,
+				"data":     map[string]interface{}{tlsCertKey: u.data},
+			}}
+
+			c := SecretCert(&o)
+			assert.Equal(t, "default/fred-tls", c.ID())
+			assert.Equal(t, CertSourceSecret, c.Source)
+			assert.Equal(t, u.err, c.Error)
+			assert.Equal(t, u.subject, c.Subject)
+			assert.Equal(t, u.issuer, c.Issuer)
+			assert.Equal(t, u.sans, c.SANs)
+			assert.True(t, u.notAfter.Equal(c.NotAfter))
+		})
+	}
+}
+
+func TestManagedCert(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"namespace": "default", "name": "fred"},
+		"spec": map[string]interface{}{
+			"commonName": "fred.example.com",
+			"dnsNames":   []interface{}{"fred.example.com"},
+			"issuerRef":  map[string]interface{}{"name": "letsencrypt"},
+			"secretName": "fred-tls",
+		},
+	}}
+
+	c := ManagedCert(&o)
+	assert.Equal(t, CertSourceCertificate, c.Source)
+	assert.Equal(t, "fred.example.com", c.Subject)
+	assert.Equal(t, []string{"fred.example.com"}, c.SANs)
+	assert.Equal(t, "letsencrypt", c.Issuer)
+	assert.True(t, c.NotAfter.IsZero())
+
+	assert.Nil(t, unstructured.SetNestedField(o.Object, "2030-01-02T03:04:05Z", "status", "notAfter"))
+	c = ManagedCert(&o)
+	assert.True(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC).Equal(c.NotAfter))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func makeCert(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "fred.example.com"},
+		DNSNames:     []string{"fred.example.com", "blee.example.com"},
+		IPAddresses:  []net.IP{net.ParseIP("10.0.0.1")},
+		NotBefore:    notAfter.Add(-24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PublicKey, key)
+	assert.Nil(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/watch"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
 	restclient "k8s.io/client-go/rest"
 )
@@ -46,6 +50,24 @@ type Factory interface {
 	Forwarders() watch.Forwarders
 }
 
+// SelectorLister represents a factory evaluating selectors on the api server.
+type SelectorLister interface {
+	// ListSelected fetch a collection of resources matching label and field selectors.
+	ListSelected(gvr, ns string, wait bool, lsel labels.Selector, fsel fields.Selector) ([]runtime.Object, error)
+}
+
+// CacheStatter represents a factory tracking its caches memory usage.
+type CacheStatter interface {
+	// CacheStats returns the usage of each informer cache.
+	CacheStats() []watch.CacheStat
+
+	// MemoryUsage returns the approximate memory held by all caches.
+	MemoryUsage() int64
+
+	// MemoryBudget returns the caches memory budget or 0 if unlimited.
+	MemoryBudget() int64
+}
+
 // Getter represents a resource getter.
 type Getter interface {
 	// Get return a given resource.
@@ -121,6 +143,39 @@ type Runnable interface {
 	Run(path string) error
 }
 
+// JobRunner represents a resource spawning jobs on demand.
+type JobRunner interface {
+	// RunJob triggers a job and returns its path.
+	RunJob(path string) (string, error)
+
+	// JobPod returns the path of a job pod once one of its containers started.
+	JobPod(path string) (string, bool, error)
+}
+
+// NodeShell represents a node that can be shelled into via a pod.
+type NodeShell interface {
+	// LaunchShell starts a node shell pod and returns its path.
+	LaunchShell(node string, cfg *config.ShellPod) (string, error)
+
+	// ShellReady checks if a node shell pod is running.
+	ShellReady(path string) (bool, error)
+
+	// DeleteShell terminates a node shell pod.
+	DeleteShell(path string) error
+}
+
+// Patchable represents a patchable resource.
+type Patchable interface {
+	// Patch patches a resource, optionally as a server side dry run.
+	Patch(path string, pt types.PatchType, data []byte, dryRun bool) (runtime.Object, error)
+}
+
+// Creatable represents a resource that can be created from a manifest.
+type Creatable interface {
+	// Create creates a resource, optionally as a server side dry run.
+	Create(ns string, o *unstructured.Unstructured, dryRun bool) (*unstructured.Unstructured, error)
+}
+
 // Logger represents a resource that exposes logs.
 type Logger interface {
 	// Logs tails a resource logs.
@@ -0,0 +1,249 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// EngineGatekeeper represents OPA Gatekeeper.
+	EngineGatekeeper = "gatekeeper"
+	// EngineKyverno represents Kyverno.
+	EngineKyverno = "kyverno"
+
+	gatekeeperGroup   = "constraints.gatekeeper.sh"
+	policyReportGroup = "wgpolicyk8s.io"
+)
+
+// policyReportResources tracks the policy report kinds emitted by Kyverno.
+var policyReportResources = []string{"policyreports", "clusterpolicyreports"}
+
+var (
+	_ Accessor = (*Violation)(nil)
+	_ Accessor = (*ViolationObject)(nil)
+)
+
+// Violation represents policy engines violations grouped by policy and namespace.
+type Violation struct {
+	NonResource
+}
+
+// List returns violations grouped by policy and namespace.
+func (v *Violation) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	vv, err := listViolations(v.Factory, ns)
+	if err != nil {
+		return nil, err
+	}
+	gg := GroupViolations(vv)
+	oo := make([]runtime.Object, 0, len(gg))
+	for _, g := range gg {
+		oo = append(oo, g)
+	}
+
+	return oo, nil
+}
+
+// ViolationObject represents the objects violating a given policy.
+type ViolationObject struct {
+	NonResource
+}
+
+// List returns the objects violating the policy in context.
+func (v *ViolationObject) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	id, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("no policy found in context")
+	}
+	// Groups are already namespace specific.
+	vv, err := listViolations(v.Factory, client.AllNamespaces)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range GroupViolations(vv) {
+		if g.ID() != id {
+			continue
+		}
+		oo := make([]runtime.Object, 0, len(g.Objects))
+		for _, o := range g.Objects {
+			oo = append(oo, o)
+		}
+		return oo, nil
+	}
+
+	return nil, nil
+}
+
+// GatekeeperViolations returns the violations reported by a Gatekeeper constraint audit.
+func GatekeeperViolations(u *unstructured.Unstructured) []render.ViolationObjectRes {
+	ss, _, _ := unstructured.NestedSlice(u.Object, "status", "violations")
+	policy := u.GetKind() + "/" + u.GetName()
+	vv := make([]render.ViolationObjectRes, 0, len(ss))
+	for _, s := range ss {
+		m, ok := s.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		v := render.ViolationObjectRes{Engine: EngineGatekeeper, Policy: policy}
+		v.Kind, _, _ = unstructured.NestedString(m, "kind")
+		v.Namespace, _, _ = unstructured.NestedString(m, "namespace")
+		v.Name, _, _ = unstructured.NestedString(m, "name")
+		v.Message, _, _ = unstructured.NestedString(m, "message")
+		v.Action, _, _ = unstructured.NestedString(m, "enforcementAction")
+		vv = append(vv, v)
+	}
+
+	return vv
+}
+
+// KyvernoViolations returns the failed results of a policy report.
+func KyvernoViolations(u *unstructured.Unstructured) []render.ViolationObjectRes {
+	rr, _, _ := unstructured.NestedSlice(u.Object, "results")
+	scope, _, _ := unstructured.NestedMap(u.Object, "scope")
+	var vv []render.ViolationObjectRes
+	for _, r := range rr {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		result, _, _ := unstructured.NestedString(m, "result")
+		if result == "" {
+			// Legacy reports track the outcome as a status.
+			result, _, _ = unstructured.NestedString(m, "status")
+		}
+		if result == "pass" || result == "skip" {
+			continue
+		}
+		v := render.ViolationObjectRes{Engine: EngineKyverno, Action: result}
+		v.Policy, _, _ = unstructured.NestedString(m, "policy")
+		if rule, _, _ := unstructured.NestedString(m, "rule"); rule != "" {
+			v.Policy += "/" + rule
+		}
+		v.Message, _, _ = unstructured.NestedString(m, "message")
+		res, _, _ := unstructured.NestedSlice(m, "resources")
+		if len(res) == 0 && scope != nil {
+			res = []interface{}{scope}
+		}
+		for _, o := range res {
+			ref, ok := o.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			vo := v
+			vo.Kind, _, _ = unstructured.NestedString(ref, "kind")
+			vo.Namespace, _, _ = unstructured.NestedString(ref, "namespace")
+			vo.Name, _, _ = unstructured.NestedString(ref, "name")
+			vv = append(vv, vo)
+		}
+	}
+
+	return vv
+}
+
+// GroupViolations groups violations by policy and namespace.
+func GroupViolations(vv []render.ViolationObjectRes) []render.ViolationRes {
+	idx := make(map[string]int)
+	var gg []render.ViolationRes
+	for _, v := range vv {
+		g := render.ViolationRes{Engine: v.Engine, Policy: v.Policy, Namespace: v.Namespace}
+		i, ok := idx[g.ID()]
+		if !ok {
+			i = len(gg)
+			idx[g.ID()] = i
+			gg = append(gg, g)
+		}
+		gg[i].Objects = append(gg[i].Objects, v)
+	}
+	sort.Slice(gg, func(i, j int) bool {
+		return gg[i].ID() < gg[j].ID()
+	})
+
+	return gg
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// violationGVRs returns the Gatekeeper constraints and policy reports
+// resources served by the cluster.
+func violationGVRs() (gatekeeper, reports []string) {
+	seen := make(map[string]struct{})
+	for _, gvr := range MetaAccess.AllGVRs() {
+		g, r := gvr.G(), gvr.R()
+		if _, ok := seen[g+"/"+r]; ok {
+			continue
+		}
+		switch {
+		case g == gatekeeperGroup:
+			gatekeeper = append(gatekeeper, gvr.String())
+		case g == policyReportGroup && in(policyReportResources, r):
+			reports = append(reports, gvr.String())
+		default:
+			continue
+		}
+		seen[g+"/"+r] = struct{}{}
+	}
+
+	return
+}
+
+func listViolations(f Factory, ns string) ([]render.ViolationObjectRes, error) {
+	gk, reports := violationGVRs()
+	if len(gk) == 0 && len(reports) == 0 {
+		return nil, errors.New("no Gatekeeper constraints or Kyverno policy reports found")
+	}
+
+	var vv []render.ViolationObjectRes
+	for _, gvr := range gk {
+		oo, err := f.List(gvr, client.ClusterScope, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to list %s", gvr)
+			continue
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting unstructured but got %T", o)
+			}
+			for _, v := range GatekeeperViolations(u) {
+				if client.IsClusterWide(ns) || v.Namespace == ns {
+					vv = append(vv, v)
+				}
+			}
+		}
+	}
+	for _, gvr := range reports {
+		rns := ns
+		if strings.HasPrefix(client.NewGVR(gvr).R(), "cluster") {
+			rns = client.ClusterScope
+		}
+		oo, err := f.List(gvr, rns, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to list %s", gvr)
+			continue
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				return nil, fmt.Errorf("expecting unstructured but got %T", o)
+			}
+			for _, v := range KyvernoViolations(u) {
+				if client.IsClusterWide(ns) || v.Namespace == ns {
+					vv = append(vv, v)
+				}
+			}
+		}
+	}
+
+	return vv, nil
+}
@@ -0,0 +1,131 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestGatekeeperViolations(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "K8sRequiredLabels",
+		"metadata": map[string]interface{}{"name": "ns-must-have-owner"},
+		"status": map[string]interface{}{
+			"totalViolations": int64(2),
+			"violations": []interface{}{
+				map[string]interface{}{
+					"enforcementAction": "deny",
+					"kind":              "Namespace",
+					"name":              "fred",
+					"message":           `you must provide labels: {"owner"}`,
+				},
+				map[string]interface{}{
+					"enforcementAction": "dryrun",
+					"kind":              "Pod",
+					"namespace":         "blee",
+					"name":              "zorg",
+					"message":           "missing owner",
+				},
+			},
+		},
+	}}
+
+	assert.Equal(t, []render.ViolationObjectRes{
+		{Engine: dao.EngineGatekeeper, Policy: "K8sRequiredLabels/ns-must-have-owner", Kind: "Namespace", Name: "fred", Message: `you must provide labels: {"owner"}`, Action: "deny"},
+		{Engine: dao.EngineGatekeeper, Policy: "K8sRequiredLabels/ns-must-have-owner", Kind: "Pod", Namespace: "blee", Name: "zorg", Message: "missing owner", Action: "dryrun"},
+	}, dao.GatekeeperViolations(&u))
+}
+
+func TestKyvernoViolations(t *testing.T) {
+	uu := map[string]struct {
+		o map[string]interface{}
+		e []render.ViolationObjectRes
+	}{
+		"resources": {
+			o: map[string]interface{}{
+				"results": []interface{}{
+					map[string]interface{}{
+						"policy":  "require-labels",
+						"rule":    "check-team",
+						"result":  "fail",
+						"message": "label team is required",
+						"resources": []interface{}{
+							map[string]interface{}{"kind": "Deployment", "namespace": "default", "name": "fred"},
+							map[string]interface{}{"kind": "Deployment", "namespace": "default", "name": "blee"},
+						},
+					},
+					map[string]interface{}{
+						"policy": "require-labels",
+						"rule":   "check-app",
+						"result": "pass",
+						"resources": []interface{}{
+							map[string]interface{}{"kind": "Deployment", "namespace": "default", "name": "fred"},
+						},
+					},
+				},
+			},
+			e: []render.ViolationObjectRes{
+				{Engine: dao.EngineKyverno, Policy: "require-labels/check-team", Kind: "Deployment", Namespace: "default", Name: "fred", Message: "label team is required", Action: "fail"},
+				{Engine: dao.EngineKyverno, Policy: "require-labels/check-team", Kind: "Deployment", Namespace: "default", Name: "blee", Message: "label team is required", Action: "fail"},
+			},
+		},
+		"scope": {
+			o: map[string]interface{}{
+				"scope": map[string]interface{}{"kind": "Pod", "namespace": "default", "name": "zorg"},
+				"results": []interface{}{
+					map[string]interface{}{
+						"policy":  "disallow-latest-tag",
+						"result":  "warn",
+						"message": "latest tag is not allowed",
+					},
+				},
+			},
+			e: []render.ViolationObjectRes{
+				{Engine: dao.EngineKyverno, Policy: "disallow-latest-tag", Kind: "Pod", Namespace: "default", Name: "zorg", Message: "latest tag is not allowed", Action: "warn"},
+			},
+		},
+		"legacy": {
+			o: map[string]interface{}{
+				"results": []interface{}{
+					map[string]interface{}{
+						"policy":    "require-labels",
+						"status":    "fail",
+						"message":   "label team is required",
+						"resources": []interface{}{map[string]interface{}{"kind": "Namespace", "name": "fred"}},
+					},
+				},
+			},
+			e: []render.ViolationObjectRes{
+				{Engine: dao.EngineKyverno, Policy: "require-labels", Kind: "Namespace", Name: "fred", Message: "label team is required", Action: "fail"},
+			},
+		},
+		"none": {o: map[string]interface{}{}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, dao.KyvernoViolations(&unstructured.Unstructured{Object: u.o}))
+		})
+	}
+}
+
+func TestGroupViolations(t *testing.T) {
+	vv := []render.ViolationObjectRes{
+		{Engine: dao.EngineKyverno, Policy: "p1", Namespace: "ns1", Kind: "Pod", Name: "a", Action: "fail"},
+		{Engine: dao.EngineGatekeeper, Policy: "c1", Namespace: "ns1", Kind: "Pod", Name: "b", Action: "dryrun"},
+		{Engine: dao.EngineKyverno, Policy: "p1", Namespace: "ns1", Kind: "Pod", Name: "c", Action: "warn"},
+		{Engine: dao.EngineKyverno, Policy: "p1", Namespace: "ns2", Kind: "Pod", Name: "d", Action: "fail"},
+	}
+
+	gg := dao.GroupViolations(vv)
+	assert.Equal(t, 3, len(gg))
+	assert.Equal(t, "gatekeeper|c1|ns1", gg[0].ID())
+	assert.Equal(t, "kyverno|p1|ns1", gg[1].ID())
+	assert.Equal(t, 2, len(gg[1].Objects))
+	assert.Equal(t, []string{"fail", "warn"}, gg[1].Actions())
+	assert.Equal(t, "kyverno|p1|ns2", gg[2].ID())
+}
@@ -0,0 +1,49 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Watchlist)(nil)
+
+// Watchlist represents pinned resources across namespaces and kinds.
+type Watchlist struct {
+	NonResource
+}
+
+// List returns the pinned resources current state.
+func (w *Watchlist) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	ii, ok := ctx.Value(internal.KeyWatchlist).([]config.WatchItem)
+	if !ok {
+		return nil, errors.New("no watchlist found in context")
+	}
+
+	oo := make([]runtime.Object, 0, len(ii))
+	for _, i := range ii {
+		res := render.WatchRes{WatchItem: i}
+		o, err := w.Factory.Get(i.GVR, i.Path, true, labels.Everything())
+		switch {
+		case kerrors.IsNotFound(err):
+		case err != nil:
+			log.Warn().Err(err).Msgf("Watchlist fetch failed for %s %s", i.GVR, i.Path)
+			res.Err = err
+		default:
+			if u, ok := o.(*unstructured.Unstructured); ok {
+				res.Object = u
+			}
+		}
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
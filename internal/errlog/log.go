@@ -0,0 +1,188 @@
+package errlog
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxEntries represents the number of errors kept by default.
+	DefaultMaxEntries = 100
+
+	// echoWindow represents how long a bare error message echoes a detailed one.
+	echoWindow = 2 * time.Second
+)
+
+// RetryFunc retries a failed request.
+type RetryFunc func()
+
+// Entry represents a recorded error.
+type Entry struct {
+	ID      string    `yaml:"id"`
+	Source  string    `yaml:"source"`
+	Context string    `yaml:"context,omitempty"`
+	Request string    `yaml:"request,omitempty"`
+	Message string    `yaml:"message"`
+	First   time.Time `yaml:"first"`
+	Last    time.Time `yaml:"last"`
+	Count   int       `yaml:"count"`
+	Retries int       `yaml:"retries,omitempty"`
+	Retry   RetryFunc `yaml:"-"`
+
+	seq int
+}
+
+// CanRetry checks if the failed request may be retried.
+func (e Entry) CanRetry() bool {
+	return e.Retry != nil
+}
+
+// Log tracks the most recent errors. Repeated errors are folded into a
+// single entry.
+type Log struct {
+	entries []*Entry
+	max     int
+	seq     int
+	mx      sync.RWMutex
+}
+
+// NewLog returns a new error log keeping up to max entries.
+func NewLog(max int) *Log {
+	if max <= 0 {
+		max = DefaultMaxEntries
+	}
+
+	return &Log{max: max}
+}
+
+// Add records an error that occurred at a given time. An error without a
+// request echoing a detailed error recorded moments earlier is dropped, as
+// is the case when a detailed error is also flashed. Returns true if the
+// error was recorded.
+func (l *Log) Add(src, ctx, req, msg string, retry RetryFunc, now time.Time) bool {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	for _, e := range l.entries {
+		if e.Message != msg {
+			continue
+		}
+		if req == "" && e.Request != "" && now.Sub(e.Last) < echoWindow {
+			return false
+		}
+		if e.Request == req && e.Context == ctx {
+			e.Count, e.Last = e.Count+1, now
+			if retry != nil {
+				e.Retry = retry
+			}
+			return true
+		}
+	}
+
+	l.seq++
+	l.entries = append(l.entries, &Entry{
+		ID:      strconv.Itoa(l.seq),
+		Source:  src,
+		Context: ctx,
+		Request: req,
+		Message: msg,
+		First:   now,
+		Last:    now,
+		Count:   1,
+		Retry:   retry,
+		seq:     l.seq,
+	})
+	if len(l.entries) > l.max {
+		l.entries = l.entries[len(l.entries)-l.max:]
+	}
+
+	return true
+}
+
+// Get returns an error entry.
+func (l *Log) Get(id string) (Entry, bool) {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	for _, e := range l.entries {
+		if e.ID == id {
+			return *e, true
+		}
+	}
+
+	return Entry{}, false
+}
+
+// Retry retries the failed request of a given error.
+func (l *Log) Retry(id string) error {
+	l.mx.Lock()
+	var fn RetryFunc
+	for _, e := range l.entries {
+		if e.ID == id {
+			fn = e.Retry
+			if fn != nil {
+				e.Retries++
+			}
+			break
+		}
+	}
+	l.mx.Unlock()
+
+	if fn == nil {
+		return fmt.Errorf("error %s can not be retried", id)
+	}
+	fn()
+
+	return nil
+}
+
+// Delete removes an error entry.
+func (l *Log) Delete(id string) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	for i, e := range l.entries {
+		if e.ID == id {
+			l.entries = append(l.entries[:i], l.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+// Clear removes all error entries.
+func (l *Log) Clear() {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.entries = nil
+}
+
+// Len returns the number of error entries.
+func (l *Log) Len() int {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	return len(l.entries)
+}
+
+// List returns all error entries, most recent first.
+func (l *Log) List() []Entry {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	ee := make([]Entry, 0, len(l.entries))
+	for _, e := range l.entries {
+		ee = append(ee, *e)
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		if ee[i].Last.Equal(ee[j].Last) {
+			return ee[i].seq > ee[j].seq
+		}
+		return ee[i].Last.After(ee[j].Last)
+	})
+
+	return ee
+}
@@ -0,0 +1,108 @@
+package errlog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/errlog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogAdd(t *testing.T) {
+	l := errlog.NewLog(0)
+	now := time.Now()
+
+	assert.True(t, l.Add("view", "ctx1", "list v1/pods in default", "boom", nil, now))
+	assert.True(t, l.Add("view", "ctx1", "list v1/pods in default", "boom", nil, now.Add(time.Second)))
+	assert.True(t, l.Add("view", "ctx1", "list v1/pods in fred", "boom", nil, now.Add(time.Second)))
+
+	ee := l.List()
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "2", ee[0].ID)
+	assert.Equal(t, "list v1/pods in fred", ee[0].Request)
+	assert.Equal(t, "1", ee[1].ID)
+	assert.Equal(t, 2, ee[1].Count)
+	assert.Equal(t, now, ee[1].First)
+	assert.Equal(t, now.Add(time.Second), ee[1].Last)
+}
+
+func TestLogAddEcho(t *testing.T) {
+	uu := map[string]struct {
+		req   string
+		delay time.Duration
+		e     bool
+		count int
+	}{
+		"echo": {
+			delay: time.Second,
+			count: 1,
+		},
+		"late": {
+			delay: 5 * time.Second,
+			e:     true,
+			count: 2,
+		},
+		"detailed": {
+			req:   "goto po",
+			delay: time.Second,
+			e:     true,
+			count: 2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l := errlog.NewLog(0)
+			now := time.Now()
+			l.Add("view", "", "list v1/pods", "boom", nil, now)
+
+			assert.Equal(t, u.e, l.Add("flash", "", u.req, "boom", nil, now.Add(u.delay)))
+			assert.Equal(t, u.count, l.Len())
+		})
+	}
+}
+
+func TestLogMax(t *testing.T) {
+	l := errlog.NewLog(2)
+	now := time.Now()
+	l.Add("view", "", "", "e1", nil, now)
+	l.Add("view", "", "", "e2", nil, now)
+	l.Add("view", "", "", "e3", nil, now)
+
+	ee := l.List()
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "e3", ee[0].Message)
+	assert.Equal(t, "e2", ee[1].Message)
+}
+
+func TestLogRetry(t *testing.T) {
+	l := errlog.NewLog(0)
+	var called int
+	l.Add("view", "", "list v1/pods", "boom", func() { called++ }, time.Now())
+	l.Add("flash", "", "", "bang", nil, time.Now())
+
+	assert.Nil(t, l.Retry("1"))
+	assert.Equal(t, 1, called)
+	e, ok := l.Get("1")
+	assert.True(t, ok)
+	assert.True(t, e.CanRetry())
+	assert.Equal(t, 1, e.Retries)
+
+	assert.Error(t, l.Retry("2"))
+	assert.Error(t, l.Retry("3"))
+}
+
+func TestLogDelete(t *testing.T) {
+	l := errlog.NewLog(0)
+	l.Add("view", "", "", "e1", nil, time.Now())
+	l.Add("view", "", "", "e2", nil, time.Now())
+
+	l.Delete("1")
+	assert.Equal(t, 1, l.Len())
+	_, ok := l.Get("1")
+	assert.False(t, ok)
+
+	l.Clear()
+	assert.Equal(t, 0, l.Len())
+}
@@ -0,0 +1,609 @@
+// Package jq evaluates a subset of the jq language against decoded JSON
+// values, ie paths such as .spec.containers[0].image, iterators, pipes,
+// comparisons and the keys, length, map, select and not builtins.
+package jq
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Query represents a compiled jq expression.
+type Query struct {
+	src  string
+	eval evalFn
+}
+
+type evalFn func(v interface{}) ([]interface{}, error)
+
+// Compile compiles a jq expression.
+func Compile(src string) (*Query, error) {
+	tt, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := parser{tokens: tt}
+	f, err := p.pipe()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, unexpected(t)
+	}
+
+	return &Query{src: src, eval: f}, nil
+}
+
+// String returns the query source.
+func (q *Query) String() string {
+	return q.src
+}
+
+// Run evaluates the query against a value.
+func (q *Query) Run(v interface{}) ([]interface{}, error) {
+	return q.eval(v)
+}
+
+// ----------------------------------------------------------------------------
+// Parser...
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+func (p *parser) expect(k tokenKind, text string) error {
+	if t := p.next(); t.kind != k {
+		return fmt.Errorf("expecting %q at position %d", text, t.pos)
+	}
+
+	return nil
+}
+
+func (p *parser) pipe() (evalFn, error) {
+	f, err := p.comma()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokPipe {
+		p.next()
+		g, err := p.comma()
+		if err != nil {
+			return nil, err
+		}
+		f = pipeOf(f, g)
+	}
+
+	return f, nil
+}
+
+func (p *parser) comma() (evalFn, error) {
+	f, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().kind == tokComma {
+		p.next()
+		g, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		f = commaOf(f, g)
+	}
+
+	return f, nil
+}
+
+func (p *parser) or() (evalFn, error) {
+	f, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.kind == tokIdent && t.text == "or"; t = p.peek() {
+		p.next()
+		g, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		f = binaryOf(f, g, func(a, b interface{}) (interface{}, error) {
+			return truthy(a) || truthy(b), nil
+		})
+	}
+
+	return f, nil
+}
+
+func (p *parser) and() (evalFn, error) {
+	f, err := p.compare()
+	if err != nil {
+		return nil, err
+	}
+	for t := p.peek(); t.kind == tokIdent && t.text == "and"; t = p.peek() {
+		p.next()
+		g, err := p.compare()
+		if err != nil {
+			return nil, err
+		}
+		f = binaryOf(f, g, func(a, b interface{}) (interface{}, error) {
+			return truthy(a) && truthy(b), nil
+		})
+	}
+
+	return f, nil
+}
+
+func (p *parser) compare() (evalFn, error) {
+	f, err := p.postfix()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokOp {
+		return f, nil
+	}
+	op := p.next().text
+	g, err := p.postfix()
+	if err != nil {
+		return nil, err
+	}
+
+	return binaryOf(f, g, func(a, b interface{}) (interface{}, error) {
+		return compare(op, a, b)
+	}), nil
+}
+
+func (p *parser) postfix() (evalFn, error) {
+	f, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch t := p.peek(); t.kind {
+		case tokField:
+			p.next()
+			f = pipeOf(f, field(t.text))
+		case tokDot:
+			switch p.tokens[p.pos+1].kind {
+			case tokString:
+				p.next()
+				f = pipeOf(f, field(p.next().text))
+			case tokLBracket:
+				p.next()
+			default:
+				return f, nil
+			}
+		case tokLBracket:
+			p.next()
+			g, err := p.bracket()
+			if err != nil {
+				return nil, err
+			}
+			f = pipeOf(f, g)
+		case tokQuestion:
+			p.next()
+			f = optional(f)
+		default:
+			return f, nil
+		}
+	}
+}
+
+func (p *parser) bracket() (evalFn, error) {
+	t := p.next()
+	switch t.kind {
+	case tokRBracket:
+		return iterate, nil
+	case tokString:
+		return field(t.text), p.expect(tokRBracket, "]")
+	case tokNumber:
+		n, err := strconv.Atoi(t.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index %q at position %d", t.text, t.pos)
+		}
+		return index(n), p.expect(tokRBracket, "]")
+	default:
+		return nil, unexpected(t)
+	}
+}
+
+func (p *parser) primary() (evalFn, error) {
+	t := p.next()
+	switch t.kind {
+	case tokField:
+		return field(t.text), nil
+	case tokDot:
+		if p.peek().kind == tokString {
+			return field(p.next().text), nil
+		}
+		return identity, nil
+	case tokString:
+		return literal(t.text), nil
+	case tokNumber:
+		n, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d", t.text, t.pos)
+		}
+		return literal(n), nil
+	case tokLParen:
+		f, err := p.pipe()
+		if err != nil {
+			return nil, err
+		}
+		return f, p.expect(tokRParen, ")")
+	case tokIdent:
+		return p.builtin(t)
+	default:
+		return nil, unexpected(t)
+	}
+}
+
+func (p *parser) builtin(t token) (evalFn, error) {
+	switch t.text {
+	case "true":
+		return literal(true), nil
+	case "false":
+		return literal(false), nil
+	case "null":
+		return literal(nil), nil
+	case "keys":
+		return keys, nil
+	case "length":
+		return length, nil
+	case "not":
+		return func(v interface{}) ([]interface{}, error) {
+			return []interface{}{!truthy(v)}, nil
+		}, nil
+	case "select", "map":
+		if err := p.expect(tokLParen, "("); err != nil {
+			return nil, err
+		}
+		f, err := p.pipe()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokRParen, ")"); err != nil {
+			return nil, err
+		}
+		if t.text == "select" {
+			return selectOf(f), nil
+		}
+		return mapOf(f), nil
+	default:
+		return nil, fmt.Errorf("unknown function %q at position %d", t.text, t.pos)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Evaluators...
+
+func identity(v interface{}) ([]interface{}, error) {
+	return []interface{}{v}, nil
+}
+
+func literal(l interface{}) evalFn {
+	return func(interface{}) ([]interface{}, error) {
+		return []interface{}{l}, nil
+	}
+}
+
+func field(name string) evalFn {
+	return func(v interface{}) ([]interface{}, error) {
+		switch o := v.(type) {
+		case nil:
+			return []interface{}{nil}, nil
+		case map[string]interface{}:
+			return []interface{}{o[name]}, nil
+		default:
+			return nil, fmt.Errorf("cannot index %s with %q", typeOf(v), name)
+		}
+	}
+}
+
+func index(n int) evalFn {
+	return func(v interface{}) ([]interface{}, error) {
+		switch a := v.(type) {
+		case nil:
+			return []interface{}{nil}, nil
+		case []interface{}:
+			i := n
+			if i < 0 {
+				i += len(a)
+			}
+			if i < 0 || i >= len(a) {
+				return []interface{}{nil}, nil
+			}
+			return []interface{}{a[i]}, nil
+		default:
+			return nil, fmt.Errorf("cannot index %s with number", typeOf(v))
+		}
+	}
+}
+
+func iterate(v interface{}) ([]interface{}, error) {
+	switch o := v.(type) {
+	case []interface{}:
+		return o, nil
+	case map[string]interface{}:
+		kk := sortedKeys(o)
+		vv := make([]interface{}, 0, len(kk))
+		for _, k := range kk {
+			vv = append(vv, o[k])
+		}
+		return vv, nil
+	default:
+		return nil, fmt.Errorf("cannot iterate over %s", typeOf(v))
+	}
+}
+
+func keys(v interface{}) ([]interface{}, error) {
+	switch o := v.(type) {
+	case map[string]interface{}:
+		kk := sortedKeys(o)
+		vv := make([]interface{}, 0, len(kk))
+		for _, k := range kk {
+			vv = append(vv, k)
+		}
+		return []interface{}{vv}, nil
+	case []interface{}:
+		vv := make([]interface{}, 0, len(o))
+		for i := range o {
+			vv = append(vv, float64(i))
+		}
+		return []interface{}{vv}, nil
+	default:
+		return nil, fmt.Errorf("%s has no keys", typeOf(v))
+	}
+}
+
+func length(v interface{}) ([]interface{}, error) {
+	switch o := v.(type) {
+	case nil:
+		return []interface{}{float64(0)}, nil
+	case string:
+		return []interface{}{float64(utf8.RuneCountInString(o))}, nil
+	case []interface{}:
+		return []interface{}{float64(len(o))}, nil
+	case map[string]interface{}:
+		return []interface{}{float64(len(o))}, nil
+	default:
+		if f, ok := toFloat(v); ok {
+			if f < 0 {
+				f = -f
+			}
+			return []interface{}{f}, nil
+		}
+		return nil, fmt.Errorf("%s has no length", typeOf(v))
+	}
+}
+
+func pipeOf(f, g evalFn) evalFn {
+	return func(v interface{}) ([]interface{}, error) {
+		vv, err := f(v)
+		if err != nil {
+			return nil, err
+		}
+		var out []interface{}
+		for _, v := range vv {
+			ww, err := g(v)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, ww...)
+		}
+		return out, nil
+	}
+}
+
+func commaOf(f, g evalFn) evalFn {
+	return func(v interface{}) ([]interface{}, error) {
+		vv, err := f(v)
+		if err != nil {
+			return nil, err
+		}
+		ww, err := g(v)
+		if err != nil {
+			return nil, err
+		}
+		return append(vv, ww...), nil
+	}
+}
+
+func binaryOf(f, g evalFn, op func(a, b interface{}) (interface{}, error)) evalFn {
+	return func(v interface{}) ([]interface{}, error) {
+		aa, err := f(v)
+		if err != nil {
+			return nil, err
+		}
+		bb, err := g(v)
+		if err != nil {
+			return nil, err
+		}
+		out := make([]interface{}, 0, len(aa)*len(bb))
+		for _, b := range bb {
+			for _, a := range aa {
+				r, err := op(a, b)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, r)
+			}
+		}
+		return out, nil
+	}
+}
+
+func optional(f evalFn) evalFn {
+	return func(v interface{}) ([]interface{}, error) {
+		vv, err := f(v)
+		if err != nil {
+			return nil, nil
+		}
+		return vv, nil
+	}
+}
+
+func selectOf(f evalFn) evalFn {
+	return func(v interface{}) ([]interface{}, error) {
+		cc, err := f(v)
+		if err != nil {
+			return nil, err
+		}
+		var out []interface{}
+		for _, c := range cc {
+			if truthy(c) {
+				out = append(out, v)
+			}
+		}
+		return out, nil
+	}
+}
+
+func mapOf(f evalFn) evalFn {
+	return func(v interface{}) ([]interface{}, error) {
+		a, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("cannot map over %s", typeOf(v))
+		}
+		out := make([]interface{}, 0, len(a))
+		for _, e := range a {
+			vv, err := f(e)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, vv...)
+		}
+		return []interface{}{out}, nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func unexpected(t token) error {
+	if t.kind == tokEOF {
+		return fmt.Errorf("unexpected end of expression")
+	}
+
+	return fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+}
+
+func compare(op string, a, b interface{}) (bool, error) {
+	switch op {
+	case "==":
+		return equal(a, b), nil
+	case "!=":
+		return !equal(a, b), nil
+	}
+
+	if fa, ok := toFloat(a); ok {
+		if fb, ok := toFloat(b); ok {
+			return ordered(op, fa < fb, fa == fb), nil
+		}
+	}
+	sa, ok1 := a.(string)
+	sb, ok2 := b.(string)
+	if !ok1 || !ok2 {
+		return false, fmt.Errorf("cannot compare %s with %s", typeOf(a), typeOf(b))
+	}
+
+	return ordered(op, sa < sb, sa == sb), nil
+}
+
+func ordered(op string, less, eq bool) bool {
+	switch op {
+	case "<":
+		return less
+	case "<=":
+		return less || eq
+	case ">":
+		return !less && !eq
+	default:
+		return !less
+	}
+}
+
+func equal(a, b interface{}) bool {
+	if fa, ok := toFloat(a); ok {
+		fb, ok := toFloat(b)
+		return ok && fa == fb
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func truthy(v interface{}) bool {
+	switch b := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return b
+	default:
+		return true
+	}
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func typeOf(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		if _, ok := toFloat(v); ok {
+			return "number"
+		}
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
@@ -0,0 +1,168 @@
+package jq
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const podJSON = `{
+  "metadata": {"name": "fred", "namespace": "default", "labels": {"app": "blee", "app.kubernetes.io/name": "zorg"}},
+  "spec": {
+    "containers": [
+      {"name": "c1", "image": "nginx:1.19", "ports": [{"containerPort": 80}]},
+      {"name": "c2", "image": "busybox", "ports": [{"containerPort": 8080}, {"containerPort": 9090}]}
+    ]
+  },
+  "status": {"phase": "Running", "restarts": 3, "ready": true}
+}`
+
+func TestQueryRun(t *testing.T) {
+	var pod map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(podJSON), &pod))
+
+	uu := map[string]struct {
+		q   string
+		e   []interface{}
+		err string
+	}{
+		"identity": {
+			q: ".status.phase | .",
+			e: []interface{}{"Running"},
+		},
+		"path": {
+			q: ".metadata.name",
+			e: []interface{}{"fred"},
+		},
+		"quoted": {
+			q: `.metadata.labels."app.kubernetes.io/name"`,
+			e: []interface{}{"zorg"},
+		},
+		"bracketField": {
+			q: `.metadata.labels["app.kubernetes.io/name"]`,
+			e: []interface{}{"zorg"},
+		},
+		"index": {
+			q: ".spec.containers[1].image",
+			e: []interface{}{"busybox"},
+		},
+		"negativeIndex": {
+			q: ".spec.containers[-1].name",
+			e: []interface{}{"c2"},
+		},
+		"outOfRange": {
+			q: ".spec.containers[5].name",
+			e: []interface{}{nil},
+		},
+		"missing": {
+			q: ".spec.blee.zorg",
+			e: []interface{}{nil},
+		},
+		"iterate": {
+			q: ".spec.containers[].image",
+			e: []interface{}{"nginx:1.19", "busybox"},
+		},
+		"nestedIterate": {
+			q: ".spec.containers[].ports[].containerPort",
+			e: []interface{}{float64(80), float64(8080), float64(9090)},
+		},
+		"comma": {
+			q: ".metadata.name, .metadata.namespace",
+			e: []interface{}{"fred", "default"},
+		},
+		"select": {
+			q: `.spec.containers[] | select(.name == "c2") | .image`,
+			e: []interface{}{"busybox"},
+		},
+		"selectAnd": {
+			q: `.spec.containers[] | select(.name != "c1" and (.ports | length) > 1) | .name`,
+			e: []interface{}{"c2"},
+		},
+		"selectNot": {
+			q: `.spec.containers[] | select(.name == "c2" | not) | .name`,
+			e: []interface{}{"c1"},
+		},
+		"map": {
+			q: ".spec.containers | map(.name)",
+			e: []interface{}{[]interface{}{"c1", "c2"}},
+		},
+		"keys": {
+			q: ".metadata.labels | keys",
+			e: []interface{}{[]interface{}{"app", "app.kubernetes.io/name"}},
+		},
+		"length": {
+			q: ".spec.containers | length",
+			e: []interface{}{float64(2)},
+		},
+		"compare": {
+			q: ".status.restarts >= 3, .status.restarts < 3, .status.ready == true",
+			e: []interface{}{true, false, true},
+		},
+		"or": {
+			q: `.status.phase == "Pending" or .status.ready`,
+			e: []interface{}{true},
+		},
+		"optional": {
+			q: ".metadata.name.blee?",
+			e: nil,
+		},
+		"badIndex": {
+			q:   ".metadata.name.blee",
+			err: `cannot index string with "blee"`,
+		},
+		"badCompare": {
+			q:   ".spec.containers > 1",
+			err: "cannot compare array with number",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			q, err := Compile(u.q)
+			assert.Nil(t, err)
+			vv, err := q.Run(pod)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, vv)
+		})
+	}
+}
+
+func TestQueryRunInt64(t *testing.T) {
+	q, err := Compile(".spec.replicas > 2")
+	assert.Nil(t, err)
+
+	vv, err := q.Run(map[string]interface{}{"spec": map[string]interface{}{"replicas": int64(3)}})
+	assert.Nil(t, err)
+	assert.Equal(t, []interface{}{true}, vv)
+}
+
+func TestCompileFail(t *testing.T) {
+	uu := map[string]string{
+		"empty":      "unexpected end of expression",
+		".a |":       "unexpected end of expression",
+		".a[":        "unexpected end of expression",
+		".a[0":       `expecting "]" at position 4`,
+		"select(.a":  `expecting ")" at position 9`,
+		"blee":       `unknown function "blee" at position 0`,
+		`.a == "b`:   "unterminated string at position 6",
+		".a = 1":     `unexpected "=" at position 3`,
+		".metadata)": `unexpected ")" at position 9`,
+	}
+
+	for k := range uu {
+		src, e := k, uu[k]
+		if src == "empty" {
+			src = ""
+		}
+		t.Run(k, func(t *testing.T) {
+			_, err := Compile(src)
+			assert.EqualError(t, err, e)
+		})
+	}
+}
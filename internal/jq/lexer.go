@@ -0,0 +1,132 @@
+package jq
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokDot
+	tokField
+	tokIdent
+	tokString
+	tokNumber
+	tokLBracket
+	tokRBracket
+	tokLParen
+	tokRParen
+	tokPipe
+	tokComma
+	tokQuestion
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// lex splits an expression into tokens.
+func lex(src string) ([]token, error) {
+	var tt []token
+	rr := []rune(src)
+	for i := 0; i < len(rr); {
+		r := rr[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '.':
+			if i+1 < len(rr) && isIdentStart(rr[i+1]) {
+				j := scanIdent(rr, i+1)
+				tt = append(tt, token{kind: tokField, text: string(rr[i+1 : j]), pos: i})
+				i = j
+				continue
+			}
+			tt = append(tt, token{kind: tokDot, text: ".", pos: i})
+			i++
+		case isIdentStart(r):
+			j := scanIdent(rr, i)
+			tt = append(tt, token{kind: tokIdent, text: string(rr[i:j]), pos: i})
+			i = j
+		case r == '"':
+			j, s, err := scanString(rr, i)
+			if err != nil {
+				return nil, err
+			}
+			tt = append(tt, token{kind: tokString, text: s, pos: i})
+			i = j
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(rr) && unicode.IsDigit(rr[i+1])):
+			j := i + 1
+			for j < len(rr) && (unicode.IsDigit(rr[j]) || rr[j] == '.' || rr[j] == 'e' || rr[j] == 'E') {
+				j++
+			}
+			tt = append(tt, token{kind: tokNumber, text: string(rr[i:j]), pos: i})
+			i = j
+		case strings.ContainsRune("=!<>", r):
+			j := i + 1
+			if j < len(rr) && rr[j] == '=' {
+				j++
+			}
+			op := string(rr[i:j])
+			if op == "=" || op == "!" {
+				return nil, fmt.Errorf("unexpected %q at position %d", op, i)
+			}
+			tt = append(tt, token{kind: tokOp, text: op, pos: i})
+			i = j
+		default:
+			k, ok := punctuations[r]
+			if !ok {
+				return nil, fmt.Errorf("unexpected %q at position %d", r, i)
+			}
+			tt = append(tt, token{kind: k, text: string(r), pos: i})
+			i++
+		}
+	}
+
+	return append(tt, token{kind: tokEOF, pos: len(rr)}), nil
+}
+
+var punctuations = map[rune]tokenKind{
+	'[': tokLBracket,
+	']': tokRBracket,
+	'(': tokLParen,
+	')': tokRParen,
+	'|': tokPipe,
+	',': tokComma,
+	'?': tokQuestion,
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+func scanIdent(rr []rune, i int) int {
+	for i < len(rr) && (rr[i] == '_' || unicode.IsLetter(rr[i]) || unicode.IsDigit(rr[i])) {
+		i++
+	}
+
+	return i
+}
+
+func scanString(rr []rune, i int) (int, string, error) {
+	for j := i + 1; j < len(rr); j++ {
+		switch rr[j] {
+		case '\\':
+			j++
+		case '"':
+			s, err := strconv.Unquote(string(rr[i : j+1]))
+			if err != nil {
+				return 0, "", fmt.Errorf("invalid string at position %d", i)
+			}
+			return j + 1, s, nil
+		}
+	}
+
+	return 0, "", fmt.Errorf("unterminated string at position %d", i)
+}
@@ -5,26 +5,38 @@ type ContextKey string
 
 // A collection of context keys.
 const (
-	KeyFactory     ContextKey = "factory"
-	KeyLabels      ContextKey = "labels"
-	KeyFields      ContextKey = "fields"
-	KeyTable       ContextKey = "table"
-	KeyDir         ContextKey = "dir"
-	KeyPath        ContextKey = "path"
-	KeySubject     ContextKey = "subject"
-	KeyGVR         ContextKey = "gvr"
-	KeyForwards    ContextKey = "forwards"
-	KeyContainers  ContextKey = "containers"
-	KeyBenchCfg    ContextKey = "benchcfg"
-	KeyAliases     ContextKey = "aliases"
-	KeyUID         ContextKey = "uid"
-	KeySubjectKind ContextKey = "subjectKind"
-	KeySubjectName ContextKey = "subjectName"
-	KeyNamespace   ContextKey = "namespace"
-	KeyCluster     ContextKey = "cluster"
-	KeyApp         ContextKey = "app"
-	KeyStyles      ContextKey = "styles"
-	KeyMetrics     ContextKey = "metrics"
-	KeyToast       ContextKey = "toast"
-	KeyWithMetrics ContextKey = "withMetrics"
+	KeyFactory       ContextKey = "factory"
+	KeyLabels        ContextKey = "labels"
+	KeyFields        ContextKey = "fields"
+	KeyTable         ContextKey = "table"
+	KeyDir           ContextKey = "dir"
+	KeyPath          ContextKey = "path"
+	KeySubject       ContextKey = "subject"
+	KeyGVR           ContextKey = "gvr"
+	KeyForwards      ContextKey = "forwards"
+	KeyContainers    ContextKey = "containers"
+	KeyBenchCfg      ContextKey = "benchcfg"
+	KeyAliases       ContextKey = "aliases"
+	KeyUID           ContextKey = "uid"
+	KeySubjectKind   ContextKey = "subjectKind"
+	KeySubjectName   ContextKey = "subjectName"
+	KeyNamespace     ContextKey = "namespace"
+	KeyCluster       ContextKey = "cluster"
+	KeyApp           ContextKey = "app"
+	KeyStyles        ContextKey = "styles"
+	KeyMetrics       ContextKey = "metrics"
+	KeyToast         ContextKey = "toast"
+	KeyWithMetrics   ContextKey = "withMetrics"
+	KeyScripts       ContextKey = "scripts"
+	KeyAlerts        ContextKey = "alerts"
+	KeyProbes        ContextKey = "probes"
+	KeyFavorites     ContextKey = "favorites"
+	KeyDiff          ContextKey = "diff"
+	KeyAuditEvents   ContextKey = "auditEvents"
+	KeyAuditFilter   ContextKey = "auditFilter"
+	KeyImageVerifier ContextKey = "imageVerifier"
+	KeyTimeline      ContextKey = "timeline"
+	KeyTimelineGVR   ContextKey = "timelineGVR"
+	KeyWatchlist     ContextKey = "watchlist"
+	KeyErrors        ContextKey = "errors"
 )
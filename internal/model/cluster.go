@@ -69,10 +69,14 @@ func (c *Cluster) ClusterName() string {
 
 // UserName returns the user name.
 func (c *Cluster) UserName() string {
-	n, err := c.factory.Client().Config().CurrentUserName()
+	cfg := c.factory.Client().Config()
+	n, err := cfg.CurrentUserName()
 	if err != nil {
 		return NA
 	}
+	if cfg.Impersonating() {
+		return n + " (impersonated)"
+	}
 	return n
 }
 
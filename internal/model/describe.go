@@ -0,0 +1,97 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FoldMarker flags a folded section.
+const FoldMarker = "▸"
+
+// DescribeSection represents a top level section of a resource description,
+// ie Containers, Volumes or Events.
+type DescribeSection struct {
+	Name       string
+	Start, End int
+}
+
+// Size returns the number of lines in the section body.
+func (s DescribeSection) Size() int {
+	return s.End - s.Start - 1
+}
+
+// DescribeSections returns the foldable sections of a resource description.
+// A section starts with an unindented key followed by indented lines.
+func DescribeSections(lines []string) []DescribeSection {
+	var ss []DescribeSection
+	for i := 0; i < len(lines); i++ {
+		name, ok := sectionName(lines[i])
+		if !ok || i+1 >= len(lines) || !isIndented(lines[i+1]) {
+			continue
+		}
+		end := i + 1
+		for end < len(lines) && (isIndented(lines[end]) || strings.TrimSpace(lines[end]) == "") {
+			end++
+		}
+		for end > i+1 && strings.TrimSpace(lines[end-1]) == "" {
+			end--
+		}
+		ss = append(ss, DescribeSection{Name: name, Start: i, End: end})
+		i = end - 1
+	}
+
+	return ss
+}
+
+// FoldDescribe folds the given sections of a resource description. It
+// returns the folded lines along with the sections start lines.
+func FoldDescribe(lines []string, ss []DescribeSection, folded map[string]bool) ([]string, []int) {
+	out := make([]string, 0, len(lines))
+	starts := make([]int, 0, len(ss))
+	var last int
+	for _, s := range ss {
+		out = append(out, lines[last:s.Start]...)
+		starts = append(starts, len(out))
+		if !folded[s.Name] {
+			out = append(out, lines[s.Start:s.End]...)
+		} else {
+			out = append(out, fmt.Sprintf("%s %s %d lines", lines[s.Start], FoldMarker, s.Size()))
+		}
+		last = s.End
+	}
+
+	return append(out, lines[last:]...), starts
+}
+
+// SectionAt returns the index of the section shown at a given line or -1
+// if none.
+func SectionAt(starts []int, line int) int {
+	idx := -1
+	for i, s := range starts {
+		if s > line {
+			break
+		}
+		idx = i
+	}
+
+	return idx
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func sectionName(l string) (string, bool) {
+	if l == "" || isIndented(l) {
+		return "", false
+	}
+	i := strings.Index(l, ":")
+	if i <= 0 {
+		return "", false
+	}
+
+	return l[:i], true
+}
+
+func isIndented(l string) bool {
+	return strings.HasPrefix(l, " ") || strings.HasPrefix(l, "\t")
+}
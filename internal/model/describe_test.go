@@ -0,0 +1,88 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const podDescribe = `Name:         fred
+Namespace:    default
+Labels:       app=fred
+              tier=web
+Status:       Running
+Containers:
+  nginx:
+    Image:  nginx:1.19
+    Port:   80/TCP
+
+  envoy:
+    Image:  envoy:1.14
+
+Conditions:
+  Type    Status
+  Ready   True
+Events:       <none>`
+
+func TestDescribeSections(t *testing.T) {
+	ss := model.DescribeSections(strings.Split(podDescribe, "\n"))
+
+	assert.Equal(t, []model.DescribeSection{
+		{Name: "Labels", Start: 2, End: 4},
+		{Name: "Containers", Start: 5, End: 12},
+		{Name: "Conditions", Start: 13, End: 16},
+	}, ss)
+	assert.Equal(t, 6, ss[1].Size())
+}
+
+func TestFoldDescribe(t *testing.T) {
+	lines := strings.Split(podDescribe, "\n")
+	ss := model.DescribeSections(lines)
+
+	uu := map[string]struct {
+		folded map[string]bool
+		e      []string
+		starts []int
+	}{
+		"expanded": {
+			e:      lines,
+			starts: []int{2, 5, 13},
+		},
+		"folded": {
+			folded: map[string]bool{"Containers": true, "Conditions": true},
+			e: []string{
+				"Name:         fred",
+				"Namespace:    default",
+				"Labels:       app=fred",
+				"              tier=web",
+				"Status:       Running",
+				"Containers: ▸ 6 lines",
+				"",
+				"Conditions: ▸ 2 lines",
+				"Events:       <none>",
+			},
+			starts: []int{2, 5, 7},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			out, starts := model.FoldDescribe(lines, ss, u.folded)
+			assert.Equal(t, u.e, out)
+			assert.Equal(t, u.starts, starts)
+		})
+	}
+}
+
+func TestSectionAt(t *testing.T) {
+	starts := []int{2, 5, 14}
+
+	assert.Equal(t, -1, model.SectionAt(starts, 0))
+	assert.Equal(t, 0, model.SectionAt(starts, 2))
+	assert.Equal(t, 1, model.SectionAt(starts, 13))
+	assert.Equal(t, 2, model.SectionAt(starts, 100))
+	assert.Equal(t, -1, model.SectionAt(nil, 10))
+}
@@ -0,0 +1,211 @@
+package model
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+)
+
+const (
+	// FleetContextCol represents the fleet context column name.
+	FleetContextCol = "CONTEXT"
+
+	fleetSep = "|"
+)
+
+// FleetTarget represents a context to list resources from.
+type FleetTarget struct {
+	Context string
+	Factory dao.Factory
+}
+
+// Fleet aggregates a resource listing across several contexts.
+type Fleet struct {
+	gvr         string
+	namespace   string
+	refreshRate time.Duration
+	targets     []FleetTarget
+	data        map[string]render.TableData
+	listeners   []TableListener
+	mx          sync.RWMutex
+}
+
+// NewFleet returns a new fleet model.
+func NewFleet(gvr string, tt []FleetTarget) *Fleet {
+	return &Fleet{
+		gvr:         gvr,
+		targets:     tt,
+		refreshRate: 2 * time.Second,
+		data:        make(map[string]render.TableData, len(tt)),
+	}
+}
+
+// FleetPath returns a fleet row path for a resource in a given context.
+func FleetPath(context, path string) string {
+	return context + fleetSep + path
+}
+
+// ParseFleetPath returns the context and resource path of a fleet row.
+func ParseFleetPath(id string) (string, string) {
+	i := strings.LastIndex(id, fleetSep)
+	if i < 0 {
+		return "", id
+	}
+
+	return id[:i], id[i+1:]
+}
+
+// Contexts returns the fleet contexts.
+func (f *Fleet) Contexts() []string {
+	cc := make([]string, 0, len(f.targets))
+	for _, t := range f.targets {
+		cc = append(cc, t.Context)
+	}
+
+	return cc
+}
+
+// SetNamespace sets the namespace to list resources from.
+func (f *Fleet) SetNamespace(ns string) {
+	f.namespace = ns
+}
+
+// GetNamespace returns the namespace resources are listed from.
+func (f *Fleet) GetNamespace() string {
+	return f.namespace
+}
+
+// SetRefreshRate sets the listings refresh rate.
+func (f *Fleet) SetRefreshRate(d time.Duration) {
+	f.refreshRate = d
+}
+
+// AddListener adds a new model listener.
+func (f *Fleet) AddListener(l TableListener) {
+	f.listeners = append(f.listeners, l)
+}
+
+// Watch lists the resource in all contexts until the context is canceled.
+func (f *Fleet) Watch(ctx context.Context) {
+	for _, t := range f.targets {
+		tm := NewTable(f.gvr)
+		tm.SetNamespace(f.namespace)
+		tm.SetRefreshRate(f.refreshRate)
+		tm.AddListener(&fleetListener{fleet: f, context: t.Context})
+		tm.Watch(context.WithValue(ctx, internal.KeyFactory, t.Factory))
+	}
+}
+
+// Peek returns the aggregated listing.
+func (f *Fleet) Peek() render.TableData {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	var header render.HeaderRow
+	for _, t := range f.targets {
+		if d, ok := f.data[t.Context]; ok && len(d.Header) > 0 {
+			header = d.Header
+			break
+		}
+	}
+	if len(header) == 0 {
+		return render.TableData{Namespace: f.namespace}
+	}
+	idx := len(header)
+	if header.HasAge() {
+		idx--
+	}
+
+	data := render.TableData{
+		Header:    insertHeader(header, idx, render.Header{Name: FleetContextCol}),
+		Namespace: f.namespace,
+	}
+	for _, t := range f.targets {
+		d, ok := f.data[t.Context]
+		if !ok || len(d.Header) != len(header) {
+			continue
+		}
+		for _, re := range d.RowEvents {
+			re.Row = render.Row{
+				ID:     FleetPath(t.Context, re.Row.ID),
+				Fields: insertField(re.Row.Fields, idx, t.Context),
+			}
+			if len(re.Deltas) > 0 {
+				re.Deltas = render.DeltaRow(insertField(render.Fields(re.Deltas), idx, ""))
+			}
+			data.RowEvents = append(data.RowEvents, re)
+		}
+	}
+
+	return data
+}
+
+func (f *Fleet) update(context string, data render.TableData) {
+	f.mx.Lock()
+	f.data[context] = data
+	f.mx.Unlock()
+
+	d := f.Peek()
+	for _, l := range f.listeners {
+		l.TableDataChanged(d)
+	}
+}
+
+func (f *Fleet) failed(context string, err error) {
+	for _, l := range f.listeners {
+		l.TableLoadFailed(&FleetError{Context: context, Err: err})
+	}
+}
+
+// FleetError represents a listing failure in a given context.
+type FleetError struct {
+	Context string
+	Err     error
+}
+
+// Error returns the error message.
+func (e *FleetError) Error() string {
+	return e.Context + " -- " + e.Err.Error()
+}
+
+type fleetListener struct {
+	fleet   *Fleet
+	context string
+}
+
+// TableDataChanged notifies the model data changed.
+func (l *fleetListener) TableDataChanged(data render.TableData) {
+	l.fleet.update(l.context, data)
+}
+
+// TableLoadFailed notifies the load failed.
+func (l *fleetListener) TableLoadFailed(err error) {
+	l.fleet.failed(l.context, err)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func insertHeader(hh render.HeaderRow, idx int, h render.Header) render.HeaderRow {
+	rr := make(render.HeaderRow, 0, len(hh)+1)
+	rr = append(rr, hh[:idx]...)
+	rr = append(rr, h)
+
+	return append(rr, hh[idx:]...)
+}
+
+func insertField(ff render.Fields, idx int, f string) render.Fields {
+	rr := make(render.Fields, 0, len(ff)+1)
+	if idx > len(ff) {
+		idx = len(ff)
+	}
+	rr = append(rr, ff[:idx]...)
+	rr = append(rr, f)
+
+	return append(rr, ff[idx:]...)
+}
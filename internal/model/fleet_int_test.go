@@ -0,0 +1,65 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFleetPath(t *testing.T) {
+	uu := map[string]struct {
+		context, path string
+	}{
+		"plain":   {context: "fred", path: "default/p1"},
+		"arn":     {context: "arn:aws:eks:us-east-1:1:cluster/prod", path: "default/p1"},
+		"user":    {context: "admin@blee", path: "n1"},
+		"noscope": {context: "fred", path: "-/p1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx, path := ParseFleetPath(FleetPath(u.context, u.path))
+			assert.Equal(t, u.context, ctx)
+			assert.Equal(t, u.path, path)
+		})
+	}
+}
+
+func TestFleetPeek(t *testing.T) {
+	f := NewFleet("v1/pods", []FleetTarget{{Context: "prod"}, {Context: "staging"}})
+	f.SetNamespace("default")
+	assert.Equal(t, 0, len(f.Peek().Header))
+
+	header := render.HeaderRow{
+		render.Header{Name: "NAME"},
+		render.Header{Name: "STATUS"},
+		render.Header{Name: "AGE"},
+	}
+	f.update("staging", render.TableData{
+		Header: header,
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "default/p2", Fields: render.Fields{"p2", "Running", "2m"}}},
+		},
+	})
+	f.update("prod", render.TableData{
+		Header: header,
+		RowEvents: render.RowEvents{
+			{
+				Kind:   render.EventUpdate,
+				Row:    render.Row{ID: "default/p1", Fields: render.Fields{"p1", "Pending", "1m"}},
+				Deltas: render.DeltaRow{"", "Running", ""},
+			},
+		},
+	})
+
+	data := f.Peek()
+	assert.Equal(t, "default", data.Namespace)
+	assert.Equal(t, []string{"NAME", "STATUS", "CONTEXT", "AGE"}, data.Header.Columns())
+	assert.Equal(t, 2, len(data.RowEvents))
+	assert.Equal(t, render.Row{ID: "prod|default/p1", Fields: render.Fields{"p1", "Pending", "prod", "1m"}}, data.RowEvents[0].Row)
+	assert.Equal(t, render.DeltaRow{"", "Running", "", ""}, data.RowEvents[0].Deltas)
+	assert.Equal(t, render.Row{ID: "staging|default/p2", Fields: render.Fields{"p2", "Running", "staging", "2m"}}, data.RowEvents[1].Row)
+	assert.Equal(t, []string{"prod", "staging"}, f.Contexts())
+}
@@ -1,9 +1,17 @@
 package model
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
 	runewidth "github.com/mattn/go-runewidth"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // MetaFQN returns a fully qualified resource name.
@@ -19,6 +27,31 @@ func FQN(ns, n string) string {
 	return ns + "/" + n
 }
 
+// ObjectMap converts a resource into a generic map.
+func ObjectMap(o interface{}) (map[string]interface{}, error) {
+	switch r := o.(type) {
+	case *unstructured.Unstructured:
+		return r.Object, nil
+	case *render.PodWithMetrics:
+		return r.Raw.Object, nil
+	case *render.NodeWithMetrics:
+		return r.Raw.Object, nil
+	case metav1beta1.TableRow:
+		if len(r.Object.Raw) == 0 {
+			return nil, errors.New("no object found in table row")
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal(r.Object.Raw, &m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case runtime.Object:
+		return runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	default:
+		return nil, fmt.Errorf("unable to convert resource %T", o)
+	}
+}
+
 // Truncate a string to the given l and suffix ellipsis if needed.
 func Truncate(str string, width int) string {
 	return runewidth.Truncate(str, width, string(tview.SemigraphicsHorizontalEllipsis))
@@ -51,6 +51,12 @@ func NewLog(gvr client.GVR, opts dao.LogOptions, timeOut time.Duration) *Log {
 	}
 }
 
+// GetGVR returns the resource descriptor.
+func (l *Log) GetGVR() client.GVR { return l.gvr }
+
+// IsPrevious checks if previous container logs are shown.
+func (l *Log) IsPrevious() bool { return l.logOptions.Previous }
+
 // GetPath returns resource path.
 func (l *Log) GetPath() string { return l.logOptions.Path }
 
@@ -0,0 +1,139 @@
+package model
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// DefaultIdleTimeout represents the inactivity period past which refreshes slow down.
+	DefaultIdleTimeout = 5 * time.Minute
+
+	// DefaultIdleRefreshRate represents the refresh interval while idle.
+	DefaultIdleRefreshRate = 30 * time.Second
+
+	idleCheckRate = 5 * time.Second
+)
+
+// Pace tracks user activity so models refresh slowly while K9s sits idle.
+var Pace = NewPacer(DefaultIdleTimeout, DefaultIdleRefreshRate)
+
+// IdleFunc notifies the idle state changed.
+type IdleFunc func(idle bool)
+
+// Pacer slows down refreshes when no user input was seen for a while and
+// resumes them as soon as the user is back.
+type Pacer struct {
+	timeout    time.Duration
+	idleRate   time.Duration
+	lastActive time.Time
+	resumed    chan struct{}
+	idleFn     IdleFunc
+	mx         sync.RWMutex
+}
+
+// NewPacer returns a new pacer. A zero timeout disables idle detection.
+func NewPacer(timeout, idleRate time.Duration) *Pacer {
+	return &Pacer{
+		timeout:    timeout,
+		idleRate:   idleRate,
+		lastActive: time.Now(),
+	}
+}
+
+// Configure sets the idle timeout and refresh rate. A zero timeout disables
+// idle detection.
+func (p *Pacer) Configure(timeout, idleRate time.Duration) {
+	p.mx.Lock()
+	p.timeout, p.idleRate = timeout, idleRate
+	p.mx.Unlock()
+	if timeout <= 0 {
+		p.Touch()
+	}
+}
+
+// SetIdleFn specifies a function to call when the idle state changes.
+func (p *Pacer) SetIdleFn(f IdleFunc) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.idleFn = f
+}
+
+// Touch records user activity, resuming refreshes if idle.
+func (p *Pacer) Touch() {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.lastActive = time.Now()
+	if p.resumed == nil {
+		return
+	}
+	log.Debug().Msg("Activity detected. Resuming refreshes")
+	close(p.resumed)
+	p.resumed = nil
+	p.notify(false)
+}
+
+// IsIdle checks if refreshes are slowed down.
+func (p *Pacer) IsIdle() bool {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.resumed != nil
+}
+
+// Rate returns the refresh interval to use given a desired interval.
+func (p *Pacer) Rate(d time.Duration) time.Duration {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	if p.resumed != nil && d < p.idleRate {
+		return p.idleRate
+	}
+
+	return d
+}
+
+// Resumed returns a channel closed once refreshes resume or nil if not idle.
+func (p *Pacer) Resumed() <-chan struct{} {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	return p.resumed
+}
+
+// Watch checks for inactivity until the context is canceled.
+func (p *Pacer) Watch(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-time.After(idleCheckRate):
+			p.check(now)
+		}
+	}
+}
+
+func (p *Pacer) check(now time.Time) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if p.timeout <= 0 || p.resumed != nil || now.Sub(p.lastActive) < p.timeout {
+		return false
+	}
+	log.Debug().Msgf("No activity for %v. Slowing down refreshes", p.timeout)
+	p.resumed = make(chan struct{})
+	p.notify(true)
+
+	return true
+}
+
+func (p *Pacer) notify(idle bool) {
+	if p.idleFn != nil {
+		go p.idleFn(idle)
+	}
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPacerIdle(t *testing.T) {
+	p := NewPacer(time.Minute, 30*time.Second)
+	assert.False(t, p.check(time.Now()))
+	assert.False(t, p.IsIdle())
+	assert.Nil(t, p.Resumed())
+	assert.Equal(t, 2*time.Second, p.Rate(2*time.Second))
+
+	assert.True(t, p.check(time.Now().Add(2*time.Minute)))
+	assert.False(t, p.check(time.Now().Add(3*time.Minute)))
+	assert.True(t, p.IsIdle())
+	assert.Equal(t, 30*time.Second, p.Rate(2*time.Second))
+	assert.Equal(t, time.Minute, p.Rate(time.Minute))
+
+	resumed := p.Resumed()
+	assert.NotNil(t, resumed)
+	p.Touch()
+	_, ok := <-resumed
+	assert.False(t, ok)
+	assert.False(t, p.IsIdle())
+	assert.Equal(t, 2*time.Second, p.Rate(2*time.Second))
+}
+
+func TestPacerDisabled(t *testing.T) {
+	p := NewPacer(time.Minute, 30*time.Second)
+	assert.True(t, p.check(time.Now().Add(2*time.Minute)))
+
+	p.Configure(0, 30*time.Second)
+	assert.False(t, p.IsIdle())
+	assert.False(t, p.check(time.Now().Add(time.Hour)))
+}
+
+func TestPacerIdleFn(t *testing.T) {
+	p := NewPacer(time.Minute, 30*time.Second)
+	states := make(chan bool, 2)
+	p.SetIdleFn(func(idle bool) { states <- idle })
+
+	p.check(time.Now().Add(2 * time.Minute))
+	assert.True(t, <-states)
+	p.Touch()
+	assert.False(t, <-states)
+}
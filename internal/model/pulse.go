@@ -58,6 +58,9 @@ func (p *Pulse) updater(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-time.After(rate):
+			rate = Pace.Rate(p.refreshRate)
+			p.refresh(ctx)
+		case <-Pace.Resumed():
 			rate = p.refreshRate
 			p.refresh(ctx)
 		}
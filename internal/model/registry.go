@@ -42,6 +42,10 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Policy{},
 		Renderer: &render.Policy{},
 	},
+	"permissions": {
+		DAO:      &dao.Permission{},
+		Renderer: &render.Permission{},
+	},
 	"users": {
 		DAO:      &dao.Subject{},
 		Renderer: &render.Subject{},
@@ -62,6 +66,66 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Alias{},
 		Renderer: &render.Alias{},
 	},
+	"audit": {
+		DAO:      &dao.Audit{},
+		Renderer: &render.Audit{},
+	},
+	"clusterdiffs": {
+		DAO:      &dao.ClusterDiff{},
+		Renderer: &render.ClusterDiff{},
+	},
+	"alerts": {
+		DAO:      &dao.Alert{},
+		Renderer: &render.Alert{},
+	},
+	"auditevents": {
+		DAO:      &dao.AuditEvent{},
+		Renderer: &render.AuditEvent{},
+	},
+	"kubebench": {
+		DAO:      &dao.KubeBench{},
+		Renderer: &render.KubeBench{},
+	},
+	"kubebenchchecks": {
+		DAO:      &dao.KubeBenchCheck{},
+		Renderer: &render.KubeBenchCheck{},
+	},
+	"violations": {
+		DAO:      &dao.Violation{},
+		Renderer: &render.Violation{},
+	},
+	"violationobjects": {
+		DAO:      &dao.ViolationObject{},
+		Renderer: &render.ViolationObject{},
+	},
+	"images": {
+		DAO:      &dao.Image{},
+		Renderer: &render.Image{},
+	},
+	"timelines": {
+		DAO:      &dao.Timeline{},
+		Renderer: &render.Timeline{},
+	},
+	"watchlist": {
+		DAO:      &dao.Watchlist{},
+		Renderer: &render.Watchlist{},
+	},
+	"errors": {
+		DAO:      &dao.ErrorLog{},
+		Renderer: &render.ErrorLog{},
+	},
+	"caches": {
+		DAO:      &dao.Cache{},
+		Renderer: &render.Cache{},
+	},
+	"tlscerts": {
+		DAO:      &dao.TLSCert{},
+		Renderer: &render.TLSCert{},
+	},
+	"podsecurity": {
+		DAO:      &dao.PodSecurity{},
+		Renderer: &render.PodSecurity{},
+	},
 
 	// Core...
 	"v1/endpoints": {
@@ -98,6 +162,10 @@ var Registry = map[string]ResourceMeta{
 	},
 
 	// Apps...
+	dao.RolloutGVR: {
+		DAO:      &dao.Rollout{},
+		Renderer: &render.Rollout{},
+	},
 	"apps/v1/deployments": {
 		DAO:          &dao.Deployment{},
 		Renderer:     &render.Deployment{},
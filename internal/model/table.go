@@ -3,6 +3,8 @@ package model
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -11,7 +13,11 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/script"
+	"github.com/derailed/k9s/internal/tracing"
 	"github.com/rs/zerolog/log"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -36,6 +42,9 @@ type Table struct {
 	inUpdate    int32
 	refreshRate time.Duration
 	instance    string
+	acc         dao.Accessor
+	accFactory  dao.Factory
+	accMx       sync.Mutex
 	mx          sync.RWMutex
 }
 
@@ -48,6 +57,11 @@ func NewTable(gvr string) *Table {
 	}
 }
 
+// GetInstance returns the single entry path if any.
+func (t *Table) GetInstance() string {
+	return t.instance
+}
+
 // SetInstance sets a single entry table.
 func (t *Table) SetInstance(path string) {
 	t.instance = path
@@ -188,6 +202,9 @@ func (t *Table) updater(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-time.After(rate):
+			rate = Pace.Rate(t.refreshRate)
+			t.refresh(ctx)
+		case <-Pace.Resumed():
 			rate = t.refreshRate
 			t.refresh(ctx)
 		}
@@ -201,20 +218,27 @@ func (t *Table) refresh(ctx context.Context) {
 	}
 	defer atomic.StoreInt32(&t.inUpdate, 0)
 
+	ctx, span := tracing.Start(ctx, "k9s.refresh "+t.gvr, trace.SpanKindInternal)
+	span.SetAttributes(
+		attribute.String("k9s.gvr", t.gvr),
+		attribute.String("k9s.namespace", t.namespace),
+	)
+	defer span.End()
 	if err := t.reconcile(ctx); err != nil {
 		log.Error().Err(err).Msg("Reconcile failed")
+		tracing.SetError(span, err)
 		t.fireTableLoadFailed(err)
 		return
 	}
 	t.fireTableChanged(t.Peek())
 }
 
-func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, error) {
+func (t *Table) list(ctx context.Context) ([]runtime.Object, error) {
 	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
 	if !ok {
 		return nil, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
 	}
-	a.Init(factory, client.NewGVR(t.gvr))
+	a := t.accessor(factory)
 
 	ns := client.CleanseNamespace(t.namespace)
 	if client.IsClusterScoped(t.namespace) {
@@ -230,17 +254,28 @@ func (t *Table) reconcile(ctx context.Context) error {
 		oo  []runtime.Object
 		err error
 	)
+	lctx, span := tracing.Start(ctx, "k9s.list", trace.SpanKindInternal)
 	if t.instance == "" {
-		oo, err = t.list(ctx, meta.DAO)
+		oo, err = t.list(lctx)
 	} else {
-		o, e := t.Get(ctx, t.instance)
+		o, e := t.Get(lctx, t.instance)
 		oo, err = []runtime.Object{o}, e
 	}
 	if err != nil {
 		log.Error().Err(err).Msg("Reconcile failed to list resource")
 	}
+	span.SetAttributes(attribute.Int("k9s.objects", len(oo)))
+	tracing.SetError(span, err)
+	span.End()
 
-	var rows render.Rows
+	// Rendering is traced apart from listing to tell local from api latency.
+	_, span = tracing.Start(ctx, "k9s.render", trace.SpanKindInternal)
+	defer span.End()
+
+	var (
+		rows render.Rows
+		objs []interface{}
+	)
 	if len(oo) > 0 {
 		if _, ok := meta.Renderer.(*render.Generic); ok {
 			table, ok := oo[0].(*metav1beta1.Table)
@@ -251,23 +286,36 @@ func (t *Table) reconcile(ctx context.Context) error {
 			if err := genericHydrate(t.namespace, table, rows, meta.Renderer); err != nil {
 				return err
 			}
+			objs = make([]interface{}, len(table.Rows))
+			for i, r := range table.Rows {
+				objs[i] = r
+			}
 		} else {
 			rows = make(render.Rows, len(oo))
 			if err := hydrate(t.namespace, oo, rows, meta.Renderer); err != nil {
 				return err
 			}
+			objs = make([]interface{}, len(oo))
+			for i, o := range oo {
+				objs[i] = o
+			}
 		}
 	}
+	header := meta.Renderer.Header(t.namespace)
+	if e, ok := ctx.Value(internal.KeyScripts).(*script.Engine); ok {
+		header = scriptColumns(e.Columns(t.gvr), objs, header, rows)
+	}
 
 	t.mx.Lock()
 	defer t.mx.Unlock()
-	// if labelSelector in place might as well clear the model data.
-	sel, ok := ctx.Value(internal.KeyLabels).(string)
-	if ok && sel != "" {
+	// if label or field selectors in place might as well clear the model data.
+	lsel, _ := ctx.Value(internal.KeyLabels).(string)
+	fsel, _ := ctx.Value(internal.KeyFields).(string)
+	if lsel != "" || fsel != "" {
 		t.data.Clear()
 	}
 	t.data.Update(rows)
-	t.data.SetHeader(t.namespace, meta.Renderer.Header(t.namespace))
+	t.data.SetHeader(t.namespace, header)
 
 	return nil
 }
@@ -278,7 +326,7 @@ func (t *Table) getMeta(ctx context.Context) (ResourceMeta, error) {
 	if !ok {
 		return ResourceMeta{}, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
 	}
-	meta.DAO.Init(factory, client.NewGVR(t.gvr))
+	meta.DAO = t.accessor(factory)
 
 	return meta, nil
 }
@@ -299,6 +347,25 @@ func (t *Table) resourceMeta() ResourceMeta {
 	return meta
 }
 
+// accessor returns the table resource accessor initialized for a given
+// factory. Registry accessors are shared so each table gets its own
+// instance, as tables may list from different contexts factories, and keeps
+// it until the factory changes.
+func (t *Table) accessor(f dao.Factory) dao.Accessor {
+	t.accMx.Lock()
+	defer t.accMx.Unlock()
+
+	if t.acc != nil && reflect.TypeOf(f).Comparable() && t.accFactory == f {
+		return t.acc
+	}
+	a := t.resourceMeta().DAO
+	a = reflect.New(reflect.TypeOf(a).Elem()).Interface().(dao.Accessor)
+	a.Init(f, client.NewGVR(t.gvr))
+	t.acc, t.accFactory = a, f
+
+	return a
+}
+
 func (t *Table) fireTableChanged(data render.TableData) {
 	for _, l := range t.listeners {
 		l.TableDataChanged(data)
@@ -324,6 +391,48 @@ func hydrate(ns string, oo []runtime.Object, rr render.Rows, re Renderer) error
 	return nil
 }
 
+func scriptColumns(cc []script.Column, oo []interface{}, header render.HeaderRow, rows render.Rows) render.HeaderRow {
+	if len(cc) == 0 {
+		return header
+	}
+
+	// Scripted columns go right before the age column if any.
+	idx := len(header)
+	if header.HasAge() {
+		idx--
+	}
+	hh := make(render.HeaderRow, 0, len(header)+len(cc))
+	hh = append(hh, header[:idx]...)
+	for _, c := range cc {
+		hh = append(hh, render.Header{Name: strings.ToUpper(c.Name)})
+	}
+	hh = append(hh, header[idx:]...)
+
+	for i := range rows {
+		if len(rows[i].Fields) < idx {
+			continue
+		}
+		ff := make(render.Fields, 0, len(rows[i].Fields)+len(cc))
+		ff = append(ff, rows[i].Fields[:idx]...)
+		m, err := ObjectMap(oo[i])
+		for _, c := range cc {
+			if err != nil {
+				ff = append(ff, render.NAValue)
+				continue
+			}
+			v, e := c.Eval(m)
+			if e != nil {
+				log.Warn().Err(e).Msgf("Script column %q failed", c.Name)
+				v = render.NAValue
+			}
+			ff = append(ff, v)
+		}
+		rows[i].Fields = append(ff, rows[i].Fields[idx:]...)
+	}
+
+	return hh
+}
+
 func genericHydrate(ns string, table *metav1beta1.Table, rr render.Rows, re Renderer) error {
 	gr, ok := re.(*render.Generic)
 	if !ok {
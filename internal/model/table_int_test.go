@@ -12,6 +12,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/script"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -33,22 +34,64 @@ func TestTableReconcile(t *testing.T) {
 	err := ta.reconcile(ctx)
 	assert.Nil(t, err)
 	data := ta.Peek()
-	assert.Equal(t, 17, len(data.Header))
+	assert.Equal(t, 18, len(data.Header))
 	assert.Equal(t, 1, len(data.RowEvents))
 	assert.Equal(t, client.NamespaceAll, data.Namespace)
 }
 
+func TestTableReconcileScripts(t *testing.T) {
+	ta := NewTable("v1/pods")
+	ta.SetNamespace(client.NamespaceAll)
+
+	e := script.NewEngine()
+	src := `
+def ip(po):
+    return po["status"]["podIP"]
+
+k9s.column(gvr="v1/pods", name="podip", fn=ip)
+`
+	assert.Nil(t, e.LoadScript("ip.star", src))
+
+	f := makeFactory()
+	f.rows = []runtime.Object{load(t, "p1")}
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, f)
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
+	ctx = context.WithValue(ctx, internal.KeyScripts, e)
+	err := ta.reconcile(ctx)
+	assert.Nil(t, err)
+	data := ta.Peek()
+	assert.Equal(t, 19, len(data.Header))
+	assert.Equal(t, "PODIP", data.Header[17].Name)
+	assert.Equal(t, "10.44.0.229", data.RowEvents[0].Row.Fields[17])
+	assert.Equal(t, "AGE", data.Header[18].Name)
+	assert.Equal(t, 19, len(data.RowEvents[0].Row.Fields))
+}
+
 func TestTableList(t *testing.T) {
 	ta := NewTable("v1/pods")
 	ta.SetNamespace("blee")
 
-	acc := accessor{}
-	ctx := context.WithValue(context.Background(), internal.KeyFactory, makeFactory())
-	rows, err := ta.list(ctx, &acc)
+	f := makeFactory()
+	ta.acc, ta.accFactory = &accessor{}, &f
+	ctx := context.WithValue(context.Background(), internal.KeyFactory, &f)
+	rows, err := ta.list(ctx)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(rows))
 }
 
+func TestTableAccessor(t *testing.T) {
+	ta := NewTable("v1/pods")
+	f1, f2 := makeFactory(), makeFactory()
+
+	a := ta.accessor(&f1)
+	_, ok := a.(*dao.Pod)
+	assert.True(t, ok)
+	assert.True(t, a == ta.accessor(&f1))
+	assert.False(t, a == ta.accessor(&f2))
+	assert.False(t, a == Registry["v1/pods"].DAO)
+}
+
 func TestTableGet(t *testing.T) {
 	ta := NewTable("v1/pods")
 	ta.SetNamespace("blee")
@@ -106,7 +149,7 @@ func TestTableHydrate(t *testing.T) {
 
 	assert.Nil(t, hydrate("blee", oo, rr, render.Pod{}))
 	assert.Equal(t, 1, len(rr))
-	assert.Equal(t, 16, len(rr[0].Fields))
+	assert.Equal(t, 17, len(rr[0].Fields))
 }
 
 func TestTableGenericHydrate(t *testing.T) {
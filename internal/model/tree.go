@@ -168,6 +168,9 @@ func (t *Tree) updater(ctx context.Context) {
 			t.root = nil
 			return
 		case <-time.After(rate):
+			rate = Pace.Rate(t.refreshRate)
+			t.refresh(ctx)
+		case <-Pace.Resumed():
 			rate = t.refreshRate
 			t.refresh(ctx)
 		}
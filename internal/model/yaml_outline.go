@@ -0,0 +1,230 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// YAMLLine represents the outline of a YAML manifest line.
+type YAMLLine struct {
+	// Path represents the path of the value on the line, ie spec.containers[0].name.
+	Path string
+
+	// Node represents the path of the node starting on the line, ie
+	// spec.containers[0] for a list item. Empty for blank lines.
+	Node string
+
+	// End represents the line past the node.
+	End int
+}
+
+// Foldable checks if the node starting on line i spans several lines.
+func (l YAMLLine) Foldable(i int) bool {
+	return l.Node != "" && l.End > i+1
+}
+
+type yamlFrame struct {
+	indent int
+	path   string
+	item   bool
+	items  int
+}
+
+// YAMLOutline returns the paths of a YAML manifest lines.
+func YAMLOutline(lines []string) []YAMLLine {
+	oo := make([]YAMLLine, len(lines))
+	var (
+		stack      []yamlFrame
+		scalar     = -1
+		scalarPath string
+	)
+	for i, l := range lines {
+		s := strings.TrimLeft(l, " ")
+		ind := len(l) - len(s)
+		if scalar >= 0 {
+			if strings.TrimSpace(s) == "" || ind > scalar {
+				oo[i] = YAMLLine{Path: scalarPath}
+				continue
+			}
+			scalar = -1
+		}
+		if strings.TrimSpace(s) == "" {
+			if i > 0 {
+				oo[i] = YAMLLine{Path: oo[i-1].Path}
+			}
+			continue
+		}
+
+		item := isListItem(s)
+		stack = popYAMLFrames(stack, ind, item)
+		var parent string
+		if len(stack) > 0 {
+			parent = stack[len(stack)-1].path
+		}
+		node := parent
+		if item {
+			var idx int
+			if len(stack) > 0 {
+				idx = stack[len(stack)-1].items
+				stack[len(stack)-1].items++
+			}
+			node = fmt.Sprintf("%s[%d]", parent, idx)
+			stack = append(stack, yamlFrame{indent: ind, path: node, item: true})
+			s = strings.TrimPrefix(strings.TrimPrefix(s, "-"), " ")
+			ind += 2
+			parent = node
+		}
+		path := parent
+		if k, v, ok := splitYAMLKey(s); ok {
+			path = joinYAMLPath(parent, k)
+			stack = append(stack, yamlFrame{indent: ind, path: path})
+			if !item {
+				node = path
+			}
+			if strings.HasPrefix(v, "|") || strings.HasPrefix(v, ">") {
+				scalar, scalarPath = ind, path
+			}
+		}
+		oo[i] = YAMLLine{Path: path, Node: node}
+	}
+
+	for i := range oo {
+		oo[i].End = i + 1
+		if oo[i].Node == "" {
+			continue
+		}
+		for oo[i].End < len(oo) && withinYAMLPath(oo[oo[i].End].Path, oo[i].Node) {
+			oo[i].End++
+		}
+		for oo[i].End > i+1 && strings.TrimSpace(lines[oo[i].End-1]) == "" {
+			oo[i].End--
+		}
+	}
+
+	return oo
+}
+
+// FoldYAML folds the given nodes of a YAML manifest. It returns the folded
+// lines along with their original line numbers.
+func FoldYAML(lines []string, oo []YAMLLine, folded map[string]bool) ([]string, []int) {
+	out, rows := make([]string, 0, len(lines)), make([]int, 0, len(lines))
+	for i := 0; i < len(lines); i++ {
+		rows = append(rows, i)
+		if !folded[oo[i].Node] || !oo[i].Foldable(i) {
+			out = append(out, lines[i])
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s %s %d lines", lines[i], FoldMarker, oo[i].End-i-1))
+		i = oo[i].End - 1
+	}
+
+	return out, rows
+}
+
+// FoldableAt returns the line of the innermost foldable node spanning a
+// given line or -1 if none.
+func FoldableAt(oo []YAMLLine, line int) int {
+	for i := line; i >= 0 && i < len(oo); i-- {
+		if oo[i].Foldable(i) && oo[i].End > line {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// FindYAMLPath returns the line of a given path or -1 if not found.
+func FindYAMLPath(oo []YAMLLine, path string) int {
+	path = strings.TrimPrefix(strings.TrimSpace(path), ".")
+	if path == "" {
+		return -1
+	}
+	for i, o := range oo {
+		if o.Node == path {
+			return i
+		}
+	}
+	for i, o := range oo {
+		if o.Path == path {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// UnfoldYAMLPath unfolds the nodes enclosing a given path.
+func UnfoldYAMLPath(folded map[string]bool, path string) {
+	for k := range folded {
+		if k != path && withinYAMLPath(path, k) {
+			delete(folded, k)
+		}
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func popYAMLFrames(stack []yamlFrame, indent int, item bool) []yamlFrame {
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		if top.indent < indent {
+			break
+		}
+		// Lists may be indented at the same level as their key.
+		if top.indent == indent && item && !top.item {
+			break
+		}
+		stack = stack[:len(stack)-1]
+	}
+
+	return stack
+}
+
+func isListItem(s string) bool {
+	return s == "-" || strings.HasPrefix(s, "- ")
+}
+
+// splitYAMLKey returns the key and value of a mapping entry.
+func splitYAMLKey(s string) (string, string, bool) {
+	if strings.HasPrefix(s, `"`) || strings.HasPrefix(s, "'") {
+		j := strings.Index(s[1:], s[:1])
+		if j < 0 {
+			return "", "", false
+		}
+		k, rest := s[1:j+1], s[j+2:]
+		switch {
+		case rest == ":":
+			return k, "", true
+		case strings.HasPrefix(rest, ": "):
+			return k, strings.TrimSpace(rest[2:]), true
+		default:
+			return "", "", false
+		}
+	}
+	if j := strings.Index(s, ": "); j > 0 {
+		return s[:j], strings.TrimSpace(s[j+2:]), true
+	}
+	if strings.HasSuffix(s, ":") && len(s) > 1 {
+		return s[:len(s)-1], "", true
+	}
+
+	return "", "", false
+}
+
+// joinYAMLPath appends a key to a path. Keys holding dots or spaces are
+// quoted, ie metadata.annotations["app.kubernetes.io/name"].
+func joinYAMLPath(path, k string) string {
+	if strings.ContainsAny(k, ". ") {
+		return path + `["` + k + `"]`
+	}
+	if path == "" {
+		return k
+	}
+
+	return path + "." + k
+}
+
+func withinYAMLPath(path, node string) bool {
+	return path == node || strings.HasPrefix(path, node+".") || strings.HasPrefix(path, node+"[")
+}
@@ -0,0 +1,128 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+const podYAML = `apiVersion: v1
+kind: Pod
+metadata:
+  annotations:
+    app.kubernetes.io/config: |
+      a: b
+      c: d
+  name: fred
+spec:
+  containers:
+  - image: nginx
+    name: nginx
+    ports:
+    - containerPort: 80
+      protocol: TCP
+  - args:
+    - -v
+    name: envoy
+  dnsPolicy: ClusterFirst`
+
+func TestYAMLOutline(t *testing.T) {
+	oo := model.YAMLOutline(strings.Split(podYAML, "\n"))
+
+	pp := make([]string, 0, len(oo))
+	for _, o := range oo {
+		pp = append(pp, o.Path)
+	}
+	assert.Equal(t, []string{
+		"apiVersion",
+		"kind",
+		"metadata",
+		"metadata.annotations",
+		`metadata.annotations["app.kubernetes.io/config"]`,
+		`metadata.annotations["app.kubernetes.io/config"]`,
+		`metadata.annotations["app.kubernetes.io/config"]`,
+		"metadata.name",
+		"spec",
+		"spec.containers",
+		"spec.containers[0].image",
+		"spec.containers[0].name",
+		"spec.containers[0].ports",
+		"spec.containers[0].ports[0].containerPort",
+		"spec.containers[0].ports[0].protocol",
+		"spec.containers[1].args",
+		"spec.containers[1].args[0]",
+		"spec.containers[1].name",
+		"spec.dnsPolicy",
+	}, pp)
+
+	assert.Equal(t, model.YAMLLine{Path: "metadata", Node: "metadata", End: 8}, oo[2])
+	assert.Equal(t, 7, oo[4].End)
+	assert.Equal(t, model.YAMLLine{Path: "spec.containers", Node: "spec.containers", End: 18}, oo[9])
+	assert.Equal(t, model.YAMLLine{Path: "spec.containers[0].image", Node: "spec.containers[0]", End: 15}, oo[10])
+	assert.Equal(t, 15, oo[13].End)
+	assert.False(t, oo[0].Foldable(0))
+	assert.True(t, oo[10].Foldable(10))
+}
+
+func TestFoldYAML(t *testing.T) {
+	lines := strings.Split(podYAML, "\n")
+	oo := model.YAMLOutline(lines)
+
+	out, rows := model.FoldYAML(lines, oo, map[string]bool{"metadata": true, "spec.containers[0]": true})
+	assert.Equal(t, []string{
+		"apiVersion: v1",
+		"kind: Pod",
+		"metadata: ▸ 5 lines",
+		"spec:",
+		"  containers:",
+		"  - image: nginx ▸ 4 lines",
+		"  - args:",
+		"    - -v",
+		"    name: envoy",
+		"  dnsPolicy: ClusterFirst",
+	}, out)
+	assert.Equal(t, []int{0, 1, 2, 8, 9, 10, 15, 16, 17, 18}, rows)
+}
+
+func TestFoldableAt(t *testing.T) {
+	oo := model.YAMLOutline(strings.Split(podYAML, "\n"))
+
+	assert.Equal(t, -1, model.FoldableAt(oo, 0))
+	assert.Equal(t, 2, model.FoldableAt(oo, 7))
+	assert.Equal(t, 4, model.FoldableAt(oo, 5))
+	assert.Equal(t, 13, model.FoldableAt(oo, 14))
+	assert.Equal(t, 10, model.FoldableAt(oo, 11))
+}
+
+func TestFindYAMLPath(t *testing.T) {
+	oo := model.YAMLOutline(strings.Split(podYAML, "\n"))
+
+	uu := map[string]struct {
+		path string
+		e    int
+	}{
+		"root":     {path: "spec", e: 8},
+		"item":     {path: "spec.containers[1]", e: 15},
+		"leaf":     {path: ".spec.containers[1].name", e: 17},
+		"quoted":   {path: `metadata.annotations["app.kubernetes.io/config"]`, e: 4},
+		"itemLeaf": {path: "spec.containers[0].image", e: 10},
+		"missing":  {path: "spec.blee", e: -1},
+		"empty":    {e: -1},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, model.FindYAMLPath(oo, u.path))
+		})
+	}
+}
+
+func TestUnfoldYAMLPath(t *testing.T) {
+	folded := map[string]bool{"spec": true, "spec.containers": true, "spec.containers[0]": true, "metadata": true}
+	model.UnfoldYAMLPath(folded, "spec.containers[0]")
+
+	assert.Equal(t, map[string]bool{"spec.containers[0]": true, "metadata": true}, folded)
+}
@@ -0,0 +1,102 @@
+package notify
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+)
+
+const appName = "k9s"
+
+// Notifier sends desktop notifications.
+type Notifier struct {
+	method string
+	out    io.Writer
+	run    func(name string, args ...string) error
+}
+
+// NewNotifier returns a new notifier for a given method. The auto method is
+// resolved against the current environment. Escape sequences are written to
+// the given terminal.
+func NewNotifier(method string, term io.Writer) *Notifier {
+	return &Notifier{
+		method: resolve(method, os.Getenv, exec.LookPath),
+		out:    term,
+		run: func(name string, args ...string) error {
+			return exec.Command(name, args...).Run()
+		},
+	}
+}
+
+// Method returns the notification delivery method.
+func (n *Notifier) Method() string {
+	return n.method
+}
+
+// Send emits a desktop notification.
+func (n *Notifier) Send(title, body string) error {
+	title, body = sanitize(title), sanitize(body)
+	switch n.method {
+	case config.NotifySend:
+		if err := n.run("notify-send", "--app-name", appName, title, body); err != nil {
+			return fmt.Errorf("notify-send failed -- %s", err)
+		}
+		return nil
+	case config.NotifyOSC9:
+		_, err := fmt.Fprint(n.out, osc9(title, body))
+		return err
+	default:
+		_, err := fmt.Fprint(n.out, osc777(title, body))
+		return err
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// resolve picks a notification method for the auto mode. notify-send is
+// preferred on graphical sessions, otherwise the terminal is asked to notify.
+func resolve(method string, getenv func(string) string, lookPath func(string) (string, error)) string {
+	if method != config.NotifyAuto && method != "" {
+		return method
+	}
+	if getenv("DISPLAY") != "" || getenv("WAYLAND_DISPLAY") != "" {
+		if _, err := lookPath("notify-send"); err == nil {
+			return config.NotifySend
+		}
+	}
+	switch getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "ghostty":
+		return config.NotifyOSC9
+	}
+	if getenv("WT_SESSION") != "" {
+		return config.NotifyOSC9
+	}
+
+	return config.NotifyOSC777
+}
+
+func osc777(title, body string) string {
+	return "\x1b]777;notify;" + strings.Replace(title, ";", ",", -1) + ";" + body + "\x1b\\"
+}
+
+func osc9(title, body string) string {
+	return "\x1b]9;" + title + ": " + body + "\a"
+}
+
+// sanitize strips control characters that would end escape sequences early.
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '\n' || r == '\t' {
+			return ' '
+		}
+		if r < 0x20 || r == 0x7f {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,105 @@
+package notify
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolve(t *testing.T) {
+	uu := map[string]struct {
+		method string
+		env    map[string]string
+		found  bool
+		e      string
+	}{
+		"explicit": {
+			method: config.NotifyOSC9,
+			env:    map[string]string{"DISPLAY": ":0"},
+			found:  true,
+			e:      config.NotifyOSC9,
+		},
+		"desktop": {
+			method: config.NotifyAuto,
+			env:    map[string]string{"DISPLAY": ":0"},
+			found:  true,
+			e:      config.NotifySend,
+		},
+		"noNotifySend": {
+			method: config.NotifyAuto,
+			env:    map[string]string{"WAYLAND_DISPLAY": "wayland-0"},
+			e:      config.NotifyOSC777,
+		},
+		"iterm": {
+			method: config.NotifyAuto,
+			env:    map[string]string{"TERM_PROGRAM": "iTerm.app"},
+			found:  true,
+			e:      config.NotifyOSC9,
+		},
+		"windowsTerminal": {
+			env: map[string]string{"WT_SESSION": "fred"},
+			e:   config.NotifyOSC9,
+		},
+		"terminal": {
+			method: config.NotifyAuto,
+			e:      config.NotifyOSC777,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			getenv := func(k string) string { return u.env[k] }
+			lookPath := func(string) (string, error) {
+				if u.found {
+					return "/usr/bin/notify-send", nil
+				}
+				return "", errors.New("not found")
+			}
+			assert.Equal(t, u.e, resolve(u.method, getenv, lookPath))
+		})
+	}
+}
+
+func TestNotifierSend(t *testing.T) {
+	uu := map[string]struct {
+		method string
+		e      string
+	}{
+		"osc777": {
+			method: config.NotifyOSC777,
+			e:      "\x1b]777;notify;K9s, bench;fred done\x1b\\",
+		},
+		"osc9": {
+			method: config.NotifyOSC9,
+			e:      "\x1b]9;K9s; bench: fred done\a",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			n := Notifier{method: u.method, out: &buff}
+			assert.Nil(t, n.Send("K9s; bench", "fred\x1b\ndone"))
+			assert.Equal(t, u.e, buff.String())
+		})
+	}
+}
+
+func TestNotifierSendCommand(t *testing.T) {
+	var args []string
+	n := Notifier{
+		method: config.NotifySend,
+		run: func(name string, aa ...string) error {
+			args = append([]string{name}, aa...)
+			return nil
+		},
+	}
+
+	assert.Nil(t, n.Send("Benchmark completed", "default/fred"))
+	assert.Equal(t, []string{"notify-send", "--app-name", "k9s", "Benchmark completed", "default/fred"}, args)
+}
@@ -87,6 +87,11 @@ func (b *Benchmark) Cancel() {
 	b.worker.Stop()
 }
 
+// Name returns the benchmarked resource.
+func (b *Benchmark) Name() string {
+	return b.config.Name
+}
+
 // Canceled checks if the benchmark was canceled.
 func (b *Benchmark) Canceled() bool {
 	return b.canceled
@@ -0,0 +1,185 @@
+package perf
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+// Metric represents a prometheus metric family.
+type Metric struct {
+	Name    string
+	Help    string
+	Type    string
+	Samples []MetricSample
+}
+
+// MetricSample represents a metric value. Suffix qualifies histogram
+// samples such as _bucket or _sum.
+type MetricSample struct {
+	Suffix string
+	Labels map[string]string
+	Value  float64
+}
+
+// MetricsFunc represents a metrics collector.
+type MetricsFunc func() []Metric
+
+// APIMetrics returns K9s api calls metrics.
+func APIMetrics() []Metric {
+	return apiMetrics(client.APICalls.Snapshot())
+}
+
+func apiMetrics(s client.APISnapshot) []Metric {
+	calls := Metric{
+		Name: "k9s_api_requests_total",
+		Help: "Number of api server requests by verb and status code.",
+		Type: "counter",
+	}
+	for k, n := range s.Calls {
+		calls.Samples = append(calls.Samples, MetricSample{
+			Labels: map[string]string{"verb": k.Verb, "code": strconv.Itoa(k.Code)},
+			Value:  float64(n),
+		})
+	}
+
+	latency := Metric{
+		Name: "k9s_api_request_duration_seconds",
+		Help: "Api server requests latency by verb.",
+		Type: "histogram",
+	}
+	verbs := make([]string, 0, len(s.Latencies))
+	for verb := range s.Latencies {
+		verbs = append(verbs, verb)
+	}
+	sort.Strings(verbs)
+	for _, verb := range verbs {
+		h := s.Latencies[verb]
+		for i, b := range client.LatencyBuckets {
+			latency.Samples = append(latency.Samples, MetricSample{
+				Suffix: "_bucket",
+				Labels: map[string]string{"verb": verb, "le": formatFloat(b)},
+				Value:  float64(h.Buckets[i]),
+			})
+		}
+		latency.Samples = append(latency.Samples,
+			MetricSample{Suffix: "_bucket", Labels: map[string]string{"verb": verb, "le": "+Inf"}, Value: float64(h.Count)},
+			MetricSample{Suffix: "_sum", Labels: map[string]string{"verb": verb}, Value: h.Sum},
+			MetricSample{Suffix: "_count", Labels: map[string]string{"verb": verb}, Value: float64(h.Count)},
+		)
+	}
+
+	return []Metric{calls, latency}
+}
+
+// EventMetrics returns watch events metrics.
+func (s *RuntimeStats) EventMetrics() []Metric {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	m := Metric{
+		Name: "k9s_watch_events_total",
+		Help: "Number of watch events received by resource.",
+		Type: "counter",
+	}
+	for gvr, n := range s.totals {
+		m.Samples = append(m.Samples, MetricSample{
+			Labels: map[string]string{"gvr": gvr},
+			Value:  float64(n),
+		})
+	}
+
+	return []Metric{m}
+}
+
+// WriteMetrics writes out metrics using the prometheus text format.
+func WriteMetrics(w io.Writer, mm []Metric) error {
+	for _, m := range mm {
+		if _, err := fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", m.Name, m.Help, m.Name, m.Type); err != nil {
+			return err
+		}
+		lines := make([]string, 0, len(m.Samples))
+		for _, s := range m.Samples {
+			lines = append(lines, m.Name+s.Suffix+formatLabels(s.Labels)+" "+formatFloat(s.Value))
+		}
+		// Histograms buckets are already in order.
+		if m.Type != "histogram" {
+			sort.Strings(lines)
+		}
+		for _, l := range lines {
+			if _, err := fmt.Fprintln(w, l); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// ServeMetrics serves prometheus metrics on a localhost port until the
+// context is canceled.
+func ServeMetrics(ctx context.Context, port int, ff ...MetricsFunc) error {
+	l, err := net.Listen("tcp", net.JoinHostPort("localhost", strconv.Itoa(port)))
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		var mm []Metric
+		for _, f := range ff {
+			mm = append(mm, f()...)
+		}
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if err := WriteMetrics(w, mm); err != nil {
+			log.Error().Err(err).Msg("Metrics write failed")
+		}
+	})
+	srv := http.Server{Handler: mux}
+	go func() {
+		<-ctx.Done()
+		if err := srv.Close(); err != nil {
+			log.Error().Err(err).Msg("Metrics close failed")
+		}
+	}()
+	go func() {
+		log.Info().Msgf("Serving metrics on http://%s/metrics", l.Addr())
+		if err := srv.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msg("Metrics failed")
+		}
+	}()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func formatLabels(ll map[string]string) string {
+	if len(ll) == 0 {
+		return ""
+	}
+	kk := make([]string, 0, len(ll))
+	for k := range ll {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		ss = append(ss, k+"="+strconv.Quote(ll[k]))
+	}
+
+	return "{" + strings.Join(ss, ",") + "}"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
@@ -0,0 +1,81 @@
+package perf
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIStatsMetrics(t *testing.T) {
+	s := client.NewAPIStats()
+	s.RecordCall("get", 200, 20*time.Millisecond)
+	s.RecordCall("get", 404, 3*time.Millisecond)
+	s.RecordCall("watch", 0, 2*time.Second)
+
+	var buff bytes.Buffer
+	assert.Nil(t, WriteMetrics(&buff, apiMetrics(s.Snapshot())))
+	out := buff.String()
+
+	assert.Contains(t, out, "# TYPE k9s_api_requests_total counter\n")
+	assert.Contains(t, out, `k9s_api_requests_total{code="200",verb="get"} 1`+"\n")
+	assert.Contains(t, out, `k9s_api_requests_total{code="404",verb="get"} 1`+"\n")
+	assert.Contains(t, out, `k9s_api_requests_total{code="0",verb="watch"} 1`+"\n")
+	assert.Contains(t, out, "# TYPE k9s_api_request_duration_seconds histogram\n")
+	assert.Contains(t, out, `k9s_api_request_duration_seconds_bucket{le="0.005",verb="get"} 1`+"\n")
+	assert.Contains(t, out, `k9s_api_request_duration_seconds_bucket{le="0.025",verb="get"} 2`+"\n")
+	assert.Contains(t, out, `k9s_api_request_duration_seconds_bucket{le="1",verb="watch"} 0`+"\n")
+	assert.Contains(t, out, `k9s_api_request_duration_seconds_bucket{le="+Inf",verb="watch"} 1`+"\n")
+	assert.Contains(t, out, `k9s_api_request_duration_seconds_count{verb="get"} 2`+"\n")
+	assert.Contains(t, out, `k9s_api_request_duration_seconds_sum{verb="watch"} 2`+"\n")
+}
+
+func TestEventMetrics(t *testing.T) {
+	s := NewRuntimeStats()
+	s.RecordEvent("v1/pods")
+	s.RecordEvent("v1/pods")
+	s.RecordEvent("v1/nodes")
+	s.Sample(time.Now())
+	s.RecordEvent("v1/pods")
+
+	var buff bytes.Buffer
+	assert.Nil(t, WriteMetrics(&buff, s.EventMetrics()))
+	assert.Equal(t, strings.Join([]string{
+		"# HELP k9s_watch_events_total Number of watch events received by resource.",
+		"# TYPE k9s_watch_events_total counter",
+		`k9s_watch_events_total{gvr="v1/nodes"} 1`,
+		`k9s_watch_events_total{gvr="v1/pods"} 3`,
+		"",
+	}, "\n"), buff.String())
+}
+
+func TestWriteMetrics(t *testing.T) {
+	uu := map[string]struct {
+		mm []Metric
+		e  string
+	}{
+		"empty": {},
+		"noLabels": {
+			mm: []Metric{{Name: "fred", Help: "Blee.", Type: "gauge", Samples: []MetricSample{{Value: 1.5}}}},
+			e:  "# HELP fred Blee.\n# TYPE fred gauge\nfred 1.5\n",
+		},
+		"quoted": {
+			mm: []Metric{{Name: "fred", Help: "Blee.", Type: "gauge", Samples: []MetricSample{
+				{Labels: map[string]string{"b": `a"b`, "a": "x"}, Value: 2},
+			}}},
+			e: "# HELP fred Blee.\n# TYPE fred gauge\n" + `fred{a="x",b="a\"b"} 2` + "\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			assert.Nil(t, WriteMetrics(&buff, u.mm))
+			assert.Equal(t, u.e, buff.String())
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package perf
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"strconv"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ServeProfiler serves pprof endpoints on a localhost port until the
+// context is canceled.
+func ServeProfiler(ctx context.Context, port int) error {
+	l, err := net.Listen("tcp", net.JoinHostPort("localhost", strconv.Itoa(port)))
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	srv := http.Server{Handler: mux}
+	go func() {
+		<-ctx.Done()
+		if err := srv.Close(); err != nil {
+			log.Error().Err(err).Msg("Profiler close failed")
+		}
+	}()
+	go func() {
+		log.Info().Msgf("Serving pprof on http://%s/debug/pprof", l.Addr())
+		if err := srv.Serve(l); err != nil && err != http.ErrServerClosed {
+			log.Error().Err(err).Msg("Profiler failed")
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package perf
+
+import (
+	"runtime"
+	"sync"
+	"time"
+)
+
+// Stats tracks K9s runtime performance.
+var Stats = NewRuntimeStats()
+
+// Sample represents runtime performance measured over a period.
+type Sample struct {
+	// FPS represents the number of screen redraws per second.
+	FPS float64
+	// DrawTime represents the average redraw time.
+	DrawTime time.Duration
+	// Goroutines represents the number of running goroutines.
+	Goroutines int
+	// HeapAlloc represents the allocated heap bytes.
+	HeapAlloc uint64
+	// EventRates represents watch events per second by resource.
+	EventRates map[string]float64
+}
+
+// RuntimeStats collects redraws and watch events statistics.
+type RuntimeStats struct {
+	frames   int
+	drawTime time.Duration
+	events   map[string]int
+	totals   map[string]int
+	since    time.Time
+	mx       sync.Mutex
+}
+
+// NewRuntimeStats returns a new stats collector.
+func NewRuntimeStats() *RuntimeStats {
+	return &RuntimeStats{
+		events: make(map[string]int),
+		totals: make(map[string]int),
+		since:  time.Now(),
+	}
+}
+
+// RecordDraw records a screen redraw.
+func (s *RuntimeStats) RecordDraw(d time.Duration) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.frames++
+	s.drawTime += d
+}
+
+// RecordEvent records a watch event for a given resource.
+func (s *RuntimeStats) RecordEvent(gvr string) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	s.events[gvr]++
+	s.totals[gvr]++
+}
+
+// Sample returns the stats measured since the last sample.
+func (s *RuntimeStats) Sample(now time.Time) Sample {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	sample := s.sample(now)
+	sample.Goroutines, sample.HeapAlloc = runtime.NumGoroutine(), ms.HeapAlloc
+
+	return sample
+}
+
+func (s *RuntimeStats) sample(now time.Time) Sample {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	sample := Sample{EventRates: make(map[string]float64, len(s.events))}
+	elapsed := now.Sub(s.since).Seconds()
+	if elapsed > 0 {
+		sample.FPS = float64(s.frames) / elapsed
+		for gvr, n := range s.events {
+			sample.EventRates[gvr] = float64(n) / elapsed
+		}
+	}
+	if s.frames > 0 {
+		sample.DrawTime = s.drawTime / time.Duration(s.frames)
+	}
+	s.frames, s.drawTime, s.since = 0, 0, now
+	s.events = make(map[string]int, len(s.events))
+
+	return sample
+}
@@ -0,0 +1,38 @@
+package perf
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuntimeStatsSample(t *testing.T) {
+	s := NewRuntimeStats()
+	start := time.Now()
+	s.since = start
+	for i := 0; i < 4; i++ {
+		s.RecordDraw(10 * time.Millisecond)
+	}
+	s.RecordDraw(20 * time.Millisecond)
+	for i := 0; i < 6; i++ {
+		s.RecordEvent("v1/pods")
+	}
+	s.RecordEvent("v1/events")
+
+	sample := s.sample(start.Add(2 * time.Second))
+	assert.Equal(t, 2.5, sample.FPS)
+	assert.Equal(t, 12*time.Millisecond, sample.DrawTime)
+	assert.Equal(t, map[string]float64{"v1/pods": 3, "v1/events": 0.5}, sample.EventRates)
+
+	sample = s.sample(start.Add(3 * time.Second))
+	assert.Equal(t, 0.0, sample.FPS)
+	assert.Equal(t, time.Duration(0), sample.DrawTime)
+	assert.Empty(t, sample.EventRates)
+}
+
+func TestRuntimeStatsRuntime(t *testing.T) {
+	sample := NewRuntimeStats().Sample(time.Now())
+	assert.True(t, sample.Goroutines > 0)
+	assert.True(t, sample.HeapAlloc > 0)
+}
@@ -0,0 +1,341 @@
+// Package pss evaluates pod specs against the Pod Security Standards.
+package pss
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// Level represents a Pod Security Standards level.
+type Level string
+
+const (
+	// Privileged represents the unrestricted level.
+	Privileged Level = "privileged"
+	// Baseline represents the minimally restrictive level.
+	Baseline Level = "baseline"
+	// Restricted represents the hardened level.
+	Restricted Level = "restricted"
+)
+
+const (
+	seccompPodAnnotation       = "seccomp.security.alpha.kubernetes.io/pod"
+	seccompContainerAnnotation = "container.seccomp.security.alpha.kubernetes.io/"
+	appArmorAnnotation         = "container.apparmor.security.beta.kubernetes.io/"
+)
+
+var (
+	baselineCaps = []string{
+		"AUDIT_WRITE", "CHOWN", "DAC_OVERRIDE", "FOWNER", "FSETID", "KILL", "MKNOD",
+		"NET_BIND_SERVICE", "SETFCAP", "SETGID", "SETPCAP", "SETUID", "SYS_CHROOT",
+	}
+	safeSysctls = []string{
+		"kernel.shm_rmid_forced",
+		"net.ipv4.ip_local_port_range",
+		"net.ipv4.ip_unprivileged_port_start",
+		"net.ipv4.tcp_syncookies",
+		"net.ipv4.ping_group_range",
+	}
+	seLinuxTypes = []string{"", "container_t", "container_init_t", "container_kvm_t"}
+)
+
+// Violation represents a pod spec field failing a level check.
+type Violation struct {
+	Level   Level  `yaml:"level"`
+	Check   string `yaml:"check"`
+	Field   string `yaml:"field"`
+	Message string `yaml:"message"`
+}
+
+// Violations represents a collection of violations.
+type Violations []Violation
+
+// Count returns the number of violations for a given level.
+func (vv Violations) Count(l Level) int {
+	var n int
+	for _, v := range vv {
+		if v.Level == l {
+			n++
+		}
+	}
+
+	return n
+}
+
+// Allowed returns the most restrictive level the pod spec satisfies.
+func (vv Violations) Allowed() Level {
+	switch {
+	case vv.Count(Baseline) > 0:
+		return Privileged
+	case vv.Count(Restricted) > 0:
+		return Baseline
+	default:
+		return Restricted
+	}
+}
+
+// Evaluate checks a pod template against the baseline and restricted levels.
+// Field paths are reported relative to the given prefix.
+func Evaluate(tpl v1.PodTemplateSpec, prefix string) Violations {
+	e := evaluator{prefix: prefix, annotations: tpl.Annotations}
+	e.checkPod(tpl.Spec)
+	cc := containers(tpl.Spec)
+	for _, c := range cc {
+		e.checkContainer(c)
+	}
+	e.checkRunAsNonRoot(tpl.Spec, cc)
+	e.checkSeccomp(tpl.Spec, cc)
+	sort.SliceStable(e.vv, func(i, j int) bool {
+		return e.vv[i].Level == Baseline && e.vv[j].Level == Restricted
+	})
+
+	return e.vv
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type container struct {
+	v1.Container
+	path string
+}
+
+func containers(spec v1.PodSpec) []container {
+	cc := make([]container, 0, len(spec.InitContainers)+len(spec.Containers))
+	for _, c := range spec.InitContainers {
+		cc = append(cc, container{Container: c, path: "spec.initContainers[" + c.Name + "]"})
+	}
+	for _, c := range spec.Containers {
+		cc = append(cc, container{Container: c, path: "spec.containers[" + c.Name + "]"})
+	}
+
+	return cc
+}
+
+type evaluator struct {
+	prefix      string
+	annotations map[string]string
+	vv          Violations
+}
+
+func (e *evaluator) add(l Level, check, field, msg string) {
+	if e.prefix != "" {
+		field = e.prefix + "." + field
+	}
+	e.vv = append(e.vv, Violation{Level: l, Check: check, Field: field, Message: msg})
+}
+
+func (e *evaluator) checkPod(spec v1.PodSpec) {
+	if spec.HostNetwork {
+		e.add(Baseline, "Host Namespaces", "spec.hostNetwork", "host network is not allowed")
+	}
+	if spec.HostPID {
+		e.add(Baseline, "Host Namespaces", "spec.hostPID", "host PID namespace is not allowed")
+	}
+	if spec.HostIPC {
+		e.add(Baseline, "Host Namespaces", "spec.hostIPC", "host IPC namespace is not allowed")
+	}
+	for _, v := range spec.Volumes {
+		field := "spec.volumes[" + v.Name + "]"
+		if v.HostPath != nil {
+			e.add(Baseline, "HostPath Volumes", field+".hostPath", "hostPath volumes are not allowed")
+			continue
+		}
+		if t := volumeType(v.VolumeSource); t != "" {
+			e.add(Restricted, "Volume Types", field+"."+t, fmt.Sprintf("%s volumes are not allowed", t))
+		}
+	}
+	if sc := spec.SecurityContext; sc != nil {
+		e.checkSELinux(sc.SELinuxOptions, "spec.securityContext.seLinuxOptions")
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			e.add(Restricted, "Running as Non-root user", "spec.securityContext.runAsUser", "running as uid 0 is not allowed")
+		}
+		for _, s := range sc.Sysctls {
+			if !in(safeSysctls, s.Name) {
+				e.add(Baseline, "Sysctls", "spec.securityContext.sysctls", fmt.Sprintf("sysctl %s is not allowed", s.Name))
+			}
+		}
+	}
+}
+
+func (e *evaluator) checkContainer(c container) {
+	for _, p := range c.Ports {
+		if p.HostPort != 0 {
+			e.add(Baseline, "Host Ports", c.path+".ports", fmt.Sprintf("host port %d is not allowed", p.HostPort))
+		}
+	}
+	if p, ok := e.annotations[appArmorAnnotation+c.Name]; ok && p != "runtime/default" && !strings.HasPrefix(p, "localhost/") {
+		e.add(Baseline, "AppArmor", "metadata.annotations["+appArmorAnnotation+c.Name+"]", fmt.Sprintf("apparmor profile %q is not allowed", p))
+	}
+
+	sc := c.SecurityContext
+	if sc == nil {
+		e.add(Restricted, "Privilege Escalation", c.path+".securityContext.allowPrivilegeEscalation", "must be set to false")
+		e.add(Restricted, "Capabilities", c.path+".securityContext.capabilities.drop", "must drop ALL capabilities")
+		return
+	}
+	field := c.path + ".securityContext"
+	if sc.Privileged != nil && *sc.Privileged {
+		e.add(Baseline, "Privileged Containers", field+".privileged", "privileged containers are not allowed")
+	}
+	if sc.ProcMount != nil && *sc.ProcMount != v1.DefaultProcMount {
+		e.add(Baseline, "/proc Mount Type", field+".procMount", fmt.Sprintf("proc mount %q is not allowed", *sc.ProcMount))
+	}
+	e.checkSELinux(sc.SELinuxOptions, field+".seLinuxOptions")
+	e.checkCapabilities(sc.Capabilities, field+".capabilities")
+	if sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+		e.add(Restricted, "Privilege Escalation", field+".allowPrivilegeEscalation", "must be set to false")
+	}
+	if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+		e.add(Restricted, "Running as Non-root user", field+".runAsUser", "running as uid 0 is not allowed")
+	}
+}
+
+func (e *evaluator) checkCapabilities(caps *v1.Capabilities, field string) {
+	var add, drop []v1.Capability
+	if caps != nil {
+		add, drop = caps.Add, caps.Drop
+	}
+	for _, c := range add {
+		switch {
+		case !in(baselineCaps, string(c)):
+			e.add(Baseline, "Capabilities", field+".add", fmt.Sprintf("capability %s is not allowed", c))
+		case c != "NET_BIND_SERVICE":
+			e.add(Restricted, "Capabilities", field+".add", fmt.Sprintf("capability %s is not allowed", c))
+		}
+	}
+	for _, c := range drop {
+		if c == "ALL" {
+			return
+		}
+	}
+	e.add(Restricted, "Capabilities", field+".drop", "must drop ALL capabilities")
+}
+
+func (e *evaluator) checkSELinux(o *v1.SELinuxOptions, field string) {
+	if o == nil {
+		return
+	}
+	if !in(seLinuxTypes, o.Type) {
+		e.add(Baseline, "SELinux", field+".type", fmt.Sprintf("selinux type %q is not allowed", o.Type))
+	}
+	if o.User != "" || o.Role != "" {
+		e.add(Baseline, "SELinux", field, "custom selinux user or role is not allowed")
+	}
+}
+
+// checkRunAsNonRoot checks all containers run as non root either explicitly
+// or via the pod security context.
+func (e *evaluator) checkRunAsNonRoot(spec v1.PodSpec, cc []container) {
+	var podNonRoot *bool
+	if spec.SecurityContext != nil {
+		podNonRoot = spec.SecurityContext.RunAsNonRoot
+	}
+	if podNonRoot != nil && !*podNonRoot {
+		e.add(Restricted, "Running as Non-root", "spec.securityContext.runAsNonRoot", "must not be set to false")
+	}
+	for _, c := range cc {
+		var nonRoot *bool
+		if c.SecurityContext != nil {
+			nonRoot = c.SecurityContext.RunAsNonRoot
+		}
+		switch {
+		case nonRoot != nil && !*nonRoot:
+			e.add(Restricted, "Running as Non-root", c.path+".securityContext.runAsNonRoot", "must not be set to false")
+		case nonRoot == nil && (podNonRoot == nil || !*podNonRoot):
+			e.add(Restricted, "Running as Non-root", c.path+".securityContext.runAsNonRoot", "must be set to true")
+		}
+	}
+}
+
+// checkSeccomp checks the seccomp profiles set via annotations.
+func (e *evaluator) checkSeccomp(spec v1.PodSpec, cc []container) {
+	pod, podSet := e.annotations[seccompPodAnnotation]
+	if podSet {
+		e.checkSeccompProfile(pod, "metadata.annotations["+seccompPodAnnotation+"]")
+	}
+	for _, c := range cc {
+		key := seccompContainerAnnotation + c.Name
+		p, ok := e.annotations[key]
+		if ok {
+			e.checkSeccompProfile(p, "metadata.annotations["+key+"]")
+			continue
+		}
+		if !podSet {
+			e.add(Restricted, "Seccomp", "metadata.annotations["+key+"]", "seccomp profile must be set to runtime/default or localhost")
+		}
+	}
+}
+
+func (e *evaluator) checkSeccompProfile(p, field string) {
+	switch {
+	case p == "unconfined":
+		e.add(Baseline, "Seccomp", field, "unconfined seccomp profile is not allowed")
+	case p != "runtime/default" && p != "docker/default" && !strings.HasPrefix(p, "localhost/"):
+		e.add(Restricted, "Seccomp", field, fmt.Sprintf("seccomp profile %q is not allowed", p))
+	}
+}
+
+// volumeType returns the type of volumes disallowed by the restricted level.
+func volumeType(s v1.VolumeSource) string {
+	switch {
+	case s.ConfigMap != nil, s.CSI != nil, s.DownwardAPI != nil, s.EmptyDir != nil,
+		s.PersistentVolumeClaim != nil, s.Projected != nil, s.Secret != nil:
+		return ""
+	case s.GCEPersistentDisk != nil:
+		return "gcePersistentDisk"
+	case s.AWSElasticBlockStore != nil:
+		return "awsElasticBlockStore"
+	case s.GitRepo != nil:
+		return "gitRepo"
+	case s.NFS != nil:
+		return "nfs"
+	case s.ISCSI != nil:
+		return "iscsi"
+	case s.Glusterfs != nil:
+		return "glusterfs"
+	case s.RBD != nil:
+		return "rbd"
+	case s.FlexVolume != nil:
+		return "flexVolume"
+	case s.Cinder != nil:
+		return "cinder"
+	case s.CephFS != nil:
+		return "cephfs"
+	case s.Flocker != nil:
+		return "flocker"
+	case s.FC != nil:
+		return "fc"
+	case s.AzureFile != nil:
+		return "azureFile"
+	case s.VsphereVolume != nil:
+		return "vsphereVolume"
+	case s.Quobyte != nil:
+		return "quobyte"
+	case s.AzureDisk != nil:
+		return "azureDisk"
+	case s.PhotonPersistentDisk != nil:
+		return "photonPersistentDisk"
+	case s.PortworxVolume != nil:
+		return "portworxVolume"
+	case s.ScaleIO != nil:
+		return "scaleIO"
+	case s.StorageOS != nil:
+		return "storageos"
+	default:
+		return ""
+	}
+}
+
+func in(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,152 @@
+package pss_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/pss"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestEvaluate(t *testing.T) {
+	uu := map[string]struct {
+		tpl     v1.PodTemplateSpec
+		allowed pss.Level
+		fields  []string
+	}{
+		"restricted": {
+			tpl:     restrictedPod(),
+			allowed: pss.Restricted,
+		},
+		"defaults": {
+			tpl: v1.PodTemplateSpec{Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "c1"}},
+			}},
+			allowed: pss.Baseline,
+			fields: []string{
+				"spec.template.spec.containers[c1].securityContext.allowPrivilegeEscalation",
+				"spec.template.spec.containers[c1].securityContext.capabilities.drop",
+				"spec.template.spec.containers[c1].securityContext.runAsNonRoot",
+				"spec.template.metadata.annotations[container.seccomp.security.alpha.kubernetes.io/c1]",
+			},
+		},
+		"privileged": {
+			tpl: func() v1.PodTemplateSpec {
+				tpl := restrictedPod()
+				tpl.Spec.HostNetwork = true
+				tpl.Spec.Containers[0].SecurityContext.Privileged = boolPtr(true)
+				tpl.Spec.Containers[0].SecurityContext.Capabilities.Add = []v1.Capability{"SYS_ADMIN", "CHOWN"}
+				tpl.Spec.Containers[0].Ports = []v1.ContainerPort{{ContainerPort: 80, HostPort: 8080}}
+				return tpl
+			}(),
+			allowed: pss.Privileged,
+			fields: []string{
+				"spec.template.spec.hostNetwork",
+				"spec.template.spec.containers[c1].ports",
+				"spec.template.spec.containers[c1].securityContext.privileged",
+				"spec.template.spec.containers[c1].securityContext.capabilities.add",
+				"spec.template.spec.containers[c1].securityContext.capabilities.add",
+			},
+		},
+		"volumes": {
+			tpl: func() v1.PodTemplateSpec {
+				tpl := restrictedPod()
+				tpl.Spec.Volumes = []v1.Volume{
+					{Name: "v1", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+					{Name: "v2", VolumeSource: v1.VolumeSource{NFS: &v1.NFSVolumeSource{}}},
+				}
+				return tpl
+			}(),
+			allowed: pss.Baseline,
+			fields:  []string{"spec.template.spec.volumes[v2].nfs"},
+		},
+		"seccomp": {
+			tpl: func() v1.PodTemplateSpec {
+				tpl := restrictedPod()
+				tpl.Annotations = map[string]string{
+					"seccomp.security.alpha.kubernetes.io/pod":          "runtime/default",
+					"container.seccomp.security.alpha.kubernetes.io/c1": "unconfined",
+					"container.apparmor.security.beta.kubernetes.io/c1": "unconfined",
+				}
+				return tpl
+			}(),
+			allowed: pss.Privileged,
+			fields: []string{
+				"spec.template.metadata.annotations[container.apparmor.security.beta.kubernetes.io/c1]",
+				"spec.template.metadata.annotations[container.seccomp.security.alpha.kubernetes.io/c1]",
+			},
+		},
+		"root": {
+			tpl: func() v1.PodTemplateSpec {
+				tpl := restrictedPod()
+				tpl.Spec.SecurityContext.RunAsNonRoot = nil
+				tpl.Spec.Containers[0].SecurityContext.RunAsUser = int64Ptr(0)
+				return tpl
+			}(),
+			allowed: pss.Baseline,
+			fields: []string{
+				"spec.template.spec.containers[c1].securityContext.runAsUser",
+				"spec.template.spec.containers[c1].securityContext.runAsNonRoot",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			vv := pss.Evaluate(u.tpl, "spec.template")
+			assert.Equal(t, u.allowed, vv.Allowed())
+			var ff []string
+			for _, v := range vv {
+				ff = append(ff, v.Field)
+			}
+			assert.Equal(t, u.fields, ff)
+		})
+	}
+}
+
+func TestViolationsCount(t *testing.T) {
+	vv := pss.Violations{
+		{Level: pss.Baseline},
+		{Level: pss.Restricted},
+		{Level: pss.Restricted},
+	}
+
+	assert.Equal(t, 1, vv.Count(pss.Baseline))
+	assert.Equal(t, 2, vv.Count(pss.Restricted))
+	assert.Equal(t, pss.Privileged, vv.Allowed())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func restrictedPod() v1.PodTemplateSpec {
+	tpl := v1.PodTemplateSpec{
+		Spec: v1.PodSpec{
+			SecurityContext: &v1.PodSecurityContext{RunAsNonRoot: boolPtr(true)},
+			Containers: []v1.Container{
+				{
+					Name: "c1",
+					SecurityContext: &v1.SecurityContext{
+						AllowPrivilegeEscalation: boolPtr(false),
+						Capabilities: &v1.Capabilities{
+							Drop: []v1.Capability{"ALL"},
+							Add:  []v1.Capability{"NET_BIND_SERVICE"},
+						},
+					},
+				},
+			},
+		},
+	}
+	tpl.Annotations = map[string]string{"seccomp.security.alpha.kubernetes.io/pod": "runtime/default"}
+
+	return tpl
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
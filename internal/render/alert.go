@@ -0,0 +1,101 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/alert"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// AlertFiring represents an alert requiring attention.
+	AlertFiring = "Firing"
+	// AlertAcked represents an acknowledged alert.
+	AlertAcked = "Acked"
+	// AlertSnoozed represents a muted alert.
+	AlertSnoozed = "Snoozed"
+)
+
+// Alert renders fired alerts to screen.
+type Alert struct{}
+
+// ColorerFunc colors a resource row.
+func (Alert) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if !strings.HasPrefix(strings.TrimSpace(re.Row.Fields[4]), AlertFiring) {
+			return CompletedColor
+		}
+		if strings.TrimSpace(re.Row.Fields[1]) == config.AlertError {
+			return ErrColor
+		}
+		return ModColor
+	}
+}
+
+// Header returns a header row.
+func (Alert) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "RULE"},
+		Header{Name: "SEVERITY"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAME"},
+		Header{Name: "STATUS"},
+		Header{Name: "MESSAGE", Wide: true},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an alert to screen.
+func (Alert) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(AlertRes)
+	if !ok {
+		return fmt.Errorf("expecting alertres, but got %T", o)
+	}
+
+	r.ID = a.ID
+	r.Fields = Fields{
+		a.Rule,
+		a.Severity,
+		a.GVR,
+		a.Path,
+		alertStatus(a.Alert, time.Now()),
+		a.Message,
+		timeToAge(a.Fired),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func alertStatus(a alert.Alert, now time.Time) string {
+	switch {
+	case a.Acked:
+		return AlertAcked
+	case a.IsSnoozed(now):
+		return AlertSnoozed + " until " + a.Snoozed.Local().Format("15:04")
+	default:
+		return AlertFiring
+	}
+}
+
+// AlertRes represents a fired alert resource.
+type AlertRes struct {
+	alert.Alert
+}
+
+// GetObjectKind returns a schema object.
+func (AlertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AlertRes) DeepCopyObject() runtime.Object {
+	return a
+}
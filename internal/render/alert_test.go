@@ -0,0 +1,40 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/alert"
+	cfg "github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertRender(t *testing.T) {
+	uu := map[string]struct {
+		a      alert.Alert
+		status string
+	}{
+		"firing": {
+			a:      alert.Alert{ID: "r1:default/p1", Rule: "r1", Severity: cfg.AlertError, GVR: "v1/pods", Path: "default/p1", Message: "boom"},
+			status: render.AlertFiring,
+		},
+		"acked": {
+			a:      alert.Alert{ID: "r1:default/p1", Rule: "r1", Severity: cfg.AlertWarn, GVR: "v1/pods", Path: "default/p1", Message: "boom", Acked: true},
+			status: render.AlertAcked,
+		},
+	}
+
+	var a render.Alert
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.a.Fired = time.Now()
+			var r render.Row
+			assert.Nil(t, a.Render(render.AlertRes{Alert: u.a}, "", &r))
+
+			assert.Equal(t, "r1:default/p1", r.ID)
+			assert.Equal(t, render.Fields{"r1", u.a.Severity, "v1/pods", "default/p1", u.status, "boom"}, r.Fields[:6])
+		})
+	}
+}
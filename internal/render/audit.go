@@ -0,0 +1,84 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AuditTimeFmt represents the audit entries time format.
+const AuditTimeFmt = "2006-01-02 15:04:05"
+
+// Audit renders audit log entries to screen.
+type Audit struct{}
+
+// ColorerFunc colors a resource row.
+func (Audit) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if !Happy(ns, re.Row) {
+			return ErrColor
+		}
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Audit) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "TIME"},
+		Header{Name: "USER"},
+		Header{Name: "CONTEXT"},
+		Header{Name: "ACTION"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAME"},
+		Header{Name: "OUTCOME"},
+		Header{Name: "VALID", Wide: true},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an audit entry to screen.
+func (Audit) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(AuditRes)
+	if !ok {
+		return fmt.Errorf("expecting auditres, but got %T", o)
+	}
+
+	e := a.Entry
+	r.ID = fmt.Sprintf("%06d", a.Line)
+	r.Fields = Fields{
+		e.Time.Local().Format(AuditTimeFmt),
+		e.User,
+		e.Context,
+		e.Action,
+		e.GVR,
+		e.Name,
+		e.Outcome,
+		e.Error,
+		timeToAge(e.Time),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// AuditRes represents an audit log entry resource.
+type AuditRes struct {
+	Entry config.AuditEntry
+	Line  int
+}
+
+// GetObjectKind returns a schema object.
+func (AuditRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AuditRes) DeepCopyObject() runtime.Object {
+	return a
+}
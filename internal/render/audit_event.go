@@ -0,0 +1,102 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/auditlog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// AuditEvent renders Kubernetes audit events to screen.
+type AuditEvent struct{}
+
+// ColorerFunc colors a resource row.
+func (AuditEvent) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if code, err := strconv.Atoi(strings.TrimSpace(re.Row.Fields[6])); err == nil && code >= 400 {
+			return ErrColor
+		}
+		switch strings.TrimSpace(re.Row.Fields[2]) {
+		case "delete", "deletecollection":
+			return KillColor
+		case "create", "update", "patch":
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (AuditEvent) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "TIME"},
+		Header{Name: "USER"},
+		Header{Name: "VERB"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "CODE", Align: tview.AlignRight},
+		Header{Name: "SOURCE", Wide: true},
+		Header{Name: "AGENT", Wide: true},
+		Header{Name: "URI", Wide: true},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an audit event to screen.
+func (AuditEvent) Render(o interface{}, ns string, r *Row) error {
+	a, ok := o.(AuditEventRes)
+	if !ok {
+		return fmt.Errorf("expecting auditeventres, but got %T", o)
+	}
+
+	e := a.Event
+	var objNS, objName string
+	if e.ObjectRef != nil {
+		objNS, objName = e.ObjectRef.Namespace, e.ObjectRef.Name
+	}
+	code := NAValue
+	if c := e.Code(); c != 0 {
+		code = strconv.Itoa(c)
+	}
+	r.ID = e.AuditID
+	r.Fields = Fields{
+		e.StageTimestamp.Local().Format(AuditTimeFmt),
+		e.Username(),
+		e.Verb,
+		e.Resource(),
+		objNS,
+		objName,
+		code,
+		strings.Join(e.SourceIPs, ","),
+		e.UserAgent,
+		e.RequestURI,
+		timeToAge(e.StageTimestamp),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// AuditEventRes represents a Kubernetes audit event resource.
+type AuditEventRes struct {
+	Event auditlog.Event
+}
+
+// GetObjectKind returns a schema object.
+func (AuditEventRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (a AuditEventRes) DeepCopyObject() runtime.Object {
+	return a
+}
@@ -0,0 +1,67 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/auditlog"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEventRender(t *testing.T) {
+	uu := map[string]struct {
+		e       auditlog.Event
+		id      string
+		eFields render.Fields
+	}{
+		"object": {
+			e: auditlog.Event{
+				AuditID:        "a1",
+				Verb:           "delete",
+				User:           auditlog.UserInfo{Username: "fred"},
+				SourceIPs:      []string{"10.0.0.1", "10.0.0.2"},
+				UserAgent:      "kubectl/v1.16.0",
+				RequestURI:     "/apis/apps/v1/namespaces/default/deployments/blee",
+				ObjectRef:      &auditlog.ObjectRef{Resource: "deployments", APIGroup: "apps", Namespace: "default", Name: "blee"},
+				ResponseStatus: &auditlog.ResponseStatus{Code: 200},
+				StageTimestamp: time.Date(2020, 1, 2, 3, 4, 5, 0, time.Local),
+			},
+			id: "a1",
+			eFields: render.Fields{
+				"2020-01-02 03:04:05",
+				"fred",
+				"delete",
+				"deployments.apps",
+				"default",
+				"blee",
+				"200",
+				"10.0.0.1,10.0.0.2",
+				"kubectl/v1.16.0",
+				"/apis/apps/v1/namespaces/default/deployments/blee",
+			},
+		},
+		"nonResource": {
+			e: auditlog.Event{
+				AuditID:        "a2",
+				Verb:           "get",
+				User:           auditlog.UserInfo{Username: "system:anonymous"},
+				RequestURI:     "/healthz",
+				StageTimestamp: time.Date(2020, 1, 2, 3, 4, 5, 0, time.Local),
+			},
+			id:      "a2",
+			eFields: render.Fields{"2020-01-02 03:04:05", "system:anonymous", "get", "", "", "", "n/a", "", "", "/healthz"},
+		},
+	}
+
+	var a render.AuditEvent
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, a.Render(render.AuditEventRes{Event: u.e}, "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.eFields, r.Fields[:10])
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	cfg "github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditRender(t *testing.T) {
+	e := cfg.NewAuditEntry("delete", "v1/pods", "default/fred", errors.New("denied"))
+	e.Time = time.Date(2020, 1, 2, 3, 4, 5, 0, time.Local)
+	e.User, e.Context = "bozo", "ctx1"
+
+	var (
+		a render.Audit
+		r render.Row
+	)
+	assert.Nil(t, a.Render(render.AuditRes{Entry: e, Line: 12}, "", &r))
+
+	assert.Equal(t, "000012", r.ID)
+	assert.Equal(t, render.Fields{"2020-01-02 03:04:05", "bozo", "ctx1", "delete", "v1/pods", "default/fred", "failure", "denied"}, r.Fields[:8])
+	assert.False(t, render.Happy("", r))
+}
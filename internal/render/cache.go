@@ -0,0 +1,89 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Cache renders informer caches usage to screen.
+type Cache struct{}
+
+// ColorerFunc colors a resource row.
+func (Cache) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (Cache) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAMESPACE"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "SELECTOR"},
+		Header{Name: "ITEMS", Align: tview.AlignRight},
+		Header{Name: "SIZE(KiB)", Align: tview.AlignRight},
+		Header{Name: "IDLE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an informer cache usage to screen.
+func (Cache) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(CacheRes)
+	if !ok {
+		return fmt.Errorf("expecting cacheres, but got %T", o)
+	}
+
+	cns := c.Namespace
+	if client.IsAllNamespaces(cns) {
+		cns = client.NamespaceAll
+	}
+	r.ID = cns + "/" + c.GVR + "?" + c.Selector
+	r.Fields = Fields{
+		cns,
+		c.GVR,
+		c.Selector,
+		strconv.Itoa(c.Items),
+		strconv.FormatInt(c.Bytes/1024, 10),
+		timeToAge(c.LastUsed),
+	}
+
+	return nil
+}
+
+// ToBytes returns a human readable memory size.
+func ToBytes(b int64) string {
+	const unit = 1024
+	if b < unit {
+		return strconv.FormatInt(b, 10) + "B"
+	}
+	div, exp := int64(unit), 0
+	for n := b / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(b)/float64(div), "KMGTPE"[exp])
+}
+
+// CacheRes represents an informer cache usage resource.
+type CacheRes struct {
+	watch.CacheStat
+}
+
+// GetObjectKind returns a schema object.
+func (CacheRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c CacheRes) DeepCopyObject() runtime.Object {
+	return c
+}
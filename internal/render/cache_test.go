@@ -0,0 +1,48 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheRender(t *testing.T) {
+	c := render.CacheRes{CacheStat: watch.CacheStat{
+		GVR:      "v1/pods",
+		Selector: "app=fred",
+		Items:    10,
+		Bytes:    2048,
+		LastUsed: time.Now(),
+	}}
+
+	var (
+		r  render.Row
+		cr render.Cache
+	)
+	assert.Nil(t, cr.Render(c, "", &r))
+	assert.Equal(t, "all/v1/pods?app=fred", r.ID)
+	assert.Equal(t, render.Fields{"all", "v1/pods", "app=fred", "10", "2"}, r.Fields[:5])
+}
+
+func TestToBytes(t *testing.T) {
+	uu := map[string]struct {
+		b int64
+		e string
+	}{
+		"zero":  {0, "0B"},
+		"bytes": {512, "512B"},
+		"kilo":  {1536, "1.5KiB"},
+		"mega":  {5 * 1024 * 1024, "5.0MiB"},
+		"giga":  {3 * 1024 * 1024 * 1024, "3.0GiB"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.ToBytes(u.b))
+		})
+	}
+}
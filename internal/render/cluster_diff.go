@@ -0,0 +1,117 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Cluster diff statuses.
+const (
+	// DiffMissing represents an object only found in the left context.
+	DiffMissing = "MISSING"
+	// DiffExtra represents an object only found in the right context.
+	DiffExtra = "EXTRA"
+	// DiffChanged represents an object differing between both contexts.
+	DiffChanged = "CHANGED"
+
+	diffIDSep     = "|"
+	diffMaxFields = 3
+)
+
+// ClusterDiff renders objects differing between two contexts to screen.
+type ClusterDiff struct{}
+
+// ColorerFunc colors a resource row.
+func (ClusterDiff) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[3] {
+		case DiffMissing:
+			return ErrColor
+		case DiffExtra:
+			return AddColor
+		default:
+			return ModColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (ClusterDiff) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "STATUS"},
+		Header{Name: "FIELDS"},
+	}
+}
+
+// Render renders a cluster diff entry to screen.
+func (ClusterDiff) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(ObjectDiff)
+	if !ok {
+		return fmt.Errorf("expecting objectdiff, but got %T", o)
+	}
+
+	dns, n := client.Namespaced(d.Path)
+	r.ID = d.ID()
+	r.Fields = Fields{
+		client.NewGVR(d.GVR).R(),
+		dns,
+		n,
+		d.Status,
+		diffFields(d.Fields),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ObjectDiff represents an object differing between two contexts.
+type ObjectDiff struct {
+	GVR    string
+	Path   string
+	Status string
+	Fields []string
+}
+
+// ID returns the diff row id.
+func (d ObjectDiff) ID() string {
+	return d.GVR + diffIDSep + d.Path
+}
+
+// ParseDiffID returns the resource and path of a diff row.
+func ParseDiffID(id string) (string, string) {
+	tokens := strings.SplitN(id, diffIDSep, 2)
+	if len(tokens) < 2 {
+		return "", id
+	}
+
+	return tokens[0], tokens[1]
+}
+
+// GetObjectKind returns a schema object.
+func (ObjectDiff) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d ObjectDiff) DeepCopyObject() runtime.Object {
+	return d
+}
+
+func diffFields(ff []string) string {
+	if len(ff) <= diffMaxFields {
+		return strings.Join(ff, ",")
+	}
+
+	return strings.Join(ff[:diffMaxFields], ",") + ",+" + strconv.Itoa(len(ff)-diffMaxFields)
+}
@@ -0,0 +1,43 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterDiffRender(t *testing.T) {
+	uu := map[string]struct {
+		diff render.ObjectDiff
+		e    render.Fields
+	}{
+		"missing": {
+			diff: render.ObjectDiff{GVR: "apps/v1/deployments", Path: "default/fred", Status: render.DiffMissing},
+			e:    render.Fields{"deployments", "default", "fred", render.DiffMissing, ""},
+		},
+		"changed": {
+			diff: render.ObjectDiff{
+				GVR:    "v1/configmaps",
+				Path:   "default/blee",
+				Status: render.DiffChanged,
+				Fields: []string{"data.a", "data.b", "data.c", "data.d", "metadata.labels.app"},
+			},
+			e: render.Fields{"configmaps", "default", "blee", render.DiffChanged, "data.a,data.b,data.c,+2"},
+		},
+	}
+
+	var c render.ClusterDiff
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, c.Render(u.diff, "", &r))
+			assert.Equal(t, u.e, r.Fields)
+
+			gvr, path := render.ParseDiffID(r.ID)
+			assert.Equal(t, u.diff.GVR, gvr)
+			assert.Equal(t, u.diff.Path, path)
+		})
+	}
+}
@@ -3,7 +3,9 @@ package render
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -11,6 +13,13 @@ import (
 	"k8s.io/client-go/tools/clientcmd/api"
 )
 
+const (
+	// ContextReachable represents a reachable context status.
+	ContextReachable = "Reachable"
+	// ContextUnreachable represents an unreachable context status.
+	ContextUnreachable = "Unreachable"
+)
+
 // Context renders a K8s ConfigMap to screen.
 type Context struct{}
 
@@ -24,6 +33,9 @@ func (Context) ColorerFunc() ColorerFunc {
 		if strings.Contains(strings.TrimSpace(r.Row.Fields[0]), "*") {
 			c = HighlightColor
 		}
+		if r.Row.Fields[4] == ContextUnreachable {
+			c = ErrColor
+		}
 
 		return c
 	}
@@ -36,6 +48,9 @@ func (Context) Header(ns string) HeaderRow {
 		Header{Name: "CLUSTER"},
 		Header{Name: "AUTHINFO"},
 		Header{Name: "NAMESPACE"},
+		Header{Name: "STATUS"},
+		Header{Name: "LATENCY"},
+		Header{Name: "VERSION"},
 	}
 }
 
@@ -46,9 +61,25 @@ func (c Context) Render(o interface{}, _ string, r *Row) error {
 		return fmt.Errorf("expected *NamedContext, but got %T", o)
 	}
 
-	name := ctx.Name
+	var marks string
 	if ctx.IsCurrentContext(ctx.Name) {
-		name += "(*)"
+		marks += "*"
+	}
+	if ctx.Favorite {
+		marks += "+"
+	}
+	name := ctx.Name
+	if marks != "" {
+		name += "(" + marks + ")"
+	}
+
+	status, latency, version := UnknownValue, NAValue, NAValue
+	if p := ctx.Probe; p != nil {
+		status = ContextUnreachable
+		if p.Reachable {
+			status = ContextReachable
+			latency, version = p.Latency.Round(time.Millisecond).String(), p.Version
+		}
 	}
 
 	r.ID = ctx.Name
@@ -57,6 +88,9 @@ func (c Context) Render(o interface{}, _ string, r *Row) error {
 		ctx.Context.Cluster,
 		ctx.Context.AuthInfo,
 		ctx.Context.Namespace,
+		status,
+		latency,
+		version,
 	}
 
 	return nil
@@ -66,9 +100,11 @@ func (c Context) Render(o interface{}, _ string, r *Row) error {
 
 // NamedContext represents a named cluster context.
 type NamedContext struct {
-	Name    string
-	Context *api.Context
-	Config  ContextNamer
+	Name     string
+	Context  *api.Context
+	Config   ContextNamer
+	Favorite bool
+	Probe    *client.Probe
 }
 
 // ContextNamer represents a named context.
@@ -1,8 +1,11 @@
 package render_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
+	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/client-go/tools/clientcmd/api"
@@ -11,7 +14,7 @@ import (
 func TestContextHeader(t *testing.T) {
 	var c render.Context
 
-	assert.Equal(t, 4, len(c.Header("")))
+	assert.Equal(t, 7, len(c.Header("")))
 }
 
 func TestContextRender(t *testing.T) {
@@ -32,7 +35,37 @@ func TestContextRender(t *testing.T) {
 			},
 			e: render.Row{
 				ID:     "c1",
-				Fields: render.Fields{"c1", "c1", "u1", "ns1"},
+				Fields: render.Fields{"c1", "c1", "u1", "ns1", "<unknown>", "n/a", "n/a"},
+			},
+		},
+		"reachable": {
+			ctx: &render.NamedContext{
+				Name:     "fred",
+				Context:  &api.Context{Cluster: "c1", AuthInfo: "u1", Namespace: "ns1"},
+				Config:   &config{},
+				Favorite: true,
+				Probe: &client.Probe{
+					Reachable: true,
+					Latency:   32*time.Millisecond + 400*time.Microsecond,
+					Version:   "v1.16.2",
+				},
+			},
+			e: render.Row{
+				ID:     "fred",
+				Fields: render.Fields{"fred(*+)", "c1", "u1", "ns1", "Reachable", "32ms", "v1.16.2"},
+			},
+		},
+		"unreachable": {
+			ctx: &render.NamedContext{
+				Name:     "c2",
+				Context:  &api.Context{Cluster: "c2", AuthInfo: "u2"},
+				Config:   &config{},
+				Favorite: true,
+				Probe:    &client.Probe{Err: errors.New("boom")},
+			},
+			e: render.Row{
+				ID:     "c2",
+				Fields: render.Fields{"c2(+)", "c2", "u2", "", "Unreachable", "n/a", "n/a"},
 			},
 		},
 	}
@@ -41,7 +74,7 @@ func TestContextRender(t *testing.T) {
 	for k := range uu {
 		uc := uu[k]
 		t.Run(k, func(t *testing.T) {
-			row := render.NewRow(4)
+			row := render.NewRow(7)
 			err := r.Render(uc.ctx, "", &row)
 
 			assert.Nil(t, err)
@@ -34,6 +34,7 @@ func (CronJob) Header(ns string) HeaderRow {
 		Header{Name: "SUSPEND"},
 		Header{Name: "ACTIVE"},
 		Header{Name: "LAST_SCHEDULE"},
+		Header{Name: "PSA"},
 		Header{Name: "SELECTOR", Wide: true},
 		Header{Name: "CONTAINERS", Wide: true},
 		Header{Name: "IMAGES", Wide: true},
@@ -71,6 +72,7 @@ func (c CronJob) Render(o interface{}, ns string, r *Row) error {
 		boolPtrToStr(cj.Spec.Suspend),
 		strconv.Itoa(len(cj.Status.Active)),
 		lastScheduled,
+		psaLevel(cj.Spec.JobTemplate.Spec.Template, "spec.jobTemplate.spec.template"),
 		jobSelector(cj.Spec.JobTemplate.Spec),
 		podContainerNames(cj.Spec.JobTemplate.Spec.Template.Spec, true),
 		podImageNames(cj.Spec.JobTemplate.Spec.Template.Spec, true),
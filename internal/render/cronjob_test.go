@@ -14,4 +14,5 @@ func TestCronJobRender(t *testing.T) {
 
 	assert.Equal(t, "default/hello", r.ID)
 	assert.Equal(t, render.Fields{"default", "hello", "*/1 * * * *", "false", "0"}, r.Fields[:5])
+	assert.Equal(t, "baseline", r.Fields[6])
 }
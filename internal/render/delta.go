@@ -20,6 +20,21 @@ func NewDeltaRow(o, n Row, excludeLast bool) DeltaRow {
 	return deltas
 }
 
+// HasDelta checks if a row changed without computing its deltas.
+func HasDelta(o, n Row, excludeLast bool) bool {
+	oldFields := o.Fields
+	if excludeLast && len(o.Fields) > 0 {
+		oldFields = o.Fields[:len(o.Fields)-1]
+	}
+	for i, old := range oldFields {
+		if old != "" && old != n.Fields[i] {
+			return true
+		}
+	}
+
+	return false
+}
+
 // IsBlank asserts a row has no values in it.
 func (d DeltaRow) IsBlank() bool {
 	if len(d) == 0 {
@@ -59,6 +59,7 @@ func TestDelta(t *testing.T) {
 			d := render.NewDeltaRow(uc.o, uc.n, false)
 			assert.Equal(t, uc.e, d)
 			assert.Equal(t, uc.blank, d.IsBlank())
+			assert.Equal(t, !uc.blank, render.HasDelta(uc.o, uc.n, false))
 		})
 	}
 }
@@ -88,3 +89,11 @@ func TestDeltaBlank(t *testing.T) {
 		})
 	}
 }
+
+func TestHasDeltaExcludeLast(t *testing.T) {
+	o := render.Row{Fields: render.Fields{"a", "b", "1m"}}
+
+	assert.False(t, render.HasDelta(o, render.Row{Fields: render.Fields{"a", "b", "2m"}}, true))
+	assert.True(t, render.HasDelta(o, render.Row{Fields: render.Fields{"a", "c", "2m"}}, true))
+	assert.False(t, render.HasDelta(render.Row{}, render.Row{}, true))
+}
@@ -43,6 +43,7 @@ func (Deployment) Header(ns string) HeaderRow {
 		Header{Name: "UP-TO-DATE", Align: tview.AlignRight},
 		Header{Name: "AVAILABLE", Align: tview.AlignRight},
 		Header{Name: "READY", Align: tview.AlignRight},
+		Header{Name: "PSA"},
 		Header{Name: "LABELS", Wide: true},
 		Header{Name: "VALID", Wide: true},
 		Header{Name: "AGE", Decorator: AgeDecorator},
@@ -73,6 +74,7 @@ func (d Deployment) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(int(dp.Status.UpdatedReplicas)),
 		strconv.Itoa(int(dp.Status.AvailableReplicas)),
 		strconv.Itoa(int(dp.Status.ReadyReplicas)),
+		psaLevel(dp.Spec.Template, "spec.template"),
 		mapToStr(dp.Labels),
 		asStatus(d.diagnose(dp.Status.Replicas, dp.Status.AvailableReplicas)),
 		toAge(dp.ObjectMeta.CreationTimestamp),
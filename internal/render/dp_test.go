@@ -13,7 +13,7 @@ func TestDpRender(t *testing.T) {
 
 	assert.Nil(t, c.Render(load(t, "dp"), "", &r))
 	assert.Equal(t, "icx/icx-db", r.ID)
-	assert.Equal(t, render.Fields{"icx", "icx-db", "1/1", "1", "1"}, r.Fields[:5])
+	assert.Equal(t, render.Fields{"icx", "icx-db", "1/1", "1", "1", "1", "baseline"}, r.Fields[:7])
 }
 
 func BenchmarkDpRender(b *testing.B) {
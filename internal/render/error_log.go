@@ -0,0 +1,77 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/errlog"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ErrorLog renders recent errors to screen.
+type ErrorLog struct{}
+
+// ColorerFunc colors a resource row.
+func (ErrorLog) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if re.Row.Fields[4] == "true" {
+			return ErrColor
+		}
+		return ModColor
+	}
+}
+
+// Header returns a header row.
+func (ErrorLog) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "SOURCE"},
+		Header{Name: "REQUEST"},
+		Header{Name: "MESSAGE"},
+		Header{Name: "COUNT", Align: tview.AlignRight},
+		Header{Name: "RETRY"},
+		Header{Name: "CONTEXT", Wide: true},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an error entry to screen.
+func (ErrorLog) Render(o interface{}, ns string, r *Row) error {
+	e, ok := o.(ErrorRes)
+	if !ok {
+		return fmt.Errorf("expecting errorres, but got %T", o)
+	}
+
+	r.ID = e.ID
+	r.Fields = Fields{
+		e.Source,
+		e.Request,
+		e.Message,
+		strconv.Itoa(e.Count),
+		boolToStr(e.CanRetry()),
+		e.Context,
+		timeToAge(e.Last),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ErrorRes represents an error entry resource.
+type ErrorRes struct {
+	errlog.Entry
+}
+
+// GetObjectKind returns a schema object.
+func (ErrorRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (e ErrorRes) DeepCopyObject() runtime.Object {
+	return e
+}
@@ -0,0 +1,39 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/errlog"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorLogRender(t *testing.T) {
+	uu := map[string]struct {
+		e  errlog.Entry
+		ff render.Fields
+	}{
+		"retry": {
+			e:  errlog.Entry{ID: "1", Source: "api", Context: "fred", Request: "list v1/pods in default", Message: "boom", Count: 2, Retry: func() {}},
+			ff: render.Fields{"api", "list v1/pods in default", "boom", "2", "true", "fred"},
+		},
+		"flash": {
+			e:  errlog.Entry{ID: "1", Source: "flash", Message: "boom", Count: 1},
+			ff: render.Fields{"flash", "", "boom", "1", "false", ""},
+		},
+	}
+
+	var e render.ErrorLog
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.e.Last = time.Now()
+			var r render.Row
+			assert.Nil(t, e.Render(render.ErrorRes{Entry: u.e}, "", &r))
+
+			assert.Equal(t, "1", r.ID)
+			assert.Equal(t, u.ff, r.Fields[:6])
+		})
+	}
+}
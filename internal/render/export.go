@@ -0,0 +1,224 @@
+package render
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/gdamore/tcell"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// ExportTable exports a human readable table.
+	ExportTable = "table"
+	// ExportWide exports a human readable table including wide columns.
+	ExportWide = "wide"
+	// ExportCSV exports raw values as comma separated values.
+	ExportCSV = "csv"
+	// ExportJSON exports raw values and row statuses as json.
+	ExportJSON = "json"
+	// ExportYAML exports raw values and row statuses as yaml.
+	ExportYAML = "yaml"
+)
+
+const (
+	// StatusStd represents a healthy row.
+	StatusStd = "standard"
+	// StatusAdd represents an added or pending row.
+	StatusAdd = "added"
+	// StatusMod represents a modified row.
+	StatusMod = "modified"
+	// StatusErr represents an unhealthy row.
+	StatusErr = "error"
+	// StatusHighlight represents a highlighted row.
+	StatusHighlight = "highlight"
+	// StatusCompleted represents a completed row.
+	StatusCompleted = "completed"
+	// StatusKill represents a terminating row.
+	StatusKill = "terminating"
+)
+
+// statusGlyphs tracks glyphs conveying row statuses without relying on colors.
+var statusGlyphs = map[string]string{
+	StatusStd:       " ",
+	StatusAdd:       "+",
+	StatusMod:       "~",
+	StatusErr:       "!",
+	StatusHighlight: "*",
+	StatusCompleted: "=",
+	StatusKill:      "x",
+}
+
+// ExportFormats lists all supported export formats.
+var ExportFormats = []string{ExportTable, ExportWide, ExportCSV, ExportJSON, ExportYAML}
+
+// ExportData represents a json or yaml export.
+type ExportData struct {
+	Namespace string      `json:"namespace" yaml:"namespace"`
+	Header    []string    `json:"header" yaml:"header"`
+	Rows      []ExportRow `json:"rows" yaml:"rows"`
+}
+
+// ExportRow represents a json or yaml export row.
+type ExportRow struct {
+	ID      string            `json:"id" yaml:"id"`
+	Status  string            `json:"status" yaml:"status"`
+	Columns map[string]string `json:"columns" yaml:"columns"`
+}
+
+// Export writes out table data in a given format.
+func Export(w io.Writer, format string, data TableData, colorer ColorerFunc) error {
+	if colorer == nil {
+		colorer = DefaultColorer
+	}
+	switch format {
+	case ExportTable, ExportWide:
+		return exportTable(w, data, format == ExportWide)
+	case ExportCSV:
+		return exportCSV(w, data)
+	case ExportJSON:
+		return exportJSON(w, data, colorer)
+	case ExportYAML:
+		return exportYAML(w, data, colorer)
+	default:
+		return fmt.Errorf("invalid output format %q. Must be one of %s", format, strings.Join(ExportFormats, ", "))
+	}
+}
+
+// RowStatus returns a row status based on its color.
+func RowStatus(c tcell.Color) string {
+	switch c {
+	case ErrColor:
+		return StatusErr
+	case KillColor:
+		return StatusKill
+	case CompletedColor:
+		return StatusCompleted
+	case HighlightColor:
+		return StatusHighlight
+	case AddColor:
+		return StatusAdd
+	case ModColor:
+		return StatusMod
+	default:
+		return StatusStd
+	}
+}
+
+// RowGlyph returns a glyph conveying a row status based on its color.
+func RowGlyph(c tcell.Color) string {
+	return statusGlyphs[RowStatus(c)]
+}
+
+func exportTable(w io.Writer, data TableData, wide bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+	hh := exportHeader(data)
+	cols := make([]string, 0, len(hh))
+	for _, h := range hh {
+		if h.Wide && !wide {
+			continue
+		}
+		cols = append(cols, h.Name)
+	}
+	if _, err := fmt.Fprintln(tw, strings.Join(cols, "\t")); err != nil {
+		return err
+	}
+
+	for _, re := range data.RowEvents {
+		cols = cols[:0]
+		for i, f := range re.Row.Fields {
+			h := hh[i]
+			if h.Wide && !wide {
+				continue
+			}
+			if h.Decorator != nil {
+				f = h.Decorator(f)
+			}
+			cols = append(cols, f)
+		}
+		if _, err := fmt.Fprintln(tw, strings.Join(cols, "\t")); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+func exportCSV(w io.Writer, data TableData) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(exportHeader(data).Columns()); err != nil {
+		return err
+	}
+	for _, re := range data.RowEvents {
+		if err := cw.Write(re.Row.Fields); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func exportJSON(w io.Writer, data TableData, colorer ColorerFunc) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(toExportData(data, colorer))
+}
+
+func exportYAML(w io.Writer, data TableData, colorer ColorerFunc) error {
+	raw, err := yaml.Marshal(toExportData(data, colorer))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(raw)
+
+	return err
+}
+
+func toExportData(data TableData, colorer ColorerFunc) ExportData {
+	cols := exportHeader(data).Columns()
+	out := ExportData{
+		Namespace: data.Namespace,
+		Header:    cols,
+		Rows:      make([]ExportRow, 0, len(data.RowEvents)),
+	}
+	for _, re := range data.RowEvents {
+		r := ExportRow{
+			ID:      re.Row.ID,
+			Status:  RowStatus(colorer(data.Namespace, re)),
+			Columns: make(map[string]string, len(cols)),
+		}
+		for i, f := range re.Row.Fields {
+			r.Columns[cols[i]] = f
+		}
+		out.Rows = append(out.Rows, r)
+	}
+
+	return out
+}
+
+// exportHeader returns the data header, naming row fields past the header
+// after their position.
+func exportHeader(data TableData) HeaderRow {
+	n := len(data.Header)
+	for _, re := range data.RowEvents {
+		if len(re.Row.Fields) > n {
+			n = len(re.Row.Fields)
+		}
+	}
+	if n == len(data.Header) {
+		return data.Header
+	}
+	hh := make(HeaderRow, len(data.Header), n)
+	copy(hh, data.Header)
+	for i := len(data.Header); i < n; i++ {
+		hh = append(hh, Header{Name: fmt.Sprintf("COL%d", i)})
+	}
+
+	return hh
+}
@@ -0,0 +1,159 @@
+package render_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestExport(t *testing.T) {
+	uu := map[string]struct {
+		format string
+		e      string
+	}{
+		"table": {
+			format: render.ExportTable,
+			e:      "NAME   STATUS\nfred   Running\nblee   Error\n",
+		},
+		"wide": {
+			format: render.ExportWide,
+			e:      "NAME   STATUS    IP\nfred   Running   10.0.0.1\nblee   Error     10.0.0.2\n",
+		},
+		"csv": {
+			format: render.ExportCSV,
+			e:      "NAME,STATUS,IP\nfred,Running,10.0.0.1\nblee,Error,10.0.0.2\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			assert.Nil(t, render.Export(&buff, u.format, exportData(), nil))
+			assert.Equal(t, u.e, buff.String())
+		})
+	}
+}
+
+func TestExportJSON(t *testing.T) {
+	std, e := render.StdColor, render.ErrColor
+	defer func() { render.StdColor, render.ErrColor = std, e }()
+	render.StdColor, render.ErrColor = tcell.ColorWhite, tcell.ColorRed
+	colorer := func(ns string, re render.RowEvent) tcell.Color {
+		if re.Row.Fields[1] == "Error" {
+			return render.ErrColor
+		}
+		return render.StdColor
+	}
+
+	var buff bytes.Buffer
+	assert.Nil(t, render.Export(&buff, render.ExportJSON, exportData(), colorer))
+
+	var data render.ExportData
+	assert.Nil(t, json.Unmarshal(buff.Bytes(), &data))
+	assert.Equal(t, "default", data.Namespace)
+	assert.Equal(t, []string{"NAME", "STATUS", "IP"}, data.Header)
+	assert.Equal(t, 2, len(data.Rows))
+	assert.Equal(t, render.StatusStd, data.Rows[0].Status)
+	assert.Equal(t, render.StatusErr, data.Rows[1].Status)
+	assert.Equal(t, "10.0.0.2", data.Rows[1].Columns["IP"])
+}
+
+func TestExportYAML(t *testing.T) {
+	var buff bytes.Buffer
+	assert.Nil(t, render.Export(&buff, render.ExportYAML, exportData(), nil))
+
+	var data render.ExportData
+	assert.Nil(t, yaml.Unmarshal(buff.Bytes(), &data))
+	assert.Equal(t, "default", data.Namespace)
+	assert.Equal(t, []string{"NAME", "STATUS", "IP"}, data.Header)
+	assert.Equal(t, 2, len(data.Rows))
+	assert.Equal(t, "default/blee", data.Rows[1].ID)
+	assert.Equal(t, "Error", data.Rows[1].Columns["STATUS"])
+}
+
+func TestExportLongRow(t *testing.T) {
+	data := exportData()
+	data.RowEvents = append(data.RowEvents, render.NewRowEvent(render.EventUnchanged, render.Row{
+		ID:     "default/zorg",
+		Fields: render.Fields{"zorg", "Running", "10.0.0.3", "extra"},
+	}))
+
+	uu := map[string]struct {
+		format string
+		e      string
+	}{
+		"table": {
+			format: render.ExportTable,
+			e:      "NAME   STATUS   COL3\nfred   Running\nblee   Error\nzorg   Running   extra\n",
+		},
+		"csv": {
+			format: render.ExportCSV,
+			e:      "NAME,STATUS,IP,COL3\nfred,Running,10.0.0.1\nblee,Error,10.0.0.2\nzorg,Running,10.0.0.3,extra\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var buff bytes.Buffer
+			assert.Nil(t, render.Export(&buff, u.format, data, nil))
+			assert.Equal(t, u.e, buff.String())
+		})
+	}
+
+	var buff bytes.Buffer
+	assert.Nil(t, render.Export(&buff, render.ExportJSON, data, nil))
+	var out render.ExportData
+	assert.Nil(t, json.Unmarshal(buff.Bytes(), &out))
+	assert.Equal(t, []string{"NAME", "STATUS", "IP", "COL3"}, out.Header)
+	assert.Equal(t, "extra", out.Rows[2].Columns["COL3"])
+}
+
+func TestExportInvalid(t *testing.T) {
+	var buff bytes.Buffer
+	assert.NotNil(t, render.Export(&buff, "bozo", exportData(), nil))
+}
+
+// Helpers...
+
+func exportData() render.TableData {
+	return render.TableData{
+		Namespace: "default",
+		Header: render.HeaderRow{
+			render.Header{Name: "NAME"},
+			render.Header{Name: "STATUS"},
+			render.Header{Name: "IP", Wide: true},
+		},
+		RowEvents: render.RowEvents{
+			render.NewRowEvent(render.EventUnchanged, render.Row{ID: "default/fred", Fields: render.Fields{"fred", "Running", "10.0.0.1"}}),
+			render.NewRowEvent(render.EventUnchanged, render.Row{ID: "default/blee", Fields: render.Fields{"blee", "Error", "10.0.0.2"}}),
+		},
+	}
+}
+
+func TestRowGlyph(t *testing.T) {
+	std, e, add := render.StdColor, render.ErrColor, render.AddColor
+	defer func() { render.StdColor, render.ErrColor, render.AddColor = std, e, add }()
+	render.StdColor, render.ErrColor, render.AddColor = tcell.ColorWhite, tcell.ColorRed, tcell.ColorGreen
+	uu := map[string]struct {
+		c tcell.Color
+		e string
+	}{
+		"std": {c: tcell.ColorWhite, e: " "},
+		"err": {c: tcell.ColorRed, e: "!"},
+		"add": {c: tcell.ColorGreen, e: "+"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.RowGlyph(u.c))
+		})
+	}
+}
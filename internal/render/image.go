@@ -0,0 +1,121 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/cosign"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Image verification statuses.
+const (
+	// ImageSigned represents an image with a valid signature.
+	ImageSigned = "signed"
+	// ImageUnsigned represents an image lacking a valid signature.
+	ImageUnsigned = "unsigned"
+	// SBOMAttested represents an image with an SBOM attestation.
+	SBOMAttested = "attested"
+	// SBOMMissing represents an image lacking an SBOM attestation.
+	SBOMMissing = "missing"
+)
+
+// Image renders container images verifications to screen.
+type Image struct{}
+
+// ColorerFunc colors a resource row.
+func (Image) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch strings.TrimSpace(re.Row.Fields[2]) {
+		case ImageUnsigned:
+			return ErrColor
+		case string(cosign.Errored), string(cosign.Unverifiable):
+			return ModColor
+		case string(cosign.Pending):
+			return CompletedColor
+		}
+		if strings.TrimSpace(re.Row.Fields[3]) != SBOMAttested {
+			return ModColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Image) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "IMAGE"},
+		Header{Name: "PODS", Align: tview.AlignRight},
+		Header{Name: "SIGNATURE"},
+		Header{Name: "SBOM"},
+		Header{Name: "MESSAGE", Wide: true},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders an image verification to screen.
+func (Image) Render(o interface{}, ns string, r *Row) error {
+	i, ok := o.(ImageRes)
+	if !ok {
+		return fmt.Errorf("expecting imageres, but got %T", o)
+	}
+
+	age := NAValue
+	if !i.Result.CheckedAt.IsZero() {
+		age = timeToAge(i.Result.CheckedAt)
+	}
+	msg := i.Result.Signature.Message
+	if msg == "" {
+		msg = i.Result.SBOM.Message
+	}
+
+	r.ID = i.Image
+	r.Fields = Fields{
+		i.Image,
+		strconv.Itoa(len(i.Pods)),
+		checkStatus(i.Result.Signature.Status, ImageSigned, ImageUnsigned),
+		checkStatus(i.Result.SBOM.Status, SBOMAttested, SBOMMissing),
+		msg,
+		age,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func checkStatus(s cosign.Status, passed, failed string) string {
+	switch s {
+	case cosign.Passed:
+		return passed
+	case cosign.Failed:
+		return failed
+	case "":
+		return NAValue
+	default:
+		return string(s)
+	}
+}
+
+// ImageRes represents a container image run by pods.
+type ImageRes struct {
+	Image  string        `json:"image" yaml:"image"`
+	Pods   []string      `json:"pods" yaml:"pods"`
+	Result cosign.Result `json:"verification" yaml:"verification"`
+}
+
+// GetObjectKind returns a schema object.
+func (ImageRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (i ImageRes) DeepCopyObject() runtime.Object {
+	return i
+}
@@ -0,0 +1,66 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/cosign"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.ImageRes
+		e   render.Fields
+	}{
+		"pending": {
+			res: render.ImageRes{
+				Image:  "nginx@sha256:abc",
+				Pods:   []string{"default/fred"},
+				Result: cosign.Result{Signature: cosign.Check{Status: cosign.Pending}, SBOM: cosign.Check{Status: cosign.Pending}},
+			},
+			e: render.Fields{"nginx@sha256:abc", "1", "pending", "pending", "", "n/a"},
+		},
+		"unsigned": {
+			res: render.ImageRes{
+				Image: "nginx@sha256:abc",
+				Pods:  []string{"default/fred", "default/blee"},
+				Result: cosign.Result{
+					Signature: cosign.Check{Status: cosign.Failed, Message: "no matching signatures"},
+					SBOM:      cosign.Check{Status: cosign.Failed, Message: "no SBOM attestation found"},
+				},
+			},
+			e: render.Fields{"nginx@sha256:abc", "2", "unsigned", "missing", "no matching signatures", "n/a"},
+		},
+		"signed": {
+			res: render.ImageRes{
+				Image: "nginx@sha256:abc",
+				Result: cosign.Result{
+					Signature: cosign.Check{Status: cosign.Passed},
+					SBOM:      cosign.Check{Status: cosign.Errored, Message: "signal: killed"},
+				},
+			},
+			e: render.Fields{"nginx@sha256:abc", "0", "signed", "error", "signal: killed", "n/a"},
+		},
+		"unverifiable": {
+			res: render.ImageRes{
+				Image: "nginx@sha256:abc",
+				Result: cosign.Result{
+					Signature: cosign.Check{Status: cosign.Unverifiable, Message: "image is not pinned to a digest"},
+					SBOM:      cosign.Check{Status: cosign.Unverifiable, Message: "image is not pinned to a digest"},
+				},
+			},
+			e: render.Fields{"nginx@sha256:abc", "0", "unverifiable", "unverifiable", "image is not pinned to a digest", "n/a"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, render.Image{}.Render(u.res, "", &r))
+			assert.Equal(t, "nginx@sha256:abc", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -34,6 +34,7 @@ func (Job) Header(ns string) HeaderRow {
 		Header{Name: "NAME"},
 		Header{Name: "COMPLETIONS"},
 		Header{Name: "DURATION"},
+		Header{Name: "PSA"},
 		Header{Name: "SELECTOR", Wide: true},
 		Header{Name: "CONTAINERS", Wide: true},
 		Header{Name: "IMAGES", Wide: true},
@@ -65,6 +66,7 @@ func (j Job) Render(o interface{}, ns string, r *Row) error {
 		job.Name,
 		ready,
 		toDuration(job.Status),
+		psaLevel(job.Spec.Template, "spec.template"),
 		jobSelector(job.Spec),
 		cc,
 		ii,
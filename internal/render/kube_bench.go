@@ -0,0 +1,149 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/cis"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// KubeBench renders kube-bench CIS benchmark sections to screen.
+type KubeBench struct{}
+
+// ColorerFunc colors a resource row.
+func (KubeBench) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if strings.TrimSpace(re.Row.Fields[4]) != "0" {
+			return ErrColor
+		}
+		if strings.TrimSpace(re.Row.Fields[5]) != "0" {
+			return ModColor
+		}
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (KubeBench) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "SECTION"},
+		Header{Name: "DESCRIPTION"},
+		Header{Name: "TARGET"},
+		Header{Name: "PASS", Align: tview.AlignRight},
+		Header{Name: "FAIL", Align: tview.AlignRight},
+		Header{Name: "WARN", Align: tview.AlignRight},
+		Header{Name: "INFO", Align: tview.AlignRight},
+		Header{Name: "CONTROLS", Wide: true},
+	}
+}
+
+// Render renders a benchmark section to screen.
+func (KubeBench) Render(o interface{}, ns string, r *Row) error {
+	k, ok := o.(KubeBenchRes)
+	if !ok {
+		return fmt.Errorf("expecting kubebenchres, but got %T", o)
+	}
+
+	r.ID = k.Group.ID
+	r.Fields = Fields{
+		k.Group.ID,
+		k.Group.Text,
+		k.NodeType,
+		strconv.Itoa(k.Group.Pass),
+		strconv.Itoa(k.Group.Fail),
+		strconv.Itoa(k.Group.Warn),
+		strconv.Itoa(k.Group.Info),
+		k.Controls,
+	}
+
+	return nil
+}
+
+// KubeBenchCheck renders kube-bench CIS benchmark checks to screen.
+type KubeBenchCheck struct{}
+
+// ColorerFunc colors a resource row.
+func (KubeBenchCheck) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch strings.TrimSpace(re.Row.Fields[1]) {
+		case cis.StatusFail:
+			return ErrColor
+		case cis.StatusWarn:
+			return ModColor
+		case cis.StatusInfo:
+			return CompletedColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (KubeBenchCheck) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "CHECK"},
+		Header{Name: "STATUS"},
+		Header{Name: "SCORED"},
+		Header{Name: "DESCRIPTION"},
+		Header{Name: "REMEDIATION", Wide: true},
+	}
+}
+
+// Render renders a benchmark check to screen.
+func (KubeBenchCheck) Render(o interface{}, ns string, r *Row) error {
+	k, ok := o.(KubeBenchCheckRes)
+	if !ok {
+		return fmt.Errorf("expecting kubebenchcheckres, but got %T", o)
+	}
+
+	r.ID = k.Check.ID
+	r.Fields = Fields{
+		k.Check.ID,
+		k.Check.Status,
+		boolToStr(k.Check.Scored),
+		k.Check.Text,
+		Truncate(strings.Join(strings.Fields(k.Check.Remediation), " "), 80),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// KubeBenchRes represents a kube-bench section resource.
+type KubeBenchRes struct {
+	Controls string
+	NodeType string
+	Group    cis.Group
+}
+
+// GetObjectKind returns a schema object.
+func (KubeBenchRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (k KubeBenchRes) DeepCopyObject() runtime.Object {
+	return k
+}
+
+// KubeBenchCheckRes represents a kube-bench check resource.
+type KubeBenchCheckRes struct {
+	Check cis.Check
+}
+
+// GetObjectKind returns a schema object.
+func (KubeBenchCheckRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (k KubeBenchCheckRes) DeepCopyObject() runtime.Object {
+	return k
+}
@@ -0,0 +1,49 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/cis"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubeBenchRender(t *testing.T) {
+	var (
+		k render.KubeBench
+		r render.Row
+	)
+	res := render.KubeBenchRes{
+		Controls: "Master Node Security Configuration",
+		NodeType: "master",
+		Group:    cis.Group{ID: "1.1", Text: "Master Node Configuration Files", Pass: 15, Fail: 1, Warn: 5},
+	}
+	assert.Nil(t, k.Render(res, "", &r))
+
+	assert.Equal(t, "1.1", r.ID)
+	assert.Equal(t, render.Fields{"1.1", "Master Node Configuration Files", "master", "15", "1", "5", "0", "Master Node Security Configuration"}, r.Fields)
+}
+
+func TestKubeBenchCheckRender(t *testing.T) {
+	var (
+		k render.KubeBenchCheck
+		r render.Row
+	)
+	res := render.KubeBenchCheckRes{Check: cis.Check{
+		ID:          "1.1.2",
+		Text:        "Ensure that the API server pod specification file ownership is set to root:root",
+		Status:      cis.StatusFail,
+		Scored:      true,
+		Remediation: "Run the below command on the master node.\n  chown root:root /etc/kubernetes/manifests/kube-apiserver.yaml",
+	}}
+	assert.Nil(t, k.Render(res, "", &r))
+
+	assert.Equal(t, "1.1.2", r.ID)
+	assert.Equal(t, render.Fields{
+		"1.1.2",
+		"FAIL",
+		"true",
+		"Ensure that the API server pod specification file ownership is set to root:root",
+		"Run the below command on the master node. chown root:root /etc/kubernetes/manif…",
+	}, r.Fields)
+}
@@ -0,0 +1,91 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ClusterWide represents the namespace of permissions granted cluster wide.
+const ClusterWide = "*"
+
+// Permission renders the effective permissions of a subject to screen.
+type Permission struct{}
+
+// ColorerFunc colors a resource row.
+func (Permission) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		if strings.TrimSpace(re.Row.Fields[0]) == ClusterWide {
+			return ModColor
+		}
+		return tcell.ColorMediumSpringGreen
+	}
+}
+
+// Header returns a header row.
+func (Permission) Header(ns string) HeaderRow {
+	h := HeaderRow{
+		Header{Name: "NAMESPACE"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "API GROUP"},
+		Header{Name: "NAMES"},
+	}
+	h = append(h, rbacVerbHeader()...)
+	h = append(h, Header{Name: "BINDINGS", Wide: true})
+
+	return h
+}
+
+// Render renders a permission to screen.
+func (Permission) Render(o interface{}, ns string, r *Row) error {
+	p, ok := o.(PermissionRes)
+	if !ok {
+		return fmt.Errorf("expecting PermissionRes but got %T", o)
+	}
+
+	r.ID = p.ID()
+	r.Fields = append(r.Fields,
+		p.Namespace,
+		p.Resource,
+		p.Group,
+		strings.Join(p.Names, ","),
+	)
+	r.Fields = append(r.Fields, asVerbs(p.Verbs)...)
+	r.Fields = append(r.Fields, strings.Join(p.Bindings, ","))
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PermissionRes represents a permission granted to a subject.
+type PermissionRes struct {
+	Namespace string
+	Group     string
+	Resource  string
+	Names     []string
+	Verbs     []string
+	Bindings  []string
+}
+
+// ID returns the permission row id.
+func (p PermissionRes) ID() string {
+	return strings.Join([]string{p.Namespace, p.Group, p.Resource, strings.Join(p.Names, ",")}, "|")
+}
+
+// GetObjectKind returns a schema object.
+func (PermissionRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PermissionRes) DeepCopyObject() runtime.Object {
+	return p
+}
+
+// Permissions represents a collection of permissions.
+type Permissions []PermissionRes
@@ -69,6 +69,7 @@ func (Pod) Header(ns string) HeaderRow {
 		Header{Name: "%MEM/R", Align: tview.AlignRight},
 		Header{Name: "%CPU/L", Align: tview.AlignRight},
 		Header{Name: "%MEM/L", Align: tview.AlignRight},
+		Header{Name: "PSA"},
 		Header{Name: "IP", Wide: true},
 		Header{Name: "NODE", Wide: true},
 		Header{Name: "QOS", Wide: true},
@@ -111,6 +112,7 @@ func (p Pod) Render(o interface{}, ns string, r *Row) error {
 		perc.mem,
 		perc.cpuLim,
 		perc.memLim,
+		psaLevel(v1.PodTemplateSpec{ObjectMeta: po.ObjectMeta, Spec: po.Spec}, ""),
 		na(po.Status.PodIP),
 		na(po.Spec.NodeName),
 		p.mapQOS(po.Status.QOSClass),
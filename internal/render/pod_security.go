@@ -0,0 +1,101 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/pss"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PodSecurity renders workloads Pod Security Standards evaluations to screen.
+type PodSecurity struct{}
+
+// ColorerFunc colors a resource row.
+func (PodSecurity) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch pss.Level(strings.TrimSpace(re.Row.Fields[3])) {
+		case pss.Privileged:
+			return ErrColor
+		case pss.Baseline:
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (PodSecurity) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "RESOURCE"},
+		Header{Name: "LEVEL"},
+		Header{Name: "BASELINE", Align: tview.AlignRight},
+		Header{Name: "RESTRICTED", Align: tview.AlignRight},
+	}
+}
+
+// Render renders a workload evaluation to screen.
+func (PodSecurity) Render(o interface{}, ns string, r *Row) error {
+	p, ok := o.(PodSecurityRes)
+	if !ok {
+		return fmt.Errorf("expecting podsecurityres, but got %T", o)
+	}
+
+	pns, n := client.Namespaced(p.Path)
+	r.ID = p.ID()
+	r.Fields = Fields{
+		pns,
+		n,
+		client.NewGVR(p.GVR).R(),
+		string(p.Violations.Allowed()),
+		strconv.Itoa(p.Violations.Count(pss.Baseline)),
+		strconv.Itoa(p.Violations.Count(pss.Restricted)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// psaLevel returns the most restrictive Pod Security Standards level a pod
+// template satisfies.
+func psaLevel(tpl v1.PodTemplateSpec, prefix string) string {
+	return string(pss.Evaluate(tpl, prefix).Allowed())
+}
+
+// PodSecurityRes represents a workload Pod Security Standards evaluation.
+type PodSecurityRes struct {
+	GVR        string
+	Path       string
+	Violations pss.Violations
+}
+
+// ID returns the evaluation row id.
+func (p PodSecurityRes) ID() string {
+	return p.GVR + diffIDSep + p.Path
+}
+
+// ParsePodSecurityID returns the resource and path of an evaluation row.
+func ParsePodSecurityID(id string) (string, string) {
+	return ParseDiffID(id)
+}
+
+// GetObjectKind returns a schema object.
+func (PodSecurityRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PodSecurityRes) DeepCopyObject() runtime.Object {
+	return p
+}
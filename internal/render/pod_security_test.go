@@ -0,0 +1,57 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/pss"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodSecurityRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.PodSecurityRes
+		e   render.Fields
+	}{
+		"restricted": {
+			res: render.PodSecurityRes{GVR: "apps/v1/deployments", Path: "default/fred"},
+			e:   render.Fields{"default", "fred", "deployments", "restricted", "0", "0"},
+		},
+		"baseline": {
+			res: render.PodSecurityRes{
+				GVR:  "v1/pods",
+				Path: "default/blee",
+				Violations: pss.Violations{
+					{Level: pss.Restricted, Check: "runAsNonRoot"},
+					{Level: pss.Restricted, Check: "allowPrivilegeEscalation"},
+				},
+			},
+			e: render.Fields{"default", "blee", "pods", "baseline", "0", "2"},
+		},
+		"privileged": {
+			res: render.PodSecurityRes{
+				GVR:  "apps/v1/daemonsets",
+				Path: "kube-system/zorg",
+				Violations: pss.Violations{
+					{Level: pss.Baseline, Check: "hostNetwork"},
+					{Level: pss.Restricted, Check: "runAsNonRoot"},
+				},
+			},
+			e: render.Fields{"kube-system", "zorg", "daemonsets", "privileged", "1", "1"},
+		},
+	}
+
+	var p render.PodSecurity
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, p.Render(u.res, "", &r))
+			assert.Equal(t, u.e, r.Fields)
+
+			gvr, path := render.ParsePodSecurityID(r.ID)
+			assert.Equal(t, u.res.GVR, gvr)
+			assert.Equal(t, u.res.Path, path)
+		})
+	}
+}
@@ -70,8 +70,8 @@ func TestPodRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "1/1", "0", "Running", "10", "10", "10", "14", "0", "5", "172.17.0.6", "minikube", "BE"}
-	assert.Equal(t, e, r.Fields[:14])
+	e := render.Fields{"default", "nginx", "1/1", "0", "Running", "10", "10", "10", "14", "0", "5", "baseline", "172.17.0.6", "minikube", "BE"}
+	assert.Equal(t, e, r.Fields[:15])
 }
 
 func BenchmarkPodRender(b *testing.B) {
@@ -101,8 +101,8 @@ func TestPodInitRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "1/1", "0", "Init:0/1", "10", "10", "10", "14", "0", "5", "172.17.0.6", "minikube", "BE"}
-	assert.Equal(t, e, r.Fields[:14])
+	e := render.Fields{"default", "nginx", "1/1", "0", "Init:0/1", "10", "10", "10", "14", "0", "5", "baseline", "172.17.0.6", "minikube", "BE"}
+	assert.Equal(t, e, r.Fields[:15])
 }
 
 // ----------------------------------------------------------------------------
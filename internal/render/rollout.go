@@ -0,0 +1,281 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Rollout phases.
+const (
+	// RolloutHealthy represents a fully promoted rollout.
+	RolloutHealthy = "Healthy"
+	// RolloutProgressing represents a rollout underway.
+	RolloutProgressing = "Progressing"
+	// RolloutPaused represents a rollout waiting to be promoted.
+	RolloutPaused = "Paused"
+	// RolloutDegraded represents an aborted or failed rollout.
+	RolloutDegraded = "Degraded"
+)
+
+// Rollout strategies.
+const (
+	RolloutCanary    = "Canary"
+	RolloutBlueGreen = "BlueGreen"
+)
+
+// Rollout renders an Argo rollout to screen.
+type Rollout struct{}
+
+// ColorerFunc colors a resource row.
+func (r Rollout) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		if re.Kind == EventAdd || re.Kind == EventUpdate {
+			return c
+		}
+		if !Happy(ns, re.Row) {
+			return ErrColor
+		}
+		if strings.TrimSpace(re.Row.Fields[r.statusCol(ns)]) == RolloutPaused {
+			return CompletedColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Rollout) Header(ns string) HeaderRow {
+	var h HeaderRow
+	if client.IsAllNamespaces(ns) {
+		h = append(h, Header{Name: "NAMESPACE"})
+	}
+
+	return append(h,
+		Header{Name: "NAME"},
+		Header{Name: "STRATEGY"},
+		Header{Name: "STATUS"},
+		Header{Name: "STEP", Align: tview.AlignRight},
+		Header{Name: "SET-WEIGHT", Align: tview.AlignRight},
+		Header{Name: "ACTUAL-WEIGHT", Align: tview.AlignRight},
+		Header{Name: "DESIRED", Align: tview.AlignRight},
+		Header{Name: "CURRENT", Align: tview.AlignRight},
+		Header{Name: "UP-TO-DATE", Align: tview.AlignRight},
+		Header{Name: "AVAILABLE", Align: tview.AlignRight},
+		Header{Name: "MESSAGE", Wide: true},
+		Header{Name: "VALID", Wide: true},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	)
+}
+
+// Render renders a K8s resource to screen.
+func (r Rollout) Render(o interface{}, ns string, row *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Rollout, but got %T", o)
+	}
+	s := NewRolloutStatus(raw.Object)
+
+	row.ID = client.FQN(raw.GetNamespace(), raw.GetName())
+	row.Fields = make(Fields, 0, len(r.Header(ns)))
+	if client.IsAllNamespaces(ns) {
+		row.Fields = append(row.Fields, raw.GetNamespace())
+	}
+	step, setWeight, actualWeight := NAValue, NAValue, NAValue
+	if s.Strategy == RolloutCanary {
+		step = strconv.Itoa(s.Step) + "/" + strconv.Itoa(len(s.Steps))
+		setWeight = strconv.Itoa(int(s.SetWeight))
+		actualWeight = strconv.Itoa(int(s.ActualWeight))
+	}
+	row.Fields = append(row.Fields,
+		raw.GetName(),
+		na(s.Strategy),
+		s.Phase,
+		step,
+		setWeight,
+		actualWeight,
+		strconv.Itoa(int(s.Desired)),
+		strconv.Itoa(int(s.Current)),
+		strconv.Itoa(int(s.Updated)),
+		strconv.Itoa(int(s.Available)),
+		s.Message,
+		asStatus(s.diagnose()),
+		toAge(raw.GetCreationTimestamp()),
+	)
+
+	return nil
+}
+
+func (Rollout) statusCol(ns string) int {
+	if client.IsAllNamespaces(ns) {
+		return 3
+	}
+
+	return 2
+}
+
+// ----------------------------------------------------------------------------
+
+// RolloutStatus represents the progress of an Argo rollout.
+type RolloutStatus struct {
+	Strategy                             string
+	Phase                                string
+	Message                              string
+	Paused, Aborted                      bool
+	Step                                 int
+	Steps                                []string
+	SetWeight, ActualWeight              int64
+	Desired, Current, Updated, Available int64
+}
+
+// NewRolloutStatus computes the status of an Argo rollout.
+func NewRolloutStatus(o map[string]interface{}) RolloutStatus {
+	var s RolloutStatus
+	if _, ok, _ := unstructured.NestedMap(o, "spec", "strategy", "canary"); ok {
+		s.Strategy = RolloutCanary
+	} else if _, ok, _ := unstructured.NestedMap(o, "spec", "strategy", "blueGreen"); ok {
+		s.Strategy = RolloutBlueGreen
+	}
+
+	s.Desired = 1
+	if v, ok := nestedInt(o, "spec", "replicas"); ok {
+		s.Desired = v
+	}
+	s.Current, _ = nestedInt(o, "status", "replicas")
+	s.Updated, _ = nestedInt(o, "status", "updatedReplicas")
+	s.Available, _ = nestedInt(o, "status", "availableReplicas")
+
+	s.Aborted, _, _ = unstructured.NestedBool(o, "status", "abort")
+	s.Paused, _, _ = unstructured.NestedBool(o, "spec", "paused")
+	if cc, _, _ := unstructured.NestedSlice(o, "status", "pauseConditions"); len(cc) > 0 {
+		s.Paused = true
+	}
+	s.Message, _, _ = unstructured.NestedString(o, "status", "message")
+
+	if s.Strategy == RolloutCanary {
+		steps, _, _ := unstructured.NestedSlice(o, "spec", "strategy", "canary", "steps")
+		s.Steps = make([]string, 0, len(steps))
+		for _, st := range steps {
+			s.Steps = append(s.Steps, rolloutStep(st))
+		}
+		s.Step = len(steps)
+		if i, ok := nestedInt(o, "status", "currentStepIndex"); ok && int(i) < len(steps) {
+			s.Step = int(i)
+		}
+		s.SetWeight = canaryWeight(steps, s.Step)
+		s.ActualWeight = s.SetWeight
+		if w, ok := nestedInt(o, "status", "canary", "weights", "canary", "weight"); ok {
+			s.ActualWeight = w
+		} else if s.Current > 0 {
+			s.ActualWeight = s.Updated * 100 / s.Current
+		}
+	}
+
+	s.Phase, _, _ = unstructured.NestedString(o, "status", "phase")
+	if s.Phase == "" {
+		s.Phase = s.phase()
+	}
+
+	return s
+}
+
+// IsCompleted checks if all canary steps were carried out.
+func (s RolloutStatus) IsCompleted() bool {
+	return s.Step >= len(s.Steps)
+}
+
+func (s RolloutStatus) phase() string {
+	switch {
+	case s.Aborted:
+		return RolloutDegraded
+	case s.Paused:
+		return RolloutPaused
+	case s.IsCompleted() && s.Updated == s.Desired && s.Available == s.Desired && s.Current == s.Desired:
+		return RolloutHealthy
+	default:
+		return RolloutProgressing
+	}
+}
+
+func (s RolloutStatus) diagnose() error {
+	if s.Aborted {
+		return fmt.Errorf("rollout aborted")
+	}
+	if s.Phase == RolloutDegraded {
+		return fmt.Errorf("rollout degraded")
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// canaryWeight returns the traffic weight set by the canary steps prior to the
+// current step. Completed rollouts send all traffic to the new version.
+func canaryWeight(steps []interface{}, step int) int64 {
+	if step >= len(steps) {
+		return 100
+	}
+	var w int64
+	for _, st := range steps[:step] {
+		m, _ := st.(map[string]interface{})
+		if v, ok := nestedInt(m, "setWeight"); ok {
+			w = v
+		}
+	}
+
+	return w
+}
+
+// rolloutStep describes a canary step, ie setWeight: 20 or pause: 1h.
+func rolloutStep(st interface{}) string {
+	m, _ := st.(map[string]interface{})
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		switch v := m[k].(type) {
+		case map[string]interface{}:
+			if d, ok := v["duration"]; ok {
+				ss = append(ss, fmt.Sprintf("%s: %v", k, d))
+				continue
+			}
+			ss = append(ss, k)
+		case []interface{}:
+			ss = append(ss, k)
+		default:
+			ss = append(ss, fmt.Sprintf("%s: %v", k, v))
+		}
+	}
+
+	return strings.Join(ss, ", ")
+}
+
+func nestedInt(o map[string]interface{}, fields ...string) (int64, bool) {
+	v, ok, _ := unstructured.NestedFieldNoCopy(o, fields...)
+	if !ok {
+		return 0, false
+	}
+	switch i := v.(type) {
+	case int64:
+		return i, true
+	case int:
+		return int64(i), true
+	case float64:
+		return int64(i), true
+	default:
+		return 0, false
+	}
+}
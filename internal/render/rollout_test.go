@@ -0,0 +1,103 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutRender(t *testing.T) {
+	c := render.Rollout{}
+	r := render.NewRow(14)
+
+	assert.Nil(t, c.Render(load(t, "rollout"), "", &r))
+	assert.Equal(t, "default/fred", r.ID)
+	assert.Equal(t, render.Fields{"default", "fred", "Canary", "Paused", "1/4", "25", "25", "4", "4", "1", "4"}, r.Fields[:11])
+}
+
+func TestNewRolloutStatus(t *testing.T) {
+	canary := func(status map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": int64(2),
+				"strategy": map[string]interface{}{
+					"canary": map[string]interface{}{
+						"steps": []interface{}{
+							map[string]interface{}{"setWeight": int64(20)},
+							map[string]interface{}{"pause": map[string]interface{}{"duration": "1h"}},
+							map[string]interface{}{"analysis": map[string]interface{}{"templates": []interface{}{}}},
+						},
+					},
+				},
+			},
+			"status": status,
+		}
+	}
+
+	uu := map[string]struct {
+		o                 map[string]interface{}
+		strategy, phase   string
+		step              int
+		set, actual       int64
+		paused, completed bool
+	}{
+		"blueGreen": {
+			o: map[string]interface{}{
+				"spec":   map[string]interface{}{"strategy": map[string]interface{}{"blueGreen": map[string]interface{}{}}},
+				"status": map[string]interface{}{"replicas": int64(1), "updatedReplicas": int64(1), "availableReplicas": int64(1)},
+			},
+			strategy:  render.RolloutBlueGreen,
+			phase:     render.RolloutHealthy,
+			completed: true,
+		},
+		"progressing": {
+			o:        canary(map[string]interface{}{"currentStepIndex": int64(1), "replicas": int64(2), "updatedReplicas": int64(1)}),
+			strategy: render.RolloutCanary,
+			phase:    render.RolloutProgressing,
+			step:     1,
+			set:      20,
+			actual:   50,
+		},
+		"paused": {
+			o:        canary(map[string]interface{}{"currentStepIndex": int64(1), "pauseConditions": []interface{}{map[string]interface{}{}}}),
+			strategy: render.RolloutCanary,
+			phase:    render.RolloutPaused,
+			step:     1,
+			set:      20,
+			actual:   20,
+			paused:   true,
+		},
+		"aborted": {
+			o:        canary(map[string]interface{}{"currentStepIndex": int64(2), "abort": true, "phase": render.RolloutDegraded}),
+			strategy: render.RolloutCanary,
+			phase:    render.RolloutDegraded,
+			step:     2,
+			set:      20,
+			actual:   20,
+		},
+		"done": {
+			o:         canary(map[string]interface{}{"currentStepIndex": int64(3), "replicas": int64(2), "updatedReplicas": int64(2), "availableReplicas": int64(2)}),
+			strategy:  render.RolloutCanary,
+			phase:     render.RolloutHealthy,
+			step:      3,
+			set:       100,
+			actual:    100,
+			completed: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := render.NewRolloutStatus(u.o)
+			assert.Equal(t, u.strategy, s.Strategy)
+			assert.Equal(t, u.phase, s.Phase)
+			assert.Equal(t, u.step, s.Step)
+			assert.Equal(t, u.set, s.SetWeight)
+			assert.Equal(t, u.actual, s.ActualWeight)
+			assert.Equal(t, u.paused, s.Paused)
+			assert.Equal(t, u.completed, s.IsCompleted())
+		})
+	}
+}
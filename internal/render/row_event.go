@@ -1,12 +1,10 @@
 package render
 
 import (
-	"fmt"
 	"reflect"
 	"sort"
 	"time"
 
-	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
@@ -154,28 +152,30 @@ func (rr RowEvents) Sort(ns string, col int, asc bool) {
 	t := RowEventSorter{NS: ns, Events: rr, Index: col, Asc: asc}
 	sort.Sort(t)
 
+	// Rows sharing a value are listed by id.
 	ageCol := rr.isAgeCol(col)
-	gg, kk := map[string][]string{}, make(StringSet, 0, len(rr))
-	for _, r := range rr {
+	ranks := make(map[string]int, len(rr))
+	groups := make([]int, len(rr))
+	for i, r := range rr {
 		g := r.Row.Fields[col]
 		if ageCol {
 			g = toAgeDuration(g)
 		}
-		kk = kk.Add(g)
-		if ss, ok := gg[g]; ok {
-			gg[g] = append(ss, r.Row.ID)
-		} else {
-			gg[g] = []string{r.Row.ID}
+		rank, ok := ranks[g]
+		if !ok {
+			rank = len(ranks)
+			ranks[g] = rank
 		}
+		groups[i] = rank
 	}
+	sort.Sort(groupSorter{events: rr, groups: groups})
+}
 
-	ids := make([]string, 0, len(rr))
-	for _, k := range kk {
-		sort.StringSlice(gg[k]).Sort()
-		ids = append(ids, gg[k]...)
-	}
-	s := IdSorter{Ids: ids, Events: rr}
-	sort.Sort(s)
+// Pin moves the pinned rows ahead of the others, preserving the sort order.
+func (rr RowEvents) Pin(pinned func(RowEvent) bool) {
+	sort.SliceStable(rr, func(i, j int) bool {
+		return pinned(rr[i]) && !pinned(rr[j])
+	})
 }
 
 func toAgeDuration(dur string) string {
@@ -211,59 +211,25 @@ func (r RowEventSorter) Less(i, j int) bool {
 
 // ----------------------------------------------------------------------------
 
-// IdSorter sorts row events by a given id.
-type IdSorter struct {
-	Ids    []string
-	Events RowEvents
+// groupSorter sorts row events by group and id.
+type groupSorter struct {
+	events RowEvents
+	groups []int
 }
 
-func (s IdSorter) Len() int {
-	return len(s.Events)
+func (s groupSorter) Len() int {
+	return len(s.events)
 }
 
-func (s IdSorter) Swap(i, j int) {
-	s.Events[i], s.Events[j] = s.Events[j], s.Events[i]
-}
-
-func (s IdSorter) Less(i, j int) bool {
-	id1, id2 := s.Events[i].Row.ID, s.Events[j].Row.ID
-	i1, i2 := findIndex(s.Ids, id1), findIndex(s.Ids, id2)
-	return i1 < i2
-}
-
-func findIndex(ss []string, s string) int {
-	for i := range ss {
-		if ss[i] == s {
-			return i
-		}
-	}
-	log.Error().Err(fmt.Errorf("Doh! index not found for %s", s))
-	return -1
+func (s groupSorter) Swap(i, j int) {
+	s.events[i], s.events[j] = s.events[j], s.events[i]
+	s.groups[i], s.groups[j] = s.groups[j], s.groups[i]
 }
 
-// ----------------------------------------------------------------------------
-
-// StringSet represents a collection of unique strings.
-type StringSet []string
-
-// Add adds a new item in the set.
-func (ss StringSet) Add(item string) StringSet {
-	if ss.In(item) {
-		return ss
+func (s groupSorter) Less(i, j int) bool {
+	if s.groups[i] != s.groups[j] {
+		return s.groups[i] < s.groups[j]
 	}
-	return append(ss, item)
-}
 
-// In checks if a string is in the set.
-func (ss StringSet) In(item string) bool {
-	return ss.indexOf(item) >= 0
-}
-
-func (ss StringSet) indexOf(item string) int {
-	for i, s := range ss {
-		if s == item {
-			return i
-		}
-	}
-	return -1
+	return s.events[i].Row.ID < s.events[j].Row.ID
 }
@@ -112,6 +112,24 @@ func TestSort(t *testing.T) {
 	}
 }
 
+func TestPin(t *testing.T) {
+	re := render.RowEvents{
+		{Row: render.Row{ID: "A", Fields: render.Fields{"A"}}},
+		{Row: render.Row{ID: "B", Fields: render.Fields{"B"}}},
+		{Row: render.Row{ID: "C", Fields: render.Fields{"C"}}},
+		{Row: render.Row{ID: "D", Fields: render.Fields{"D"}}},
+	}
+	re.Pin(func(r render.RowEvent) bool {
+		return r.Row.ID == "D" || r.Row.ID == "B"
+	})
+
+	ids := make([]string, 0, len(re))
+	for _, r := range re {
+		ids = append(ids, r.Row.ID)
+	}
+	assert.Equal(t, []string{"B", "D", "A", "C"}, ids)
+}
+
 func TestDefaultColorer(t *testing.T) {
 	uu := map[string]struct {
 		k render.ResEvent
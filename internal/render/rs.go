@@ -43,6 +43,7 @@ func (ReplicaSet) Header(ns string) HeaderRow {
 		Header{Name: "DESIRED", Align: tview.AlignRight},
 		Header{Name: "CURRENT", Align: tview.AlignRight},
 		Header{Name: "READY", Align: tview.AlignRight},
+		Header{Name: "PSA"},
 		Header{Name: "LABELS", Wide: true},
 		Header{Name: "VALID", Wide: true},
 		Header{Name: "AGE", Decorator: AgeDecorator},
@@ -71,6 +72,7 @@ func (r ReplicaSet) Render(o interface{}, ns string, row *Row) error {
 		strconv.Itoa(int(*rs.Spec.Replicas)),
 		strconv.Itoa(int(rs.Status.Replicas)),
 		strconv.Itoa(int(rs.Status.ReadyReplicas)),
+		psaLevel(rs.Spec.Template, "spec.template"),
 		mapToStr(rs.Labels),
 		asStatus(r.diagnose(rs)),
 		toAge(rs.ObjectMeta.CreationTimestamp),
@@ -41,6 +41,7 @@ func (StatefulSet) Header(ns string) HeaderRow {
 		Header{Name: "READY"},
 		Header{Name: "SELECTOR", Wide: true},
 		Header{Name: "SERVICE"},
+		Header{Name: "PSA"},
 		Header{Name: "CONTAINERS", Wide: true},
 		Header{Name: "IMAGES", Wide: true},
 		Header{Name: "LABELS", Wide: true},
@@ -71,6 +72,7 @@ func (s StatefulSet) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(int(sts.Status.ReadyReplicas))+"/"+strconv.Itoa(int(sts.Status.Replicas)),
 		asSelector(sts.Spec.Selector),
 		na(sts.Spec.ServiceName),
+		psaLevel(sts.Spec.Template, "spec.template"),
 		podContainerNames(sts.Spec.Template.Spec, true),
 		podImageNames(sts.Spec.Template.Spec, true),
 		mapToStr(sts.Labels),
@@ -35,6 +35,10 @@ func (t *TableData) SetHeader(ns string, h HeaderRow) {
 func (t *TableData) Update(rows Rows) {
 	empty := len(t.RowEvents) == 0
 	kk := make(map[string]struct{}, len(rows))
+	index := make(map[string]int, len(t.RowEvents))
+	for i, re := range t.RowEvents {
+		index[re.Row.ID] = i
+	}
 	var blankDelta DeltaRow
 	for _, row := range rows {
 		kk[row.ID] = struct{}{}
@@ -43,16 +47,16 @@ func (t *TableData) Update(rows Rows) {
 			continue
 		}
 
-		if index, ok := t.RowEvents.FindIndex(row.ID); ok {
-			delta := NewDeltaRow(t.RowEvents[index].Row, row, t.Header.HasAge())
-			if delta.IsBlank() {
-				t.RowEvents[index].Kind, t.RowEvents[index].Deltas = EventUnchanged, blankDelta
-				t.RowEvents[index].Row = row
+		if i, ok := index[row.ID]; ok {
+			if !HasDelta(t.RowEvents[i].Row, row, t.Header.HasAge()) {
+				t.RowEvents[i].Kind, t.RowEvents[i].Deltas = EventUnchanged, blankDelta
+				t.RowEvents[i].Row = row
 			} else {
-				t.RowEvents[index] = NewDeltaRowEvent(row, delta)
+				t.RowEvents[i] = NewDeltaRowEvent(row, NewDeltaRow(t.RowEvents[i].Row, row, t.Header.HasAge()))
 			}
 			continue
 		}
+		index[row.ID] = len(t.RowEvents)
 		t.RowEvents = append(t.RowEvents, NewRowEvent(EventAdd, row))
 	}
 
@@ -63,16 +67,17 @@ func (t *TableData) Update(rows Rows) {
 
 // Delete removes items in cache that are no longer valid.
 func (t *TableData) Delete(newKeys map[string]struct{}) {
-	var victims []string
+	rr := t.RowEvents[:0]
 	for _, re := range t.RowEvents {
-		if _, ok := newKeys[re.Row.ID]; !ok {
-			victims = append(victims, re.Row.ID)
+		if _, ok := newKeys[re.Row.ID]; ok {
+			rr = append(rr, re)
 		}
 	}
-
-	for _, id := range victims {
-		t.RowEvents = t.RowEvents.Delete(id)
+	// Let go of deleted rows.
+	for i := len(rr); i < len(t.RowEvents); i++ {
+		t.RowEvents[i] = RowEvent{}
 	}
+	t.RowEvents = rr
 }
 
 // Diff checks if two tables are equal.
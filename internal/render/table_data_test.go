@@ -49,5 +49,27 @@ func TestTableDataDelete(t *testing.T) {
 			assert.Equal(t, u.e, table.RowEvents)
 		})
 	}
+}
+
+func TestTableDataUpdate(t *testing.T) {
+	table := render.TableData{
+		Header: render.HeaderRow{{Name: "NAME"}, {Name: "STATUS"}, {Name: "AGE"}},
+	}
+	table.Update(render.Rows{
+		{ID: "A", Fields: render.Fields{"a", "Running", "1m"}},
+		{ID: "B", Fields: render.Fields{"b", "Pending", "1m"}},
+		{ID: "C", Fields: render.Fields{"c", "Running", "1m"}},
+	})
+	table.Update(render.Rows{
+		{ID: "A", Fields: render.Fields{"a", "Running", "2m"}},
+		{ID: "C", Fields: render.Fields{"c", "Failed", "2m"}},
+		{ID: "D", Fields: render.Fields{"d", "Pending", "1s"}},
+	})
 
+	e := render.RowEvents{
+		{Kind: render.EventUnchanged, Row: render.Row{ID: "A", Fields: render.Fields{"a", "Running", "2m"}}},
+		{Kind: render.EventUpdate, Row: render.Row{ID: "C", Fields: render.Fields{"c", "Failed", "2m"}}, Deltas: render.DeltaRow{"", "Running", ""}},
+		{Kind: render.EventAdd, Row: render.Row{ID: "D", Fields: render.Fields{"d", "Pending", "1s"}}},
+	}
+	assert.Equal(t, e, table.RowEvents)
 }
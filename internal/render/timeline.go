@@ -0,0 +1,82 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Timeline renders an object recorded revisions to screen.
+type Timeline struct{}
+
+// ColorerFunc colors a resource row.
+func (Timeline) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch re.Row.Fields[2] {
+		case watch.RevisionInitial:
+			return AddColor
+		case watch.RevisionDeleted:
+			return KillColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Timeline) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "REVISION", Align: tview.AlignRight},
+		Header{Name: "VERSION"},
+		Header{Name: "OP"},
+		Header{Name: "CHANGES", Align: tview.AlignRight},
+		Header{Name: "FIELDS"},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a revision to screen.
+func (Timeline) Render(o interface{}, ns string, r *Row) error {
+	rev, ok := o.(RevisionRes)
+	if !ok {
+		return fmt.Errorf("expecting revisionres, but got %T", o)
+	}
+
+	r.ID = strconv.Itoa(rev.Index)
+	r.Fields = Fields{
+		r.ID,
+		rev.Revision.Version,
+		rev.Revision.Op,
+		strconv.Itoa(len(rev.Fields)),
+		diffFields(rev.Fields),
+		timeToAge(rev.Revision.Time),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// RevisionRes represents a recorded revision of an object along with the
+// fields changed since the previous revision.
+type RevisionRes struct {
+	Index    int
+	Revision watch.Revision
+	Fields   []string
+}
+
+// GetObjectKind returns a schema object.
+func (RevisionRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r RevisionRes) DeepCopyObject() runtime.Object {
+	return r
+}
@@ -0,0 +1,42 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimelineRender(t *testing.T) {
+	uu := map[string]struct {
+		rev render.RevisionRes
+		e   render.Fields
+	}{
+		"initial": {
+			rev: render.RevisionRes{Revision: watch.Revision{Version: "10", Op: watch.RevisionInitial}},
+			e:   render.Fields{"0", "10", watch.RevisionInitial, "0", ""},
+		},
+		"updated": {
+			rev: render.RevisionRes{
+				Index:    3,
+				Revision: watch.Revision{Version: "12", Op: watch.RevisionUpdated},
+				Fields:   []string{"spec.replicas", "status.availableReplicas", "status.readyReplicas", "status.replicas"},
+			},
+			e: render.Fields{"3", "12", watch.RevisionUpdated, "4", "spec.replicas,status.availableReplicas,status.readyReplicas,+1"},
+		},
+	}
+
+	var tl render.Timeline
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.rev.Revision.Time = time.Now()
+			var r render.Row
+			assert.Nil(t, tl.Render(u.rev, "", &r))
+			assert.Equal(t, u.e, r.Fields[:5])
+			assert.Equal(t, u.e[0], r.ID)
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// TLS certificate statuses.
+const (
+	// CertValid represents a certificate not expiring soon.
+	CertValid = "Valid"
+	// CertExpiring represents a certificate expiring within the warning threshold.
+	CertExpiring = "Expiring"
+	// CertExpired represents an expired certificate.
+	CertExpired = "Expired"
+	// CertPending represents a certificate not issued yet.
+	CertPending = "Pending"
+	// CertInvalid represents a certificate that could not be parsed.
+	CertInvalid = "Invalid"
+
+	// CertWarnDays represents the number of days prior to expiry to warn about.
+	CertWarnDays = 30
+	// CertCriticalDays represents the number of days prior to expiry to alert on.
+	CertCriticalDays = 7
+)
+
+// TLSCert renders TLS certificates to screen.
+type TLSCert struct{}
+
+// ColorerFunc colors a resource row.
+func (TLSCert) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		switch strings.TrimSpace(re.Row.Fields[7]) {
+		case CertExpired, CertInvalid:
+			return ErrColor
+		case CertPending:
+			return CompletedColor
+		case CertExpiring:
+			if days, err := strconv.Atoi(strings.TrimSpace(re.Row.Fields[6])); err == nil && days <= CertCriticalDays {
+				return ErrColor
+			}
+			return ModColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (TLSCert) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "SOURCE"},
+		Header{Name: "SUBJECT"},
+		Header{Name: "SANS"},
+		Header{Name: "ISSUER"},
+		Header{Name: "DAYS", Align: tview.AlignRight},
+		Header{Name: "STATUS"},
+		Header{Name: "EXPIRES"},
+		Header{Name: "ERROR", Wide: true},
+	}
+}
+
+// Render renders a TLS certificate to screen.
+func (TLSCert) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(TLSCertRes)
+	if !ok {
+		return fmt.Errorf("expecting tlscertres, but got %T", o)
+	}
+
+	now := time.Now()
+	r.ID = c.ID()
+	r.Fields = Fields{
+		c.Namespace,
+		c.Name,
+		c.Source,
+		c.Subject,
+		strings.Join(c.SANs, ","),
+		c.Issuer,
+		certDays(c, now),
+		c.Status(now),
+		certExpires(c),
+		c.Error,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func certDays(c TLSCertRes, now time.Time) string {
+	if c.NotAfter.IsZero() {
+		return NAValue
+	}
+
+	return strconv.Itoa(c.DaysLeft(now))
+}
+
+func certExpires(c TLSCertRes) string {
+	if c.NotAfter.IsZero() {
+		return NAValue
+	}
+
+	return c.NotAfter.UTC().Format("2006-01-02 15:04")
+}
+
+// TLSCertRes represents a TLS certificate resource.
+type TLSCertRes struct {
+	Namespace string
+	Name      string
+	Source    string
+	Subject   string
+	SANs      []string
+	Issuer    string
+	NotAfter  time.Time
+	Error     string
+}
+
+// ID returns the certificate row id.
+func (c TLSCertRes) ID() string {
+	if c.Namespace == "" {
+		return c.Name
+	}
+
+	return c.Namespace + "/" + c.Name
+}
+
+// DaysLeft returns the number of days until expiry, rounded down.
+func (c TLSCertRes) DaysLeft(now time.Time) int {
+	return int(c.NotAfter.Sub(now).Hours() / 24)
+}
+
+// Status returns the certificate status given the current time.
+func (c TLSCertRes) Status(now time.Time) string {
+	switch {
+	case c.Error != "":
+		return CertInvalid
+	case c.NotAfter.IsZero():
+		return CertPending
+	case !now.Before(c.NotAfter):
+		return CertExpired
+	case c.DaysLeft(now) <= CertWarnDays:
+		return CertExpiring
+	default:
+		return CertValid
+	}
+}
+
+// GetObjectKind returns a schema object.
+func (TLSCertRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c TLSCertRes) DeepCopyObject() runtime.Object {
+	return c
+}
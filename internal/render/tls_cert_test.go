@@ -0,0 +1,81 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTLSCertStatus(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	uu := map[string]struct {
+		cert render.TLSCertRes
+		days int
+		e    string
+	}{
+		"valid": {
+			cert: render.TLSCertRes{NotAfter: now.Add(90 * 24 * time.Hour)},
+			days: 90,
+			e:    render.CertValid,
+		},
+		"expiring": {
+			cert: render.TLSCertRes{NotAfter: now.Add(30*24*time.Hour + time.Hour)},
+			days: 30,
+			e:    render.CertExpiring,
+		},
+		"expired": {
+			cert: render.TLSCertRes{NotAfter: now.Add(-49 * time.Hour)},
+			days: -2,
+			e:    render.CertExpired,
+		},
+		"pending": {
+			cert: render.TLSCertRes{},
+			e:    render.CertPending,
+		},
+		"invalid": {
+			cert: render.TLSCertRes{Error: "no certificate found"},
+			e:    render.CertInvalid,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.cert.Status(now))
+			if !u.cert.NotAfter.IsZero() {
+				assert.Equal(t, u.days, u.cert.DaysLeft(now))
+			}
+		})
+	}
+}
+
+func TestTLSCertRender(t *testing.T) {
+	c := render.TLSCertRes{
+		Namespace: "default",
+		Name:      "fred-tls",
+		Source:    "Secret",
+		Subject:   "fred.example.com",
+		SANs:      []string{"fred.example.com", "10.0.0.1"},
+		Issuer:    "letsencrypt",
+		NotAfter:  time.Now().Add(10*24*time.Hour + time.Hour),
+	}
+
+	var r render.Row
+	assert.Nil(t, render.TLSCert{}.Render(c, "", &r))
+	assert.Equal(t, "default/fred-tls", r.ID)
+	assert.Equal(t, render.Fields{
+		"default",
+		"fred-tls",
+		"Secret",
+		"fred.example.com",
+		"fred.example.com,10.0.0.1",
+		"letsencrypt",
+		"10",
+		render.CertExpiring,
+		c.NotAfter.UTC().Format("2006-01-02 15:04"),
+		"",
+	}, r.Fields)
+}
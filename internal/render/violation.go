@@ -0,0 +1,189 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// violationIDSep separates violation ids tokens.
+const violationIDSep = "|"
+
+// Violation renders policy violations grouped by policy and namespace to screen.
+type Violation struct{}
+
+// ColorerFunc colors a resource row.
+func (Violation) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		return violationColor(re.Row.Fields[4])
+	}
+}
+
+// Header returns a header row.
+func (Violation) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "ENGINE"},
+		Header{Name: "POLICY"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "VIOLATIONS", Align: tview.AlignRight},
+		Header{Name: "ACTIONS"},
+	}
+}
+
+// Render renders a violations group to screen.
+func (Violation) Render(o interface{}, ns string, r *Row) error {
+	v, ok := o.(ViolationRes)
+	if !ok {
+		return fmt.Errorf("expecting violationres, but got %T", o)
+	}
+
+	r.ID = v.ID()
+	r.Fields = Fields{
+		v.Engine,
+		v.Policy,
+		v.Namespace,
+		strconv.Itoa(len(v.Objects)),
+		strings.Join(v.Actions(), ","),
+	}
+
+	return nil
+}
+
+// ViolationObject renders objects violating a policy to screen.
+type ViolationObject struct{}
+
+// ColorerFunc colors a resource row.
+func (ViolationObject) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		return violationColor(re.Row.Fields[3])
+	}
+}
+
+// Header returns a header row.
+func (ViolationObject) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "KIND"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "ACTION"},
+		Header{Name: "MESSAGE"},
+		Header{Name: "POLICY", Wide: true},
+	}
+}
+
+// Render renders a violating object to screen.
+func (ViolationObject) Render(o interface{}, ns string, r *Row) error {
+	v, ok := o.(ViolationObjectRes)
+	if !ok {
+		return fmt.Errorf("expecting violationobjectres, but got %T", o)
+	}
+
+	r.ID = v.ID()
+	r.Fields = Fields{
+		v.Kind,
+		v.Namespace,
+		v.Name,
+		v.Action,
+		v.Message,
+		v.Policy,
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// violationColor flags enforced violations as errors and the others as warnings.
+func violationColor(actions string) tcell.Color {
+	for _, a := range strings.Split(actions, ",") {
+		switch strings.TrimSpace(a) {
+		case "deny", "fail", "error":
+			return ErrColor
+		}
+	}
+
+	return ModColor
+}
+
+// ViolationRes represents policy violations for a given policy and namespace.
+type ViolationRes struct {
+	Engine    string
+	Policy    string
+	Namespace string
+	Objects   []ViolationObjectRes
+}
+
+// ID returns the violations group id.
+func (v ViolationRes) ID() string {
+	return strings.Join([]string{v.Engine, v.Policy, v.Namespace}, violationIDSep)
+}
+
+// Actions returns the distinct actions taken on the violations.
+func (v ViolationRes) Actions() []string {
+	seen := make(map[string]struct{})
+	aa := make([]string, 0, 1)
+	for _, o := range v.Objects {
+		if _, ok := seen[o.Action]; ok || o.Action == "" {
+			continue
+		}
+		seen[o.Action] = struct{}{}
+		aa = append(aa, o.Action)
+	}
+	sort.Strings(aa)
+
+	return aa
+}
+
+// GetObjectKind returns a schema object.
+func (ViolationRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (v ViolationRes) DeepCopyObject() runtime.Object {
+	return v
+}
+
+// ViolationObjectRes represents an object violating a policy.
+type ViolationObjectRes struct {
+	Engine    string
+	Policy    string
+	Kind      string
+	Namespace string
+	Name      string
+	Message   string
+	Action    string
+}
+
+// ID returns the violating object id.
+func (v ViolationObjectRes) ID() string {
+	return v.Kind + violationIDSep + client.FQN(v.Namespace, v.Name)
+}
+
+// ParseViolationObjectID returns the kind and path of a violating object.
+func ParseViolationObjectID(id string) (string, string) {
+	tokens := strings.SplitN(id, violationIDSep, 2)
+	if len(tokens) < 2 {
+		return "", id
+	}
+
+	return tokens[0], tokens[1]
+}
+
+// GetObjectKind returns a schema object.
+func (ViolationObjectRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (v ViolationObjectRes) DeepCopyObject() runtime.Object {
+	return v
+}
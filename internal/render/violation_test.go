@@ -0,0 +1,47 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestViolationRender(t *testing.T) {
+	v := render.ViolationRes{
+		Engine:    "kyverno",
+		Policy:    "require-labels/check-team",
+		Namespace: "default",
+		Objects: []render.ViolationObjectRes{
+			{Kind: "Pod", Name: "a", Action: "warn"},
+			{Kind: "Pod", Name: "b", Action: "fail"},
+			{Kind: "Pod", Name: "c", Action: "fail"},
+		},
+	}
+
+	var r render.Row
+	assert.Nil(t, render.Violation{}.Render(v, "", &r))
+	assert.Equal(t, "kyverno|require-labels/check-team|default", r.ID)
+	assert.Equal(t, render.Fields{"kyverno", "require-labels/check-team", "default", "3", "fail,warn"}, r.Fields)
+}
+
+func TestViolationObjectRender(t *testing.T) {
+	v := render.ViolationObjectRes{
+		Engine:    "gatekeeper",
+		Policy:    "K8sRequiredLabels/owner",
+		Kind:      "Deployment",
+		Namespace: "default",
+		Name:      "fred",
+		Message:   "missing owner",
+		Action:    "dryrun",
+	}
+
+	var r render.Row
+	assert.Nil(t, render.ViolationObject{}.Render(v, "", &r))
+	assert.Equal(t, "Deployment|default/fred", r.ID)
+	assert.Equal(t, render.Fields{"Deployment", "default", "fred", "dryrun", "missing owner", "K8sRequiredLabels/owner"}, r.Fields)
+
+	kind, path := render.ParseViolationObjectID(r.ID)
+	assert.Equal(t, "Deployment", kind)
+	assert.Equal(t, "default/fred", path)
+}
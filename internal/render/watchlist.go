@@ -0,0 +1,299 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/gdamore/tcell"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Watchlist statuses.
+const (
+	// WatchReady represents a healthy resource.
+	WatchReady = "Ready"
+	// WatchNotReady represents an unhealthy resource.
+	WatchNotReady = "NotReady"
+	// WatchProgressing represents a resource rolling out.
+	WatchProgressing = "Progressing"
+	// WatchMissing represents a pinned resource that no longer exists.
+	WatchMissing = "Missing"
+)
+
+// Watchlist renders pinned resources to screen.
+type Watchlist struct{}
+
+// ColorerFunc colors a resource row.
+func (Watchlist) ColorerFunc() ColorerFunc {
+	return func(ns string, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, re)
+		if re.Kind == EventAdd {
+			return c
+		}
+		if !Happy(ns, re.Row) {
+			return ErrColor
+		}
+		if strings.TrimSpace(re.Row.Fields[4]) == WatchProgressing {
+			return ModColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Watchlist) Header(ns string) HeaderRow {
+	return HeaderRow{
+		Header{Name: "RESOURCE"},
+		Header{Name: "NAMESPACE"},
+		Header{Name: "NAME"},
+		Header{Name: "READY"},
+		Header{Name: "STATUS"},
+		Header{Name: "VALID", Wide: true},
+		Header{Name: "AGE", Decorator: AgeDecorator},
+	}
+}
+
+// Render renders a pinned resource to screen.
+func (Watchlist) Render(o interface{}, _ string, r *Row) error {
+	res, ok := o.(WatchRes)
+	if !ok {
+		return fmt.Errorf("expecting watchres, but got %T", o)
+	}
+
+	ns, n := client.Namespaced(res.Path)
+	r.ID = res.ID()
+	if res.Object == nil {
+		status, valid := WatchMissing, "resource not found"
+		if res.Err != nil {
+			status, valid = NAValue, res.Err.Error()
+		}
+		r.Fields = Fields{
+			client.NewGVR(res.GVR).R(),
+			ns,
+			n,
+			NAValue,
+			status,
+			valid,
+			NAValue,
+		}
+		return nil
+	}
+
+	s := NewWatchStatus(res.Object.Object)
+	r.Fields = Fields{
+		client.NewGVR(res.GVR).R(),
+		ns,
+		n,
+		s.Ready,
+		s.Status,
+		asStatus(s.err()),
+		toAge(res.Object.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// WatchRes represents a pinned resource.
+type WatchRes struct {
+	config.WatchItem
+
+	// Object is nil when the resource does not exist or could not be fetched.
+	Object *unstructured.Unstructured
+	Err    error
+}
+
+// GetObjectKind returns a schema object.
+func (WatchRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (w WatchRes) DeepCopyObject() runtime.Object {
+	return w
+}
+
+// WatchStatus summarizes the health of a resource of any kind.
+type WatchStatus struct {
+	Ready   string
+	Status  string
+	Healthy bool
+	Message string
+}
+
+// NewWatchStatus computes the status of a resource based on its kind,
+// falling back to its phase or its Ready or Available conditions.
+func NewWatchStatus(o map[string]interface{}) WatchStatus {
+	kind, _, _ := unstructured.NestedString(o, "kind")
+	switch kind {
+	case "Pod":
+		return podWatchStatus(o)
+	case "Deployment", "StatefulSet", "ReplicaSet":
+		return replicasWatchStatus(o, "readyReplicas", "updatedReplicas")
+	case "DaemonSet":
+		return replicasWatchStatus(o, "numberReady", "updatedNumberScheduled")
+	case "Job":
+		return jobWatchStatus(o)
+	case "Node":
+		s := conditionsWatchStatus(o, "Ready")
+		if u, _, _ := unstructured.NestedBool(o, "spec", "unschedulable"); u {
+			s.Status += ",SchedulingDisabled"
+		}
+		return s
+	}
+
+	if phase, _, _ := unstructured.NestedString(o, "status", "phase"); phase != "" {
+		return phaseWatchStatus(phase)
+	}
+
+	return conditionsWatchStatus(o, "Ready", "Available")
+}
+
+func (s WatchStatus) err() error {
+	if s.Healthy {
+		return nil
+	}
+	if s.Message != "" {
+		return fmt.Errorf("%s", s.Message)
+	}
+
+	return fmt.Errorf("%s", strings.ToLower(s.Status))
+}
+
+func podWatchStatus(o map[string]interface{}) WatchStatus {
+	phase, _, _ := unstructured.NestedString(o, "status", "phase")
+	cc, _, _ := unstructured.NestedSlice(o, "status", "containerStatuses")
+	s := WatchStatus{Status: phase}
+	var ready int
+	for _, c := range cc {
+		m, _ := c.(map[string]interface{})
+		if ok, _, _ := unstructured.NestedBool(m, "ready"); ok {
+			ready++
+		}
+		if r, ok, _ := unstructured.NestedString(m, "state", "waiting", "reason"); ok && r != "" {
+			s.Status = r
+			s.Message, _, _ = unstructured.NestedString(m, "state", "waiting", "message")
+		}
+	}
+	s.Ready = strconv.Itoa(ready) + "/" + strconv.Itoa(len(cc))
+	s.Healthy = phase == "Succeeded" || (phase == "Running" && ready == len(cc))
+	if r, _, _ := unstructured.NestedString(o, "status", "reason"); r != "" && !s.Healthy {
+		s.Status = r
+	}
+
+	return s
+}
+
+func replicasWatchStatus(o map[string]interface{}, readyField, updatedField string) WatchStatus {
+	desired, ok := nestedInt(o, "spec", "replicas")
+	if !ok {
+		desired = 1
+	}
+	if d, ok := nestedInt(o, "status", "desiredNumberScheduled"); ok {
+		desired = d
+	}
+	ready, _ := nestedInt(o, "status", readyField)
+	updated, _ := nestedInt(o, "status", updatedField)
+
+	s := WatchStatus{
+		Ready:   strconv.Itoa(int(ready)) + "/" + strconv.Itoa(int(desired)),
+		Status:  WatchReady,
+		Healthy: true,
+	}
+	if ready < desired || updated < desired {
+		s.Status = WatchProgressing
+	}
+	for _, c := range watchConditions(o) {
+		switch {
+		case c.kind == "Available" && c.status == "False":
+			s.Status, s.Healthy, s.Message = WatchNotReady, false, c.message
+		case c.kind == "Progressing" && c.reason == "ProgressDeadlineExceeded":
+			s.Status, s.Healthy, s.Message = WatchNotReady, false, c.message
+		}
+	}
+
+	return s
+}
+
+func jobWatchStatus(o map[string]interface{}) WatchStatus {
+	completions, ok := nestedInt(o, "spec", "completions")
+	if !ok {
+		completions = 1
+	}
+	succeeded, _ := nestedInt(o, "status", "succeeded")
+	s := WatchStatus{
+		Ready:   strconv.Itoa(int(succeeded)) + "/" + strconv.Itoa(int(completions)),
+		Status:  "Running",
+		Healthy: true,
+	}
+	for _, c := range watchConditions(o) {
+		if c.status != "True" {
+			continue
+		}
+		switch c.kind {
+		case "Complete":
+			s.Status = "Complete"
+		case "Failed":
+			s.Status, s.Healthy, s.Message = "Failed", false, c.message
+		}
+	}
+
+	return s
+}
+
+func phaseWatchStatus(phase string) WatchStatus {
+	s := WatchStatus{Ready: NAValue, Status: phase, Healthy: true}
+	if phase == "Failed" || phase == "Lost" || phase == "Unknown" {
+		s.Healthy = false
+	}
+
+	return s
+}
+
+// conditionsWatchStatus checks the first available condition of the given types.
+func conditionsWatchStatus(o map[string]interface{}, kinds ...string) WatchStatus {
+	cc := watchConditions(o)
+	for _, k := range kinds {
+		for _, c := range cc {
+			if c.kind != k {
+				continue
+			}
+			if c.status == "True" {
+				return WatchStatus{Ready: "True", Status: WatchReady, Healthy: true}
+			}
+			return WatchStatus{Ready: "False", Status: WatchNotReady, Message: c.message}
+		}
+	}
+
+	return WatchStatus{Ready: NAValue, Status: NAValue, Healthy: true}
+}
+
+type watchCondition struct {
+	kind, status, reason, message string
+}
+
+func watchConditions(o map[string]interface{}) []watchCondition {
+	cc, _, _ := unstructured.NestedSlice(o, "status", "conditions")
+	ww := make([]watchCondition, 0, len(cc))
+	for _, c := range cc {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var w watchCondition
+		w.kind, _, _ = unstructured.NestedString(m, "type")
+		w.status, _, _ = unstructured.NestedString(m, "status")
+		w.reason, _, _ = unstructured.NestedString(m, "reason")
+		w.message, _, _ = unstructured.NestedString(m, "message")
+		ww = append(ww, w)
+	}
+
+	return ww
+}
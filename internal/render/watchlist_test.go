@@ -0,0 +1,159 @@
+package render_test
+
+import (
+	"testing"
+
+	cfg "github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchlistRenderMissing(t *testing.T) {
+	var w render.Watchlist
+	r := render.NewRow(7)
+	res := render.WatchRes{WatchItem: cfg.WatchItem{GVR: "apps/v1/deployments", Path: "default/nginx"}}
+
+	assert.Nil(t, w.Render(res, "", &r))
+	assert.Equal(t, "apps/v1/deployments|default/nginx", r.ID)
+	assert.Equal(t, render.Fields{"deployments", "default", "nginx", "n/a", render.WatchMissing}, r.Fields[:5])
+	assert.False(t, render.Happy("", r))
+}
+
+func TestNewWatchStatus(t *testing.T) {
+	uu := map[string]struct {
+		o       map[string]interface{}
+		ready   string
+		status  string
+		healthy bool
+	}{
+		"podRunning": {
+			o: map[string]interface{}{
+				"kind": "Pod",
+				"status": map[string]interface{}{
+					"phase": "Running",
+					"containerStatuses": []interface{}{
+						map[string]interface{}{"ready": true},
+						map[string]interface{}{"ready": true},
+					},
+				},
+			},
+			ready: "2/2", status: "Running", healthy: true,
+		},
+		"podCrashing": {
+			o: map[string]interface{}{
+				"kind": "Pod",
+				"status": map[string]interface{}{
+					"phase": "Running",
+					"containerStatuses": []interface{}{
+						map[string]interface{}{
+							"ready": false,
+							"state": map[string]interface{}{
+								"waiting": map[string]interface{}{"reason": "CrashLoopBackOff"},
+							},
+						},
+					},
+				},
+			},
+			ready: "0/1", status: "CrashLoopBackOff",
+		},
+		"dpReady": {
+			o: map[string]interface{}{
+				"kind":   "Deployment",
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"readyReplicas": int64(3), "updatedReplicas": int64(3)},
+			},
+			ready: "3/3", status: render.WatchReady, healthy: true,
+		},
+		"dpRolling": {
+			o: map[string]interface{}{
+				"kind":   "Deployment",
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"readyReplicas": int64(3), "updatedReplicas": int64(1)},
+			},
+			ready: "3/3", status: render.WatchProgressing, healthy: true,
+		},
+		"dpStuck": {
+			o: map[string]interface{}{
+				"kind": "Deployment",
+				"spec": map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{
+					"readyReplicas":   int64(1),
+					"updatedReplicas": int64(1),
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Progressing", "status": "False", "reason": "ProgressDeadlineExceeded"},
+					},
+				},
+			},
+			ready: "1/2", status: render.WatchNotReady,
+		},
+		"dsReady": {
+			o: map[string]interface{}{
+				"kind":   "DaemonSet",
+				"status": map[string]interface{}{"desiredNumberScheduled": int64(4), "numberReady": int64(4), "updatedNumberScheduled": int64(4)},
+			},
+			ready: "4/4", status: render.WatchReady, healthy: true,
+		},
+		"jobFailed": {
+			o: map[string]interface{}{
+				"kind": "Job",
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Failed", "status": "True"},
+					},
+				},
+			},
+			ready: "0/1", status: "Failed",
+		},
+		"nodeCordoned": {
+			o: map[string]interface{}{
+				"kind": "Node",
+				"spec": map[string]interface{}{"unschedulable": true},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "True"},
+					},
+				},
+			},
+			ready: "True", status: "Ready,SchedulingDisabled", healthy: true,
+		},
+		"pvcBound": {
+			o: map[string]interface{}{
+				"kind":   "PersistentVolumeClaim",
+				"status": map[string]interface{}{"phase": "Bound"},
+			},
+			ready: "n/a", status: "Bound", healthy: true,
+		},
+		"pvcLost": {
+			o: map[string]interface{}{
+				"kind":   "PersistentVolumeClaim",
+				"status": map[string]interface{}{"phase": "Lost"},
+			},
+			ready: "n/a", status: "Lost",
+		},
+		"crNotReady": {
+			o: map[string]interface{}{
+				"kind": "Certificate",
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "False", "message": "issuing"},
+					},
+				},
+			},
+			ready: "False", status: render.WatchNotReady,
+		},
+		"crNoStatus": {
+			o:     map[string]interface{}{"kind": "ConfigMap"},
+			ready: "n/a", status: "n/a", healthy: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := render.NewWatchStatus(u.o)
+			assert.Equal(t, u.ready, s.Ready)
+			assert.Equal(t, u.status, s.Status)
+			assert.Equal(t, u.healthy, s.Healthy)
+		})
+	}
+}
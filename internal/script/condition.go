@@ -0,0 +1,40 @@
+package script
+
+import (
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// Condition represents a compiled boolean expression evaluated against a
+// resource bound to `o`.
+type Condition struct {
+	src  string
+	expr syntax.Expr
+}
+
+// NewCondition compiles a condition expression.
+func NewCondition(src string) (*Condition, error) {
+	expr, err := syntax.ParseExpr("condition", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Condition{src: src, expr: expr}, nil
+}
+
+// String returns the condition source.
+func (c *Condition) String() string {
+	return c.src
+}
+
+// Eval checks if the condition holds for a given resource.
+func (c *Condition) Eval(o map[string]interface{}) (bool, error) {
+	v, err := eval(func(th *starlark.Thread) (starlark.Value, error) {
+		return starlark.EvalExpr(th, c.expr, predeclared(starlark.StringDict{"o": toValue(o)}))
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return bool(v.Truth()), nil
+}
@@ -0,0 +1,71 @@
+package script_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/script"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditionEval(t *testing.T) {
+	po := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "fred"},
+		"status": map[string]interface{}{
+			"phase": "Running",
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"name": "c1", "restartCount": int64(1)},
+				map[string]interface{}{"name": "c2", "restartCount": int64(5)},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		expr string
+		e    bool
+		err  bool
+	}{
+		"restarts": {
+			expr: `any([c["restartCount"] > 3 for c in o["status"].get("containerStatuses", [])])`,
+			e:    true,
+		},
+		"phase": {
+			expr: `o["status"]["phase"] != "Running"`,
+		},
+		"truthy": {
+			expr: `o["metadata"].get("labels")`,
+		},
+		"missingKey": {
+			expr: `o["spec"]["nodeName"] == "n1"`,
+			err:  true,
+		},
+		"range": {
+			expr: `len([i for i in range(10)]) == 10`,
+			e:    true,
+		},
+		"tooManySteps": {
+			expr: `len([i for i in range(1000) for j in range(1000)]) > 0`,
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := script.NewCondition(u.expr)
+			assert.Nil(t, err)
+			assert.Equal(t, u.expr, c.String())
+			ok, err := c.Eval(po)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, ok)
+		})
+	}
+}
+
+func TestConditionInvalid(t *testing.T) {
+	_, err := script.NewCondition(`o["status"] ==`)
+	assert.NotNil(t, err)
+}
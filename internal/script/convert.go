@@ -0,0 +1,57 @@
+package script
+
+import (
+	"fmt"
+	"sort"
+
+	"go.starlark.net/starlark"
+)
+
+func toValue(v interface{}) starlark.Value {
+	switch o := v.(type) {
+	case nil:
+		return starlark.None
+	case string:
+		return starlark.String(o)
+	case bool:
+		return starlark.Bool(o)
+	case int:
+		return starlark.MakeInt(o)
+	case int32:
+		return starlark.MakeInt64(int64(o))
+	case int64:
+		return starlark.MakeInt64(o)
+	case float64:
+		return starlark.Float(o)
+	case []interface{}:
+		ll := make([]starlark.Value, 0, len(o))
+		for _, e := range o {
+			ll = append(ll, toValue(e))
+		}
+		return starlark.NewList(ll)
+	case map[string]interface{}:
+		kk := make([]string, 0, len(o))
+		for k := range o {
+			kk = append(kk, k)
+		}
+		sort.Strings(kk)
+		d := starlark.NewDict(len(o))
+		for _, k := range kk {
+			_ = d.SetKey(starlark.String(k), toValue(o[k]))
+		}
+		return d
+	default:
+		return starlark.String(fmt.Sprintf("%v", o))
+	}
+}
+
+func toString(v starlark.Value) string {
+	switch s := v.(type) {
+	case starlark.NoneType:
+		return ""
+	case starlark.String:
+		return string(s)
+	default:
+		return v.String()
+	}
+}
@@ -0,0 +1,291 @@
+package script
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	// AllGVRs matches any resource.
+	AllGVRs = "*"
+
+	// OnSelect fires when a resource gets selected.
+	OnSelect HookKind = "select"
+
+	// OnDeleteConfirm fires prior to deleting a resource.
+	OnDeleteConfirm HookKind = "delete-confirm"
+
+	scriptExt = ".star"
+)
+
+// HookKind represents a scripting event.
+type HookKind string
+
+// Column represents a scripted table column.
+type Column struct {
+	Name string
+	fn   starlark.Callable
+}
+
+// Eval computes the column value for a given resource.
+func (c Column) Eval(o map[string]interface{}) (string, error) {
+	return call(c.fn, o)
+}
+
+// Action represents a scripted resource action.
+type Action struct {
+	ShortCut    string
+	Description string
+	fn          starlark.Callable
+}
+
+// Run runs the action on a given resource and returns an optional message.
+func (a Action) Run(o map[string]interface{}) (string, error) {
+	return call(a.fn, o)
+}
+
+type hooks map[HookKind][]starlark.Callable
+
+// Engine manages user defined scripts.
+type Engine struct {
+	columns map[string][]Column
+	actions map[string][]Action
+	hooks   map[string]hooks
+	mx      sync.RWMutex
+}
+
+// NewEngine returns a new scripting engine.
+func NewEngine() *Engine {
+	e := Engine{}
+	e.reset()
+
+	return &e
+}
+
+// Load loads all scripts located in a given directory.
+func (e *Engine) Load(dir string) error {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+
+	e.reset()
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	files := make([]string, 0, len(ff))
+	for _, f := range ff {
+		if f.IsDir() || filepath.Ext(f.Name()) != scriptExt {
+			continue
+		}
+		files = append(files, filepath.Join(dir, f.Name()))
+	}
+	sort.Strings(files)
+
+	var errs []string
+	for _, f := range files {
+		if err := e.exec(f, nil); err != nil {
+			log.Error().Err(err).Msgf("Script %s failed to load", f)
+			errs = append(errs, err.Error())
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("script load failed: %v", errs)
+	}
+
+	return nil
+}
+
+// LoadScript loads a script from a given source.
+func (e *Engine) LoadScript(name string, src interface{}) error {
+	e.mx.Lock()
+	defer e.mx.Unlock()
+
+	return e.exec(name, src)
+}
+
+// Columns returns all scripted columns for a given resource.
+func (e *Engine) Columns(gvr string) []Column {
+	e.mx.RLock()
+	defer e.mx.RUnlock()
+
+	cc := make([]Column, 0, len(e.columns[gvr])+len(e.columns[AllGVRs]))
+	cc = append(cc, e.columns[gvr]...)
+
+	return append(cc, e.columns[AllGVRs]...)
+}
+
+// Actions returns all scripted actions for a given resource.
+func (e *Engine) Actions(gvr string) []Action {
+	e.mx.RLock()
+	defer e.mx.RUnlock()
+
+	cc := make([]Action, 0, len(e.actions[gvr])+len(e.actions[AllGVRs]))
+	cc = append(cc, e.actions[gvr]...)
+
+	return append(cc, e.actions[AllGVRs]...)
+}
+
+// HasHook checks if a given resource has any hooks of a given kind.
+func (e *Engine) HasHook(gvr string, k HookKind) bool {
+	return len(e.hooksFor(gvr, k)) > 0
+}
+
+// OnSelect fires select hooks and returns their messages if any.
+func (e *Engine) OnSelect(gvr string, o map[string]interface{}) (string, error) {
+	var msg string
+	for _, fn := range e.hooksFor(gvr, OnSelect) {
+		s, err := call(fn, o)
+		if err != nil {
+			return "", err
+		}
+		if s != "" {
+			msg = s
+		}
+	}
+
+	return msg, nil
+}
+
+// ConfirmDelete fires delete confirmation hooks. A hook vetoes the deletion
+// by returning False or a message explaining the veto.
+func (e *Engine) ConfirmDelete(gvr string, o map[string]interface{}) error {
+	for _, fn := range e.hooksFor(gvr, OnDeleteConfirm) {
+		v, err := eval(func(th *starlark.Thread) (starlark.Value, error) {
+			return starlark.Call(th, fn, starlark.Tuple{toValue(o)}, nil)
+		})
+		if err != nil {
+			return err
+		}
+		switch r := v.(type) {
+		case starlark.NoneType:
+		case starlark.Bool:
+			if !r {
+				return errors.New("deletion vetoed by script")
+			}
+		case starlark.String:
+			if r != "" {
+				return errors.New(string(r))
+			}
+		default:
+			return fmt.Errorf("expecting bool or string from %s hook but got %s", OnDeleteConfirm, v.Type())
+		}
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (e *Engine) reset() {
+	e.columns = make(map[string][]Column)
+	e.actions = make(map[string][]Action)
+	e.hooks = make(map[string]hooks)
+}
+
+func (e *Engine) hooksFor(gvr string, k HookKind) []starlark.Callable {
+	e.mx.RLock()
+	defer e.mx.RUnlock()
+
+	var hh []starlark.Callable
+	if h, ok := e.hooks[gvr]; ok {
+		hh = append(hh, h[k]...)
+	}
+	if h, ok := e.hooks[AllGVRs]; ok {
+		hh = append(hh, h[k]...)
+	}
+
+	return hh
+}
+
+func (e *Engine) exec(file string, src interface{}) error {
+	k9s := starlarkstruct.FromStringDict(starlark.String("k9s"), starlark.StringDict{
+		"column": starlark.NewBuiltin("column", e.columnFn),
+		"action": starlark.NewBuiltin("action", e.actionFn),
+		"on":     starlark.NewBuiltin("on", e.onFn),
+	})
+	_, err := eval(func(th *starlark.Thread) (starlark.Value, error) {
+		_, err := starlark.ExecFile(th, file, src, predeclared(starlark.StringDict{"k9s": k9s}))
+		return starlark.None, err
+	})
+
+	return err
+}
+
+func (e *Engine) columnFn(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		gvr, name string
+		fn        starlark.Callable
+	)
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "gvr", &gvr, "name", &name, "fn", &fn); err != nil {
+		return nil, err
+	}
+	e.columns[gvr] = append(e.columns[gvr], Column{Name: name, fn: fn})
+
+	return starlark.None, nil
+}
+
+func (e *Engine) actionFn(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		gvr, key, desc string
+		fn             starlark.Callable
+	)
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "gvr", &gvr, "key", &key, "description", &desc, "fn", &fn); err != nil {
+		return nil, err
+	}
+	e.actions[gvr] = append(e.actions[gvr], Action{ShortCut: key, Description: desc, fn: fn})
+
+	return starlark.None, nil
+}
+
+func (e *Engine) onFn(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var (
+		event, gvr string
+		fn         starlark.Callable
+	)
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, "event", &event, "gvr", &gvr, "fn", &fn); err != nil {
+		return nil, err
+	}
+	k := HookKind(event)
+	if k != OnSelect && k != OnDeleteConfirm {
+		return nil, fmt.Errorf("%s: unknown event %q", b.Name(), event)
+	}
+	if _, ok := e.hooks[gvr]; !ok {
+		e.hooks[gvr] = make(hooks)
+	}
+	e.hooks[gvr][k] = append(e.hooks[gvr][k], fn)
+
+	return starlark.None, nil
+}
+
+func newThread() *starlark.Thread {
+	return &starlark.Thread{
+		Name: "k9s",
+		Print: func(_ *starlark.Thread, msg string) {
+			log.Debug().Msgf("[Script] %s", msg)
+		},
+	}
+}
+
+func call(fn starlark.Callable, o map[string]interface{}) (string, error) {
+	v, err := eval(func(th *starlark.Thread) (starlark.Value, error) {
+		return starlark.Call(th, fn, starlark.Tuple{toValue(o)}, nil)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return toString(v), nil
+}
@@ -0,0 +1,141 @@
+package script_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/script"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngineLoad(t *testing.T) {
+	e := script.NewEngine()
+	assert.Nil(t, e.Load("testdata"))
+
+	assert.Equal(t, 2, len(e.Columns("v1/pods")))
+	assert.Equal(t, 0, len(e.Columns("v1/services")))
+	assert.Equal(t, 1, len(e.Actions("v1/pods")))
+	assert.True(t, e.HasHook("v1/pods", script.OnSelect))
+	assert.False(t, e.HasHook("v1/services", script.OnSelect))
+	assert.True(t, e.HasHook("v1/services", script.OnDeleteConfirm))
+}
+
+func TestEngineLoadMissingDir(t *testing.T) {
+	e := script.NewEngine()
+	assert.Nil(t, e.Load("testdata/nope"))
+	assert.Equal(t, 0, len(e.Columns("v1/pods")))
+}
+
+func TestEngineLoadFailed(t *testing.T) {
+	e := script.NewEngine()
+	assert.NotNil(t, e.LoadScript("boom.star", `k9s.on(event="blee", gvr="v1/pods", fn=len)`))
+	assert.NotNil(t, e.LoadScript("boom.star", `k9s.column(gvr="v1/pods")`))
+}
+
+func TestColumnEval(t *testing.T) {
+	e := script.NewEngine()
+	assert.Nil(t, e.Load("testdata"))
+
+	cc := e.Columns("v1/pods")
+	v, err := cc[0].Eval(makePod("fred", false))
+	assert.Nil(t, err)
+	assert.Equal(t, "10.0.0.1", v)
+
+	v, err = cc[1].Eval(makePod("fred", false))
+	assert.Nil(t, err)
+	assert.Equal(t, "3", v)
+}
+
+func TestActionRun(t *testing.T) {
+	e := script.NewEngine()
+	assert.Nil(t, e.Load("testdata"))
+
+	msg, err := e.Actions("v1/pods")[0].Run(makePod("fred", false))
+	assert.Nil(t, err)
+	assert.Equal(t, "Annotated fred", msg)
+}
+
+func TestEngineColumnsCopy(t *testing.T) {
+	e := script.NewEngine()
+	assert.Nil(t, e.LoadScript("cols.star", `
+k9s.column(gvr="v1/pods", name="a", fn=str)
+k9s.column(gvr="v1/pods", name="b", fn=str)
+k9s.column(gvr="*", name="c", fn=str)
+`))
+
+	cc := e.Columns("v1/pods")[:1]
+	_ = append(cc, script.Column{Name: "fred"})
+	assert.Equal(t, "b", e.Columns("v1/pods")[1].Name)
+	assert.Equal(t, "c", e.Columns("v1/pods")[2].Name)
+}
+
+func TestActionRunTooManySteps(t *testing.T) {
+	e := script.NewEngine()
+	assert.Nil(t, e.LoadScript("loop.star", `
+def spin(o):
+    for i in range(1000):
+        for j in range(1000):
+            pass
+    return "done"
+
+k9s.action(gvr="v1/pods", key="Shift-S", description="Spin", fn=spin)
+`))
+
+	_, err := e.Actions("v1/pods")[0].Run(makePod("fred", false))
+	assert.NotNil(t, err)
+}
+
+func TestOnSelect(t *testing.T) {
+	e := script.NewEngine()
+	assert.Nil(t, e.Load("testdata"))
+
+	msg, err := e.OnSelect("v1/pods", makePod("fred", false))
+	assert.Nil(t, err)
+	assert.Equal(t, "Selected fred", msg)
+}
+
+func TestConfirmDelete(t *testing.T) {
+	e := script.NewEngine()
+	assert.Nil(t, e.Load("testdata"))
+
+	assert.Nil(t, e.ConfirmDelete("v1/pods", makePod("fred", false)))
+	err := e.ConfirmDelete("v1/pods", makePod("fred", true))
+	assert.NotNil(t, err)
+	assert.Equal(t, "Resource fred is protected", err.Error())
+}
+
+func TestConfirmDeleteVeto(t *testing.T) {
+	e := script.NewEngine()
+	src := `
+def veto(o):
+    return False
+
+k9s.on(event="delete-confirm", gvr="v1/pods", fn=veto)
+`
+	assert.Nil(t, e.LoadScript("veto.star", src))
+
+	assert.NotNil(t, e.ConfirmDelete("v1/pods", makePod("fred", false)))
+	assert.Nil(t, e.ConfirmDelete("v1/services", makePod("fred", false)))
+}
+
+// Helpers...
+
+func makePod(n string, protected bool) map[string]interface{} {
+	labels := map[string]interface{}{}
+	if protected {
+		labels["protected"] = "true"
+	}
+
+	return map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":   n,
+			"labels": labels,
+		},
+		"status": map[string]interface{}{
+			"podIP": "10.0.0.1",
+			"containerStatuses": []interface{}{
+				map[string]interface{}{"restartCount": int64(1)},
+				map[string]interface{}{"restartCount": int64(2)},
+			},
+		},
+	}
+}
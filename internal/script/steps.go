@@ -0,0 +1,104 @@
+package script
+
+import (
+	"fmt"
+
+	"go.starlark.net/starlark"
+)
+
+// MaxSteps caps the number of range iterations a single evaluation may
+// perform. Starlark disallows while loops and recursion, so bounding range
+// bounds the evaluation.
+const MaxSteps = 100000
+
+const stepsKey = "k9s.steps"
+
+// steps tracks the iterations performed by a thread.
+type steps struct {
+	count    int
+	exceeded bool
+}
+
+func (s *steps) next() bool {
+	if s.count >= MaxSteps {
+		s.exceeded = true
+		return false
+	}
+	s.count++
+
+	return true
+}
+
+// predeclared returns the builtins shared by all evaluations.
+func predeclared(dd starlark.StringDict) starlark.StringDict {
+	dd["range"] = starlark.NewBuiltin("range", rangeFn)
+
+	return dd
+}
+
+// eval runs a starlark evaluation and fails it when exceeding its step budget.
+func eval(fn func(*starlark.Thread) (starlark.Value, error)) (starlark.Value, error) {
+	th := newThread()
+	v, err := fn(th)
+	if s := threadSteps(th); s.exceeded {
+		return nil, fmt.Errorf("script exceeded %d steps", MaxSteps)
+	}
+
+	return v, err
+}
+
+func threadSteps(th *starlark.Thread) *steps {
+	s, ok := th.Local(stepsKey).(*steps)
+	if !ok {
+		s = new(steps)
+		th.SetLocal(stepsKey, s)
+	}
+
+	return s
+}
+
+// rangeFn wraps the universe range so its iterations count against the
+// thread step budget.
+func rangeFn(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	v, err := starlark.Call(th, starlark.Universe["range"], args, kwargs)
+	if err != nil {
+		return nil, err
+	}
+	seq, ok := v.(starlark.Indexable)
+	if !ok {
+		return v, nil
+	}
+
+	return &boundedRange{Indexable: seq, steps: threadSteps(th)}, nil
+}
+
+// boundedRange represents a range whose iterations are budgeted.
+type boundedRange struct {
+	starlark.Indexable
+
+	steps *steps
+}
+
+var _ starlark.Sequence = (*boundedRange)(nil)
+
+// Iterate returns a budgeted range iterator.
+func (r *boundedRange) Iterate() starlark.Iterator {
+	return &boundedIterator{r: r}
+}
+
+type boundedIterator struct {
+	r *boundedRange
+	i int
+}
+
+func (it *boundedIterator) Next(p *starlark.Value) bool {
+	if it.i >= it.r.Len() || !it.r.steps.next() {
+		return false
+	}
+	*p = it.r.Index(it.i)
+	it.i++
+
+	return true
+}
+
+func (it *boundedIterator) Done() {}
@@ -0,0 +1,116 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ServiceName represents the service traces are reported for.
+	ServiceName = "k9s"
+
+	tracesPath = "/v1/traces"
+)
+
+var enabled int32
+
+// Init exports traces to an OTLP/HTTP collector. The returned function
+// flushes pending spans and stops exporting.
+func Init(ctx context.Context, endpoint string, headers map[string]string) (func(context.Context) error, error) {
+	opts, err := exporterOptions(endpoint, headers)
+	if err != nil {
+		return nil, err
+	}
+	exp, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", ServiceName))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	atomic.StoreInt32(&enabled, 1)
+
+	return func(ctx context.Context) error {
+		atomic.StoreInt32(&enabled, 0)
+		return tp.Shutdown(ctx)
+	}, nil
+}
+
+// Enabled checks if traces are exported.
+func Enabled() bool {
+	return atomic.LoadInt32(&enabled) == 1
+}
+
+// Start starts a span, child of the context span if any. Spans are no-ops
+// when tracing is disabled.
+func Start(ctx context.Context, name string, kind trace.SpanKind) (context.Context, trace.Span) {
+	return otel.Tracer(ServiceName).Start(ctx, name, trace.WithSpanKind(kind))
+}
+
+// SetError flags the span as failed.
+func SetError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// Inject sets the context span W3C trace context headers. Headers are left
+// untouched when tracing is disabled.
+func Inject(ctx context.Context, h http.Header) {
+	if !Enabled() {
+		return
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(h))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func exporterOptions(endpoint string, headers map[string]string) ([]otlptracehttp.Option, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("invalid traces endpoint %q", endpoint)
+	}
+	opts := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(u.Host),
+		otlptracehttp.WithURLPath(urlPath(u)),
+	}
+	if u.Scheme == "http" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	if len(headers) > 0 {
+		opts = append(opts, otlptracehttp.WithHeaders(headers))
+	}
+
+	return opts, nil
+}
+
+// urlPath defaults bare collector urls to the OTLP traces path.
+func urlPath(u *url.URL) string {
+	if strings.Trim(u.Path, "/") == "" {
+		return tracesPath
+	}
+
+	return u.Path
+}
@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestURLPath(t *testing.T) {
+	uu := map[string]struct {
+		endpoint, e string
+	}{
+		"bare":  {"http://localhost:4318", "/v1/traces"},
+		"slash": {"http://localhost:4318/", "/v1/traces"},
+		"path":  {"https://otel.example.com/custom/traces", "/custom/traces"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ep, err := url.Parse(u.endpoint)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, urlPath(ep))
+		})
+	}
+}
+
+func TestExporterOptionsInvalid(t *testing.T) {
+	_, err := exporterOptions("localhost:4318", nil)
+	assert.NotNil(t, err)
+}
+
+func TestInjectDisabled(t *testing.T) {
+	h := make(http.Header)
+	ctx, span := Start(context.Background(), "fred", trace.SpanKindClient)
+	defer span.End()
+	Inject(ctx, h)
+
+	assert.False(t, Enabled())
+	assert.Equal(t, "", h.Get("traceparent"))
+}
+
+func TestInit(t *testing.T) {
+	paths, auth := make(chan string, 1), make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.Path
+		auth <- r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+
+	shutdown, err := Init(context.Background(), srv.URL, map[string]string{"Authorization": "Bearer fred"})
+	assert.Nil(t, err)
+	assert.True(t, Enabled())
+
+	ctx, root := Start(context.Background(), "root", trace.SpanKindInternal)
+	cctx, child := Start(ctx, "child", trace.SpanKindClient)
+	assert.Equal(t, root.SpanContext().TraceID(), child.SpanContext().TraceID())
+	h := make(http.Header)
+	Inject(cctx, h)
+	assert.Regexp(t, `\A00-`+child.SpanContext().TraceID().String()+`-`+child.SpanContext().SpanID().String()+`-01\z`, h.Get("traceparent"))
+	child.End()
+	root.End()
+
+	assert.Nil(t, shutdown(context.Background()))
+	assert.False(t, Enabled())
+	assert.Equal(t, "/v1/traces", <-paths)
+	assert.Equal(t, "Bearer fred", <-auth)
+}
@@ -2,6 +2,8 @@ package ui
 
 import (
 	"sort"
+	"strings"
+	"unicode"
 
 	"github.com/derailed/k9s/internal/model"
 	"github.com/gdamore/tcell"
@@ -12,26 +14,73 @@ type (
 	// ActionHandler handles a keyboard command.
 	ActionHandler func(*tcell.EventKey) *tcell.EventKey
 
+	// KeyHookFunc consumes a single key press.
+	KeyHookFunc func(*tcell.EventKey)
+
 	// KeyAction represents a keyboard action.
 	KeyAction struct {
+		// ID identifies the action regardless of later relabels.
+		ID          string
 		Description string
 		Action      ActionHandler
 		Visible     bool
 		Shared      bool
+		Category    string
 	}
 
 	// KeyActions tracks mappings between keystrokes and actions.
 	KeyActions map[tcell.Key]KeyAction
 )
 
+const (
+	// PluginCategory categorizes actions added by plugins.
+	PluginCategory = "PLUGINS"
+
+	// HotKeyCategory categorizes actions added by hotkeys.
+	HotKeyCategory = "HOTKEYS"
+)
+
 // NewKeyAction returns a new keyboard action.
 func NewKeyAction(d string, a ActionHandler, display bool) KeyAction {
-	return KeyAction{Description: d, Action: a, Visible: display}
+	return KeyAction{ID: ActionID(d), Description: d, Action: a, Visible: display}
 }
 
 // NewSharedKeyAction returns a new shared keyboard action.
 func NewSharedKeyAction(d string, a ActionHandler, display bool) KeyAction {
-	return KeyAction{Description: d, Action: a, Visible: display, Shared: true}
+	return KeyAction{ID: ActionID(d), Description: d, Action: a, Visible: display, Shared: true}
+}
+
+// ActionID returns an action identifier, namely its lower cased description
+// with words separated by dashes. ie Port-Forward -> port-forward.
+func ActionID(d string) string {
+	var (
+		b    strings.Builder
+		dash bool
+	)
+	for _, r := range strings.ToLower(d) {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			dash = b.Len() > 0
+			continue
+		}
+		if dash {
+			b.WriteRune('-')
+			dash = false
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// Keys returns the action keys in order.
+func (a KeyActions) Keys() []tcell.Key {
+	kk := make([]tcell.Key, 0, len(a))
+	for k := range a {
+		kk = append(kk, k)
+	}
+	sort.Slice(kk, func(i, j int) bool { return kk[i] < kk[j] })
+
+	return kk
 }
 
 // Add sets up keyboard action listener.
@@ -5,6 +5,7 @@ import (
 
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -20,3 +21,32 @@ func TestKeyActionsHints(t *testing.T) {
 	assert.Equal(t, 3, len(hh))
 	assert.Equal(t, model.MenuHint{Mnemonic: "b", Description: "blee", Visible: true}, hh[0])
 }
+
+func TestActionID(t *testing.T) {
+	uu := map[string]struct {
+		d, e string
+	}{
+		"plain":  {d: "Logs", e: "logs"},
+		"dash":   {d: "Port-Forward", e: "port-forward"},
+		"spaces": {d: "Sort  Name", e: "sort-name"},
+		"punct":  {d: "<Previous> Logs!", e: "previous-logs"},
+		"id":     {d: "port-forward", e: "port-forward"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ui.ActionID(u.d))
+		})
+	}
+}
+
+func TestKeyActionsKeys(t *testing.T) {
+	kk := ui.KeyActions{
+		ui.KeyZ: ui.NewKeyAction("zorg", nil, true),
+		ui.KeyB: ui.NewKeyAction("blee", nil, true),
+		ui.KeyF: ui.NewKeyAction("fred", nil, true),
+	}
+
+	assert.Equal(t, []tcell.Key{ui.KeyB, ui.KeyF, ui.KeyZ}, kk.Keys())
+}
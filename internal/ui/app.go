@@ -1,9 +1,13 @@
 package ui
 
 import (
+	"sync"
+	"time"
+
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -19,6 +23,11 @@ type App struct {
 	actions KeyActions
 	views   map[string]tview.Primitive
 	cmdBuff *CmdBuff
+	drawAt  time.Time
+	snapFn  SnapshotFunc
+	keyHook KeyHookFunc
+	screen  tcell.Screen
+	mx      sync.Mutex
 }
 
 // NewApp returns a new app.
@@ -46,6 +55,8 @@ func NewApp(context string) *App {
 func (a *App) Init() {
 	a.bindKeys()
 	a.SetInputCapture(a.keyboard)
+	a.SetBeforeDrawFunc(a.beforeDraw)
+	a.SetAfterDrawFunc(a.afterDraw)
 	a.cmdBuff.AddListener(a.Cmd())
 	a.Styles.AddListener(a)
 	a.CmdBuff().AddListener(a)
@@ -53,6 +64,32 @@ func (a *App) Init() {
 	a.SetRoot(a.Main, true)
 }
 
+func (a *App) beforeDraw(tcell.Screen) bool {
+	a.drawAt = time.Now()
+
+	return false
+}
+
+func (a *App) afterDraw(s tcell.Screen) {
+	perf.Stats.RecordDraw(time.Since(a.drawAt))
+
+	a.mx.Lock()
+	a.screen = s
+	fn := a.snapFn
+	a.snapFn = nil
+	a.mx.Unlock()
+	if fn != nil {
+		go fn(CaptureScreen(s))
+	}
+}
+
+// Snapshot captures the screen content once the next draw completes.
+func (a *App) Snapshot(fn SnapshotFunc) {
+	a.mx.Lock()
+	a.snapFn = fn
+	a.mx.Unlock()
+}
+
 // BufferChanged indicates the buffer was changed.
 func (a *App) BufferChanged(s string) {}
 
@@ -148,6 +185,12 @@ func (a *App) quitCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+// AwaitKey routes the next key press to the given hook, ie for two keys
+// sequences such as setting marks.
+func (a *App) AwaitKey(fn KeyHookFunc) {
+	a.keyHook = fn
+}
+
 // InCmdMode check if command mode is active.
 func (a *App) InCmdMode() bool {
 	return a.Cmd().InCmdMode()
@@ -171,6 +214,12 @@ func (a *App) Views() map[string]tview.Primitive {
 }
 
 func (a *App) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	model.Pace.Touch()
+	if fn := a.keyHook; fn != nil {
+		a.keyHook = nil
+		fn(evt)
+		return nil
+	}
 	key := evt.Key()
 	if key == tcell.KeyRune {
 		if a.cmdBuff.IsActive() && evt.Modifiers() == tcell.ModNone {
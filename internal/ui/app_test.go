@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -55,6 +56,24 @@ func TestAppGetActions(t *testing.T) {
 	assert.Equal(t, 9, len(a.GetActions()))
 }
 
+func TestAppAwaitKey(t *testing.T) {
+	a := ui.NewApp("")
+	a.Init()
+
+	var got rune
+	a.AwaitKey(func(evt *tcell.EventKey) {
+		got = evt.Rune()
+	})
+	capture := a.GetInputCapture()
+
+	assert.Nil(t, capture(tcell.NewEventKey(tcell.KeyRune, 'a', tcell.ModNone)))
+	assert.Equal(t, 'a', got)
+
+	evt := tcell.NewEventKey(tcell.KeyRune, 'b', tcell.ModNone)
+	assert.Equal(t, evt, capture(evt))
+	assert.Equal(t, 'a', got)
+}
+
 func TestAppViews(t *testing.T) {
 	a := ui.NewApp("")
 	a.Init()
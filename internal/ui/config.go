@@ -1,23 +1,14 @@
 package ui
 
 import (
-	"context"
 	"fmt"
 	"path/filepath"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
-	"github.com/derailed/tview"
-	"github.com/fsnotify/fsnotify"
 	"github.com/rs/zerolog/log"
 )
 
-// Synchronizer manages ui event queue.
-type synchronizer interface {
-	QueueUpdateDraw(func()) *tview.Application
-	QueueUpdate(func()) *tview.Application
-}
-
 // Configurator represents an application configurationa.
 type Configurator struct {
 	skinFile  string
@@ -31,42 +22,6 @@ func (c *Configurator) HasSkin() bool {
 	return c.skinFile != ""
 }
 
-// StylesUpdater watches for skin file changes.
-func (c *Configurator) StylesUpdater(ctx context.Context, s synchronizer) error {
-	if !c.HasSkin() {
-		return nil
-	}
-
-	w, err := fsnotify.NewWatcher()
-	if err != nil {
-		return err
-	}
-
-	go func() {
-		for {
-			select {
-			case evt := <-w.Events:
-				_ = evt
-				s.QueueUpdateDraw(func() {
-					c.RefreshStyles(c.Config.K9s.CurrentCluster)
-				})
-			case err := <-w.Errors:
-				log.Info().Err(err).Msg("Skin watcher failed")
-				return
-			case <-ctx.Done():
-				log.Debug().Msgf("SkinWatcher Done `%s!!", c.skinFile)
-				if err := w.Close(); err != nil {
-					log.Error().Err(err).Msg("Closing watcher")
-				}
-				return
-			}
-		}
-	}()
-
-	log.Debug().Msgf("SkinWatcher watching `%s", c.skinFile)
-	return w.Add(c.skinFile)
-}
-
 // BenchConfig location of the benchmarks configuration file.
 func BenchConfig(context string) string {
 	return filepath.Join(config.K9sHome, config.K9sBench+"-"+context+".yml")
@@ -76,12 +31,29 @@ func BenchConfig(context string) string {
 func (c *Configurator) RefreshStyles(context string) {
 	c.BenchFile = BenchConfig(context)
 
-	clusterSkins := filepath.Join(config.K9sHome, fmt.Sprintf("%s_skin.yml", context))
 	if c.Styles == nil {
 		c.Styles = config.NewStyles()
 	} else {
 		c.Styles.Reset()
 	}
+
+	if c.Config != nil {
+		if a := c.Config.K9s.AccessibilityConfig(); a.HighContrast {
+			c.Styles.K9s = a.Roles.Style()
+			c.updateStyles(config.HighContrastSkin)
+			return
+		}
+		if skin, ok := c.Config.K9s.ActiveSkin(); ok {
+			if err := c.Styles.Load(skin); err != nil {
+				log.Warn().Err(err).Msgf("Unable to load mapped skin file -- %s", skin)
+			} else {
+				c.updateStyles(skin)
+				return
+			}
+		}
+	}
+
+	clusterSkins := filepath.Join(config.K9sHome, fmt.Sprintf("%s_skin.yml", context))
 	if err := c.Styles.Load(clusterSkins); err != nil {
 		log.Info().Msgf("No context specific skin file found -- %s", clusterSkins)
 	} else {
@@ -103,11 +75,15 @@ func (c *Configurator) updateStyles(f string) {
 		c.Styles.DefaultSkin()
 	}
 	c.Styles.Update()
+	SetRenderColors(c.Styles)
+}
 
-	render.StdColor = c.Styles.Frame().Status.NewColor.Color()
-	render.AddColor = c.Styles.Frame().Status.AddColor.Color()
-	render.ModColor = c.Styles.Frame().Status.ModifyColor.Color()
-	render.ErrColor = c.Styles.Frame().Status.ErrorColor.Color()
-	render.HighlightColor = c.Styles.Frame().Status.HighlightColor.Color()
-	render.CompletedColor = c.Styles.Frame().Status.CompletedColor.Color()
+// SetRenderColors sets up resource rows colors given a skin.
+func SetRenderColors(s *config.Styles) {
+	render.StdColor = s.Frame().Status.NewColor.Color()
+	render.AddColor = s.Frame().Status.AddColor.Color()
+	render.ModColor = s.Frame().Status.ModifyColor.Color()
+	render.ErrColor = s.Frame().Status.ErrorColor.Color()
+	render.HighlightColor = s.Frame().Status.HighlightColor.Color()
+	render.CompletedColor = s.Frame().Status.CompletedColor.Color()
 }
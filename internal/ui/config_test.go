@@ -26,3 +26,31 @@ func TestConfiguratorRefreshStyle(t *testing.T) {
 	assert.Equal(t, tcell.ColorGhostWhite, render.StdColor)
 	assert.Equal(t, tcell.ColorWhiteSmoke, render.ErrColor)
 }
+
+func TestConfiguratorRefreshMappedStyle(t *testing.T) {
+	config.K9sHome = "."
+	config.K9sStylesFile = "/tmp/blee/skin.yml"
+	cfg := ui.Configurator{Config: config.NewConfig(nil)}
+	cfg.Config.K9s.CurrentContext = "prod"
+	cfg.Config.K9s.Skins = &config.Skins{
+		Contexts: map[string]string{
+			"prod": filepath.Join("..", "config", "testdata", "black_and_wtf.yml"),
+		},
+	}
+	cfg.RefreshStyles("prod")
+
+	assert.True(t, cfg.HasSkin())
+	assert.Equal(t, tcell.ColorGhostWhite, render.StdColor)
+	assert.Equal(t, tcell.ColorWhiteSmoke, render.ErrColor)
+}
+
+func TestConfiguratorRefreshHighContrastStyle(t *testing.T) {
+	config.K9sStylesFile = filepath.Join("..", "config", "testdata", "black_and_wtf.yml")
+	cfg := ui.Configurator{Config: config.NewConfig(nil)}
+	cfg.Config.K9s.Accessibility = &config.Accessibility{HighContrast: true}
+	cfg.RefreshStyles("")
+
+	assert.True(t, cfg.HasSkin())
+	assert.Equal(t, tcell.ColorWhite, render.StdColor)
+	assert.Equal(t, tcell.ColorRed, render.ErrColor)
+}
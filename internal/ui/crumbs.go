@@ -13,8 +13,10 @@ import (
 type Crumbs struct {
 	*tview.TextView
 
-	styles *config.Styles
-	stack  *model.Stack
+	styles    *config.Styles
+	stack     *model.Stack
+	tabs      []string
+	activeTab int
 }
 
 // NewCrumbs returns a new breadcrumb view.
@@ -55,9 +57,28 @@ func (c *Crumbs) StackPopped(_, _ model.Component) {
 // StackTop indicates the top of the stack
 func (c *Crumbs) StackTop(top model.Component) {}
 
+// SetTabs lists the open tabs ahead of the crumbs. A single tab is not listed.
+func (c *Crumbs) SetTabs(tabs []string, active int) {
+	c.tabs, c.activeTab = tabs, active
+	c.refresh(c.stack.Flatten())
+}
+
 // Refresh updates view with new crumbs.
 func (c *Crumbs) refresh(crumbs []string) {
 	c.Clear()
+	if len(c.tabs) > 1 {
+		for i, tab := range c.tabs {
+			bgColor := c.styles.Frame().Crumb.BgColor
+			if i == c.activeTab {
+				bgColor = c.styles.Frame().Crumb.ActiveColor
+			}
+			fmt.Fprintf(c, "[%s:%s:b] %d:%s [-:%s:-] ",
+				c.styles.Frame().Crumb.FgColor,
+				bgColor, i+1, tab,
+				c.styles.Body().BgColor)
+		}
+		fmt.Fprint(c, "| ")
+	}
 	last, bgColor := len(crumbs)-1, c.styles.Frame().Crumb.BgColor
 	for i, crumb := range crumbs {
 		if i == last {
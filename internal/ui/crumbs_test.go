@@ -26,6 +26,16 @@ func TestNewCrumbs(t *testing.T) {
 	assert.Equal(t, "[black:aqua:b] <c1> [-:black:-] [black:aqua:b] <c2> [-:black:-] [black:orange:b] <c3> [-:black:-] \n", v.GetText(false))
 }
 
+func TestCrumbsTabs(t *testing.T) {
+	v := ui.NewCrumbs(config.NewStyles())
+	v.StackPushed(makeComponent("c1"))
+	v.SetTabs([]string{"t1"}, 0)
+	assert.Equal(t, "[black:orange:b] <c1> [-:black:-] \n", v.GetText(false))
+
+	v.SetTabs([]string{"t1", "t2"}, 1)
+	assert.Equal(t, "[black:aqua:b] 1:t1 [-:black:-] [black:orange:b] 2:t2 [-:black:-] | [black:orange:b] <c1> [-:black:-] \n", v.GetText(false))
+}
+
 // Helpers...
 
 type c struct {
@@ -0,0 +1,41 @@
+package dialog
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const deviceCodeKey = "deviceCode"
+
+// ShowDeviceCode pops a dialog prompting the user to authorize a device login.
+func ShowDeviceCode(pages *ui.Pages, url, code string, copyFn func(), cancel cancelFunc) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	f.AddButton("Copy Code", copyFn)
+	f.AddButton("Cancel", func() {
+		DismissDeviceCode(pages)
+		cancel()
+	})
+
+	modal := tview.NewModalForm(" <Login Required> ", f)
+	modal.SetText(fmt.Sprintf("Visit [::b]%s[::-]\nand enter code [orange::b]%s[-::-]\n\nWaiting for authorization...", url, code))
+	modal.SetDoneFunc(func(int, string) {
+		DismissDeviceCode(pages)
+		cancel()
+	})
+	pages.AddPage(deviceCodeKey, modal, false, false)
+	pages.ShowPage(deviceCodeKey)
+}
+
+// DismissDeviceCode dismisses the device login dialog.
+func DismissDeviceCode(pages *ui.Pages) {
+	pages.RemovePage(deviceCodeKey)
+}
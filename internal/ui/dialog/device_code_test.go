@@ -0,0 +1,25 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeviceCodeDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	var canceled bool
+	ShowDeviceCode(p, "https://idp/device", "ABCD-EFGH", func() {}, func() { canceled = true })
+
+	d := p.GetPrimitive(deviceCodeKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+	assert.False(t, canceled)
+
+	DismissDeviceCode(p)
+	assert.Nil(t, p.GetPrimitive(deviceCodeKey))
+}
@@ -0,0 +1,35 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const errorKey = "error"
+
+// ShowError pops an error dialog.
+func ShowError(pages *ui.Pages, title, msg string) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	f.AddButton("OK", func() {
+		dismissError(pages)
+	})
+
+	modal := tview.NewModalForm(" <"+title+"> ", f)
+	modal.SetText(msg)
+	modal.SetDoneFunc(func(int, string) {
+		dismissError(pages)
+	})
+	pages.AddPage(errorKey, modal, false, false)
+	pages.ShowPage(errorKey)
+}
+
+func dismissError(pages *ui.Pages) {
+	pages.RemovePage(errorKey)
+}
@@ -0,0 +1,23 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowError(p, "Blee", "Yo")
+
+	d := p.GetPrimitive(errorKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissError(p)
+	assert.Nil(t, p.GetPrimitive(errorKey))
+}
@@ -0,0 +1,59 @@
+package dialog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const confirmNameKey = "confirmName"
+
+// ShowConfirmName pops a confirmation dialog requiring the given name to be typed in.
+func ShowConfirmName(pages *ui.Pages, title, msg, name string, ack confirmFunc, cancel cancelFunc) {
+	var typed string
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	f.AddInputField("Name:", "", 30, nil, func(s string) {
+		typed = s
+	})
+
+	modal := tview.NewModalForm(" <"+title+"> ", f)
+	prompt := fmt.Sprintf("%s\nType [::b]%s[::-] to confirm.", msg, name)
+	modal.SetText(prompt)
+	f.AddButton("Cancel", func() {
+		dismissConfirmName(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		if !NameMatches(typed, name) {
+			modal.SetText(fmt.Sprintf("%s\n[red::b]Name mismatch! Type %s to confirm.", msg, name))
+			return
+		}
+		ack()
+		dismissConfirmName(pages)
+		cancel()
+	})
+	modal.SetDoneFunc(func(int, string) {
+		dismissConfirmName(pages)
+		cancel()
+	})
+	pages.AddPage(confirmNameKey, modal, false, false)
+	pages.ShowPage(confirmNameKey)
+}
+
+// NameMatches checks if the typed in text matches the expected name.
+func NameMatches(typed, name string) bool {
+	return name != "" && strings.TrimSpace(typed) == name
+}
+
+func dismissConfirmName(pages *ui.Pages) {
+	pages.RemovePage(confirmNameKey)
+}
@@ -0,0 +1,43 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmNameDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowConfirmName(p, "Blee", "Yo", "fred", func() {}, func() {})
+
+	d := p.GetPrimitive(confirmNameKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismissConfirmName(p)
+	assert.Nil(t, p.GetPrimitive(confirmNameKey))
+}
+
+func TestNameMatches(t *testing.T) {
+	uu := map[string]struct {
+		typed, name string
+		e           bool
+	}{
+		"match":    {typed: "fred", name: "fred", e: true},
+		"spaces":   {typed: " fred ", name: "fred", e: true},
+		"mismatch": {typed: "fre", name: "fred"},
+		"case":     {typed: "Fred", name: "fred"},
+		"empty":    {typed: "", name: ""},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, NameMatches(u.typed, u.name))
+		})
+	}
+}
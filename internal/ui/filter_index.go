@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+)
+
+// sigBits represents the trigram signature size.
+const sigBits = 256
+
+// trigramSig represents a row trigrams as a bit set. A row may contain a
+// literal only if its signature holds all of the literal trigrams.
+type trigramSig [sigBits / 64]uint64
+
+func newTrigramSig(s string) trigramSig {
+	var sig trigramSig
+	for i := 0; i+3 <= len(s); i++ {
+		h := (uint32(s[i])*31+uint32(s[i+1]))*31 + uint32(s[i+2])
+		h %= sigBits
+		sig[h/64] |= 1 << (h % 64)
+	}
+
+	return sig
+}
+
+func (s trigramSig) contains(o trigramSig) bool {
+	for i := range s {
+		if s[i]&o[i] != o[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// indexDoc tracks a row searchable content.
+type indexDoc struct {
+	fields []string
+	text   string
+	sig    trigramSig
+	gen    int
+}
+
+func newIndexDoc(fields []string, gen int) indexDoc {
+	text := strings.ToLower(strings.Join(fields, " "))
+	return indexDoc{
+		fields: append([]string(nil), fields...),
+		text:   text,
+		sig:    newTrigramSig(text),
+		gen:    gen,
+	}
+}
+
+func (d indexDoc) same(fields []string) bool {
+	if len(d.fields) != len(fields) {
+		return false
+	}
+	for i := range fields {
+		if d.fields[i] != fields[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterIndex keeps rows lowercased content and trigrams as updates come in
+// so literal filters don't rescan every row content on each keystroke.
+type filterIndex struct {
+	docs map[string]indexDoc
+	gen  int
+}
+
+func newFilterIndex() *filterIndex {
+	return &filterIndex{docs: make(map[string]indexDoc)}
+}
+
+// update indexes new or changed rows and drops rows no longer listed.
+func (x *filterIndex) update(rr render.RowEvents) {
+	x.gen++
+	for _, re := range rr {
+		d, ok := x.docs[re.Row.ID]
+		if ok && d.same(re.Row.Fields) {
+			d.gen = x.gen
+		} else {
+			d = newIndexDoc(re.Row.Fields, x.gen)
+		}
+		x.docs[re.Row.ID] = d
+	}
+	for id, d := range x.docs {
+		if d.gen != x.gen {
+			delete(x.docs, id)
+		}
+	}
+}
+
+// filter returns rows matching a literal query. Returns false if the query
+// is not a literal.
+func (x *filterIndex) filter(q string, data render.TableData) (render.TableData, bool) {
+	if regexp.QuoteMeta(q) != q {
+		return data, false
+	}
+
+	q = strings.ToLower(q)
+	sig := newTrigramSig(q)
+	filtered := render.TableData{
+		Header:    data.Header,
+		RowEvents: make(render.RowEvents, 0, len(data.RowEvents)),
+		Namespace: data.Namespace,
+	}
+	for _, re := range data.RowEvents {
+		d, ok := x.docs[re.Row.ID]
+		if !ok || !d.same(re.Row.Fields) {
+			d = newIndexDoc(re.Row.Fields, x.gen)
+		}
+		if d.sig.contains(sig) && strings.Contains(d.text, q) {
+			filtered.RowEvents = append(filtered.RowEvents, re)
+		}
+	}
+
+	return filtered, true
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterIndexUpdate(t *testing.T) {
+	x := newFilterIndex()
+	x.update(render.RowEvents{
+		{Row: render.Row{ID: "a", Fields: render.Fields{"Fred", "Running"}}},
+		{Row: render.Row{ID: "b", Fields: render.Fields{"Blee", "Pending"}}},
+	})
+	assert.Equal(t, 2, len(x.docs))
+	assert.Equal(t, "fred running", x.docs["a"].text)
+
+	x.update(render.RowEvents{
+		{Row: render.Row{ID: "a", Fields: render.Fields{"Fred", "Completed"}}},
+	})
+	assert.Equal(t, 1, len(x.docs))
+	assert.Equal(t, "fred completed", x.docs["a"].text)
+}
+
+func TestFilterIndexFilter(t *testing.T) {
+	data := render.TableData{
+		Header: render.HeaderRow{{Name: "NAME"}, {Name: "STATUS"}},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "a", Fields: render.Fields{"nginx-1", "Running"}}},
+			{Row: render.Row{ID: "b", Fields: render.Fields{"redis-1", "Pending"}}},
+			{Row: render.Row{ID: "c", Fields: render.Fields{"nginx-2", "Pending"}}},
+		},
+	}
+	x := newFilterIndex()
+	x.update(data.RowEvents[:2])
+
+	uu := map[string]struct {
+		q   string
+		ok  bool
+		ids []string
+	}{
+		"literal":  {q: "nginx", ok: true, ids: []string{"a", "c"}},
+		"case":     {q: "PEND", ok: true, ids: []string{"b", "c"}},
+		"short":    {q: "1", ok: true, ids: []string{"a", "b"}},
+		"spanning": {q: "x-1 run", ok: true, ids: []string{"a"}},
+		"none":     {q: "blee", ok: true, ids: []string{}},
+		"regex":    {q: "nginx|redis"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			filtered, ok := x.filter(u.q, data)
+			assert.Equal(t, u.ok, ok)
+			if !ok {
+				return
+			}
+			ids := make([]string, 0, len(filtered.RowEvents))
+			for _, re := range filtered.RowEvents {
+				ids = append(ids, re.Row.ID)
+			}
+			assert.Equal(t, u.ids, ids)
+		})
+	}
+}
+
+func TestTrigramSig(t *testing.T) {
+	s := newTrigramSig("nginx-1 running")
+	assert.True(t, s.contains(newTrigramSig("nginx")))
+	assert.True(t, s.contains(newTrigramSig("ng")))
+	assert.False(t, s.contains(newTrigramSig("redis")))
+}
@@ -20,8 +20,10 @@ const (
 type Flash struct {
 	*tview.TextView
 
-	app      *App
-	testMode bool
+	app       *App
+	testMode  bool
+	plain     bool
+	listeners []model.FlashListener
 }
 
 // NewFlash returns a new flash view.
@@ -43,6 +45,16 @@ func (f *Flash) SetTestMode(b bool) {
 	f.testMode = b
 }
 
+// SetPlain labels messages with their level rather than an emoji.
+func (f *Flash) SetPlain(b bool) {
+	f.plain = b
+}
+
+// AddListener registers a flash listener.
+func (f *Flash) AddListener(l model.FlashListener) {
+	f.listeners = append(f.listeners, l)
+}
+
 // StylesChanged notifies listener the skin changed.
 func (f *Flash) StylesChanged(s *config.Styles) {
 	f.SetBackgroundColor(s.BgColor())
@@ -64,12 +76,23 @@ func (f *Flash) Watch(ctx context.Context, c model.FlashChan) {
 
 // SetMessage sets flash message and level.
 func (f *Flash) SetMessage(m model.LevelMessage) {
+	for _, l := range f.listeners {
+		if m.Text == "" {
+			l.FlashCleared()
+		} else {
+			l.FlashChanged(m.Level, m.Text)
+		}
+	}
 	fn := func() {
 		if m.Text == "" {
 			f.Clear()
 			return
 		}
 		f.SetTextColor(flashColor(m.Level))
+		if f.plain {
+			f.SetText(FlashLabel(m.Level) + " " + m.Text)
+			return
+		}
 		f.SetText(flashEmoji(m.Level) + " " + m.Text)
 	}
 
@@ -80,6 +103,18 @@ func (f *Flash) SetMessage(m model.LevelMessage) {
 	}
 }
 
+// FlashLabel returns a plain text label for a given flash level.
+func FlashLabel(l model.FlashLevel) string {
+	switch l {
+	case model.FlashWarn:
+		return "Warning:"
+	case model.FlashErr:
+		return "Error:"
+	default:
+		return "Info:"
+	}
+}
+
 func flashEmoji(l model.FlashLevel) string {
 	switch l {
 	case model.FlashWarn:
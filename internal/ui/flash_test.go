@@ -38,3 +38,33 @@ func TestFlash(t *testing.T) {
 		})
 	}
 }
+
+func TestFlashPlain(t *testing.T) {
+	a := ui.NewApp("test")
+	f := ui.NewFlash(a)
+	f.SetTestMode(true)
+	f.SetPlain(true)
+	var l flashListener
+	f.AddListener(&l)
+
+	f.SetMessage(model.LevelMessage{Level: model.FlashErr, Text: "hello"})
+	assert.Equal(t, "Error: hello\n", f.GetText(false))
+	f.SetMessage(model.LevelMessage{})
+
+	assert.Equal(t, []string{"Error: hello", "cleared"}, l.msgs)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type flashListener struct {
+	msgs []string
+}
+
+func (l *flashListener) FlashChanged(level model.FlashLevel, msg string) {
+	l.msgs = append(l.msgs, ui.FlashLabel(level)+" "+msg)
+}
+
+func (l *flashListener) FlashCleared() {
+	l.msgs = append(l.msgs, "cleared")
+}
@@ -10,11 +10,19 @@ func initKeys() {
 	tcell.KeyNames[tcell.Key(KeyHelp)] = "?"
 	tcell.KeyNames[tcell.Key(KeySlash)] = "/"
 	tcell.KeyNames[tcell.Key(KeySpace)] = "space"
+	tcell.KeyNames[tcell.Key(KeyLeftBracket)] = "["
+	tcell.KeyNames[tcell.Key(KeyRightBracket)] = "]"
+	tcell.KeyNames[tcell.Key(KeyApostrophe)] = "'"
+	tcell.KeyNames[tcell.Key(KeyLessThan)] = "<"
+	tcell.KeyNames[tcell.Key(KeyEqual)] = "="
+	tcell.KeyNames[tcell.Key(KeyGreaterThan)] = ">"
+	tcell.KeyNames[tcell.Key(KeyPipe)] = "|"
 
 	initNumbKeys()
 	initStdKeys()
 	initShiftKeys()
 	initShiftNumKeys()
+	initAltNumKeys()
 }
 
 // Defines numeric keys for container actions
@@ -45,6 +53,19 @@ const (
 	KeyShift9 int32 = 40
 )
 
+// Defines Alt numeric keys, see AsKey.
+const (
+	KeyAlt1 = tcell.Key(Key1 * int32(tcell.ModAlt))
+	KeyAlt2 = tcell.Key(Key2 * int32(tcell.ModAlt))
+	KeyAlt3 = tcell.Key(Key3 * int32(tcell.ModAlt))
+	KeyAlt4 = tcell.Key(Key4 * int32(tcell.ModAlt))
+	KeyAlt5 = tcell.Key(Key5 * int32(tcell.ModAlt))
+	KeyAlt6 = tcell.Key(Key6 * int32(tcell.ModAlt))
+	KeyAlt7 = tcell.Key(Key7 * int32(tcell.ModAlt))
+	KeyAlt8 = tcell.Key(Key8 * int32(tcell.ModAlt))
+	KeyAlt9 = tcell.Key(Key9 * int32(tcell.ModAlt))
+)
+
 // Defines char keystrokes
 const (
 	KeyA tcell.Key = iota + 97
@@ -77,6 +98,14 @@ const (
 	KeySlash = 47
 	KeyColon = 58
 	KeySpace = 32
+
+	KeyApostrophe   = 39
+	KeyLeftBracket  = 91
+	KeyRightBracket = 93
+	KeyLessThan     = 60
+	KeyEqual        = 61
+	KeyGreaterThan  = 62
+	KeyPipe         = 124
 )
 
 // Define Shift Keys
@@ -123,6 +152,19 @@ var NumKeys = map[int]int32{
 	9: Key9,
 }
 
+// AltNumKeys tracks Alt number keys.
+var AltNumKeys = map[int]tcell.Key{
+	1: KeyAlt1,
+	2: KeyAlt2,
+	3: KeyAlt3,
+	4: KeyAlt4,
+	5: KeyAlt5,
+	6: KeyAlt6,
+	7: KeyAlt7,
+	8: KeyAlt8,
+	9: KeyAlt9,
+}
+
 func initNumbKeys() {
 	tcell.KeyNames[tcell.Key(Key0)] = "0"
 	tcell.KeyNames[tcell.Key(Key1)] = "1"
@@ -178,6 +220,18 @@ func initShiftNumKeys() {
 	tcell.KeyNames[tcell.Key(KeyShift9)] = "Shift-9"
 }
 
+func initAltNumKeys() {
+	tcell.KeyNames[KeyAlt1] = "Alt-1"
+	tcell.KeyNames[KeyAlt2] = "Alt-2"
+	tcell.KeyNames[KeyAlt3] = "Alt-3"
+	tcell.KeyNames[KeyAlt4] = "Alt-4"
+	tcell.KeyNames[KeyAlt5] = "Alt-5"
+	tcell.KeyNames[KeyAlt6] = "Alt-6"
+	tcell.KeyNames[KeyAlt7] = "Alt-7"
+	tcell.KeyNames[KeyAlt8] = "Alt-8"
+	tcell.KeyNames[KeyAlt9] = "Alt-9"
+}
+
 func initShiftKeys() {
 	tcell.KeyNames[tcell.Key(KeyShiftA)] = "Shift-A"
 	tcell.KeyNames[tcell.Key(KeyShiftB)] = "Shift-B"
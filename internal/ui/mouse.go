@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+// MouseHandler handles mouse events and reports whether they got consumed.
+type MouseHandler func(*tcell.EventMouse) bool
+
+// MouseScreen wraps a terminal screen to intercept mouse events.
+type MouseScreen struct {
+	tcell.Screen
+
+	handler MouseHandler
+}
+
+// NewMouseScreen returns a new mouse aware screen.
+func NewMouseScreen(s tcell.Screen, h MouseHandler) *MouseScreen {
+	return &MouseScreen{Screen: s, handler: h}
+}
+
+// Init initializes the screen and enables mouse reporting.
+func (m *MouseScreen) Init() error {
+	if err := m.Screen.Init(); err != nil {
+		return err
+	}
+	m.EnableMouse()
+
+	return nil
+}
+
+// PollEvent dispatches mouse events to the handler and returns all others,
+// including the mouse events the handler did not consume.
+func (m *MouseScreen) PollEvent() tcell.Event {
+	for {
+		evt := m.Screen.PollEvent()
+		if me, ok := evt.(*tcell.EventMouse); ok && m.handler(me) {
+			continue
+		}
+		return evt
+	}
+}
+
+// TableRowAt returns the table row located at a given screen row or -1 if none.
+// Fixed rows remain in place while scrolling.
+func TableRowAt(t *tview.Table, fixed, y int) int {
+	_, top, _, height := t.GetInnerRect()
+	if y < top || y >= top+height {
+		return -1
+	}
+	row := y - top
+	if row >= fixed {
+		offset, _ := t.GetOffset()
+		row += offset
+	}
+	if row >= t.GetRowCount() {
+		return -1
+	}
+
+	return row
+}
+
+// InRect checks if a screen location is within a primitive bounds.
+func InRect(p tview.Primitive, x, y int) bool {
+	px, py, w, h := p.GetRect()
+
+	return x >= px && x < px+w && y >= py && y < py+h
+}
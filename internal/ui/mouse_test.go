@@ -0,0 +1,67 @@
+package ui_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableRowAt(t *testing.T) {
+	uu := map[string]struct {
+		offset, y, e int
+	}{
+		"above":    {y: 4, e: -1},
+		"header":   {y: 5, e: 0},
+		"first":    {y: 6, e: 1},
+		"last":     {y: 8, e: 3},
+		"below":    {y: 9, e: -1},
+		"scrolled": {offset: 3, y: 6, e: 4},
+		"fixed":    {offset: 3, y: 5, e: 0},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tv := tview.NewTable()
+			for r := 0; r < 10; r++ {
+				tv.SetCell(r, 0, tview.NewTableCell("fred"))
+			}
+			tv.SetRect(0, 5, 20, 4)
+			tv.SetOffset(u.offset, 0)
+
+			assert.Equal(t, u.e, ui.TableRowAt(tv, 1, u.y))
+		})
+	}
+}
+
+func TestInRect(t *testing.T) {
+	tv := tview.NewTable()
+	tv.SetRect(2, 5, 20, 4)
+
+	assert.True(t, ui.InRect(tv, 2, 5))
+	assert.True(t, ui.InRect(tv, 21, 8))
+	assert.False(t, ui.InRect(tv, 22, 8))
+	assert.False(t, ui.InRect(tv, 2, 9))
+}
+
+func TestMouseScreenPassThrough(t *testing.T) {
+	s := tcell.NewSimulationScreen("")
+	m := ui.NewMouseScreen(s, func(evt *tcell.EventMouse) bool {
+		return evt.Buttons()&tcell.Button1 != 0
+	})
+	assert.Nil(t, m.Init())
+	defer m.Fini()
+
+	s.InjectMouse(1, 1, tcell.Button1, tcell.ModNone)
+	s.InjectMouse(1, 1, tcell.WheelUp, tcell.ModNone)
+	s.InjectKey(tcell.KeyEnter, 0, tcell.ModNone)
+
+	evt, ok := m.PollEvent().(*tcell.EventMouse)
+	assert.True(t, ok)
+	assert.Equal(t, tcell.WheelUp, evt.Buttons())
+	_, ok = m.PollEvent().(*tcell.EventKey)
+	assert.True(t, ok)
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
 )
@@ -9,10 +10,14 @@ import (
 type SelectTable struct {
 	*tview.Table
 
-	model       Tabular
-	selectedRow int
-	selectedFn  func(string) string
-	marks       map[string]struct{}
+	model         Tabular
+	selectedRow   int
+	selectedFn    func(string) string
+	selectedRowFn SelectedRowFunc
+	rowFns        []SelectedRowFunc
+	materializeFn func(r int)
+	marks         map[string]struct{}
+	rows          render.RowEvents
 }
 
 // SetModel sets the table model.
@@ -57,7 +62,7 @@ func (s *SelectTable) GetSelectedItem() string {
 	if s.GetSelectedRowIndex() == 0 || s.model.Empty() {
 		return ""
 	}
-	sel, ok := s.GetCell(s.GetSelectedRowIndex(), 0).GetReference().(string)
+	sel, ok := s.RowID(s.GetSelectedRowIndex())
 	if !ok {
 		return ""
 	}
@@ -67,6 +72,42 @@ func (s *SelectTable) GetSelectedItem() string {
 	return sel
 }
 
+// SelectItem selects the row of a given item. Returns false if the item is not listed.
+func (s *SelectTable) SelectItem(id string) bool {
+	for r := 1; r < s.GetRowCount(); r++ {
+		ref, ok := s.RowID(r)
+		if !ok {
+			continue
+		}
+		if ref == id || (s.selectedFn != nil && s.selectedFn(ref) == id) {
+			s.SelectRow(r, true)
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetRow returns the resource row shown on a given table row. Rows are
+// resolved from the table data as large tables only draw rows in view.
+func (s *SelectTable) GetRow(r int) (render.Row, bool) {
+	if r <= 0 || r > len(s.rows) {
+		return render.Row{}, false
+	}
+
+	return s.rows[r-1].Row, true
+}
+
+// RowID returns the id of the resource shown on a given table row.
+func (s *SelectTable) RowID(r int) (string, bool) {
+	if row, ok := s.GetRow(r); ok {
+		return row.ID, true
+	}
+	id, ok := s.GetCell(r, 0).GetReference().(string)
+
+	return id, ok
+}
+
 // GetSelectedCell returns the content of a cell for the currently selected row.
 func (s *SelectTable) GetSelectedCell(col int) string {
 	return TrimCell(s, s.selectedRow, col)
@@ -77,6 +118,22 @@ func (s *SelectTable) SetSelectedFn(f func(string) string) {
 	s.selectedFn = f
 }
 
+// SetSelectedRowFn defines a function to be notified when the selected row changes.
+func (s *SelectTable) SetSelectedRowFn(f SelectedRowFunc) {
+	s.selectedRowFn = f
+}
+
+// AddSelectedRowFn registers an additional function to be notified when the
+// selected row changes.
+func (s *SelectTable) AddSelectedRowFn(f SelectedRowFunc) {
+	s.rowFns = append(s.rowFns, f)
+}
+
+// SetMaterializeFn defines a function that draws a row before it gets selected.
+func (s *SelectTable) SetMaterializeFn(f func(r int)) {
+	s.materializeFn = f
+}
+
 // GetSelectedRowIndex fetch the currently selected row index.
 func (s *SelectTable) GetSelectedRowIndex() int {
 	return s.selectedRow
@@ -101,8 +158,17 @@ func (s *SelectTable) selectionChanged(r, c int) {
 		return
 	}
 	s.selectedRow = r
+	if s.materializeFn != nil {
+		s.materializeFn(r)
+	}
 	cell := s.GetCell(r, c)
 	s.SetSelectedStyle(tcell.ColorBlack, cell.Color, tcell.AttrBold)
+	if s.selectedRowFn != nil {
+		s.selectedRowFn(r)
+	}
+	for _, f := range s.rowFns {
+		f(r)
+	}
 }
 
 // ClearMarks delete all marked items.
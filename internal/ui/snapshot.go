@@ -0,0 +1,191 @@
+package ui
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"github.com/gdamore/tcell"
+)
+
+// SnapCell represents a screen cell content and style.
+type SnapCell struct {
+	Text   string
+	Fg, Bg tcell.Color
+	Attrs  tcell.AttrMask
+}
+
+// Snapshot represents a screen content, one slice of cells per line.
+type Snapshot [][]SnapCell
+
+// SnapshotFunc represents a screen snapshot callback.
+type SnapshotFunc func(Snapshot)
+
+// CaptureScreen grabs a screen content. Cells covered by a wide rune are
+// skipped.
+func CaptureScreen(s tcell.Screen) Snapshot {
+	w, h := s.Size()
+	snap := make(Snapshot, 0, h)
+	for y := 0; y < h; y++ {
+		line := make([]SnapCell, 0, w)
+		for x := 0; x < w; x++ {
+			r, cc, style, width := s.GetContent(x, y)
+			fg, bg, attrs := style.Decompose()
+			text := " "
+			if r != 0 {
+				text = string(append([]rune{r}, cc...))
+			}
+			line = append(line, SnapCell{Text: text, Fg: fg, Bg: bg, Attrs: attrs})
+			if width > 1 {
+				x += width - 1
+			}
+		}
+		snap = append(snap, line)
+	}
+
+	return snap
+}
+
+// ANSI renders a snapshot as text colored with ANSI escape sequences.
+func (s Snapshot) ANSI() string {
+	var b strings.Builder
+	for _, line := range s {
+		var last string
+		for _, c := range trimLine(line) {
+			if seq := ansiStyle(c); seq != last {
+				b.WriteString(seq)
+				last = seq
+			}
+			b.WriteString(c.Text)
+		}
+		b.WriteString("\x1b[0m\n")
+	}
+
+	return b.String()
+}
+
+// HTML renders a snapshot as a standalone html document.
+func (s Snapshot) HTML(title string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, htmlHeader, html.EscapeString(title))
+	for _, line := range s {
+		var last string
+		cells := trimLine(line)
+		for i, c := range cells {
+			css := cssStyle(c)
+			if i == 0 || css != last {
+				if i > 0 {
+					b.WriteString("</span>")
+				}
+				fmt.Fprintf(&b, `<span style="%s">`, css)
+				last = css
+			}
+			b.WriteString(html.EscapeString(c.Text))
+		}
+		if len(cells) > 0 {
+			b.WriteString("</span>")
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(htmlFooter)
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+const (
+	htmlHeader = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+</head>
+<body style="background-color:#000000;color:#ffffff">
+<pre style="font-family:monospace;line-height:1.2">
+`
+	htmlFooter = `</pre>
+</body>
+</html>
+`
+)
+
+// colorHex returns a color rgb value or -1 for the terminal default color.
+func colorHex(c tcell.Color) int32 {
+	if c == tcell.ColorDefault {
+		return -1
+	}
+
+	return c.Hex()
+}
+
+// trimLine drops trailing blank cells without a background.
+func trimLine(line []SnapCell) []SnapCell {
+	n := len(line)
+	for n > 0 {
+		c := line[n-1]
+		if strings.TrimSpace(c.Text) != "" || colorHex(c.Bg) != -1 || c.Attrs&tcell.AttrReverse != 0 {
+			break
+		}
+		n--
+	}
+
+	return line[:n]
+}
+
+func ansiStyle(c SnapCell) string {
+	ss := []string{"0"}
+	if c.Attrs&tcell.AttrBold != 0 {
+		ss = append(ss, "1")
+	}
+	if c.Attrs&tcell.AttrDim != 0 {
+		ss = append(ss, "2")
+	}
+	if c.Attrs&tcell.AttrUnderline != 0 {
+		ss = append(ss, "4")
+	}
+	if c.Attrs&tcell.AttrReverse != 0 {
+		ss = append(ss, "7")
+	}
+	if rgb := colorHex(c.Fg); rgb != -1 {
+		ss = append(ss, fmt.Sprintf("38;2;%d;%d;%d", rgb>>16&0xff, rgb>>8&0xff, rgb&0xff))
+	}
+	if rgb := colorHex(c.Bg); rgb != -1 {
+		ss = append(ss, fmt.Sprintf("48;2;%d;%d;%d", rgb>>16&0xff, rgb>>8&0xff, rgb&0xff))
+	}
+
+	return "\x1b[" + strings.Join(ss, ";") + "m"
+}
+
+func cssStyle(c SnapCell) string {
+	fg, bg := colorHex(c.Fg), colorHex(c.Bg)
+	if c.Attrs&tcell.AttrReverse != 0 {
+		fg, bg = bg, fg
+		if fg == -1 {
+			fg = 0x000000
+		}
+		if bg == -1 {
+			bg = 0xffffff
+		}
+	}
+
+	var ss []string
+	if fg != -1 {
+		ss = append(ss, fmt.Sprintf("color:#%06x", fg))
+	}
+	if bg != -1 {
+		ss = append(ss, fmt.Sprintf("background-color:#%06x", bg))
+	}
+	if c.Attrs&tcell.AttrBold != 0 {
+		ss = append(ss, "font-weight:bold")
+	}
+	if c.Attrs&tcell.AttrDim != 0 {
+		ss = append(ss, "opacity:0.6")
+	}
+	if c.Attrs&tcell.AttrUnderline != 0 {
+		ss = append(ss, "text-decoration:underline")
+	}
+
+	return strings.Join(ss, ";")
+}
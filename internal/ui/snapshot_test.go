@@ -0,0 +1,77 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureScreen(t *testing.T) {
+	s := tcell.NewSimulationScreen("UTF-8")
+	assert.Nil(t, s.Init())
+	s.SetSize(4, 2)
+	style := tcell.StyleDefault.Foreground(tcell.NewHexColor(0xff0000)).Bold(true)
+	s.SetContent(0, 0, 'o', nil, style)
+	s.SetContent(1, 0, 'k', nil, style)
+
+	snap := CaptureScreen(s)
+	assert.Equal(t, 2, len(snap))
+	assert.Equal(t, 4, len(snap[0]))
+	assert.Equal(t, "o", snap[0][0].Text)
+	assert.Equal(t, int32(0xff0000), snap[0][0].Fg.Hex())
+	assert.True(t, snap[0][0].Attrs&tcell.AttrBold != 0)
+	assert.Equal(t, " ", snap[1][0].Text)
+}
+
+func TestSnapshotANSI(t *testing.T) {
+	uu := map[string]struct {
+		snap Snapshot
+		e    string
+	}{
+		"empty": {
+			e: "",
+		},
+		"plain": {
+			snap: Snapshot{{plainCell("o"), plainCell("k"), plainCell(" ")}},
+			e:    "\x1b[0mok\x1b[0m\n",
+		},
+		"colors": {
+			snap: Snapshot{{
+				{Text: "a", Fg: tcell.NewHexColor(0xff0000), Bg: tcell.ColorDefault, Attrs: tcell.AttrBold},
+				{Text: "b", Fg: tcell.NewHexColor(0xff0000), Bg: tcell.ColorDefault, Attrs: tcell.AttrBold},
+				{Text: "c", Fg: tcell.ColorDefault, Bg: tcell.NewHexColor(0x0000ff)},
+			}},
+			e: "\x1b[0;1;38;2;255;0;0mab\x1b[0;48;2;0;0;255mc\x1b[0m\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.snap.ANSI())
+		})
+	}
+}
+
+func TestSnapshotHTML(t *testing.T) {
+	snap := Snapshot{
+		{
+			{Text: "<", Fg: tcell.NewHexColor(0x00ff00), Bg: tcell.ColorDefault},
+			{Text: "a", Fg: tcell.NewHexColor(0x00ff00), Bg: tcell.ColorDefault},
+			{Text: "b", Fg: tcell.ColorDefault, Bg: tcell.ColorDefault, Attrs: tcell.AttrReverse},
+			plainCell(" "),
+		},
+		{plainCell(" ")},
+	}
+
+	s := snap.HTML("pods & co")
+	assert.Contains(t, s, "<title>pods &amp; co</title>")
+	assert.Contains(t, s, `<span style="color:#00ff00">&lt;a</span><span style="color:#000000;background-color:#ffffff">b</span>`+"\n\n</pre>")
+}
+
+// Helpers...
+
+func plainCell(s string) SnapCell {
+	return SnapCell{Text: s, Fg: tcell.ColorDefault, Bg: tcell.ColorDefault}
+}
@@ -4,7 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
@@ -22,10 +25,24 @@ type (
 	// DecorateFunc represents a row decorator.
 	DecorateFunc func(render.TableData) render.TableData
 
+	// PinFunc checks if a row must be listed first.
+	PinFunc func(render.RowEvent) bool
+
+	// PageFunc returns the collection size of a paginated listing or -1 if
+	// unknown. Returns false if the listing is not paginated.
+	PageFunc func() (int64, bool)
+
+	// StaleFunc returns the time a listing went stale. Returns false if the
+	// listing is up to date.
+	StaleFunc func() (time.Time, bool)
+
 	// SelectedRowFunc a table selection callback.
 	SelectedRowFunc func(r int)
 )
 
+// markGlyph prefixes marked rows when status glyphs are shown.
+const markGlyph = ">"
+
 // Table represents tabular data.
 type Table struct {
 	*SelectTable
@@ -38,8 +55,18 @@ type Table struct {
 	sortCol    SortColumn
 	colorerFn  render.ColorerFunc
 	decorateFn DecorateFunc
+	pinFn      PinFunc
+	pageFn     PageFunc
+	staleFn    StaleFunc
+	rate       time.Duration
+	drawn      drawnRows
+	virtual    *virtualRows
+	index      *filterIndex
+	buff       []string
 	wide       bool
 	toast      bool
+	cols       columnLayout
+	glyphs     bool
 }
 
 // NewTable returns a new table view.
@@ -52,6 +79,7 @@ func NewTable(gvr string) *Table {
 			marks:       make(map[string]struct{}),
 		},
 		actions:   make(KeyActions),
+		index:     newFilterIndex(),
 		cmdBuff:   NewCmdBuff('/', FilterBuff),
 		BaseTitle: gvr,
 		sortCol:   SortColumn{index: -1, colCount: 0, asc: true},
@@ -67,6 +95,7 @@ func (t *Table) Init(ctx context.Context) {
 	t.SetSelectable(true, false)
 	t.SetSelectionChangedFunc(t.selectionChanged)
 	t.SetInputCapture(t.keyboard)
+	t.SetMaterializeFn(t.MaterializeRow)
 	t.SetBackgroundColor(tcell.ColorDefault)
 
 	t.styles = mustExtractSyles(ctx)
@@ -75,6 +104,7 @@ func (t *Table) Init(ctx context.Context) {
 
 // StylesChanged notifies the skin changed.
 func (t *Table) StylesChanged(s *config.Styles) {
+	t.drawn.reset()
 	t.SetBackgroundColor(s.Table().BgColor.Color())
 	t.SetBorderColor(s.Table().FgColor.Color())
 	t.SetBorderFocusColor(s.Frame().Border.FocusColor.Color())
@@ -86,6 +116,13 @@ func (t *Table) StylesChanged(s *config.Styles) {
 	t.Refresh()
 }
 
+// SetStatusGlyphs prefixes rows with a glyph conveying their status so the
+// status does not solely rely on the row color.
+func (t *Table) SetStatusGlyphs(b bool) {
+	t.glyphs = b
+	t.drawn.reset()
+}
+
 // ResetToast resets toast flag.
 func (t *Table) ResetToast() {
 	t.toast = false
@@ -167,11 +204,77 @@ func (t *Table) GetFilteredData() render.TableData {
 	return t.filtered(t.GetModel().Peek())
 }
 
+// GetVisibleData returns the table data as currently shown, ie filtered and
+// sorted with hidden wide columns dropped.
+func (t *Table) GetVisibleData() render.TableData {
+	return visibleData(t.shownData(), t.wide)
+}
+
+// Export writes out the table data as currently shown in a given format.
+func (t *Table) Export(w io.Writer, format string) error {
+	data := t.shownData()
+	color := render.DefaultColorer
+	if t.colorerFn != nil {
+		color = t.colorerFn
+	}
+	// Colorers expect all columns so rows are colored prior to dropping any.
+	rr := make(map[string]render.RowEvent, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		rr[re.Row.ID] = re
+	}
+	colorer := func(ns string, re render.RowEvent) tcell.Color {
+		return color(ns, rr[re.Row.ID])
+	}
+
+	return render.Export(w, format, visibleData(data, t.wide), colorer)
+}
+
+// shownData returns the filtered table data in display order.
+func (t *Table) shownData() render.TableData {
+	data := t.GetModel().Peek()
+	if t.decorateFn != nil {
+		data = t.decorateFn(data)
+	}
+	data = t.filtered(data)
+	rr := make(render.RowEvents, len(data.RowEvents))
+	copy(rr, data.RowEvents)
+	if t.sortCol.index >= 0 && t.sortCol.index < len(data.Header) {
+		rr.Sort(data.Namespace, t.sortCol.index, t.sortCol.asc)
+	}
+	if t.pinFn != nil {
+		rr.Pin(t.pinFn)
+	}
+	data.RowEvents = rr
+
+	return data
+}
+
 // SetDecorateFn specifies the default row decorator.
 func (t *Table) SetDecorateFn(f DecorateFunc) {
 	t.decorateFn = f
 }
 
+// SetPinFn specifies which rows are listed first.
+func (t *Table) SetPinFn(f PinFunc) {
+	t.pinFn = f
+}
+
+// SetPageFn specifies how to retrieve a paginated listing size.
+func (t *Table) SetPageFn(f PageFunc) {
+	t.pageFn = f
+}
+
+// SetStaleFn specifies how to check if a listing went stale.
+func (t *Table) SetStaleFn(f StaleFunc) {
+	t.staleFn = f
+}
+
+// SetRefreshIndicator shows a custom refresh rate in the title. A zero rate
+// hides the indicator.
+func (t *Table) SetRefreshIndicator(d time.Duration) {
+	t.rate = d
+}
+
 // SetColorerFn specifies the default colorer.
 func (t *Table) SetColorerFn(f render.ColorerFunc) {
 	t.colorerFn = f
@@ -182,11 +285,17 @@ func (t *Table) SetSortCol(index, count int, asc bool) {
 	t.sortCol.index, t.sortCol.colCount, t.sortCol.asc = index, count, asc
 }
 
+// GetSortCol returns the sort column index, column count and order.
+func (t *Table) GetSortCol() (int, int, bool) {
+	return t.sortCol.index, t.sortCol.colCount, t.sortCol.asc
+}
+
 // Update table content.
 func (t *Table) Update(data render.TableData) {
 	if t.decorateFn != nil {
 		data = t.decorateFn(data)
 	}
+	t.index.update(data.RowEvents)
 	t.doUpdate(t.filtered(data))
 	t.UpdateTitle()
 }
@@ -198,29 +307,97 @@ func (t *Table) doUpdate(data render.TableData) {
 		t.actions.Delete(KeyShiftP)
 	}
 
-	t.Clear()
 	t.adjustSorter(data)
+	t.updateHeader(data.Header)
+	data.RowEvents.Sort(data.Namespace, t.sortCol.index, t.sortCol.asc)
+	if t.pinFn != nil {
+		data.RowEvents.Pin(t.pinFn)
+	}
+
+	// Only rows that changed since the last update are redrawn.
+	pads := make(MaxyPad, len(data.Header))
+	ComputeMaxColumns(pads, t.sortCol.index, data.Header, data.RowEvents)
+	t.updateColumns(data.Header, pads)
+	t.rows, t.virtual = data.RowEvents, nil
+	if len(data.RowEvents) > VirtualRowThreshold {
+		t.virtual = newVirtualRows(data, pads)
+	}
+	count := len(data.RowEvents)
+	t.drawn.resize(count)
+	for i, re := range data.RowEvents {
+		// Offscreen rows of large tables are drawn once scrolled into view.
+		if t.virtual != nil && !t.inWindow(i, count) {
+			t.virtual.pending[i] = true
+			t.drawn.invalidate(i)
+			if i+1 >= t.GetRowCount() {
+				t.SetCell(i+1, 0, placeholder)
+			}
+			continue
+		}
+		// Unchanged rows hand their fields buffer over to the next row.
+		row := t.renderRow(data.Namespace, re, data.Header, pads, t.buff)
+		if !t.drawn.changed(i, row) {
+			t.buff = row.fields
+			continue
+		}
+		t.drawRow(i+1, row, data.Header)
+		t.drawn.rows[i], t.buff = row, nil
+	}
+	for r := t.GetRowCount() - 1; r > count; r-- {
+		t.RemoveRow(r)
+	}
+	t.updateSelection(true)
+}
+
+// Draw draws the table, drawing rows about to be shown first.
+func (t *Table) Draw(screen tcell.Screen) {
+	if t.virtual != nil {
+		offset, _ := t.GetOffset()
+		t.MaterializeRow(offset + 1)
+		t.MaterializeRow(t.GetSelectedRowIndex())
+	}
+	t.SelectTable.Draw(screen)
+}
+
+// Clear clears out the table content.
+func (t *Table) Clear() *tview.Table {
+	t.drawn.reset()
+	t.rows, t.virtual = nil, nil
+
+	return t.SelectTable.Clear()
+}
+
+// updateHeader redraws the whole table if the header changed.
+func (t *Table) updateHeader(header render.HeaderRow) {
+	hh := make([]string, 0, len(header))
+	for _, h := range header {
+		if h.Wide && !t.wide {
+			continue
+		}
+		hh = append(hh, sortIndicator(t.sortCol, t.styles.Table(), len(hh), h.Name))
+	}
+	if t.drawn.sameHeader(hh) {
+		return
+	}
+
+	t.Clear()
 	fg := t.styles.Table().Header.FgColor.Color()
 	bg := t.styles.Table().Header.BgColor.Color()
 	var col int
-	for _, h := range data.Header {
+	for _, h := range header {
 		if h.Wide && !t.wide {
 			continue
 		}
 		t.AddHeaderCell(col, h)
 		c := t.GetCell(0, col)
+		if t.glyphs && col == 0 {
+			c.SetText("  " + c.Text)
+		}
 		c.SetBackgroundColor(bg)
 		c.SetTextColor(fg)
 		col++
 	}
-	data.RowEvents.Sort(data.Namespace, t.sortCol.index, t.sortCol.asc)
-
-	pads := make(MaxyPad, len(data.Header))
-	ComputeMaxColumns(pads, t.sortCol.index, data.Header, data.RowEvents)
-	for i, r := range data.RowEvents {
-		t.buildRow(data.Namespace, i+1, r, data.Header, pads)
-	}
-	t.updateSelection(true)
+	t.drawn.header = hh
 }
 
 // SortColCmd designates a sorted column.
@@ -270,13 +447,25 @@ func (t *Table) adjustSorter(data render.TableData) {
 	}
 }
 
-func (t *Table) buildRow(ns string, r int, re render.RowEvent, header render.HeaderRow, pads MaxyPad) {
+// renderRow computes a row cells content.
+// Fields are rendered into the given buffer when large enough.
+func (t *Table) renderRow(ns string, re render.RowEvent, header render.HeaderRow, pads MaxyPad, buff []string) drawnRow {
 	color := render.DefaultColorer
 	if t.colorerFn != nil {
 		color = t.colorerFn
 	}
-	marked := t.IsMarked(re.Row.ID)
-	var col int
+	if cap(buff) < len(re.Row.Fields) {
+		buff = make([]string, 0, len(re.Row.Fields))
+	}
+	row := drawnRow{
+		id:     re.Row.ID,
+		fields: buff[:0],
+		color:  color(ns, re),
+	}
+	glyph := render.RowGlyph(row.color)
+	if t.IsMarked(re.Row.ID) {
+		row.color, glyph = t.styles.Table().MarkColor.Color(), markGlyph
+	}
 	for c, field := range re.Row.Fields {
 		if header[c].Wide && !t.wide {
 			continue
@@ -287,21 +476,47 @@ func (t *Table) buildRow(ns string, r int, re render.RowEvent, header render.Hea
 		if header[c].Decorator != nil {
 			field = header[c].Decorator(field)
 		}
-		if header[c].Align == tview.AlignLeft {
+		if w, ok := t.cols.widths[header[c].Name]; ok {
+			field = fitCell(field, w, header[c].Align)
+		} else if header[c].Align == tview.AlignLeft {
 			field = formatCell(field, pads[c])
 		}
+		if t.glyphs && len(row.fields) == 0 {
+			field = glyph + " " + field
+		}
+		row.fields = append(row.fields, field)
+	}
+
+	return row
+}
 
-		cell := tview.NewTableCell(field)
+// Cells of a row last drawn with as many columns are updated in place.
+func (t *Table) drawRow(r int, row drawnRow, header render.HeaderRow) {
+	reuse := t.drawn.drawnCols(r-1) == len(row.fields)
+	var col int
+	for c := range header {
+		if header[c].Wide && !t.wide {
+			continue
+		}
+		if col >= len(row.fields) {
+			break
+		}
+		var cell *tview.TableCell
+		if reuse {
+			cell = t.GetCell(r, col)
+			cell.SetText(row.fields[col])
+		} else {
+			cell = tview.NewTableCell(row.fields[col])
+		}
 		cell.SetExpansion(1)
 		cell.SetAlign(header[c].Align)
-		cell.SetTextColor(color(ns, re))
-		if marked {
-			cell.SetTextColor(t.styles.Table().MarkColor.Color())
-		}
+		cell.SetTextColor(row.color)
 		if col == 0 {
-			cell.SetReference(re.Row.ID)
+			cell.SetReference(row.id)
+		}
+		if !reuse {
+			t.SetCell(r, col, cell)
 		}
-		t.SetCell(r, col, cell)
 		col++
 	}
 }
@@ -320,8 +535,8 @@ func (t *Table) Refresh() {
 
 // GetSelectedRow returns the entire selected row.
 func (t *Table) GetSelectedRow() render.Row {
-	log.Debug().Msgf("INDEX %d", t.GetSelectedRowIndex())
-	return t.model.Peek().RowEvents[t.GetSelectedRowIndex()-1].Row
+	row, _ := t.GetRow(t.GetSelectedRowIndex())
+	return row
 }
 
 // NameColIndex returns the index of the resource name column.
@@ -349,7 +564,7 @@ func (t *Table) filtered(data render.TableData) render.TableData {
 	if t.toast {
 		filtered = filterToast(data)
 	}
-	if t.cmdBuff.Empty() || IsLabelSelector(t.cmdBuff.String()) {
+	if t.cmdBuff.Empty() || IsServerSelector(t.cmdBuff.String()) {
 		return filtered
 	}
 
@@ -357,6 +572,9 @@ func (t *Table) filtered(data render.TableData) render.TableData {
 	if IsFuzzySelector(q) {
 		return fuzzyFilter(q[2:], t.NameColIndex(), filtered)
 	}
+	if indexed, ok := t.index.filter(q, filtered); ok {
+		return indexed
+	}
 
 	filtered, err := rxFilter(t.cmdBuff.String(), filtered)
 	if err != nil {
@@ -375,6 +593,7 @@ func (t *Table) SearchBuff() *CmdBuff {
 // ShowDeleted marks row as deleted.
 func (t *Table) ShowDeleted() {
 	r, _ := t.GetSelection()
+	t.drawn.invalidate(r - 1)
 	cols := t.GetColumnCount()
 	for x := 0; x < cols; x++ {
 		t.GetCell(r, x).SetAttributes(tcell.AttrDim)
@@ -408,19 +627,48 @@ func (t *Table) styleTitle() string {
 	}
 
 	var title string
-	if ns == client.ClusterScope {
+	total, paged := t.pageTotal()
+	switch {
+	case paged && ns == client.ClusterScope:
+		title = SkinTitle(fmt.Sprintf(PagedTitleFmt, base, rc, total), t.styles.Frame())
+	case paged:
+		title = SkinTitle(fmt.Sprintf(PagedNSTitleFmt, base, ns, rc, total), t.styles.Frame())
+	case ns == client.ClusterScope:
 		title = SkinTitle(fmt.Sprintf(TitleFmt, base, rc), t.styles.Frame())
-	} else {
+	default:
 		title = SkinTitle(fmt.Sprintf(NSTitleFmt, base, ns, rc), t.styles.Frame())
 	}
+	if t.rate > 0 {
+		title += SkinTitle(fmt.Sprintf(RateFmt, rateLabel(t.rate)), t.styles.Frame())
+	}
+	if t.staleFn != nil {
+		if since, ok := t.staleFn(); ok {
+			title += SkinTitle(fmt.Sprintf(StaleFmt, since.Format(staleTimeFmt)), t.styles.Frame())
+		}
+	}
 
 	buff := t.cmdBuff.String()
 	if buff == "" {
 		return title
 	}
-	if IsLabelSelector(buff) {
+	if IsServerSelector(buff) {
 		buff = TrimLabelSelector(buff)
 	}
 
 	return title + SkinTitle(fmt.Sprintf(SearchFmt, buff), t.styles.Frame())
 }
+
+func (t *Table) pageTotal() (string, bool) {
+	if t.pageFn == nil {
+		return "", false
+	}
+	total, ok := t.pageFn()
+	if !ok {
+		return "", false
+	}
+	if total < 0 {
+		return "?", true
+	}
+
+	return strconv.FormatInt(total, 10), true
+}
@@ -0,0 +1,218 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	runewidth "github.com/mattn/go-runewidth"
+)
+
+const (
+	// ColumnStep represents the number of characters a column grows or shrinks by.
+	ColumnStep = 4
+
+	minColumnWidth = 3
+)
+
+// columnLayout tracks custom column widths, the column cursor and whether the
+// columns up to the name column are frozen while scrolling horizontally.
+type columnLayout struct {
+	widths map[string]int
+	frozen bool
+	cursor int
+	shown  bool
+	names  []string
+	sizes  []int
+}
+
+// SetColumnWidths sets custom column widths keyed by column name.
+func (t *Table) SetColumnWidths(ww map[string]int) {
+	t.cols.widths = make(map[string]int, len(ww))
+	for k, v := range ww {
+		t.cols.widths[k] = v
+	}
+}
+
+// ColumnWidths returns the custom column widths.
+func (t *Table) ColumnWidths() map[string]int {
+	ww := make(map[string]int, len(t.cols.widths))
+	for k, v := range t.cols.widths {
+		ww[k] = v
+	}
+
+	return ww
+}
+
+// SetFrozen freezes the columns up to the name column so they remain shown
+// while scrolling horizontally.
+func (t *Table) SetFrozen(b bool) {
+	t.cols.frozen = b
+	t.applyFrozen()
+}
+
+// IsFrozen checks if the name column is frozen.
+func (t *Table) IsFrozen() bool {
+	return t.cols.frozen
+}
+
+// ColumnCursor returns the name of the column under the cursor.
+func (t *Table) ColumnCursor() string {
+	if t.cols.cursor < 0 || t.cols.cursor >= len(t.cols.names) {
+		return ""
+	}
+
+	return t.cols.names[t.cols.cursor]
+}
+
+// MoveColumnCursor moves the column cursor, scrolling the table horizontally
+// to keep the column shown.
+func (t *Table) MoveColumnCursor(delta int) {
+	c := t.cols.cursor + delta
+	if c < 0 {
+		c = 0
+	}
+	if n := len(t.cols.names); c >= n {
+		c = n - 1
+	}
+	t.cols.cursor, t.cols.shown = c, true
+	t.styleColumnCursor()
+	t.scrollToColumn(c)
+}
+
+// ResizeColumn widens or shrinks the column under the cursor. Returns the
+// column name and its new width.
+func (t *Table) ResizeColumn(delta int) (string, int) {
+	name := t.ColumnCursor()
+	if name == "" {
+		return "", 0
+	}
+	w := t.cols.sizes[t.cols.cursor] + delta
+	if min := minWidth(name); w < min {
+		w = min
+	}
+	if t.cols.widths == nil {
+		t.cols.widths = make(map[string]int)
+	}
+	t.cols.widths[name], t.cols.shown = w, true
+	t.Refresh()
+
+	return name, w
+}
+
+// ResetColumn restores the automatic width of the column under the cursor.
+func (t *Table) ResetColumn() string {
+	name := t.ColumnCursor()
+	delete(t.cols.widths, name)
+	t.Refresh()
+
+	return name
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// updateColumns tracks the shown columns names and widths.
+func (t *Table) updateColumns(header render.HeaderRow, pads MaxyPad) {
+	t.cols.names, t.cols.sizes = t.cols.names[:0], t.cols.sizes[:0]
+	for c, h := range header {
+		if h.Wide && !t.wide {
+			continue
+		}
+		w := pads[c]
+		if cw, ok := t.cols.widths[h.Name]; ok {
+			w = cw
+		}
+		t.cols.names, t.cols.sizes = append(t.cols.names, h.Name), append(t.cols.sizes, w)
+	}
+	if t.cols.cursor >= len(t.cols.names) {
+		t.cols.cursor = len(t.cols.names) - 1
+	}
+	if t.cols.cursor < 0 {
+		t.cols.cursor = 0
+	}
+	t.applyFrozen()
+	t.styleColumnCursor()
+}
+
+func (t *Table) applyFrozen() {
+	if t.cols.frozen {
+		t.SetFixed(1, t.NameColIndex()+1)
+		return
+	}
+	t.SetFixed(1, 0)
+}
+
+// styleColumnCursor highlights the header of the column under the cursor once used.
+func (t *Table) styleColumnCursor() {
+	if t.GetRowCount() == 0 {
+		return
+	}
+	for c := 0; c < t.GetColumnCount(); c++ {
+		cell := t.GetCell(0, c)
+		if t.cols.shown && c == t.cols.cursor {
+			cell.SetAttributes(tcell.AttrReverse)
+		} else {
+			cell.SetAttributes(tcell.AttrNone)
+		}
+	}
+}
+
+// scrollToColumn scrolls horizontally so a given column is shown.
+func (t *Table) scrollToColumn(c int) {
+	_, _, width, _ := t.GetInnerRect()
+	row, offset := t.GetOffset()
+	fixed := 0
+	if t.cols.frozen {
+		fixed = t.NameColIndex() + 1
+	}
+	if c < fixed {
+		return
+	}
+	if c < fixed+offset {
+		t.SetOffset(row, c-fixed)
+		return
+	}
+	for offset < c-fixed && columnsWidth(t.cols.sizes, fixed, fixed+offset, c) > width {
+		offset++
+	}
+	t.SetOffset(row, offset)
+}
+
+// columnsWidth computes the screen width of the fixed columns along with the
+// columns in the given range, each column being followed by a separator.
+func columnsWidth(sizes []int, fixed, from, to int) int {
+	var w int
+	for c := 0; c < fixed && c < len(sizes); c++ {
+		w += sizes[c] + 1
+	}
+	for c := from; c <= to && c < len(sizes); c++ {
+		w += sizes[c] + 1
+	}
+
+	return w
+}
+
+// fitCell truncates or pads a field to a given width.
+func fitCell(field string, width int, align int) string {
+	w := runewidth.StringWidth(field)
+	switch {
+	case w > width:
+		return render.Truncate(field, width)
+	case w == width:
+		return field
+	case align == tview.AlignRight:
+		return strings.Repeat(" ", width-w) + field
+	default:
+		return field + strings.Repeat(" ", width-w)
+	}
+}
+
+func minWidth(name string) int {
+	if len(name) > minColumnWidth {
+		return len(name)
+	}
+
+	return minColumnWidth
+}
@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFitCell(t *testing.T) {
+	uu := map[string]struct {
+		f     string
+		w     int
+		align int
+		e     string
+	}{
+		"same":     {f: "fred", w: 4, e: "fred"},
+		"pad":      {f: "fred", w: 6, e: "fred  "},
+		"padRight": {f: "10", w: 4, align: tview.AlignRight, e: "  10"},
+		"truncate": {f: "nginx:1.17.3", w: 6, e: "nginx…"},
+		"blank":    {w: 2, e: "  "},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, fitCell(u.f, u.w, u.align))
+		})
+	}
+}
+
+func TestColumnsWidth(t *testing.T) {
+	sizes := []int{10, 5, 20, 8}
+	uu := map[string]struct {
+		fixed, from, to int
+		e               int
+	}{
+		"all":      {from: 0, to: 3, e: 47},
+		"scrolled": {from: 2, to: 3, e: 30},
+		"frozen":   {fixed: 1, from: 2, to: 3, e: 41},
+		"overflow": {from: 3, to: 10, e: 9},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, columnsWidth(sizes, u.fixed, u.from, u.to))
+		})
+	}
+}
+
+func TestMinWidth(t *testing.T) {
+	assert.Equal(t, 3, minWidth("IP"))
+	assert.Equal(t, 5, minWidth("IMAGE"))
+}
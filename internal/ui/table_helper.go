@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/config"
@@ -17,6 +18,12 @@ const (
 	// DefaultColorName indicator to keep term colors.
 	DefaultColorName = "default"
 
+	// RateFmt represents a custom refresh rate view title.
+	RateFmt = "<[hilite:bg:b]⟳ %s[fg:bg:-]> "
+
+	// StaleFmt represents a stale listing view title.
+	StaleFmt = "<[red::b]stale since %s[fg:bg:-]> "
+
 	// SearchFmt represents a filter view title.
 	SearchFmt = "<[filter:bg:r]/%s[fg:bg:-]> "
 
@@ -26,20 +33,30 @@ const (
 	// TitleFmt represents a standard view title.
 	TitleFmt = "[fg:bg:b] %s[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
 
+	// PagedNSTitleFmt represents a paginated namespaced view title.
+	PagedNSTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%d[fg:bg:-]/[count:bg:b]%s[fg:bg:-]][fg:bg:-] "
+
+	// PagedTitleFmt represents a paginated view title.
+	PagedTitleFmt = "[fg:bg:b] %s[fg:bg:-][[count:bg:b]%d[fg:bg:-]/[count:bg:b]%s[fg:bg:-]][fg:bg:-] "
+
+	staleTimeFmt  = "15:04:05"
 	descIndicator = "↓"
 	ascIndicator  = "↑"
 
 	// FullFmat specifies a namespaced dump file name.
-	FullFmat = "%s-%s-%d.csv"
+	FullFmat = "%s-%s-%d.%s"
 
 	// NoNSFmat specifies a cluster wide dump file name.
-	NoNSFmat = "%s-%d.csv"
+	NoNSFmat = "%s-%d.%s"
 )
 
 var (
 	// LableRx identifies a label query
 	LableRx = regexp.MustCompile(`\A\-l`)
 
+	// FieldRx identifies a field query.
+	FieldRx = regexp.MustCompile(`\A\-F`)
+
 	fuzzyRx = regexp.MustCompile(`\A\-f`)
 )
 
@@ -51,8 +68,12 @@ func mustExtractSyles(ctx context.Context) *config.Styles {
 	return styles
 }
 
-// TrimCell removes superfluous padding.
+// TrimCell removes superfluous padding. The row is drawn first in case it
+// was not scrolled into view yet.
 func TrimCell(tv *SelectTable, row, col int) string {
+	if tv.materializeFn != nil {
+		tv.materializeFn(row)
+	}
 	c := tv.GetCell(row, col)
 	if c == nil {
 		log.Error().Err(fmt.Errorf("No cell at location [%d:%d]", row, col)).Msg("Trim cell failed!")
@@ -82,6 +103,25 @@ func TrimLabelSelector(s string) string {
 	return strings.TrimSpace(s[2:])
 }
 
+// IsFieldSelector checks if query is a field query.
+func IsFieldSelector(s string) bool {
+	if s == "" {
+		return false
+	}
+	return FieldRx.MatchString(s)
+}
+
+// TrimFieldSelector extracts field query.
+func TrimFieldSelector(s string) string {
+	return strings.TrimSpace(s[2:])
+}
+
+// IsServerSelector checks if query is a label or field query which are
+// evaluated by the api server.
+func IsServerSelector(s string) bool {
+	return IsLabelSelector(s) || IsFieldSelector(s)
+}
+
 // SkinTitle decorates a title.
 func SkinTitle(fmat string, style config.Frame) string {
 	bgColor := style.Title.BgColor
@@ -110,6 +150,22 @@ func sortIndicator(col SortColumn, style config.Table, index int, name string) s
 	return fmt.Sprintf("%s[%s::b]%s[::]", name, style.Header.SorterColor, order)
 }
 
+// rateLabel returns a human readable refresh interval.
+func rateLabel(d time.Duration) string {
+	if d <= config.LiveRefreshRate {
+		return "live"
+	}
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+
+	return s
+}
+
 func formatCell(field string, padding int) string {
 	if IsASCII(field) {
 		return Pad(field, padding)
@@ -118,6 +174,50 @@ func formatCell(field string, padding int) string {
 	return field
 }
 
+// visibleData drops wide columns unless they are shown.
+func visibleData(td render.TableData, wide bool) render.TableData {
+	cols := make([]int, 0, len(td.Header))
+	for i, h := range td.Header {
+		if h.Wide && !wide {
+			continue
+		}
+		cols = append(cols, i)
+	}
+
+	data := render.TableData{
+		Namespace: td.Namespace,
+		Header:    make(render.HeaderRow, 0, len(cols)),
+		RowEvents: make(render.RowEvents, 0, len(td.RowEvents)),
+	}
+	for _, c := range cols {
+		data.Header = append(data.Header, td.Header[c])
+	}
+	for _, re := range td.RowEvents {
+		ff := make(render.Fields, 0, len(cols))
+		for _, c := range cols {
+			if c < len(re.Row.Fields) {
+				ff = append(ff, re.Row.Fields[c])
+			}
+		}
+		data.RowEvents = append(data.RowEvents, render.RowEvent{
+			Kind: re.Kind,
+			Row:  render.Row{ID: re.Row.ID, Fields: ff},
+		})
+	}
+
+	return data
+}
+
+// FilterData returns rows matching a table filter, either a regular
+// expression or a fuzzy filter on the given name column.
+func FilterData(q string, nameCol int, data render.TableData) (render.TableData, error) {
+	if IsFuzzySelector(q) {
+		return fuzzyFilter(strings.TrimSpace(q[2:]), nameCol, data), nil
+	}
+
+	return rxFilter(q, data)
+}
+
 func filterToast(data render.TableData) render.TableData {
 	validX := data.Header.IndexOf("VALID")
 	if validX == -1 {
@@ -2,7 +2,9 @@ package ui
 
 import (
 	"testing"
+	"time"
 
+	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,3 +42,141 @@ func TestTrimLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestIsFieldSelector(t *testing.T) {
+	uu := map[string]struct {
+		sel          string
+		e, eSelector bool
+	}{
+		"cool":    {"-F status.phase=Running", true, true},
+		"noMode":  {"status.phase=Running", false, false},
+		"noSpace": {"-Fstatus.phase=Running", true, true},
+		"fuzzy":   {"-f status.phase=Running", false, false},
+		"label":   {"-l app=fred", false, true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsFieldSelector(u.sel))
+			assert.Equal(t, u.eSelector, IsServerSelector(u.sel))
+		})
+	}
+}
+
+func TestTrimFieldSelector(t *testing.T) {
+	uu := map[string]struct {
+		sel, e string
+	}{
+		"cool":    {"-F status.phase=Running", "status.phase=Running"},
+		"noSpace": {"-Fstatus.phase=Running", "status.phase=Running"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, TrimFieldSelector(u.sel))
+		})
+	}
+}
+
+func TestRateLabel(t *testing.T) {
+	uu := map[string]struct {
+		d time.Duration
+		e string
+	}{
+		"live":    {500 * time.Millisecond, "live"},
+		"seconds": {30 * time.Second, "30s"},
+		"mixed":   {90 * time.Second, "1m30s"},
+		"minutes": {2 * time.Minute, "2m"},
+		"hours":   {time.Hour, "1h"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rateLabel(u.d))
+		})
+	}
+}
+
+func TestVisibleData(t *testing.T) {
+	data := render.TableData{
+		Namespace: "default",
+		Header: render.HeaderRow{
+			render.Header{Name: "NAME"},
+			render.Header{Name: "IP", Wide: true},
+			render.Header{Name: "STATUS"},
+		},
+		RowEvents: render.RowEvents{
+			render.NewRowEvent(render.EventAdd, render.Row{ID: "default/fred", Fields: render.Fields{"fred", "10.0.0.1", "Running"}}),
+		},
+	}
+
+	uu := map[string]struct {
+		wide bool
+		cols []string
+		e    render.Fields
+	}{
+		"narrow": {
+			cols: []string{"NAME", "STATUS"},
+			e:    render.Fields{"fred", "Running"},
+		},
+		"wide": {
+			wide: true,
+			cols: []string{"NAME", "IP", "STATUS"},
+			e:    render.Fields{"fred", "10.0.0.1", "Running"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v := visibleData(data, u.wide)
+			assert.Equal(t, "default", v.Namespace)
+			assert.Equal(t, u.cols, v.Header.Columns())
+			assert.Equal(t, 1, len(v.RowEvents))
+			assert.Equal(t, "default/fred", v.RowEvents[0].Row.ID)
+			assert.Equal(t, render.EventAdd, v.RowEvents[0].Kind)
+			assert.Equal(t, u.e, v.RowEvents[0].Row.Fields)
+		})
+	}
+}
+
+func TestFilterData(t *testing.T) {
+	data := render.TableData{
+		Header: render.HeaderRow{render.Header{Name: "NAME"}, render.Header{Name: "STATUS"}},
+		RowEvents: render.RowEvents{
+			render.NewRowEvent(render.EventUnchanged, render.Row{ID: "fred", Fields: render.Fields{"fred", "Running"}}),
+			render.NewRowEvent(render.EventUnchanged, render.Row{ID: "blee", Fields: render.Fields{"blee", "Error"}}),
+		},
+	}
+
+	uu := map[string]struct {
+		q   string
+		e   []string
+		err bool
+	}{
+		"regex": {q: "err", e: []string{"blee"}},
+		"fuzzy": {q: "-f frd", e: []string{"fred"}},
+		"none":  {q: "zorg", e: []string{}},
+		"bad":   {q: "fred(", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f, err := FilterData(u.q, 0, data)
+			if u.err {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			ids := make([]string, 0, len(f.RowEvents))
+			for _, re := range f.RowEvents {
+				ids = append(ids, re.Row.ID)
+			}
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
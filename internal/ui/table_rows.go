@@ -0,0 +1,86 @@
+package ui
+
+import (
+	"github.com/gdamore/tcell"
+)
+
+// drawnRow tracks a table row as last drawn on screen.
+type drawnRow struct {
+	id     string
+	fields []string
+	color  tcell.Color
+}
+
+func (r drawnRow) equal(o drawnRow) bool {
+	if r.id != o.id || r.color != o.color || len(r.fields) != len(o.fields) {
+		return false
+	}
+	for i := range r.fields {
+		if r.fields[i] != o.fields[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// drawnRows tracks a table content as last drawn so only changed rows are
+// redrawn on updates.
+type drawnRows struct {
+	header []string
+	rows   []drawnRow
+}
+
+// sameHeader checks if the given header was already drawn.
+func (d *drawnRows) sameHeader(hh []string) bool {
+	if len(d.header) != len(hh) {
+		return false
+	}
+	for i := range hh {
+		if d.header[i] != hh[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// changed checks if a row must be redrawn.
+func (d *drawnRows) changed(i int, r drawnRow) bool {
+	return i >= len(d.rows) || !d.rows[i].equal(r)
+}
+
+// invalidate forces a row to be redrawn on next update.
+func (d *drawnRows) invalidate(i int) {
+	if i >= 0 && i < len(d.rows) {
+		d.rows[i] = drawnRow{}
+	}
+}
+
+// drawnCols returns the number of columns a row was drawn with.
+func (d *drawnRows) drawnCols(i int) int {
+	if i < 0 || i >= len(d.rows) {
+		return 0
+	}
+
+	return len(d.rows[i].fields)
+}
+
+// resize tracks a given number of rows, keeping rows already drawn.
+func (d *drawnRows) resize(n int) {
+	if n <= cap(d.rows) {
+		l := len(d.rows)
+		d.rows = d.rows[:n]
+		for i := l; i < n; i++ {
+			d.rows[i] = drawnRow{}
+		}
+		return
+	}
+	rr := make([]drawnRow, n)
+	copy(rr, d.rows)
+	d.rows = rr
+}
+
+func (d *drawnRows) reset() {
+	d.header, d.rows = nil, nil
+}
@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrawnRowsChanged(t *testing.T) {
+	d := drawnRows{
+		rows: []drawnRow{
+			{id: "r1", fields: []string{"a", "b"}, color: tcell.ColorWhite},
+		},
+	}
+
+	uu := map[string]struct {
+		i int
+		r drawnRow
+		e bool
+	}{
+		"same":    {r: drawnRow{id: "r1", fields: []string{"a", "b"}, color: tcell.ColorWhite}},
+		"field":   {r: drawnRow{id: "r1", fields: []string{"a", "c"}, color: tcell.ColorWhite}, e: true},
+		"id":      {r: drawnRow{id: "r2", fields: []string{"a", "b"}, color: tcell.ColorWhite}, e: true},
+		"color":   {r: drawnRow{id: "r1", fields: []string{"a", "b"}, color: tcell.ColorRed}, e: true},
+		"columns": {r: drawnRow{id: "r1", fields: []string{"a"}, color: tcell.ColorWhite}, e: true},
+		"new":     {i: 1, r: drawnRow{id: "r1", fields: []string{"a", "b"}, color: tcell.ColorWhite}, e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, d.changed(u.i, u.r))
+		})
+	}
+}
+
+func TestDrawnRowsSameHeader(t *testing.T) {
+	d := drawnRows{header: []string{"NAME", "AGE"}}
+
+	assert.True(t, d.sameHeader([]string{"NAME", "AGE"}))
+	assert.False(t, d.sameHeader([]string{"NAME", "AGE↓"}))
+	assert.False(t, d.sameHeader([]string{"NAME"}))
+	d.reset()
+	assert.False(t, d.sameHeader([]string{"NAME", "AGE"}))
+}
+
+func TestDrawnRowsInvalidate(t *testing.T) {
+	r := drawnRow{id: "r1", fields: []string{"a"}}
+	d := drawnRows{rows: []drawnRow{r}}
+	d.invalidate(-1)
+	d.invalidate(1)
+	assert.False(t, d.changed(0, r))
+
+	d.invalidate(0)
+	assert.True(t, d.changed(0, r))
+}
+
+func TestDrawnRowsResize(t *testing.T) {
+	r1 := drawnRow{id: "r1", fields: []string{"a", "b"}}
+	r2 := drawnRow{id: "r2", fields: []string{"c", "d"}}
+	d := drawnRows{rows: []drawnRow{r1, r2}}
+
+	d.resize(1)
+	assert.Equal(t, []drawnRow{r1}, d.rows)
+	assert.Equal(t, 2, d.drawnCols(0))
+	assert.Equal(t, 0, d.drawnCols(1))
+
+	d.resize(3)
+	assert.Equal(t, []drawnRow{r1, {}, {}}, d.rows)
+	assert.Equal(t, 0, d.drawnCols(1))
+	assert.Equal(t, 0, d.drawnCols(-1))
+}
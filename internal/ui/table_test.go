@@ -2,6 +2,8 @@ package ui_test
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -53,14 +56,104 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableColumns(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+	v.Update(m.Peek())
+	assert.Equal(t, "a", v.ColumnCursor())
+
+	v.MoveColumnCursor(1)
+	assert.Equal(t, "b", v.ColumnCursor())
+	v.MoveColumnCursor(5)
+	assert.Equal(t, "c", v.ColumnCursor())
+	v.MoveColumnCursor(-5)
+	assert.Equal(t, "a", v.ColumnCursor())
+
+	n, w := v.ResizeColumn(ui.ColumnStep)
+	assert.Equal(t, "a", n)
+	assert.Equal(t, 9, w)
+	assert.Equal(t, "blee     ", v.GetCell(1, 0).Text)
+
+	_, w = v.ResizeColumn(-20)
+	assert.Equal(t, 3, w)
+	assert.Equal(t, "bl…", v.GetCell(1, 0).Text)
+	assert.Equal(t, map[string]int{"a": 3}, v.ColumnWidths())
+
+	assert.Equal(t, "a", v.ResetColumn())
+	assert.Equal(t, map[string]int{}, v.ColumnWidths())
+	assert.Equal(t, "blee ", v.GetCell(1, 0).Text)
+
+	v.SetFrozen(true)
+	assert.True(t, v.IsFrozen())
+}
+
+func TestTableStatusGlyphs(t *testing.T) {
+	render.StdColor, render.ErrColor = tcell.ColorWhite, tcell.ColorRed
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+	v.SetStatusGlyphs(true)
+	v.Update(m.Peek())
+
+	assert.True(t, strings.HasPrefix(v.GetCell(0, 0).Text, "  "))
+	assert.Equal(t, "  blee ", v.GetCell(1, 0).Text)
+	assert.Equal(t, "duh ", v.GetCell(1, 1).Text)
+
+	v.SelectRow(1, false)
+	v.ToggleMark()
+	v.Refresh()
+	assert.Equal(t, "> blee ", v.GetCell(1, 0).Text)
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func TestTableVirtualRows(t *testing.T) {
+	v := ui.NewTable("fred")
+	ctx := context.WithValue(context.Background(), internal.KeyStyles, config.NewStyles())
+	v.Init(ctx)
+	m := &testModel{}
+	v.SetModel(m)
+	data := makeTableData()
+	data.RowEvents = make(render.RowEvents, ui.VirtualRowThreshold+500)
+	for i := range data.RowEvents {
+		data.RowEvents[i].Row = render.Row{
+			ID:     fmt.Sprintf("r%04d", i),
+			Fields: render.Fields{fmt.Sprintf("n%04d", i), "duh", "fred"},
+		}
+	}
+	v.Update(data)
+
+	last := len(data.RowEvents)
+	assert.Equal(t, last+1, v.GetRowCount())
+	assert.Equal(t, "", v.GetCell(last, 0).Text)
+
+	row, ok := v.GetRow(last)
+	assert.True(t, ok)
+	assert.Equal(t, "r2499", row.ID)
+	id, ok := v.RowID(last)
+	assert.True(t, ok)
+	assert.Equal(t, "r2499", id)
+	assert.Equal(t, "n2000", ui.TrimCell(v.SelectTable, 2001, 0))
+
+	assert.True(t, v.SelectItem("r2499"))
+	assert.Equal(t, last, v.GetSelectedRowIndex())
+	assert.Equal(t, "r2499", v.GetSelectedItem())
+	assert.Equal(t, "n2499", v.GetSelectedCell(0))
+	assert.Equal(t, row, v.GetSelectedRow())
+}
+
 type testModel struct{}
 
 var _ ui.Tabular = &testModel{}
 
 func (t *testModel) SetInstance(string)              {}
+func (t *testModel) GetInstance() string             { return "" }
 func (t *testModel) Empty() bool                     { return false }
 func (t *testModel) Peek() render.TableData          { return makeTableData() }
 func (t *testModel) ClusterWide() bool               { return false }
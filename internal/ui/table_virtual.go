@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+)
+
+const (
+	// VirtualRowThreshold represents the row count past which only rows
+	// about to be shown are drawn.
+	VirtualRowThreshold = 2000
+
+	// overscan represents the number of rows drawn past the visible ones.
+	overscan = 50
+)
+
+// virtualRows tracks a large table content so rows are only rendered and
+// styled once they scroll into view.
+type virtualRows struct {
+	ns      string
+	header  render.HeaderRow
+	pads    MaxyPad
+	events  render.RowEvents
+	pending []bool
+}
+
+func newVirtualRows(data render.TableData, pads MaxyPad) *virtualRows {
+	return &virtualRows{
+		ns:      data.Namespace,
+		header:  data.Header,
+		pads:    pads,
+		events:  data.RowEvents,
+		pending: make([]bool, len(data.RowEvents)),
+	}
+}
+
+// rowWindow returns the range of data rows to draw so that rows around the
+// given one are ready to be shown either way.
+func rowWindow(row, height, count int) (int, int) {
+	from, to := row-height-overscan, row+height+overscan+1
+	if from < 0 {
+		from = 0
+	}
+	if to > count {
+		to = count
+	}
+	if from > to {
+		from = to
+	}
+
+	return from, to
+}
+
+// placeholder holds a spot for rows not drawn yet.
+var placeholder = tview.NewTableCell("")
+
+// inWindow checks if a data row must be drawn right away.
+func (t *Table) inWindow(i, count int) bool {
+	_, _, _, height := t.GetInnerRect()
+	offset, _ := t.GetOffset()
+	for _, r := range []int{offset, t.GetSelectedRowIndex() - 1} {
+		if from, to := rowWindow(r, height, count); i >= from && i < to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MaterializeRow draws pending rows surrounding a given table row.
+func (t *Table) MaterializeRow(r int) {
+	v := t.virtual
+	if v == nil || len(t.drawn.rows) != len(v.events) {
+		return
+	}
+	_, _, _, height := t.GetInnerRect()
+	from, to := rowWindow(r-1, height, len(v.events))
+	for i := from; i < to; i++ {
+		if !v.pending[i] {
+			continue
+		}
+		v.pending[i] = false
+		row := t.renderRow(v.ns, v.events[i], v.header, v.pads, nil)
+		t.drawRow(i+1, row, v.header)
+		t.drawn.rows[i] = row
+	}
+}
@@ -0,0 +1,31 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRowWindow(t *testing.T) {
+	uu := map[string]struct {
+		row, height, count int
+		from, to           int
+	}{
+		"empty":  {row: 0, height: 20, count: 0, from: 0, to: 0},
+		"small":  {row: 0, height: 20, count: 10, from: 0, to: 10},
+		"top":    {row: 0, height: 20, count: 10000, from: 0, to: 71},
+		"middle": {row: 5000, height: 20, count: 10000, from: 4930, to: 5071},
+		"bottom": {row: 9999, height: 20, count: 10000, from: 9929, to: 10000},
+		"past":   {row: 20000, height: 20, count: 10000, from: 10000, to: 10000},
+		"before": {row: -1, height: 20, count: 10000, from: 0, to: 70},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			from, to := rowWindow(u.row, u.height, u.count)
+			assert.Equal(t, u.from, from)
+			assert.Equal(t, u.to, to)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package ui
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	ttyPath      = "/dev/tty"
+	terminalBell = "\a"
+)
+
+type beeper interface {
+	Beep() error
+}
+
+// Beep rings the terminal bell.
+func (a *App) Beep() {
+	a.QueueUpdate(func() {
+		if b, ok := a.currentScreen().(beeper); ok {
+			if err := b.Beep(); err != nil {
+				log.Warn().Err(err).Msg("Terminal bell failed")
+			}
+			return
+		}
+		if err := writeTTY(terminalBell); err != nil {
+			log.Warn().Err(err).Msg("Terminal bell failed")
+		}
+	})
+}
+
+// Terminal returns a writer emitting raw escape sequences to the terminal.
+// Writes happen on the event loop so they never interleave with a draw.
+func (a *App) Terminal() io.Writer {
+	return termWriter{app: a}
+}
+
+type termWriter struct {
+	app *App
+}
+
+// Write writes to the controlling terminal.
+func (w termWriter) Write(b []byte) (int, error) {
+	errs := make(chan error, 1)
+	s := string(b)
+	w.app.QueueUpdate(func() {
+		errs <- writeTTY(s)
+	})
+	if err := <-errs; err != nil {
+		return 0, err
+	}
+
+	return len(b), nil
+}
+
+func (a *App) currentScreen() tcell.Screen {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	return a.screen
+}
+
+// writeTTY writes to the controlling terminal, bypassing stdout which may be
+// redirected.
+func writeTTY(s string) error {
+	tty, err := os.OpenFile(ttyPath, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tty.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing tty")
+		}
+	}()
+	_, err = fmt.Fprint(tty, s)
+
+	return err
+}
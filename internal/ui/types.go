@@ -55,6 +55,9 @@ type Tabular interface {
 
 	SetInstance(string)
 
+	// GetInstance returns the single instance path if any.
+	GetInstance() string
+
 	// Empty returns true if model has no data.
 	Empty() bool
 
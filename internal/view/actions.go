@@ -2,10 +2,14 @@ package view
 
 import (
 	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
@@ -52,10 +56,18 @@ func inScope(scopes, aliases []string) bool {
 func hotKeyActions(r Runner, aa ui.KeyActions) {
 	hh := config.NewHotKeys()
 	if err := hh.Load(); err != nil {
-		return
+		log.Warn().Err(err).Msg("HOT-KEY Unable to load hotkeys")
 	}
 
+	ctx, cl := r.App().Config.K9s.CurrentContext, r.App().Config.K9s.CurrentCluster
 	for k, hk := range hh.HotKey {
+		if !hk.InContext(ctx, cl) {
+			continue
+		}
+		if err := hk.Validate(); err != nil {
+			log.Warn().Err(err).Msgf("HOT-KEY Invalid hotkey %q", k)
+			continue
+		}
 		key, err := asKey(hk.ShortCut)
 		if err != nil {
 			log.Warn().Err(err).Msg("HOT-KEY Unable to map hotkey shortcut to a key")
@@ -66,30 +78,92 @@ func hotKeyActions(r Runner, aa ui.KeyActions) {
 			log.Warn().Err(fmt.Errorf("HOT-KEY Doh! you are trying to overide an existing command `%s", k)).Msg("Invalid shortcut")
 			continue
 		}
-		aa[key] = ui.NewSharedKeyAction(
-			hk.Description,
-			gotoCmd(r, "", hk.Command),
-			false)
+		act := ui.NewSharedKeyAction(hk.Description, hotKeyCmd(r, hk), false)
+		act.Category = ui.HotKeyCategory
+		aa[key] = act
 	}
 }
 
-func gotoCmd(r Runner, cmd, path string) ui.ActionHandler {
+func hotKeyCmd(r Runner, hk config.HotKey) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
-		if err := r.App().gotoResource(cmd, path, true); err != nil {
-			r.App().Flash().Err(err)
+		if len(hk.Params) == 0 {
+			runHotKey(r, hk, nil)
+			return nil
 		}
+		ShowParams(r.App(), hk.Description, "HotKey Arguments", hk.Params, func(params map[string]string) {
+			runHotKey(r, hk, params)
+		})
+
 		return nil
 	}
 }
 
+func runHotKey(r Runner, hk config.HotKey, params map[string]string) {
+	env := hotKeyEnv(r)
+	for k, v := range params {
+		env[strings.ToUpper(k)] = v
+	}
+
+	cmd, filter, err := hotKeyCommand(env, hk.Command)
+	if err != nil {
+		log.Error().Err(err).Msg("HOT-KEY Command match failed")
+		r.App().Flash().Err(err)
+		return
+	}
+	if err := r.App().gotoResource(cmd, "", true); err != nil {
+		r.App().Flash().Err(err)
+		return
+	}
+	if filter != "" {
+		r.App().filterTop(filter)
+	}
+}
+
+// hotKeyEnv returns the selection env if any or the general env otherwise.
+func hotKeyEnv(r Runner) K9sEnv {
+	if r.GetSelectedItem() != "" && r.EnvFn() != nil {
+		return r.EnvFn()()
+	}
+	env := generalEnv(r.App())
+	env["NAMESPACE"] = r.App().Config.ActiveNamespace()
+
+	return env
+}
+
+// hotKeyCommand resolves a hotkey command template into a command and an
+// optional filter specified as a trailing /filter.
+func hotKeyCommand(env K9sEnv, tpl string) (string, string, error) {
+	tokens := strings.Fields(tpl)
+	for i, t := range tokens {
+		v, err := env.envFor("", t)
+		if err != nil {
+			return "", "", err
+		}
+		tokens[i] = v
+	}
+
+	for i, t := range tokens {
+		if strings.HasPrefix(t, "/") {
+			return strings.Join(tokens[:i], " "), strings.Join(tokens[i:], " ")[1:], nil
+		}
+	}
+
+	return strings.Join(tokens, " "), "", nil
+}
+
 func pluginActions(r Runner, aa ui.KeyActions) {
 	pp := config.NewPlugins()
 	if err := pp.Load(); err != nil {
-		return
+		log.Warn().Err(err).Msg("Unable to load plugins")
 	}
 
+	ctx, cl := r.App().Config.K9s.CurrentContext, r.App().Config.K9s.CurrentCluster
 	for k, plugin := range pp.Plugin {
-		if !inScope(plugin.Scopes, r.Aliases()) {
+		if !inScope(plugin.Scopes, r.Aliases()) || !plugin.InContext(ctx, cl) {
+			continue
+		}
+		if err := plugin.Validate(); err != nil {
+			log.Warn().Err(err).Msgf("Invalid plugin %q", k)
 			continue
 		}
 		key, err := asKey(plugin.ShortCut)
@@ -102,43 +176,100 @@ func pluginActions(r Runner, aa ui.KeyActions) {
 			log.Warn().Err(fmt.Errorf("Doh! you are trying to overide an existing command `%s", k)).Msg("Invalid shortcut")
 			continue
 		}
-		aa[key] = ui.NewKeyAction(
-			plugin.Description,
-			execCmd(r, plugin.Command, plugin.Background, plugin.Args...),
-			true)
+		act := ui.NewKeyAction(plugin.Description, execCmd(r, plugin), true)
+		act.Category = ui.PluginCategory
+		aa[key] = act
 	}
 }
 
-func execCmd(r Runner, bin string, bg bool, args ...string) ui.ActionHandler {
+func execCmd(r Runner, plugin config.Plugin) ui.ActionHandler {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		path := r.GetSelectedItem()
 		if path == "" {
 			return evt
 		}
-
-		ns, _ := client.Namespaced(path)
-		var (
-			aa  = make([]string, len(args))
-			err error
-		)
-
 		if r.EnvFn() == nil {
 			return nil
 		}
 
-		for i, a := range args {
-			aa[i], err = r.EnvFn()().envFor(ns, a)
-			if err != nil {
-				log.Error().Err(err).Msg("Plugin Args match failed")
-				return nil
-			}
-		}
-		if run(r.App(), shellOpts{clear: true, binary: bin, background: bg, args: aa}) {
-			r.App().Flash().Info("Plugin command launched successfully!")
-		} else {
-			r.App().Flash().Info("Plugin command failed!")
+		if len(plugin.Params) == 0 {
+			runPlugin(r, plugin, path, nil)
+			return nil
 		}
+		ShowParams(r.App(), plugin.Description, "Plugin Arguments", plugin.Params, func(params map[string]string) {
+			runPlugin(r, plugin, path, params)
+		})
 
 		return nil
 	}
 }
+
+func runPlugin(r Runner, plugin config.Plugin, path string, params map[string]string) {
+	ns, _ := client.Namespaced(path)
+	env := r.EnvFn()()
+	for k, v := range params {
+		env[strings.ToUpper(k)] = v
+	}
+
+	bin, aa, err := pluginCommand(env, ns, plugin)
+	if err != nil {
+		log.Error().Err(err).Msg("Plugin command expansion failed")
+		r.App().Flash().Err(err)
+		return
+	}
+	if !plugin.ShouldConfirm() {
+		launchPlugin(r.App(), plugin, bin, aa)
+		return
+	}
+	msg := fmt.Sprintf("Run %s?\n\n%s", plugin.Description, commandLine(bin, aa))
+	dialog.ShowConfirm(r.App().Content.Pages, "Confirm Plugin", msg, func() {
+		launchPlugin(r.App(), plugin, bin, aa)
+	}, func() {})
+}
+
+func launchPlugin(a *App, plugin config.Plugin, bin string, args []string) {
+	if plugin.Async {
+		if err := a.inject(NewPluginOutput(a, bin, args)); err != nil {
+			a.Flash().Err(err)
+		}
+		return
+	}
+	if run(a, shellOpts{clear: true, binary: bin, background: plugin.Background, args: args}) {
+		a.Flash().Info("Plugin command launched successfully!")
+	} else {
+		a.Flash().Info("Plugin command failed!")
+	}
+}
+
+// pluginCommand expands a plugin command and args and checks the command can be run.
+func pluginCommand(env K9sEnv, ns string, plugin config.Plugin) (string, []string, error) {
+	bin, err := env.expand(ns, plugin.Command)
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := exec.LookPath(bin); err != nil {
+		return "", nil, fmt.Errorf("plugin command %q not found -- %s", bin, err)
+	}
+
+	aa := make([]string, len(plugin.Args))
+	for i, a := range plugin.Args {
+		if aa[i], err = env.expand(ns, a); err != nil {
+			return "", nil, err
+		}
+	}
+
+	return bin, aa, nil
+}
+
+// commandLine returns a printable command line, quoting args as needed.
+func commandLine(bin string, args []string) string {
+	ss := make([]string, 0, len(args)+1)
+	for _, a := range append([]string{bin}, args...) {
+		if a == "" || strings.ContainsAny(a, " \t\n'\"") {
+			a = strconv.Quote(a)
+		}
+		ss = append(ss, a)
+	}
+
+	return strings.Join(ss, " ")
+}
@@ -0,0 +1,98 @@
+package view
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHotKeyCommand(t *testing.T) {
+	uu := map[string]struct {
+		tpl         string
+		cmd, filter string
+		err         error
+	}{
+		"plain":     {tpl: "pods", cmd: "pods"},
+		"ns":        {tpl: "pods $NAMESPACE", cmd: "pods fred"},
+		"filter":    {tpl: "pods $NS /$FILTER", cmd: "pods blee", filter: "zorg"},
+		"labels":    {tpl: "pods /-l app=$NAME", cmd: "pods", filter: "-l app=nginx"},
+		"noMatch":   {tpl: "pods $BOZO", err: errors.New(`no env vars exists for argument "$BOZO" using key "BOZO"`)},
+		"filterRaw": {tpl: "dp /ngin", cmd: "dp", filter: "ngin"},
+	}
+
+	env := K9sEnv{
+		"NAMESPACE": "fred",
+		"NS":        "blee",
+		"FILTER":    "zorg",
+		"NAME":      "nginx",
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmd, filter, err := hotKeyCommand(env, u.tpl)
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.cmd, cmd)
+			assert.Equal(t, u.filter, filter)
+		})
+	}
+}
+
+func TestPluginCommand(t *testing.T) {
+	uu := map[string]struct {
+		plugin config.Plugin
+		bin    string
+		args   []string
+		err    string
+	}{
+		"plain": {
+			plugin: config.Plugin{Command: "sh", Args: []string{"-c", "echo $NAME"}},
+			bin:    "sh",
+			args:   []string{"-c", "echo fred"},
+		},
+		"noBin": {
+			plugin: config.Plugin{Command: "$NAME-zorg"},
+			err:    `plugin command "fred-zorg" not found`,
+		},
+		"noEnv": {
+			plugin: config.Plugin{Command: "sh", Args: []string{"$BLEE"}},
+			err:    `no env vars exists for argument "$BLEE" using key "BLEE"`,
+		},
+	}
+
+	env := K9sEnv{"NAME": "fred"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			bin, args, err := pluginCommand(env, "", u.plugin)
+			if u.err != "" {
+				assert.Contains(t, err.Error(), u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.bin, bin)
+			assert.Equal(t, u.args, args)
+		})
+	}
+}
+
+func TestCommandLine(t *testing.T) {
+	uu := map[string]struct {
+		bin  string
+		args []string
+		e    string
+	}{
+		"plain":  {bin: "kubectl", args: []string{"logs", "-f"}, e: "kubectl logs -f"},
+		"spaces": {bin: "sh", args: []string{"-c", "echo fred"}, e: `sh -c "echo fred"`},
+		"blank":  {bin: "echo", args: []string{""}, e: `echo ""`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, commandLine(u.bin, u.args))
+		})
+	}
+}
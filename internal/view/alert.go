@@ -0,0 +1,262 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/alert"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const alertRefresh = 10 * time.Second
+
+var snoozeDurations = []string{"15m", "1h", "4h", "24h"}
+
+// Alert presents a fired alerts viewer.
+type Alert struct {
+	ResourceViewer
+}
+
+// NewAlert returns a new viewer.
+func NewAlert(gvr client.GVR) ResourceViewer {
+	a := Alert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetBorderFocusColor(tcell.ColorOrangeRed)
+	a.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorOrangeRed, tcell.AttrNone)
+	a.GetTable().SetColorerFn(render.Alert{}.ColorerFunc())
+	a.GetTable().SetSortCol(6, 0, true)
+	a.GetTable().SetEnterFn(a.gotoAlert)
+	a.SetBindKeysFn(a.bindKeys)
+	a.SetContextFn(a.alertContext)
+
+	return &a
+}
+
+func (a *Alert) alertContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyAlerts, a.App().alerts)
+}
+
+func (a *Alert) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyA: ui.NewKeyAction("Ack", a.ackCmd, true),
+		ui.KeyZ: ui.NewKeyAction("Snooze", a.snoozeCmd, true),
+	})
+}
+
+func (a *Alert) gotoAlert(app *App, model ui.Tabular, gvr, id string) {
+	al, ok := a.alert(id)
+	if !ok {
+		return
+	}
+	ns, n := client.Namespaced(al.Path)
+	cmd := al.GVR
+	if ns != "" {
+		cmd += " " + ns
+	}
+	if err := app.gotoResource(cmd, "", false); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.followLink(DeepLink{Command: cmd, Select: n})
+}
+
+func (a *Alert) ackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := a.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	if err := a.App().alerts.Ack(id); err != nil {
+		a.App().Flash().Err(err)
+		return nil
+	}
+	a.App().Flash().Infof("Alert %s acknowledged", id)
+	a.Start()
+
+	return nil
+}
+
+func (a *Alert) snoozeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := a.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	pp := []config.PluginParam{{Name: "duration", Description: "Duration", Default: snoozeDurations[0], Enum: snoozeDurations}}
+	ShowParams(a.App(), "Snooze "+id, "Mute the alert for", pp, func(params map[string]string) {
+		d, err := time.ParseDuration(params["duration"])
+		if err != nil {
+			a.App().Flash().Errf("Invalid snooze duration %q", params["duration"])
+			return
+		}
+		if err := a.App().alerts.Snooze(id, d, time.Now()); err != nil {
+			a.App().Flash().Err(err)
+			return
+		}
+		a.App().Flash().Infof("Alert %s snoozed for %s", id, d)
+		a.Start()
+	})
+
+	return nil
+}
+
+func (a *Alert) alert(id string) (alert.Alert, bool) {
+	for _, al := range a.App().alerts.List() {
+		if al.ID == id {
+			return al, true
+		}
+	}
+
+	return alert.Alert{}, false
+}
+
+// ----------------------------------------------------------------------------
+// App alerts...
+
+// loadAlerts compiles the alert rules applicable to the current context.
+func (a *App) loadAlerts() error {
+	aa := config.NewAlerts()
+	if err := aa.Load(); err != nil {
+		return err
+	}
+	rr, errs := alert.NewRules(aa, a.Config.K9s.CurrentContext, a.Config.K9s.CurrentCluster)
+	a.alerts.SetRules(rr)
+	hh, herrs := alert.NewWebhooks(aa)
+	a.webhooks.SetWebhooks(hh)
+	errs = append(errs, herrs...)
+	if len(errs) == 0 {
+		return nil
+	}
+	ee := make([]string, 0, len(errs))
+	for _, err := range errs {
+		ee = append(ee, err.Error())
+	}
+
+	return errors.New(strings.Join(ee, "\n"))
+}
+
+func (a *App) alertUpdater(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug().Msg("Alerts updater canceled!")
+			return
+		case <-time.After(alertRefresh):
+			if a.ConOK() {
+				a.checkAlerts()
+			}
+			a.flushWebhooks()
+		}
+	}
+}
+
+// checkAlerts evaluates alert rules against the informer cache and notifies
+// newly fired alerts.
+func (a *App) checkAlerts() {
+	now := time.Now()
+	var fired []alert.Alert
+	for _, r := range a.alerts.Rules() {
+		ns := a.alertNamespace(r)
+		paths, err := a.matchRule(r, ns)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Alert rule %s failed", r.Name)
+			continue
+		}
+		aa := a.alerts.Update(r, ns, paths, now)
+		if len(aa) > 0 && len(r.Webhooks) > 0 {
+			a.notifyWebhooks(r.Webhooks, aa)
+		}
+		fired = append(fired, aa...)
+	}
+	if len(fired) == 0 {
+		return
+	}
+
+	a.Beep()
+	al := fired[0]
+	msg := al.Message
+	if len(fired) > 1 {
+		msg = fmt.Sprintf("%s (+%d more alerts)", msg, len(fired)-1)
+	}
+	a.notify(config.NotifyAlert, "Alert fired", msg)
+	a.QueueUpdateDraw(func() {
+		if al.Severity == config.AlertError {
+			a.Flash().Errf("%s", msg)
+			return
+		}
+		a.Flash().Warn(msg)
+	})
+}
+
+// notifyWebhooks queues fired alerts for the given webhooks.
+func (a *App) notifyWebhooks(names []string, aa []alert.Alert) {
+	ctx, cl := a.Config.K9s.CurrentContext, a.Config.K9s.CurrentCluster
+	for _, al := range aa {
+		for _, err := range a.webhooks.Enqueue(names, alert.NewNotification(al, ctx, cl)) {
+			log.Warn().Err(err).Msgf("Alert %s notification failed", al.ID)
+		}
+	}
+}
+
+// flushWebhooks posts queued alerts to their webhooks.
+func (a *App) flushWebhooks() {
+	ctx, cl := a.Config.K9s.CurrentContext, a.Config.K9s.CurrentCluster
+	for _, err := range a.webhooks.Flush(ctx, cl, time.Now()) {
+		log.Warn().Err(err).Msg("Alert webhook failed")
+	}
+}
+
+// alertNamespace returns the namespace a rule scans. Rules only scan the
+// active namespace unless flagged cluster wide.
+func (a *App) alertNamespace(r *alert.Rule) string {
+	if r.ClusterWide {
+		return client.AllNamespaces
+	}
+	if m, err := dao.MetaAccess.MetaFor(client.NewGVR(r.GVR)); err == nil && !m.Namespaced {
+		return client.AllNamespaces
+	}
+
+	return client.CleanseNamespace(a.Config.ActiveNamespace())
+}
+
+func (a *App) matchRule(r *alert.Rule, ns string) ([]string, error) {
+	oo, err := a.factory.List(r.GVR, ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		m, err := model.ObjectMap(u)
+		if err != nil {
+			return nil, err
+		}
+		ok, err = r.Match(m)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Alert rule %s skipped %s", r.Name, u.GetName())
+			continue
+		}
+		if ok {
+			paths = append(paths, client.FQN(u.GetNamespace(), u.GetName()))
+		}
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,122 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/rs/zerolog/log"
+)
+
+var _ model.FlashListener = (*Announcer)(nil)
+
+// Announcer writes selection and flash changes as plain lines so terminal
+// screen readers may speak them.
+type Announcer struct {
+	w    io.Writer
+	last string
+	mx   sync.Mutex
+}
+
+// NewAnnouncer returns a new announcer writing to a given output.
+func NewAnnouncer(w io.Writer) *Announcer {
+	return &Announcer{w: w}
+}
+
+// Announce writes out a message, skipping repeats.
+func (a *Announcer) Announce(msg string) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	msg = strings.TrimSpace(msg)
+	if msg == "" || msg == a.last {
+		return
+	}
+	a.last = msg
+	if _, err := fmt.Fprintln(a.w, msg); err != nil {
+		log.Warn().Err(err).Msg("Announce failed")
+	}
+}
+
+// FlashChanged notifies the flash message changed.
+func (a *Announcer) FlashChanged(l model.FlashLevel, msg string) {
+	a.Announce(ui.FlashLabel(l) + " " + msg)
+}
+
+// FlashCleared notifies the flash message was cleared.
+func (a *Announcer) FlashCleared() {}
+
+// Close closes the announcer output.
+func (a *Announcer) Close() error {
+	if c, ok := a.w.(io.Closer); ok {
+		return c.Close()
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// initAnnouncer opens the screen reader output if any.
+func (a *App) initAnnouncer() {
+	path := a.Config.K9s.AccessibilityConfig().Announce
+	if path == "" {
+		return
+	}
+	path, err := expandPath(path)
+	if err != nil {
+		log.Error().Err(err).Msg("Invalid announce path")
+		return
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		log.Error().Err(err).Msgf("Unable to open announce output %q", path)
+		return
+	}
+	a.announcer = NewAnnouncer(f)
+}
+
+func (a *App) stopAnnouncer() {
+	if a.announcer == nil {
+		return
+	}
+	if err := a.announcer.Close(); err != nil {
+		log.Error().Err(err).Msg("Announce output close failed")
+	}
+}
+
+// announceSelection announces the selected row whenever the selected resource changes.
+func (t *Table) announceSelection(r int) {
+	id := t.GetSelectedItem()
+	if id == "" || id == t.announced {
+		return
+	}
+	t.announced = id
+	data := t.GetVisibleData()
+	fields, ok := rowFields(data, id)
+	if !ok {
+		return
+	}
+	t.app.announcer.Announce(describeRow(data.Header, fields, r, len(data.RowEvents)))
+}
+
+// describeRow summarizes a row, ie its standard columns values along with its
+// position.
+func describeRow(h render.HeaderRow, fields []string, index, count int) string {
+	ss := make([]string, 0, len(fields)+1)
+	for c, f := range fields {
+		if c >= len(h) || h[c].Wide || f == "" {
+			continue
+		}
+		ss = append(ss, h[c].Name+" "+f)
+	}
+	ss = append(ss, fmt.Sprintf("row %d of %d", index, count))
+
+	return strings.Join(ss, ", ")
+}
@@ -0,0 +1,58 @@
+package view
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnouncer(t *testing.T) {
+	var buff bytes.Buffer
+	a := NewAnnouncer(&buff)
+
+	a.Announce("NAME fred, row 1 of 2")
+	a.Announce("NAME fred, row 1 of 2")
+	a.Announce("  ")
+	a.FlashChanged(model.FlashErr, "boom")
+	a.FlashCleared()
+
+	assert.Equal(t, "NAME fred, row 1 of 2\nError: boom\n", buff.String())
+	assert.Nil(t, a.Close())
+}
+
+func TestDescribeRow(t *testing.T) {
+	h := render.HeaderRow{
+		render.Header{Name: "NAME"},
+		render.Header{Name: "STATUS"},
+		render.Header{Name: "IP", Wide: true},
+		render.Header{Name: "AGE"},
+	}
+	uu := map[string]struct {
+		ff           []string
+		index, count int
+		e            string
+	}{
+		"full": {
+			ff:    []string{"fred", "Running", "10.0.0.1", "2m"},
+			index: 1,
+			count: 3,
+			e:     "NAME fred, STATUS Running, AGE 2m, row 1 of 3",
+		},
+		"blank": {
+			ff:    []string{"blee", "", "", "5d"},
+			index: 3,
+			count: 3,
+			e:     "NAME blee, AGE 5d, row 3 of 3",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, describeRow(h, u.ff, u.index, u.count))
+		})
+	}
+}
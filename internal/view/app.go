@@ -4,13 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"sync/atomic"
 	"time"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/alert"
+	"github.com/derailed/k9s/internal/auditlog"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/cosign"
+	"github.com/derailed/k9s/internal/errlog"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/script"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
 	"github.com/derailed/tview"
@@ -33,26 +39,69 @@ const (
 type App struct {
 	*ui.App
 
-	Content      *PageStack
-	command      *Command
-	factory      *watch.Factory
-	version      string
-	showHeader   bool
-	cancelFn     context.CancelFunc
-	conRetry     int32
-	clusterModel *model.ClusterInfo
+	Content       *PageStack
+	command       *Command
+	factory       *watch.Factory
+	contexts      *watch.Factories
+	version       string
+	showHeader    bool
+	cancelFn      context.CancelFunc
+	conRetry      int32
+	loggingIn     int32
+	latency       int64
+	clusterModel  *model.ClusterInfo
+	scripts       *script.Engine
+	recorder      *MacroRecorder
+	macros        config.Macros
+	keyMap        *config.KeyMap
+	keyConflicts  map[string]struct{}
+	mouse         mouseState
+	history       *CmdHistory
+	navHistory    *NavHistory
+	navRecorder   *navRecorder
+	tabs          *Tabs
+	marks         *Marks
+	announcer     *Announcer
+	errLog        *errlog.Log
+	zoomed        bool
+	zoomChrome    []tview.Primitive
+	sessions      *config.Sessions
+	watchlist     *config.Watchlist
+	control       net.Listener
+	alerts        *alert.Board
+	webhooks      *alert.Dispatcher
+	auditEvents   *auditlog.Stream
+	imageVerifier *cosign.Verifier
+	probes        *client.Probes
 }
 
 // NewApp returns a K9s app instance.
 func NewApp(cfg *config.Config) *App {
 	a := App{
-		App:     ui.NewApp(cfg.K9s.CurrentContext),
-		Content: NewPageStack(),
+		App:          ui.NewApp(cfg.K9s.CurrentContext),
+		Content:      NewPageStack(),
+		scripts:      script.NewEngine(),
+		keyMap:       config.NewKeyMap(),
+		keyConflicts: make(map[string]struct{}),
+		macros:       config.NewMacros(),
+		history:      NewCmdHistory(),
+		navHistory:   NewNavHistory(),
+		tabs:         NewTabs(),
+		marks:        NewMarks(),
+		sessions:     config.NewSessions(),
+		watchlist:    config.NewWatchlist(),
+		alerts:       alert.NewBoard(),
+		webhooks:     alert.NewDispatcher(),
+		errLog:       errlog.NewLog(errlog.DefaultMaxEntries),
+		probes:       client.NewProbes(),
 	}
 	a.Config = cfg
+	a.ReloadStyles(cfg.K9s.CurrentContext)
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
 	a.Views()["clusterInfo"] = NewClusterInfo(&a)
+	a.Views()["headerWidgets"] = NewHeaderWidgets(&a)
+	a.Views()["perf"] = NewPerfOverlay(&a)
 
 	return &a
 }
@@ -75,6 +124,22 @@ func (a *App) Init(version string, rate int) error {
 
 	a.App.Init()
 	a.bindKeys()
+	a.initHistory()
+	a.initNavHistory()
+	a.initSessions()
+	a.initWatchlist()
+	a.initAnnouncer()
+	if err := a.loadKeyMap(); err != nil {
+		log.Error().Err(err).Msg("KeyMap load failed")
+		a.Flash().Errf("Keymap load failed -- %s", err)
+	}
+	if err := a.loadMacros(); err != nil {
+		log.Error().Err(err).Msg("Macros load failed")
+	}
+	a.remap(a.GetActions(), appKeyMapView)
+	if err := a.initMouse(); err != nil {
+		log.Error().Err(err).Msg("Mouse init failed")
+	}
 	if a.Conn() == nil {
 		return errors.New("No client connection detected")
 	}
@@ -84,21 +149,51 @@ func (a *App) Init(version string, rate int) error {
 	}
 
 	a.factory = watch.NewFactory(a.Conn())
+	a.factory.SetIdleTTL(a.Config.K9s.GetInformerTTL())
+	a.factory.SetMemoryBudget(a.Config.K9s.CacheConfig().Budget())
 	a.initFactory(ns)
+	a.contexts = watch.NewFactories(a.Conn().Config())
+	a.contexts.SetIdleTTL(a.Config.K9s.GetInformerTTL())
+	a.contexts.SetMemoryBudget(a.Config.K9s.CacheConfig().Budget())
+	a.initPacer()
+	a.initProfiler(ctx)
+	a.initMetrics(ctx)
+	a.initTracing(ctx)
+	a.initAuditEvents(ctx)
+	a.initImageVerifier()
+	mx := a.Config.K9s.MetricsConfig()
+	client.ConfigureMetrics(mx.Poll(), mx.MaxStaleness())
+	if p := a.Config.K9s.PagingConfig(); p.Enabled() {
+		a.factory.SetPaging(p.Threshold, p.PageSize)
+		a.contexts.SetPaging(p.Threshold, p.PageSize)
+	}
+
+	if err := a.scripts.Load(config.K9sScriptsDir); err != nil {
+		log.Error().Err(err).Msg("Scripts load failed")
+	}
 
 	a.clusterModel = model.NewClusterInfo(a.factory, version)
 	a.clusterModel.AddListener(a.clusterInfo())
 	a.clusterModel.AddListener(a.statusIndicator())
+	a.clusterModel.AddListener(a.headerWidgets())
 	a.clusterModel.Refresh()
 
 	a.command = NewCommand(a)
 	if err := a.command.Init(); err != nil {
-		return err
+		if !a.Conn().Config().NeedsOIDCLogin() {
+			return err
+		}
+		log.Warn().Err(err).Msg("Commands unavailable until login")
 	}
 
 	a.clusterInfo().Init()
 
 	flash := ui.NewFlash(a.App)
+	flash.SetPlain(a.Config.K9s.AccessibilityConfig().StatusGlyphs)
+	if a.announcer != nil {
+		flash.AddListener(a.announcer)
+	}
+	flash.AddListener(errorRecorder{app: a})
 	go flash.Watch(ctx, a.Flash().Channel())
 
 	main := tview.NewFlex().SetDirection(tview.FlexRow)
@@ -116,11 +211,23 @@ func (a *App) Init(version string, rate int) error {
 
 func (a *App) bindKeys() {
 	a.AddActions(ui.KeyActions{
-		tcell.KeyCtrlE: ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
-		ui.KeyHelp:     ui.NewSharedKeyAction("Help", a.helpCmd, false),
-		tcell.KeyCtrlA: ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
-		tcell.KeyEnter: ui.NewKeyAction("Goto", a.gotoCmd, false),
+		tcell.KeyCtrlE:          ui.NewSharedKeyAction("ToggleHeader", a.toggleHeaderCmd, false),
+		ui.KeyHelp:              ui.NewSharedKeyAction("Help", a.helpCmd, false),
+		tcell.KeyCtrlA:          ui.NewSharedKeyAction("Aliases", a.aliasCmd, false),
+		tcell.KeyEnter:          ui.NewKeyAction("Goto", a.gotoCmd, false),
+		tcell.KeyCtrlR:          ui.NewKeyAction("Redraw", a.historyCmd, false),
+		tcell.KeyCtrlG:          ui.NewSharedKeyAction("Switch Context", a.ctxSwitchCmd, false),
+		tcell.KeyCtrlN:          ui.NewSharedKeyAction("Switch Namespace", a.nsPickCmd, false),
+		tcell.KeyCtrlP:          ui.NewSharedKeyAction("Perf", a.togglePerfCmd, false),
+		tcell.KeyCtrlF:          ui.NewSharedKeyAction("Snapshot", a.snapshotCmd, false),
+		tcell.KeyCtrlV:          ui.NewSharedKeyAction("Zoom", a.zoomCmd, false),
+		ui.KeyLeftBracket:       ui.NewSharedKeyAction("Back", a.navBackCmd, false),
+		ui.KeyRightBracket:      ui.NewSharedKeyAction("Forward", a.navForwardCmd, false),
+		ui.KeyM:                 ui.NewSharedKeyAction("Mark", a.setMarkCmd, false),
+		ui.KeyApostrophe:        ui.NewSharedKeyAction("Jump Mark", a.jumpMarkCmd, false),
+		tcell.KeyCtrlUnderscore: ui.NewSharedKeyAction("Find", a.finderCmd, false),
 	})
+	a.AddActions(a.tabKeys())
 }
 
 // ActiveView returns the currently active view.
@@ -130,14 +237,23 @@ func (a *App) ActiveView() model.Component {
 
 func (a *App) toggleHeader(flag bool) {
 	a.showHeader = flag
+	if a.zoomed {
+		return
+	}
 	flex, ok := a.Main.GetPrimitive("main").(*tview.Flex)
 	if !ok {
 		log.Fatal().Msg("Expecting valid flex view")
 	}
-	if a.showHeader {
+	switch {
+	case a.showHeader && a.Config.K9s.HeaderConfig().Compact:
+		a.headerWidgets().SetCompact(true)
+		flex.RemoveItemAtIndex(0)
+		flex.AddItemAtIndex(0, a.headerWidgets(), 1, 1, false)
+	case a.showHeader:
+		a.headerWidgets().SetCompact(false)
 		flex.RemoveItemAtIndex(0)
 		flex.AddItemAtIndex(0, a.buildHeader(), 8, 1, false)
-	} else {
+	default:
 		flex.RemoveItemAtIndex(0)
 		flex.AddItemAtIndex(0, a.statusIndicator(), 1, 1, false)
 	}
@@ -159,8 +275,15 @@ func (a *App) buildHeader() tview.Primitive {
 			clWidth = size
 		}
 	}
-	header.AddItem(a.clusterInfo(), clWidth, 1, false)
+	cfg := a.Config.K9s.HeaderConfig()
+	if len(cfg.Info) > 0 {
+		a.clusterInfo().Relayout()
+		header.AddItem(a.clusterInfo(), clWidth, 1, false)
+	}
 	header.AddItem(a.Menu(), 0, 1, false)
+	if len(cfg.Widgets) > 0 {
+		header.AddItem(a.headerWidgets(), headerWidgetsWidth, 1, false)
+	}
 	header.AddItem(a.Logo(), 26, 1, false)
 
 	return header
@@ -179,8 +302,17 @@ func (a *App) Resume() {
 	var ctx context.Context
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 	go a.clusterUpdater(ctx)
-	if err := a.StylesUpdater(ctx, a); err != nil {
-		log.Error().Err(err).Msgf("Styles update failed")
+	go a.headerUpdater(ctx)
+	go model.Pace.Watch(ctx)
+	if err := a.loadAlerts(); err != nil {
+		log.Error().Err(err).Msgf("Alert rules load failed")
+	}
+	go a.alertUpdater(ctx)
+	if err := a.configUpdater(ctx); err != nil {
+		log.Error().Err(err).Msgf("Config watcher failed")
+	}
+	if err := a.kubeConfigUpdater(ctx); err != nil {
+		log.Error().Err(err).Msgf("Kubeconfig watcher failed")
 	}
 }
 
@@ -190,15 +322,45 @@ func (a *App) clusterUpdater(ctx context.Context) {
 		case <-ctx.Done():
 			log.Debug().Msg("ClusterInfo updater canceled!")
 			return
-		case <-time.After(clusterRefresh):
+		case <-time.After(model.Pace.Rate(clusterRefresh)):
+			a.refreshCluster()
+		case <-model.Pace.Resumed():
 			a.refreshCluster()
 		}
 	}
 }
 
 func (a *App) refreshCluster() {
+	if atomic.LoadInt32(&a.loggingIn) == 1 {
+		return
+	}
 	c := a.Content.Top()
-	if ok := a.Conn().CheckConnectivity(); ok {
+	t := time.Now()
+	ok := a.Conn().CheckConnectivity()
+	if ok {
+		atomic.StoreInt64(&a.latency, int64(time.Since(t)))
+		a.QueueUpdateDraw(a.headerWidgets().refresh)
+	}
+	if !ok && atomic.LoadInt32(&a.conRetry) == 0 && a.Conn().Config().NeedsOIDCLogin() {
+		if c != nil {
+			c.Stop()
+		}
+		a.oidcLogin(func() {
+			if c != nil {
+				c.Start()
+			}
+		})
+		return
+	}
+	if !ok && atomic.LoadInt32(&a.conRetry) == 0 {
+		// Credentials might have been rotated, give them a spin before bailing.
+		if err := a.refreshCredentials(); err != nil {
+			log.Warn().Err(err).Msg("Credentials refresh failed")
+		} else {
+			ok = true
+		}
+	}
+	if ok {
 		if atomic.LoadInt32(&a.conRetry) > 0 {
 			atomic.StoreInt32(&a.conRetry, 0)
 			a.Status(model.FlashInfo, "K8s connectivity OK")
@@ -260,6 +422,7 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 			log.Warn().Msg("No namespace specified in context. Using K9s config")
 		}
 		a.initFactory(ns)
+		a.navHistory.Clear()
 
 		client.ResetMetrics()
 		if err := a.command.Reset(true); err != nil {
@@ -271,6 +434,7 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 		}
 		a.Flash().Infof("Switching context to %s", name)
 		a.ReloadStyles(name)
+		a.refreshTabs()
 		if err := a.gotoResource("pods", "", true); loadPods && err != nil {
 			a.Flash().Err(err)
 		}
@@ -282,12 +446,19 @@ func (a *App) switchCtx(name string, loadPods bool) error {
 
 func (a *App) initFactory(ns string) {
 	a.factory.Terminate()
+	a.initTimeline()
+	a.factory.SetFallbackNamespaces(a.Config.FavNamespaces())
 	a.factory.Start(ns)
 }
 
 // BailOut exists the application.
 func (a *App) BailOut() {
+	a.saveSession()
+	a.stopControl()
+	a.stopAnnouncer()
 	a.factory.Terminate()
+	a.contexts.Terminate()
+	a.Conn().Config().CloseTunnels()
 	a.App.BailOut()
 }
 
@@ -302,8 +473,15 @@ func (a *App) Run() error {
 		})
 	}()
 
-	if err := a.command.defaultCmd(); err != nil {
-		return err
+	if err := a.startControl(); err != nil {
+		log.Error().Err(err).Msg("Control API failed to start")
+	}
+	if a.Conn().Config().NeedsOIDCLogin() && !a.Conn().CheckConnectivity() {
+		a.oidcLogin(a.resumeLogin)
+	} else if !a.restoreSession() {
+		if err := a.command.defaultCmd(); err != nil {
+			return err
+		}
 	}
 	if err := a.Application.Run(); err != nil {
 		return err
@@ -384,7 +562,16 @@ func (a *App) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if a.CmdBuff().IsActive() && !a.CmdBuff().Empty() {
 		if err := a.gotoResource(a.GetCmd(), "", true); err != nil {
 			log.Error().Err(err).Msgf("Goto resource for %q failed", a.GetCmd())
+			cmd := a.GetCmd()
+			a.recordErr(errSourceCommand, ":"+cmd, err.Error(), func() {
+				if err := a.gotoResource(cmd, "", true); err != nil {
+					a.Flash().Err(err)
+				}
+			})
 			a.Flash().Err(err)
+		} else {
+			a.recordStep(config.MacroStep{Command: a.GetCmd()})
+			a.pushHistory(a.GetCmd())
 		}
 		a.ResetCmd()
 		return nil
@@ -427,6 +614,31 @@ func (a *App) aliasCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (a *App) skinCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Content.Top() != nil && a.Content.Top().Name() == "skins" {
+		return evt
+	}
+	if err := a.inject(NewSkin()); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (a *App) configCmd(evt *tcell.EventKey) *tcell.EventKey {
+	raw, err := a.Config.EffectiveYAML()
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(a, "Config", a.Config.K9s.CurrentContext, true).Update(raw)
+	if err := a.inject(details); err != nil {
+		a.Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (a *App) viewResource(gvr, path string, clearStack bool) error {
 	return a.command.run(gvr, path, clearStack)
 }
@@ -435,11 +647,33 @@ func (a *App) gotoResource(cmd, path string, clearStack bool) error {
 	return a.command.run(cmd, path, clearStack)
 }
 
+// filterTop applies a filter to the top most view if it supports filtering.
+func (a *App) filterTop(filter string) {
+	switch v := a.Content.Top().(type) {
+	case TableViewer:
+		a.applyFilter(v, filter)
+	case filterer:
+		v.setFilter(filter)
+	}
+}
+
+// applyFilter applies a filter to a given table view.
+func (a *App) applyFilter(v TableViewer, filter string) {
+	prev := v.GetTable().SearchBuff().String()
+	v.GetTable().SearchBuff().Set(filter)
+	if ui.IsServerSelector(filter) || ui.IsServerSelector(prev) {
+		v.Start()
+		return
+	}
+	v.GetTable().Refresh()
+}
+
 func (a *App) inject(c model.Component) error {
 	ctx := context.WithValue(context.Background(), internal.KeyApp, a)
 	if err := c.Init(ctx); err != nil {
 		return fmt.Errorf("component init failed for %q %v", c.Name(), err)
 	}
+	a.remapComponent(c, c.Name())
 	a.Content.Push(c)
 
 	return nil
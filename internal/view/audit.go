@@ -0,0 +1,80 @@
+package view
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+)
+
+// Audit presents an audit log viewer.
+type Audit struct {
+	ResourceViewer
+}
+
+// NewAudit returns a new viewer.
+func NewAudit(gvr client.GVR) ResourceViewer {
+	a := Audit{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetBorderFocusColor(tcell.ColorSteelBlue)
+	a.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorRoyalBlue, tcell.AttrNone)
+	a.GetTable().SetColorerFn(render.Audit{}.ColorerFunc())
+	a.GetTable().SetSortCol(0, 0, false)
+	a.GetTable().SetEnterFn(a.viewEntry)
+	a.SetContextFn(a.auditContext)
+
+	return &a
+}
+
+func (a *Audit) auditContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, config.K9sAuditFile)
+}
+
+func (a *Audit) viewEntry(app *App, model ui.Tabular, gvr, path string) {
+	line, err := strconv.Atoi(path)
+	if err != nil {
+		app.Flash().Errf("Invalid audit entry %q", path)
+		return
+	}
+	ee, err := config.LoadAudit(config.K9sAuditFile)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if line < 1 || line > len(ee) {
+		app.Flash().Errf("Audit entry %d not found", line)
+		return
+	}
+	raw, err := yaml.Marshal(ee[line-1])
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	details := NewDetails(app, "Audit", path, true).Update(string(raw))
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// audit records a mutating or sensitive action in the audit log.
+func (a *App) audit(action, gvr, path string, err error) {
+	e := config.NewAuditEntry(action, gvr, path, err)
+	e.Context, e.Cluster = a.Config.K9s.CurrentContext, a.Config.K9s.CurrentCluster
+	if a.Conn() != nil {
+		if user, err := a.Conn().Config().CurrentUserName(); err == nil {
+			e.User = user
+		}
+	}
+	if err := config.AppendAudit(config.K9sAuditFile, e); err != nil {
+		log.Error().Err(err).Msgf("Unable to record %s audit entry", action)
+	}
+}
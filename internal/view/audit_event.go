@@ -0,0 +1,188 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/auditlog"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	auditFilterKey = "auditFilter"
+	auditFilePoll  = time.Second
+)
+
+// AuditEvent presents a live Kubernetes audit events viewer.
+type AuditEvent struct {
+	ResourceViewer
+
+	filter auditlog.Filter
+}
+
+// NewAuditEvent returns a new viewer.
+func NewAuditEvent(gvr client.GVR) ResourceViewer {
+	a := AuditEvent{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetColorerFn(render.AuditEvent{}.ColorerFunc())
+	a.GetTable().SetSortCol(0, 0, false)
+	a.GetTable().SetEnterFn(a.viewEvent)
+	a.SetBindKeysFn(a.bindKeys)
+	a.SetContextFn(a.auditContext)
+
+	return &a
+}
+
+func (a *AuditEvent) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyF:      ui.NewKeyAction("Filter", a.filterCmd, true),
+		ui.KeyShiftU: ui.NewKeyAction("Sort User", a.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Verb", a.GetTable().SortColCmd(2, true), false),
+	})
+}
+
+func (a *AuditEvent) auditContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyAuditEvents, a.App().auditEvents)
+	return context.WithValue(ctx, internal.KeyAuditFilter, a.filter)
+}
+
+func (a *AuditEvent) viewEvent(app *App, _ ui.Tabular, _, id string) {
+	if app.auditEvents == nil {
+		return
+	}
+	e, ok := app.auditEvents.Get(id)
+	if !ok {
+		app.Flash().Errf("Audit event %q is no longer available", id)
+		return
+	}
+	raw, err := yaml.Marshal(e)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	details := NewDetails(app, "Audit Event", id, true)
+	if isSecretEvent(e) && app.Config.K9s.MaskSecrets() {
+		masked, err := yaml.Marshal(maskAuditEvent(e))
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		app.maskDetails(details, secretGVR, id, string(masked), string(raw))
+	} else {
+		details.Update(string(raw))
+	}
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (a *AuditEvent) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	app, f := a.App(), a.filter
+	styles := app.Styles
+
+	form := tview.NewForm()
+	form.SetItemPadding(0)
+	form.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+	form.AddInputField("User:", f.User, 40, nil, func(s string) {
+		f.User = s
+	})
+	form.AddInputField("Verb:", f.Verb, 40, nil, func(s string) {
+		f.Verb = s
+	})
+	form.AddInputField("Resource:", f.Resource, 40, nil, func(s string) {
+		f.Resource = s
+	})
+
+	pages := app.Content.Pages
+	dismiss := func() {
+		pages.RemovePage(auditFilterKey)
+		app.SetFocus(pages.CurrentPage().Item)
+	}
+	form.AddButton("OK", func() {
+		dismiss()
+		a.applyFilter(f)
+	})
+	form.AddButton("Clear", func() {
+		dismiss()
+		a.applyFilter(auditlog.Filter{})
+	})
+	form.AddButton("Cancel", dismiss)
+
+	modal := tview.NewModalForm("<Filter Audit Events>", form)
+	modal.SetText("Shows events whose user, verb and resource contain the given values")
+	modal.SetDoneFunc(func(int, string) {
+		dismiss()
+	})
+	pages.AddPage(auditFilterKey, modal, false, true)
+	pages.ShowPage(auditFilterKey)
+	app.SetFocus(pages.GetPrimitive(auditFilterKey))
+
+	return nil
+}
+
+func (a *AuditEvent) applyFilter(f auditlog.Filter) {
+	a.filter = f
+	if f.IsEmpty() {
+		a.App().Flash().Info("Showing all audit events")
+	} else {
+		a.App().Flash().Infof("Showing audit events for %s", f)
+	}
+	a.Start()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// initAuditEvents starts streaming audit events when configured.
+func (a *App) initAuditEvents(ctx context.Context) {
+	cfg := a.Config.K9s.AuditEventsConfig()
+	if !cfg.Enabled() {
+		return
+	}
+	s := auditlog.NewStream(cfg.MaxEvents)
+	switch cfg.Source {
+	case config.AuditSourceFile:
+		go auditlog.TailFile(ctx, cfg.Path, auditFilePoll, s)
+		log.Info().Msgf("Tailing audit events from %s", cfg.Path)
+	case config.AuditSourceWebhook:
+		wh := auditlog.Webhook{
+			Address:  cfg.Address,
+			CertFile: cfg.CertFile,
+			KeyFile:  cfg.KeyFile,
+			Token:    cfg.Token,
+		}
+		if err := auditlog.ServeWebhook(ctx, wh, s); err != nil {
+			log.Error().Err(err).Msg("Audit webhook start failed")
+			return
+		}
+	}
+	a.auditEvents = s
+}
+
+// isSecretEvent checks if an audit event targets secrets.
+func isSecretEvent(e auditlog.Event) bool {
+	return e.ObjectRef != nil && e.ObjectRef.APIGroup == "" && e.ObjectRef.Resource == "secrets"
+}
+
+// maskAuditEvent returns a copy of an audit event with masked payloads.
+func maskAuditEvent(e auditlog.Event) auditlog.Event {
+	e.RequestObject = maskSecretPayload(e.RequestObject)
+	e.ResponseObject = maskSecretPayload(e.ResponseObject)
+
+	return e
+}
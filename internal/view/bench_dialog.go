@@ -0,0 +1,150 @@
+package view
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+)
+
+const benchConfigKey = "benchConfig"
+
+// BenchConfigFunc represents a benchmark spec callback.
+type BenchConfigFunc func(cfg config.BenchConfig) error
+
+// ShowBenchConfig pops a benchmark spec editor.
+func ShowBenchConfig(app *App, name string, cfg config.BenchConfig, saveFn, testFn BenchConfigFunc) {
+	styles := app.Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	cfg.HTTP.Method = config.BenchMethods[methodIndex(cfg.HTTP.Method)]
+	c, n, headers := strconv.Itoa(cfg.C), strconv.Itoa(cfg.N), config.FormatHeaders(cfg.HTTP.Headers)
+	f.AddInputField("Concurrency:", c, 10, tview.InputFieldInteger, func(s string) {
+		c = s
+	})
+	f.AddInputField("Requests:", n, 10, tview.InputFieldInteger, func(s string) {
+		n = s
+	})
+	f.AddDropDown("Method:", config.BenchMethods, methodIndex(cfg.HTTP.Method), func(m string, _ int) {
+		cfg.HTTP.Method = m
+	})
+	f.AddInputField("Host:", cfg.HTTP.Host, 40, nil, func(s string) {
+		cfg.HTTP.Host = s
+	})
+	f.AddInputField("Path:", cfg.HTTP.Path, 40, nil, func(s string) {
+		cfg.HTTP.Path = s
+	})
+	f.AddCheckbox("HTTP2:", cfg.HTTP.HTTP2, func(b bool) {
+		cfg.HTTP.HTTP2 = b
+	})
+	f.AddInputField("Headers:", headers, 40, nil, func(s string) {
+		headers = s
+	})
+	f.AddInputField("Body:", cfg.HTTP.Body, 40, nil, func(s string) {
+		cfg.HTTP.Body = s
+	})
+	f.AddInputField("User:", cfg.Auth.User, 40, nil, func(s string) {
+		cfg.Auth.User = s
+	})
+	f.AddPasswordField("Password:", cfg.Auth.Password, 40, '*', func(s string) {
+		cfg.Auth.Password = s
+	})
+
+	f.AddButton("Save", func() {
+		spec, err := benchSpec(cfg, c, n, headers)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		if err := saveFn(spec); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		DismissBenchConfig(app)
+		app.Flash().Infof("Benchmark config saved for %s", name)
+	})
+	f.AddButton("Test", func() {
+		spec, err := benchSpec(cfg, c, n, headers)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		DismissBenchConfig(app)
+		if err := testFn(spec); err != nil {
+			app.Flash().Errf("Bench failed %v", err)
+		}
+	})
+	f.AddButton("Cancel", func() {
+		DismissBenchConfig(app)
+	})
+
+	modal := tview.NewModalForm(fmt.Sprintf("<Benchmark %s>", name), f)
+	modal.SetText("Headers are specified as `name: value` pairs separated by `;`")
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissBenchConfig(app)
+	})
+
+	pages := app.Content.Pages
+	pages.AddPage(benchConfigKey, modal, false, true)
+	pages.ShowPage(benchConfigKey)
+	app.SetFocus(pages.GetPrimitive(benchConfigKey))
+}
+
+// DismissBenchConfig dismisses the benchmark spec editor.
+func DismissBenchConfig(app *App) {
+	p := app.Content.Pages
+	p.RemovePage(benchConfigKey)
+	app.SetFocus(p.CurrentPage().Item)
+}
+
+// saveBench records a benchmark spec in a benchmarks config file.
+func saveBench(path string, set func(*config.Bench)) error {
+	b, err := config.NewBench(path)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to load benchmarks config -- %s", err)
+	}
+	set(b)
+
+	return b.Save(path)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func benchSpec(cfg config.BenchConfig, c, n, headers string) (config.BenchConfig, error) {
+	var err error
+	if cfg.C, err = strconv.Atoi(strings.TrimSpace(c)); err != nil {
+		return cfg, fmt.Errorf("invalid concurrency %q", c)
+	}
+	if cfg.N, err = strconv.Atoi(strings.TrimSpace(n)); err != nil {
+		return cfg, fmt.Errorf("invalid requests %q", n)
+	}
+	if cfg.HTTP.Headers, err = config.ParseHeaders(headers); err != nil {
+		return cfg, err
+	}
+	if len(cfg.HTTP.Headers) == 0 {
+		cfg.HTTP.Headers = nil
+	}
+
+	return cfg, cfg.Validate()
+}
+
+func methodIndex(m string) int {
+	for i, v := range config.BenchMethods {
+		if v == m {
+			return i
+		}
+	}
+
+	return 0
+}
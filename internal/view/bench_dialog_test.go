@@ -0,0 +1,64 @@
+package view
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBenchSpec(t *testing.T) {
+	uu := map[string]struct {
+		c, n, headers string
+		e             config.BenchConfig
+		err           string
+	}{
+		"plain": {
+			c: "2", n: " 100 ",
+			e: config.BenchConfig{C: 2, N: 100, HTTP: config.HTTP{Method: "GET", Path: "/"}},
+		},
+		"headers": {
+			c: "1", n: "10", headers: "Accept: text/html",
+			e: config.BenchConfig{C: 1, N: 10, HTTP: config.HTTP{
+				Method:  "GET",
+				Path:    "/",
+				Headers: http.Header{"Accept": []string{"text/html"}},
+			}},
+		},
+		"badC": {
+			c: "", n: "10",
+			err: `invalid concurrency ""`,
+		},
+		"badN": {
+			c: "1", n: "fred",
+			err: `invalid requests "fred"`,
+		},
+		"badHeaders": {
+			c: "1", n: "10", headers: "Accept",
+			err: `invalid header "Accept". Expecting name: value`,
+		},
+		"invalid": {
+			c: "20", n: "10",
+			err: "concurrency 20 exceeds requests 10",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			spec, err := benchSpec(config.DefaultBenchSpec(), u.c, u.n, u.headers)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, spec)
+		})
+	}
+}
+
+func TestMethodIndex(t *testing.T) {
+	assert.Equal(t, 1, methodIndex("POST"))
+	assert.Equal(t, 0, methodIndex("BOZO"))
+}
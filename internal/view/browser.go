@@ -12,8 +12,10 @@ import (
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/script"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/k9s/internal/watch"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -28,6 +30,13 @@ type Browser struct {
 	accessor   dao.Accessor
 	contextFn  ContextFunc
 	cancelFn   context.CancelFunc
+	labelSel   string
+	fieldSel   string
+	drillLabel string
+	drillField string
+	patches    map[string]string
+	recording  bool
+	recorded   string
 }
 
 // NewBrowser returns a new browser.
@@ -51,7 +60,7 @@ func (b *Browser) Init(ctx context.Context) error {
 	}
 	ns := client.CleanseNamespace(b.app.Config.ActiveNamespace())
 	if dao.IsK8sMeta(b.meta) && b.app.ConOK() {
-		if _, e := b.app.factory.CanForResource(ns, b.GVR(), client.MonitorAccess); e != nil {
+		if _, e := b.app.factory.CanAccess(ns, b.GVR(), client.MonitorAccess); e != nil {
 			return e
 		}
 	}
@@ -71,8 +80,18 @@ func (b *Browser) Init(ctx context.Context) error {
 	if row == 0 && b.GetRowCount() > 0 {
 		b.Select(1, 0)
 	}
+	b.SetPageFn(b.listingSize)
+	b.SetStaleFn(b.staleSince)
 	b.GetModel().AddListener(b)
-	b.GetModel().SetRefreshRate(time.Duration(b.App().Config.K9s.GetRefreshRate()) * time.Second)
+	rate, custom := b.app.Config.K9s.RefreshRateFor(append([]string{b.GVR()}, b.Aliases()...)...)
+	b.GetModel().SetRefreshRate(rate)
+	if custom {
+		b.SetRefreshIndicator(rate)
+	}
+	if dao.IsK8sMeta(b.meta) && b.app.scripts.HasHook(b.GVR(), script.OnSelect) {
+		b.SetSelectedRowFn(scriptSelectHook(b))
+	}
+	b.AddSelectedRowFn(b.recordSelection)
 
 	return nil
 }
@@ -95,6 +114,10 @@ func (b *Browser) Start() {
 
 	b.Table.Start()
 	b.GetModel().Watch(b.prepareContext())
+	if dao.IsK8sMeta(b.meta) && b.app.ConOK() {
+		b.recording = true
+		b.recordSelection(b.GetSelectedRowIndex())
+	}
 }
 
 func (b *Browser) prepareContext() context.Context {
@@ -106,6 +129,8 @@ func (b *Browser) prepareContext() context.Context {
 	if path, ok := ctx.Value(internal.KeyPath).(string); ok && path != "" {
 		b.Path = path
 	}
+	b.labelSel, _ = ctx.Value(internal.KeyLabels).(string)
+	b.fieldSel, _ = ctx.Value(internal.KeyFields).(string)
 
 	return ctx
 }
@@ -118,6 +143,29 @@ func (b *Browser) Stop() {
 	b.Table.Stop()
 	b.cancelFn()
 	b.cancelFn = nil
+	if b.recording {
+		b.recordSelection(0)
+		b.recording = false
+	}
+}
+
+// recordSelection records the changes of the selected object only.
+func (b *Browser) recordSelection(int) {
+	var path string
+	if b.recording && b.cancelFn != nil {
+		path = b.GetSelectedItem()
+	}
+	if path == b.recorded {
+		return
+	}
+	tl := b.app.factory.Timeline()
+	if b.recorded != "" {
+		tl.Unwatch(b.GVR(), b.recorded)
+	}
+	if path != "" {
+		tl.Watch(b.GVR(), path)
+	}
+	b.recorded = path
 }
 
 func (b *Browser) refresh() {
@@ -130,6 +178,14 @@ func (b *Browser) Name() string { return b.meta.Kind }
 // SetContextFn populates a custom context.
 func (b *Browser) SetContextFn(f ContextFunc) { b.contextFn = f }
 
+// SetSelectors sets the selectors a drill down view lists resources with.
+func (b *Browser) SetSelectors(labels, fields string) {
+	b.drillLabel, b.drillField = labels, fields
+}
+
+// Selectors returns the drill down label and field selectors.
+func (b *Browser) Selectors() (string, string) { return b.drillLabel, b.drillField }
+
 // GetTable returns the underlying table.
 func (b *Browser) GetTable() *Table { return b.Table }
 
@@ -150,11 +206,13 @@ func (b *Browser) TableDataChanged(data render.TableData) {
 	b.app.QueueUpdateDraw(func() {
 		b.refreshActions()
 		b.Update(data)
+		b.selectPending()
 	})
 }
 
 // TableLoadFailed notifies view something went south.
 func (b *Browser) TableLoadFailed(err error) {
+	b.app.recordLoadErr(b.GVR(), b.GetModel().GetNamespace(), err)
 	b.app.QueueUpdateDraw(func() {
 		b.app.Flash().Err(err)
 		b.App().ClearStatus(false)
@@ -177,7 +235,11 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(b.app, "YAML", path, true).Update(raw)
+	details, err := yamlDetails(b.app, b.GVR(), path, raw)
+	if err != nil {
+		b.App().Flash().Err(err)
+		return nil
+	}
 	if err := b.App().inject(details); err != nil {
 		b.App().Flash().Err(err)
 	}
@@ -194,8 +256,9 @@ func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	cmd := b.SearchBuff().String()
 	b.App().Flash().Info("Clearing filter...")
 	b.SearchBuff().Reset()
+	b.app.recordStep(filterStep(""))
 
-	if ui.IsLabelSelector(cmd) {
+	if ui.IsServerSelector(cmd) {
 		b.Start()
 	} else {
 		b.Refresh()
@@ -212,7 +275,8 @@ func (b *Browser) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	b.SearchBuff().SetActive(false)
 
 	cmd := b.SearchBuff().String()
-	if ui.IsLabelSelector(cmd) {
+	b.app.recordStep(filterStep(cmd))
+	if ui.IsServerSelector(cmd) {
 		b.Start()
 		return nil
 	}
@@ -243,11 +307,52 @@ func (b *Browser) refreshCmd(*tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) loadMoreCmd(*tcell.EventKey) *tcell.EventKey {
+	if !b.app.factory.LoadMore(b.GVR(), b.GetModel().GetNamespace(), b.labelSel, b.fieldSel) {
+		b.app.Flash().Info("All items loaded")
+		return nil
+	}
+	b.app.Flash().Info("Loading more...")
+	b.refresh()
+
+	return nil
+}
+
+// listingSize returns the collection size if the listing is paginated.
+func (b *Browser) listingSize() (int64, bool) {
+	page, ok := b.page()
+	if !ok {
+		return 0, false
+	}
+
+	return page.Total, true
+}
+
+func (b *Browser) staleSince() (time.Time, bool) {
+	if b.app.factory == nil {
+		return time.Time{}, false
+	}
+
+	return b.app.factory.StaleSince(b.GVR(), b.GetModel().GetNamespace(), b.labelSel, b.fieldSel)
+}
+
+func (b *Browser) page() (watch.Page, bool) {
+	if b.app.factory == nil {
+		return watch.Page{}, false
+	}
+
+	return b.app.factory.PageFor(b.GVR(), b.GetModel().GetNamespace(), b.labelSel, b.fieldSel)
+}
+
 func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	selections := b.GetSelectedItems()
 	if len(selections) == 0 {
 		return evt
 	}
+	if err := scriptConfirmDelete(b, selections); err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
 
 	b.Stop()
 	defer b.Start()
@@ -296,9 +401,12 @@ func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 		args = append(args, "edit")
 		args = append(args, b.meta.SingularName)
 		args = append(args, "-n", ns)
+		var err error
 		if !runK(b.app, shellOpts{clear: true, args: append(args, n)}) {
-			b.app.Flash().Err(errors.New("Edit exec failed"))
+			err = errors.New("Edit exec failed")
+			b.app.Flash().Err(err)
 		}
+		b.app.audit(config.VerbEdit, b.GVR(), path, err)
 	}
 
 	return evt
@@ -358,12 +466,16 @@ func (b *Browser) defaultContext() context.Context {
 	ctx = context.WithValue(ctx, internal.KeyGVR, b.gvr.String())
 	ctx = context.WithValue(ctx, internal.KeyPath, b.Path)
 
-	ctx = context.WithValue(ctx, internal.KeyLabels, "")
+	ctx = context.WithValue(ctx, internal.KeyLabels, b.drillLabel)
 	if ui.IsLabelSelector(b.SearchBuff().String()) {
 		ctx = context.WithValue(ctx, internal.KeyLabels, ui.TrimLabelSelector(b.SearchBuff().String()))
 	}
-	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyFields, b.drillField)
+	if ui.IsFieldSelector(b.SearchBuff().String()) {
+		ctx = context.WithValue(ctx, internal.KeyFields, ui.TrimFieldSelector(b.SearchBuff().String()))
+	}
 	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(b.App().Config.ActiveNamespace()))
+	ctx = context.WithValue(ctx, internal.KeyScripts, b.app.scripts)
 
 	return ctx
 }
@@ -379,10 +491,10 @@ func (b *Browser) refreshActions() {
 		b.namespaceActions(aa)
 		if !b.app.Config.K9s.GetReadOnly() {
 			if client.Can(b.meta.Verbs, "edit") {
-				aa[ui.KeyE] = ui.NewKeyAction("Edit", b.editCmd, true)
+				aa[ui.KeyE] = ui.NewKeyAction("Edit", guardCmd(b, config.VerbEdit, b.GetSelectedItems, b.editCmd), true)
 			}
 			if client.Can(b.meta.Verbs, "delete") {
-				aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", b.deleteCmd, true)
+				aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", guardCmd(b, config.VerbDelete, b.GetSelectedItems, b.deleteCmd), true)
 			}
 		}
 	}
@@ -390,15 +502,33 @@ func (b *Browser) refreshActions() {
 	if !dao.IsK9sMeta(b.meta) {
 		aa[ui.KeyY] = ui.NewKeyAction("YAML", b.viewCmd, true)
 		aa[ui.KeyD] = ui.NewKeyAction("Describe", b.describeCmd, true)
+		aa[ui.KeyShiftJ] = ui.NewKeyAction("Kubectl Plugins", b.kubectlPluginsCmd, true)
+		aa[ui.KeyQ] = ui.NewKeyAction("Query", b.queryCmd, true)
+		aa[ui.KeyX] = ui.NewKeyAction("Copy Kubectl", b.cpKubectlCmd, true)
+		aa[ui.KeyShiftQ] = ui.NewKeyAction("Query All", b.queryAllCmd, true)
+		aa[ui.KeyO] = ui.NewKeyAction("Open Link", b.openLinkCmd, true)
+		aa[ui.KeyShiftH] = ui.NewKeyAction("Timeline", b.timelineCmd, true)
+		aa[ui.KeyShiftW] = ui.NewKeyAction("Pin", b.pinCmd, true)
+	}
+	if page, ok := b.page(); ok && page.More {
+		aa[tcell.KeyCtrlO] = ui.NewKeyAction("Load More", b.loadMoreCmd, true)
+	} else {
+		b.Actions().Delete(tcell.KeyCtrlO)
 	}
 
 	pluginActions(b, aa)
+	if b.app.ConOK() && !b.app.Config.K9s.GetReadOnly() && client.Can(b.meta.Verbs, "patch") {
+		aa[ui.KeyShiftY] = ui.NewKeyAction("Patch", guardCmd(b, config.VerbPatch, b.GetSelectedItems, b.patchEditCmd), true)
+		patchActions(b, aa)
+	}
+	scriptActions(b, aa)
 	hotKeyActions(b, aa)
 	b.Actions().Add(aa)
 
 	if b.bindKeysFn != nil {
 		b.bindKeysFn(b.Actions())
 	}
+	b.app.remap(b.Actions(), append(b.Aliases(), b.Name())...)
 	b.app.Menu().HydrateMenu(b.Hints())
 }
 
@@ -453,7 +583,9 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 			b.app.Flash().Infof("Delete resource %s %s", b.gvr, selections[0])
 		}
 		for _, sel := range selections {
-			if err := b.GetModel().Delete(b.defaultContext(), sel, cascade, force); err != nil {
+			err := b.GetModel().Delete(b.defaultContext(), sel, cascade, force)
+			b.app.audit(config.VerbDelete, b.GVR(), sel, err)
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.app.Flash().Infof("%s `%s deleted successfully", b.GVR(), sel)
@@ -0,0 +1,40 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/gdamore/tcell"
+)
+
+// Cache presents the informer caches usage.
+type Cache struct {
+	ResourceViewer
+}
+
+// NewCache returns a new viewer.
+func NewCache(gvr client.GVR) ResourceViewer {
+	c := Cache{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorCadetBlue)
+	c.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorCadetBlue, tcell.AttrNone)
+	c.GetTable().SetColorerFn(render.Cache{}.ColorerFunc())
+	c.GetTable().SetDecorateFn(c.decorate)
+	c.GetTable().SetSortCol(4, 0, false)
+
+	return &c
+}
+
+// decorate shows the caches memory usage in the view title.
+func (c *Cache) decorate(data render.TableData) render.TableData {
+	f := c.App().factory
+	budget := "unlimited"
+	if b := f.MemoryBudget(); b > 0 {
+		budget = render.ToBytes(b)
+	}
+	c.GetTable().BaseTitle = fmt.Sprintf("caches %s/%s", render.ToBytes(f.MemoryUsage()), budget)
+
+	return data
+}
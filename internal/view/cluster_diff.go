@@ -0,0 +1,173 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// diffResources lists the resources compared when none are specified.
+var diffResources = []string{
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"v1/services",
+	"v1/configmaps",
+	"v1/serviceaccounts",
+}
+
+// DiffCmdSpec represents a cluster diff command.
+type DiffCmdSpec struct {
+	Left, Right string
+	Resources   []string
+	Namespace   string
+}
+
+// ParseDiff parses a diff command of the form `diff ctx-a ctx-b [res1,res2] [ns]`.
+func ParseDiff(cmd string) (DiffCmdSpec, error) {
+	tokens := strings.Fields(cmd)
+	if len(tokens) < 3 {
+		return DiffCmdSpec{}, errors.New("You must specify two contexts")
+	}
+	if len(tokens) > 5 {
+		return DiffCmdSpec{}, fmt.Errorf("invalid diff command %q", cmd)
+	}
+	spec := DiffCmdSpec{Left: tokens[1], Right: tokens[2]}
+	if spec.Left == spec.Right {
+		return DiffCmdSpec{}, errors.New("You must specify two distinct contexts")
+	}
+	if len(tokens) > 3 {
+		for _, r := range strings.Split(tokens[3], ",") {
+			if r = strings.TrimSpace(r); r != "" {
+				spec.Resources = append(spec.Resources, r)
+			}
+		}
+	}
+	if len(tokens) > 4 {
+		spec.Namespace = tokens[4]
+	}
+
+	return spec, nil
+}
+
+// ClusterDiff presents the objects differing between two contexts.
+type ClusterDiff struct {
+	ResourceViewer
+
+	spec *dao.DiffSpec
+	ns   string
+}
+
+// NewClusterDiff returns a new cluster diff viewer.
+func NewClusterDiff(gvr client.GVR, spec *dao.DiffSpec, ns string) ResourceViewer {
+	d := ClusterDiff{
+		ResourceViewer: NewBrowser(gvr),
+		spec:           spec,
+		ns:             ns,
+	}
+	d.GetTable().SetColorerFn(render.ClusterDiff{}.ColorerFunc())
+	d.GetTable().SetEnterFn(d.showDiff)
+	d.SetContextFn(d.diffContext)
+
+	return &d
+}
+
+// Init initializes the view.
+func (d *ClusterDiff) Init(ctx context.Context) error {
+	if err := d.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	d.GetTable().GetModel().SetNamespace(client.CleanseNamespace(d.ns))
+
+	return nil
+}
+
+func (d *ClusterDiff) diffContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyDiff, d.spec)
+}
+
+func (d *ClusterDiff) showDiff(app *App, _ ui.Tabular, _, id string) {
+	gvr, path := render.ParseDiffID(id)
+	var cd dao.ClusterDiff
+	diff, err := cd.Describe(d.spec, gvr, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if diff == "" {
+		app.Flash().Info("No differences found")
+		return
+	}
+
+	details := NewDetails(app, "Diff", path, true).Update(diff)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (a *App) diffCmd(cmd string) error {
+	spec, err := ParseDiff(cmd)
+	if err != nil {
+		return err
+	}
+	rr := spec.Resources
+	if len(rr) == 0 {
+		rr = diffResources
+	}
+	gvrs := make([]string, 0, len(rr))
+	for _, r := range rr {
+		gvr, ok := a.command.alias.AsGVR(r)
+		if !ok {
+			return fmt.Errorf("Huh? `%s` resource not found", r)
+		}
+		gvrs = append(gvrs, gvr.String())
+	}
+	ns := a.Config.ActiveNamespace()
+	if spec.Namespace != "" {
+		ns = spec.Namespace
+	}
+
+	a.Flash().Infof("Comparing %s with %s...", spec.Left, spec.Right)
+	go func() {
+		left, err := a.diffTarget(spec.Left)
+		if err != nil {
+			a.QueueUpdateDraw(func() { a.Flash().Err(err) })
+			return
+		}
+		right, err := a.diffTarget(spec.Right)
+		if err != nil {
+			a.QueueUpdateDraw(func() { a.Flash().Err(err) })
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			spec := dao.DiffSpec{Left: left, Right: right, GVRs: gvrs}
+			if err := a.inject(NewClusterDiff(client.NewGVR("clusterdiffs"), &spec, ns)); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func (a *App) diffTarget(context string) (dao.DiffTarget, error) {
+	if context == a.Config.K9s.CurrentContext {
+		return dao.DiffTarget{Context: context, Factory: a.factory}, nil
+	}
+	f, err := a.contexts.For(context)
+	if err != nil {
+		return dao.DiffTarget{}, err
+	}
+
+	return dao.DiffTarget{Context: context, Factory: f}, nil
+}
@@ -0,0 +1,53 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDiff(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		e   DiffCmdSpec
+		err string
+	}{
+		"contexts": {
+			cmd: "diff prod dr",
+			e:   DiffCmdSpec{Left: "prod", Right: "dr"},
+		},
+		"resources": {
+			cmd: "diff prod dr dp,cm,",
+			e:   DiffCmdSpec{Left: "prod", Right: "dr", Resources: []string{"dp", "cm"}},
+		},
+		"full": {
+			cmd: "diff  prod dr dp kube-system",
+			e:   DiffCmdSpec{Left: "prod", Right: "dr", Resources: []string{"dp"}, Namespace: "kube-system"},
+		},
+		"missing": {
+			cmd: "diff prod",
+			err: "You must specify two contexts",
+		},
+		"same": {
+			cmd: "diff prod prod",
+			err: "You must specify two distinct contexts",
+		},
+		"extra": {
+			cmd: "diff prod dr dp fred blee",
+			err: `invalid diff command "diff prod dr dp fred blee"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			spec, err := ParseDiff(u.cmd)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, spec)
+		})
+	}
+}
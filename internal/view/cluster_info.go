@@ -1,6 +1,8 @@
 package view
 
 import (
+	"strings"
+
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
@@ -17,6 +19,8 @@ type ClusterInfo struct {
 
 	app    *App
 	styles *config.Styles
+	blocks []string
+	meta   model.ClusterMeta
 }
 
 // NewClusterInfo returns a new cluster info view.
@@ -25,6 +29,7 @@ func NewClusterInfo(app *App) *ClusterInfo {
 		Table:  tview.NewTable(),
 		app:    app,
 		styles: app.Styles,
+		meta:   model.NewClusterMeta(),
 	}
 }
 
@@ -44,12 +49,25 @@ func (c *ClusterInfo) StylesChanged(s *config.Styles) {
 }
 
 func (c *ClusterInfo) layout() {
-	for row, v := range []string{"Context", "Cluster", "User", "K9s Rev", "K8s Rev", "CPU", "MEM"} {
-		c.SetCell(row, 0, c.sectionCell(v))
+	c.blocks = c.app.Config.K9s.HeaderConfig().Info
+	c.Clear()
+	for row, b := range c.blocks {
+		c.SetCell(row, 0, c.sectionCell(headerLabels[b]))
 		c.SetCell(row, 1, c.infoCell(render.NAValue))
 	}
 }
 
+// Relayout refreshes the cluster info blocks should the header settings change.
+func (c *ClusterInfo) Relayout() {
+	bb := c.app.Config.K9s.HeaderConfig().Info
+	if strings.Join(bb, ",") == strings.Join(c.blocks, ",") {
+		return
+	}
+	c.layout()
+	c.update(c.meta, render.AsPerc(c.meta.Cpu)+"%", render.AsPerc(c.meta.Mem)+"%")
+	c.updateStyle()
+}
+
 func (c *ClusterInfo) sectionCell(t string) *tview.TableCell {
 	cell := tview.NewTableCell(t + ":")
 	cell.SetAlign(tview.AlignLeft)
@@ -77,21 +95,7 @@ func (c *ClusterInfo) infoCell(t string) *tview.TableCell {
 // ClusterInfoUpdated notifies the cluster meta was updated.
 func (c *ClusterInfo) ClusterInfoUpdated(data model.ClusterMeta) {
 	c.app.QueueUpdateDraw(func() {
-		var row int
-		c.GetCell(row, 1).SetText(data.Context)
-		row++
-		c.GetCell(row, 1).SetText(data.Cluster)
-		row++
-		c.GetCell(row, 1).SetText(data.User)
-		row++
-		c.GetCell(row, 1).SetText(data.K9sVer)
-		row++
-		c.GetCell(row, 1).SetText(data.K8sVer)
-		row++
-		c.GetCell(row, 1).SetText(render.AsPerc(data.Cpu) + "%")
-		row++
-		c.GetCell(row, 1).SetText(render.AsPerc(data.Mem) + "%")
-
+		c.update(data, render.AsPerc(data.Cpu)+"%", render.AsPerc(data.Mem)+"%")
 		c.updateStyle()
 	})
 }
@@ -99,25 +103,18 @@ func (c *ClusterInfo) ClusterInfoUpdated(data model.ClusterMeta) {
 // ClusterInfoChanged notifies the cluster meta was changed.
 func (c *ClusterInfo) ClusterInfoChanged(prev, curr model.ClusterMeta) {
 	c.app.QueueUpdateDraw(func() {
-		var row int
-		c.GetCell(row, 1).SetText(curr.Context)
-		row++
-		c.GetCell(row, 1).SetText(curr.Cluster)
-		row++
-		c.GetCell(row, 1).SetText(curr.User)
-		row++
-		c.GetCell(row, 1).SetText(curr.K9sVer)
-		row++
-		c.GetCell(row, 1).SetText(curr.K8sVer)
-		row++
-		c.GetCell(row, 1).SetText(ui.AsPercDelta(prev.Cpu, curr.Cpu))
-		row++
-		c.GetCell(row, 1).SetText(ui.AsPercDelta(prev.Mem, curr.Mem))
-
+		c.update(curr, ui.AsPercDelta(prev.Cpu, curr.Cpu), ui.AsPercDelta(prev.Mem, curr.Mem))
 		c.updateStyle()
 	})
 }
 
+func (c *ClusterInfo) update(data model.ClusterMeta, cpu, mem string) {
+	c.meta = data
+	for row, b := range c.blocks {
+		c.GetCell(row, 1).SetText(headerBlockValue(b, data, cpu, mem))
+	}
+}
+
 func (c *ClusterInfo) updateStyle() {
 	for row := 0; row < c.GetRowCount(); row++ {
 		c.GetCell(row, 0).SetTextColor(c.styles.K9s.Info.FgColor.Color())
@@ -126,3 +123,38 @@ func (c *ClusterInfo) updateStyle() {
 		c.GetCell(row, 1).SetStyle(s.Bold(true).Foreground(c.styles.K9s.Info.SectionColor.Color()))
 	}
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+var headerLabels = map[string]string{
+	config.HeaderContext: "Context",
+	config.HeaderCluster: "Cluster",
+	config.HeaderUser:    "User",
+	config.HeaderK9sRev:  "K9s Rev",
+	config.HeaderK8sRev:  "K8s Rev",
+	config.HeaderCPU:     "CPU",
+	config.HeaderMEM:     "MEM",
+}
+
+// headerBlockValue returns the value of a cluster info block.
+func headerBlockValue(block string, data model.ClusterMeta, cpu, mem string) string {
+	switch block {
+	case config.HeaderContext:
+		return data.Context
+	case config.HeaderCluster:
+		return data.Cluster
+	case config.HeaderUser:
+		return data.User
+	case config.HeaderK9sRev:
+		return data.K9sVer
+	case config.HeaderK8sRev:
+		return data.K8sVer
+	case config.HeaderCPU:
+		return cpu
+	case config.HeaderMEM:
+		return mem
+	default:
+		return render.NAValue
+	}
+}
@@ -13,6 +13,11 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	suggestionsKey = "suggestions"
+	maxSuggestions = 8
+)
+
 var (
 	customViewers MetaViewers
 
@@ -111,11 +116,20 @@ func (c *Command) run(cmd, path string, clearStack bool) error {
 	if c.specialCmd(cmd) {
 		return nil
 	}
+	if m, ok := c.app.macro(cmd); ok {
+		return c.app.runMacro(cmd, m)
+	}
+	if spec, ok, err := ParseFleet(cmd); ok {
+		if err != nil {
+			return err
+		}
+		return c.fleetCmd(spec)
+	}
 
 	cmds := strings.Split(cmd, " ")
 	gvr, v, err := c.viewMetaFor(cmds[0])
 	if err != nil {
-		return err
+		return c.suggestCmd(cmds, path, clearStack)
 	}
 	switch cmds[0] {
 	case "ctx", "context", "contexts":
@@ -140,12 +154,39 @@ func (c *Command) run(cmd, path string, clearStack bool) error {
 	}
 }
 
+// suggestCmd resolves an unknown command by fuzzy matching known aliases.
+// A single matching resource runs right away, otherwise suggestions are shown.
+func (c *Command) suggestCmd(cmds []string, path string, clearStack bool) error {
+	ss := c.alias.Suggest(cmds[0], maxSuggestions)
+	switch len(ss) {
+	case 0:
+		return fmt.Errorf("Huh? `%s` Command not found", strings.Join(cmds, " "))
+	case 1:
+		log.Debug().Msgf("Resolved command %q to %q", cmds[0], ss[0])
+		return c.run(strings.Join(append([]string{ss[0]}, cmds[1:]...), " "), path, clearStack)
+	}
+
+	ShowListMenu(c.app, suggestionsKey, "Did you mean?", ss, func(i int) {
+		cmd := strings.Join(append([]string{ss[i]}, cmds[1:]...), " ")
+		c.app.pushHistory(cmd)
+		if err := c.run(cmd, path, clearStack); err != nil {
+			c.app.Flash().Err(err)
+		}
+	})
+
+	return nil
+}
+
 func (c *Command) defaultCmd() error {
-	err := c.run(c.app.Config.ActiveView(), "", true)
-	if err != nil {
+	l := ParseDeepLink(c.app.Config.ActiveView())
+	if err := c.run(l.Command, "", true); err != nil {
 		log.Error().Err(err).Msgf("Saved command failed. Loading default view")
 		return c.run("pod", "", true)
 	}
+	if l.IsNavigation() {
+		c.app.followLink(l)
+	}
+
 	return nil
 }
 
@@ -161,11 +202,66 @@ func (c *Command) specialCmd(cmd string) bool {
 	case "a", "alias":
 		c.app.aliasCmd(nil)
 		return true
+	case "skin", "skins":
+		c.app.skinCmd(nil)
+		return true
+	case "config":
+		c.app.configCmd(nil)
+		return true
 	case "x", "xray":
 		if err := c.xrayCmd(cmd); err != nil {
 			c.app.Flash().Err(err)
 		}
 		return true
+	case "split":
+		if err := c.app.splitCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "diff":
+		if err := c.app.diffCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "goto":
+		if err := c.app.gotoPathCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "history":
+		c.app.navHistoryCmd()
+		return true
+	case "find":
+		ShowFinder(c.app, c.app.factory.CachedRefs(), strings.Join(cmds[1:], " "))
+		return true
+	case "marks":
+		c.app.marksCmd()
+		return true
+	case "tab", "tabs":
+		if err := c.app.tabCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "new":
+		if err := c.app.newCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "kubebench":
+		if err := c.app.kubeBenchCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "impersonate":
+		if err := c.app.impersonateCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "rec", "record":
+		if err := c.app.recordCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
 	default:
 		if !canRX.MatchString(cmd) {
 			return false
@@ -188,12 +284,16 @@ func (c *Command) viewMetaFor(cmd string) (string, *MetaViewer, error) {
 		return "", nil, fmt.Errorf("Huh? `%s` Command not found", cmd)
 	}
 
+	return gvr.String(), c.metaFor(gvr), nil
+}
+
+func (c *Command) metaFor(gvr client.GVR) *MetaViewer {
 	v, ok := customViewers[gvr]
 	if !ok {
-		return gvr.String(), &MetaViewer{viewerFn: NewBrowser}, nil
+		return &MetaViewer{viewerFn: NewBrowser}
 	}
 
-	return gvr.String(), &v, nil
+	return &v
 }
 
 func (c *Command) componentFor(gvr, path string, v *MetaViewer) ResourceViewer {
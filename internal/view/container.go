@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
@@ -39,8 +40,8 @@ func (c *Container) Name() string { return containerTitle }
 
 func (c *Container) bindDangerousKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyS: ui.NewKeyAction("Shell", c.shellCmd, true),
-		ui.KeyA: ui.NewKeyAction("Attach", c.attachCmd, true),
+		ui.KeyS: ui.NewKeyAction("Shell", guardCmd(c, config.VerbShell, c.GetTable().GetSelectedItems, c.shellCmd), true),
+		ui.KeyA: ui.NewKeyAction("Attach", guardCmd(c, config.VerbAttach, c.GetTable().GetSelectedItems, c.attachCmd), true),
 	})
 }
 
@@ -52,7 +53,7 @@ func (c *Container) bindKeys(aa ui.KeyActions) {
 	}
 
 	aa.Add(ui.KeyActions{
-		ui.KeyShiftF:   ui.NewKeyAction("PortForward", c.portFwdCmd, true),
+		ui.KeyShiftF:   ui.NewKeyAction("PortForward", guardCmd(c, config.VerbPortForward, c.GetTable().GetSelectedItems, c.portFwdCmd), true),
 		ui.KeyShiftC:   ui.NewKeyAction("Sort CPU", c.GetTable().SortColCmd(6, false), false),
 		ui.KeyShiftM:   ui.NewKeyAction("Sort MEM", c.GetTable().SortColCmd(7, false), false),
 		ui.KeyShiftX:   ui.NewKeyAction("Sort %CPU (REQ)", c.GetTable().SortColCmd(8, false), false),
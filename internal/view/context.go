@@ -1,19 +1,31 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"sync"
+	"time"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	contextProbeRate = 30 * time.Second
+	maxContextProbes = 5
+)
+
 // Context presents a context viewer.
 type Context struct {
 	ResourceViewer
+
+	cancelFn context.CancelFunc
 }
 
 // NewContext returns a new viewer.
@@ -23,13 +35,102 @@ func NewContext(gvr client.GVR) ResourceViewer {
 	}
 	c.GetTable().SetEnterFn(c.useCtx)
 	c.GetTable().SetColorerFn(render.Context{}.ColorerFunc())
+	c.GetTable().SetPinFn(c.isFavorite)
 	c.SetBindKeysFn(c.bindKeys)
+	c.SetContextFn(c.probesContext)
 
 	return &c
 }
 
+// Start starts the view updates and the contexts probes.
+func (c *Context) Start() {
+	c.ResourceViewer.Start()
+	c.stopProbes()
+
+	var ctx context.Context
+	ctx, c.cancelFn = context.WithCancel(context.Background())
+	go c.App().contextProber(ctx)
+}
+
+// Stop terminates the view updates and the contexts probes.
+func (c *Context) Stop() {
+	c.stopProbes()
+	c.ResourceViewer.Stop()
+}
+
+func (c *Context) stopProbes() {
+	if c.cancelFn == nil {
+		return
+	}
+	c.cancelFn()
+	c.cancelFn = nil
+}
+
 func (c *Context) bindKeys(aa ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyF: ui.NewKeyAction("Favorite", c.favoriteCmd, true),
+		ui.KeyP: ui.NewKeyAction("Probe", c.probeCmd, true),
+	})
+}
+
+func (c *Context) probesContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyProbes, c.App().probes)
+	favs := append([]string(nil), c.App().Config.K9s.FavoriteContexts...)
+
+	return context.WithValue(ctx, internal.KeyFavorites, favs)
+}
+
+func (c *Context) isFavorite(r render.RowEvent) bool {
+	return c.App().Config.K9s.IsFavoriteContext(r.Row.ID)
+}
+
+func (c *Context) favoriteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := c.GetTable().GetSelectedItem()
+	if name == "" {
+		return evt
+	}
+
+	app := c.App()
+	fav := app.Config.K9s.ToggleFavoriteContext(name)
+	if err := app.Config.Save(); err != nil {
+		app.Flash().Err(err)
+		return nil
+	}
+	if fav {
+		app.Flash().Infof("Context %s pinned", name)
+	} else {
+		app.Flash().Infof("Context %s unpinned", name)
+	}
+	c.ResourceViewer.Start()
+
+	return nil
+}
+
+// probeCmd probes the selected context, including contexts skipped by the
+// background probes.
+func (c *Context) probeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	name := c.GetTable().GetSelectedItem()
+	if name == "" {
+		return evt
+	}
+
+	app := c.App()
+	app.Flash().Infof("Probing context %s...", name)
+	go func() {
+		p := client.ProbeContext(app.Conn().Config(), name, client.DefaultProbeTimeout)
+		app.probes.Set(name, p)
+		app.QueueUpdateDraw(func() {
+			if p.Err != nil {
+				app.Flash().Errf("Context %s is unreachable -- %s", name, p.Err)
+			} else {
+				app.Flash().Infof("Context %s is reachable", name)
+			}
+			c.Refresh()
+		})
+	}()
+
+	return nil
 }
 
 func (c *Context) useCtx(app *App, model ui.Tabular, gvr, path string) {
@@ -46,6 +147,7 @@ func useContext(app *App, name string) error {
 	if app.Content.Top() != nil {
 		app.Content.Top().Stop()
 	}
+	app.saveSession()
 	res, err := dao.AccessorFor(app.factory, client.NewGVR("contexts"))
 	if err != nil {
 		return nil
@@ -64,3 +166,49 @@ func useContext(app *App, name string) error {
 
 	return nil
 }
+
+// contextProber probes contexts periodically until canceled. Only the current
+// context and the contexts that can be probed unattended are probed.
+func (a *App) contextProber(ctx context.Context) {
+	for {
+		a.probeContexts(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(model.Pace.Rate(contextProbeRate)):
+		}
+	}
+}
+
+func (a *App) probeContexts(ctx context.Context) {
+	cfg := a.Conn().Config()
+	names, err := cfg.ContextNames()
+	if err != nil {
+		log.Error().Err(err).Msg("Unable to list contexts")
+		return
+	}
+
+	current, _ := cfg.CurrentContextName()
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxContextProbes)
+	for _, n := range names {
+		if n != current && !cfg.CanProbe(n) {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case sem <- struct{}{}:
+		}
+		wg.Add(1)
+		go func(n string) {
+			defer func() { <-sem; wg.Done() }()
+			p := client.ProbeContext(cfg, n, client.DefaultProbeTimeout)
+			if p.Err != nil {
+				log.Debug().Msgf("Context %q probe failed -- %s", n, p.Err)
+			}
+			a.probes.Set(n, p)
+		}(n)
+	}
+	wg.Wait()
+}
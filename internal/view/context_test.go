@@ -13,5 +13,5 @@ func TestContext(t *testing.T) {
 
 	assert.Nil(t, ctx.Init(makeCtx()))
 	assert.Equal(t, "Contexts", ctx.Name())
-	assert.Equal(t, 4, len(ctx.Hints()))
+	assert.Equal(t, 6, len(ctx.Hints()))
 }
@@ -0,0 +1,227 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// ControlSocketEnv exposes the control socket location to child processes.
+const ControlSocketEnv = "K9S_SOCKET"
+
+// controlDirMod restricts the control sockets directory to the current user.
+const controlDirMod os.FileMode = 0700
+
+// ControlRequest represents a control API request.
+type ControlRequest struct {
+	ID     int               `json:"id"`
+	Method string            `json:"method"`
+	Params map[string]string `json:"params,omitempty"`
+}
+
+// ControlResponse represents a control API response.
+type ControlResponse struct {
+	ID     int         `json:"id"`
+	Result interface{} `json:"result,omitempty"`
+	Error  string      `json:"error,omitempty"`
+}
+
+// Selection represents the active view selection.
+type Selection struct {
+	Context   string   `json:"context"`
+	Cluster   string   `json:"cluster"`
+	Namespace string   `json:"namespace"`
+	View      string   `json:"view"`
+	GVR       string   `json:"gvr,omitempty"`
+	Path      string   `json:"path,omitempty"`
+	Items     []string `json:"items,omitempty"`
+}
+
+type controlFunc func(a *App, params map[string]string) (interface{}, error)
+
+type controlMethod struct {
+	params []string
+	fn     controlFunc
+}
+
+var controlMethods = map[string]controlMethod{
+	"view":        {params: []string{"command"}, fn: ctlView},
+	"context":     {params: []string{"name"}, fn: ctlContext},
+	"filter":      {params: []string{"filter"}, fn: ctlFilter},
+	"portForward": {params: []string{"path", "container", "localPort", "containerPort"}, fn: ctlPortForward},
+	"selection":   {fn: ctlSelection},
+}
+
+func controlSocketPath() string {
+	return filepath.Join(config.K9sHome, "sockets", fmt.Sprintf("k9s-%d.sock", os.Getpid()))
+}
+
+// ensureControlDir creates a directory only accessible by the current user.
+// The socket is created in it so it is never exposed while being set up.
+func ensureControlDir(dir string) error {
+	if err := os.MkdirAll(dir, controlDirMod); err != nil {
+		return err
+	}
+
+	// The directory might have been created with looser permissions.
+	return os.Chmod(dir, controlDirMod)
+}
+
+// startControl serves the control API if enabled.
+func (a *App) startControl() error {
+	if !a.Config.K9s.ControlSocket {
+		return nil
+	}
+
+	path := controlSocketPath()
+	if err := ensureControlDir(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("unable to secure control socket directory -- %s", err)
+	}
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		log.Warn().Err(err).Msgf("Unable to restrict control socket access")
+	}
+	if err := os.Setenv(ControlSocketEnv, path); err != nil {
+		log.Warn().Err(err).Msgf("Unable to export control socket location")
+	}
+	a.control = l
+	log.Info().Msgf("Control API listening on %s", path)
+	go a.serveControl(l)
+
+	return nil
+}
+
+// stopControl shuts down the control API if any.
+func (a *App) stopControl() {
+	if a.control == nil {
+		return
+	}
+	if err := a.control.Close(); err != nil {
+		log.Error().Err(err).Msg("Control socket close failed")
+	}
+	a.control = nil
+}
+
+func (a *App) serveControl(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			log.Debug().Msgf("Control API bailing out -- %s", err)
+			return
+		}
+		go a.handleControl(conn)
+	}
+}
+
+func (a *App) handleControl(conn net.Conn) {
+	defer func() {
+		if err := conn.Close(); err != nil {
+			log.Error().Err(err).Msg("Control connection close failed")
+		}
+	}()
+
+	dec, enc := json.NewDecoder(conn), json.NewEncoder(conn)
+	for {
+		var req ControlRequest
+		if err := dec.Decode(&req); err != nil {
+			if err != io.EOF {
+				log.Error().Err(err).Msg("Invalid control request")
+			}
+			return
+		}
+		if err := enc.Encode(a.dispatchControl(req)); err != nil {
+			log.Error().Err(err).Msg("Control response failed")
+			return
+		}
+	}
+}
+
+func (a *App) dispatchControl(req ControlRequest) ControlResponse {
+	resp := ControlResponse{ID: req.ID}
+	m, ok := controlMethods[req.Method]
+	if !ok {
+		resp.Error = fmt.Sprintf("unknown method %q", req.Method)
+		return resp
+	}
+	for _, p := range m.params {
+		if strings.TrimSpace(req.Params[p]) == "" {
+			resp.Error = fmt.Sprintf("method %q requires param %q", req.Method, p)
+			return resp
+		}
+	}
+
+	err := a.queueStep(func() error {
+		var err error
+		resp.Result, err = m.fn(a, req.Params)
+		return err
+	})
+	if err != nil {
+		resp.Error = err.Error()
+	}
+
+	return resp
+}
+
+func ctlView(a *App, params map[string]string) (interface{}, error) {
+	return nil, a.gotoResource(params["command"], "", true)
+}
+
+func ctlContext(a *App, params map[string]string) (interface{}, error) {
+	return nil, useContext(a, params["name"])
+}
+
+func ctlFilter(a *App, params map[string]string) (interface{}, error) {
+	if _, ok := a.Content.Top().(TableViewer); !ok {
+		return nil, fmt.Errorf("view %q can't be filtered", a.Content.Top().Name())
+	}
+	a.filterTop(params["filter"])
+
+	return nil, nil
+}
+
+func ctlPortForward(a *App, params map[string]string) (interface{}, error) {
+	address := params["address"]
+	if address == "" {
+		address = "localhost"
+	}
+	t := client.PortTunnel{
+		Address:       address,
+		LocalPort:     params["localPort"],
+		ContainerPort: params["containerPort"],
+	}
+
+	return nil, startForward(a, params["path"], params["container"], t)
+}
+
+func ctlSelection(a *App, _ map[string]string) (interface{}, error) {
+	sel := Selection{
+		Context:   a.Config.K9s.CurrentContext,
+		Cluster:   a.Config.K9s.CurrentCluster,
+		Namespace: a.Config.ActiveNamespace(),
+	}
+	top := a.Content.Top()
+	if top == nil {
+		return sel, nil
+	}
+	sel.View = top.Name()
+	if v, ok := top.(ResourceViewer); ok {
+		sel.GVR = v.GVR()
+		sel.Path = v.GetTable().GetSelectedItem()
+		if sel.Path != "" {
+			sel.Items = v.GetTable().GetSelectedItems()
+		}
+	}
+
+	return sel, nil
+}
@@ -0,0 +1,57 @@
+package view
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDispatchControlErrors(t *testing.T) {
+	uu := map[string]struct {
+		req ControlRequest
+		e   string
+	}{
+		"unknown": {
+			req: ControlRequest{ID: 1, Method: "bozo"},
+			e:   `unknown method "bozo"`,
+		},
+		"missingParam": {
+			req: ControlRequest{ID: 2, Method: "view"},
+			e:   `method "view" requires param "command"`,
+		},
+		"blankParam": {
+			req: ControlRequest{ID: 3, Method: "portForward", Params: map[string]string{
+				"path":          "default/fred",
+				"container":     "c1",
+				"localPort":     " ",
+				"containerPort": "80",
+			}},
+			e: `method "portForward" requires param "localPort"`,
+		},
+	}
+
+	var a App
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			resp := a.dispatchControl(u.req)
+			assert.Equal(t, u.req.ID, resp.ID)
+			assert.Equal(t, u.e, resp.Error)
+			assert.Nil(t, resp.Result)
+		})
+	}
+}
+
+func TestEnsureControlDir(t *testing.T) {
+	dir := filepath.Join(os.TempDir(), "k9s-test-sockets")
+	defer os.RemoveAll(dir)
+	assert.Nil(t, os.MkdirAll(dir, 0755))
+	assert.Nil(t, os.Chmod(dir, 0755))
+
+	assert.Nil(t, ensureControlDir(dir))
+	fi, err := os.Stat(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, controlDirMod, fi.Mode().Perm())
+}
@@ -0,0 +1,151 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	crFormKey   = "crForm"
+	crFormName  = "metadata.name"
+	crFormNS    = "metadata.namespace"
+	crFormWidth = 40
+)
+
+// showCRForm pops a form to create a custom resource from its schema. It
+// returns false when the resource schema can't be expressed as a form.
+func (a *App) showCRForm(gvr client.GVR, meta metav1.APIResource) bool {
+	schema, err := dao.CRDSchema(a.factory, meta)
+	if err != nil {
+		log.Warn().Err(err).Msgf("No schema found for %s", gvr)
+		return false
+	}
+	ff, unsupported := dao.FormFields(schema)
+	if unsupported || len(ff) == 0 || len(ff) > dao.MaxFormFields {
+		return false
+	}
+
+	styles := a.Styles
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	vars := a.templateVars(meta)
+	values := map[string]string{crFormName: vars["NAME"]}
+	f.AddInputField("*name:", values[crFormName], crFormWidth, nil, func(v string) {
+		values[crFormName] = v
+	})
+	if meta.Namespaced {
+		values[crFormNS] = vars["NAMESPACE"]
+		f.AddInputField("*namespace:", values[crFormNS], crFormWidth, nil, func(v string) {
+			values[crFormNS] = v
+		})
+	}
+	for _, fd := range ff {
+		key := fd.Key()
+		values[key] = fd.Default
+		if opts := formOptions(fd); len(opts) > 0 {
+			f.AddDropDown(formLabel(fd), opts, enumIndex(opts, fd.Default), func(v string, _ int) {
+				values[key] = v
+			})
+			continue
+		}
+		f.AddInputField(formLabel(fd), fd.Default, crFormWidth, nil, func(v string) {
+			values[key] = v
+		})
+	}
+
+	pages := a.Content.Pages
+	f.AddButton("Create", func() {
+		o, err := dao.NewCustomResource(meta, values[crFormName], values[crFormNS], ff, values)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		dismissCRForm(a, pages)
+		a.createObject(gvr, meta, o)
+	})
+	f.AddButton("Edit", func() {
+		o, err := dao.NewCustomResource(meta, values[crFormName], values[crFormNS], optionalFields(ff), values)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		raw, err := yaml.Marshal(o.Object)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		dismissCRForm(a, pages)
+		a.createFromManifest(gvr, meta, string(raw))
+	})
+	f.AddButton("Cancel", func() {
+		dismissCRForm(a, pages)
+	})
+
+	modal := tview.NewModalForm(fmt.Sprintf("<New %s>", meta.Kind), f)
+	modal.SetText("Required fields are marked with *. Use Edit to fill in the manifest.")
+	modal.SetDoneFunc(func(_ int, b string) {
+		dismissCRForm(a, pages)
+	})
+
+	pages.AddPage(crFormKey, modal, false, true)
+	pages.ShowPage(crFormKey)
+	a.SetFocus(pages.GetPrimitive(crFormKey))
+
+	return true
+}
+
+func dismissCRForm(a *App, p *ui.Pages) {
+	p.RemovePage(crFormKey)
+	a.SetFocus(p.CurrentPage().Item)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// formLabel returns a form field label, flagging required fields.
+func formLabel(f dao.FormField) string {
+	label := f.Key() + ":"
+	if f.Required {
+		return "*" + label
+	}
+
+	return label
+}
+
+// formOptions returns the choices of an enum or boolean field.
+func formOptions(f dao.FormField) []string {
+	opts := f.Enum
+	if len(opts) == 0 && f.Type == dao.FormBoolean {
+		opts = []string{"true", "false"}
+	}
+	if len(opts) == 0 || f.Required {
+		return opts
+	}
+
+	return append([]string{""}, opts...)
+}
+
+// optionalFields returns a copy of the form fields with no required fields so
+// partially filled forms can be edited.
+func optionalFields(ff []dao.FormField) []dao.FormField {
+	oo := make([]dao.FormField, 0, len(ff))
+	for _, f := range ff {
+		f.Required = false
+		oo = append(oo, f)
+	}
+
+	return oo
+}
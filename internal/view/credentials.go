@@ -0,0 +1,87 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog/log"
+)
+
+// kubeConfigUpdater watches the kubeconfig files and refreshes the cluster
+// credentials as they change on disk.
+func (a *App) kubeConfigUpdater(ctx context.Context) error {
+	files := a.Conn().Config().KubeConfigFiles()
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	// Files are often replaced rather than written so their dirs are watched.
+	var dirs []string
+	for _, f := range files {
+		d := filepath.Dir(f)
+		if config.InList(dirs, d) {
+			continue
+		}
+		if _, err := os.Stat(d); err != nil {
+			continue
+		}
+		if err := w.Add(d); err != nil {
+			log.Warn().Err(err).Msgf("Unable to watch kubeconfig dir %s", d)
+			continue
+		}
+		dirs = append(dirs, d)
+	}
+
+	go func() {
+		var timer <-chan time.Time
+		for {
+			select {
+			case evt := <-w.Events:
+				if !config.InList(files, evt.Name) || timer != nil {
+					continue
+				}
+				timer = time.After(reloadDelay)
+			case <-timer:
+				timer = nil
+				if err := a.refreshCredentials(); err != nil {
+					log.Warn().Err(err).Msg("Kubeconfig reload failed")
+					a.Flash().Warnf("Kubeconfig reload failed -- %s", err)
+					continue
+				}
+				a.Flash().Info("Kubeconfig reloaded")
+			case err := <-w.Errors:
+				log.Info().Err(err).Msg("Kubeconfig watcher failed")
+				return
+			case <-ctx.Done():
+				log.Debug().Msg("KubeConfigWatcher Done!")
+				if err := w.Close(); err != nil {
+					log.Error().Err(err).Msg("Closing kubeconfig watcher")
+				}
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+// refreshCredentials reloads the kubeconfig and restarts the informers so
+// rotated credentials are used from now on.
+func (a *App) refreshCredentials() error {
+	if err := a.Conn().RefreshCredentials(); err != nil {
+		return err
+	}
+	if !a.Conn().CheckConnectivity() {
+		return errors.New("unable to connect to api server with refreshed credentials")
+	}
+	a.initFactory(a.Config.ActiveNamespace())
+	a.clusterModel.Reset(a.factory)
+	log.Info().Msg("Cluster credentials refreshed")
+
+	return nil
+}
@@ -3,9 +3,11 @@ package view
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
@@ -17,6 +19,11 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const (
+	jobPodWait    = 1 * time.Second
+	jobPodTimeout = 2 * time.Minute
+)
+
 // CronJob represents a cronjob viewer.
 type CronJob struct {
 	ResourceViewer
@@ -63,7 +70,7 @@ func jobCtx(path, uid string) ContextFunc {
 
 func (c *CronJob) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlT: ui.NewKeyAction("Trigger", c.trigger, true),
+		tcell.KeyCtrlT: ui.NewKeyAction("Trigger", guardCmd(c, config.VerbTrigger, c.GetTable().GetSelectedItems, c.trigger), true),
 	})
 }
 
@@ -77,17 +84,59 @@ func (c *CronJob) trigger(evt *tcell.EventKey) *tcell.EventKey {
 	if err != nil {
 		return nil
 	}
-	runner, ok := res.(dao.Runnable)
+	runner, ok := res.(dao.JobRunner)
 	if !ok {
 		c.App().Flash().Err(fmt.Errorf("expecting a jobrunner resource for %q", c.GVR()))
 		return nil
 	}
 
-	if err := runner.Run(sel); err != nil {
+	job, err := runner.RunJob(sel)
+	c.App().audit(config.VerbTrigger, c.GVR(), sel, err)
+	if err != nil {
 		c.App().Flash().Errf("Cronjob trigger failed %v", err)
 		return evt
 	}
-	c.App().Flash().Infof("Triggering Job %s %s", c.GVR(), sel)
+	c.App().Flash().Infof("Triggering Job %s. Waiting for its pod...", job)
+	go c.followJob(runner, job)
 
 	return nil
 }
+
+// followJob shows the logs of a triggered job once its pod starts, provided
+// the cronjob view is still active.
+func (c *CronJob) followJob(runner dao.JobRunner, job string) {
+	app := c.App()
+	path, err := waitForJobPod(runner, job)
+	if err == nil {
+		ns, _ := client.Namespaced(path)
+		_, err = app.factory.CanForResource(ns, "v1/pods", client.MonitorAccess)
+	}
+	app.QueueUpdateDraw(func() {
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		if app.Content.Top() != c {
+			app.Flash().Infof("Job %s pod %s started", job, path)
+			return
+		}
+		if err := app.inject(NewLog(client.NewGVR("v1/pods"), path, "", false)); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+}
+
+func waitForJobPod(runner dao.JobRunner, job string) (string, error) {
+	for start := time.Now(); time.Since(start) < jobPodTimeout; {
+		path, ok, err := runner.JobPod(job)
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return path, nil
+		}
+		<-time.After(jobPodWait)
+	}
+
+	return "", fmt.Errorf("timed out waiting for job %s pod", job)
+}
@@ -0,0 +1,149 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	ctxSwitcherKey    = "ctxSwitcher"
+	ctxSwitcherWidth  = 60
+	ctxSwitcherHeight = 15
+)
+
+func (a *App) ctxSwitchCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Cmd().InCmdMode() {
+		return evt
+	}
+	names, err := a.Conn().Config().ContextNames()
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	ShowContextSwitcher(a, names)
+
+	return nil
+}
+
+// ShowContextSwitcher pops a fuzzy finder to quickly switch contexts.
+func ShowContextSwitcher(app *App, names []string) {
+	styles := app.Styles
+
+	input := tview.NewInputField()
+	input.SetLabel("> ")
+	input.SetLabelColor(styles.K9s.Info.FgColor.Color())
+	input.SetFieldBackgroundColor(styles.BgColor())
+	input.SetFieldTextColor(styles.FgColor())
+
+	l := tview.NewList()
+	l.ShowSecondaryText(false)
+	l.SetBackgroundColor(styles.BgColor())
+	l.SetMainTextColor(styles.FgColor())
+
+	var ranked []string
+	fill := func(q string) {
+		l.Clear()
+		ranked = rankContexts(names, app.Config.K9s.FavoriteContexts, q)
+		for _, n := range ranked {
+			l.AddItem(contextLabel(app, n), "", 0, nil)
+		}
+	}
+	input.SetChangedFunc(fill)
+	input.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		switch evt.Key() {
+		case tcell.KeyUp:
+			if i := l.GetCurrentItem(); i > 0 {
+				l.SetCurrentItem(i - 1)
+			}
+			return nil
+		case tcell.KeyDown:
+			if i := l.GetCurrentItem(); i < l.GetItemCount()-1 {
+				l.SetCurrentItem(i + 1)
+			}
+			return nil
+		}
+		return evt
+	})
+	input.SetDoneFunc(func(key tcell.Key) {
+		DismissContextSwitcher(app)
+		if key != tcell.KeyEnter || len(ranked) == 0 {
+			return
+		}
+		name := ranked[l.GetCurrentItem()]
+		if name == app.Config.K9s.CurrentContext {
+			return
+		}
+		if err := useContext(app, name); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+	fill("")
+
+	f := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(input, 1, 0, true).
+		AddItem(l, 0, 1, false)
+	f.SetBorder(true)
+	f.SetBorderPadding(0, 0, 1, 1)
+	f.SetTitle(" Switch Context ")
+	f.SetBackgroundColor(styles.BgColor())
+
+	pages := app.Content.Pages
+	pages.AddPage(ctxSwitcherKey, centered(f, ctxSwitcherWidth, ctxSwitcherHeight), true, true)
+	pages.ShowPage(ctxSwitcherKey)
+	app.SetFocus(input)
+}
+
+// DismissContextSwitcher dismisses the context switcher.
+func DismissContextSwitcher(app *App) {
+	p := app.Content.Pages
+	p.RemovePage(ctxSwitcherKey)
+	app.SetFocus(p.CurrentPage().Item)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// rankContexts orders contexts matching a query, favorites first.
+func rankContexts(names, favs []string, q string) []string {
+	var cc []string
+	if q == "" {
+		cc = append(cc, names...)
+		sort.Strings(cc)
+	} else {
+		for _, m := range fuzzy.Find(q, names) {
+			cc = append(cc, m.Str)
+		}
+	}
+	sort.SliceStable(cc, func(i, j int) bool {
+		return config.InList(favs, cc[i]) && !config.InList(favs, cc[j])
+	})
+
+	return cc
+}
+
+func contextLabel(app *App, n string) string {
+	label := n
+	if app.Config.K9s.IsFavoriteContext(n) {
+		label = "+ " + label
+	} else {
+		label = "  " + label
+	}
+	if n == app.Config.K9s.CurrentContext {
+		label += "(*)"
+	}
+	p, ok := app.probes.Get(n)
+	switch {
+	case !ok:
+		return label
+	case p.Reachable:
+		return fmt.Sprintf("%s [gray::](%s %s)", label, p.Latency.Round(time.Millisecond), p.Version)
+	default:
+		return label + " [red::](unreachable)"
+	}
+}
@@ -0,0 +1,43 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankContexts(t *testing.T) {
+	names := []string{"prod-us", "staging", "prod-eu", "dev"}
+	uu := map[string]struct {
+		favs []string
+		q    string
+		e    []string
+	}{
+		"all": {
+			e: []string{"dev", "prod-eu", "prod-us", "staging"},
+		},
+		"favorites": {
+			favs: []string{"staging", "prod-us"},
+			e:    []string{"prod-us", "staging", "dev", "prod-eu"},
+		},
+		"query": {
+			q: "prd",
+			e: []string{"prod-eu", "prod-us"},
+		},
+		"queryFavorites": {
+			favs: []string{"prod-us"},
+			q:    "prd",
+			e:    []string{"prod-us", "prod-eu"},
+		},
+		"none": {
+			q: "zorg",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rankContexts(names, u.favs, u.q))
+		})
+	}
+}
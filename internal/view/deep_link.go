@@ -0,0 +1,180 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	deepLinkDelay   = 500 * time.Millisecond
+	deepLinkRetries = 20
+)
+
+// deepLinkActions maps deep link flags to view actions.
+var deepLinkActions = map[string]tcell.Key{
+	"--follow-logs": ui.KeyL,
+	"--describe":    ui.KeyD,
+	"--yaml":        ui.KeyY,
+	"--shell":       ui.KeyS,
+}
+
+// DeepLink represents a startup navigation target.
+type DeepLink struct {
+	Command string
+	Filter  string
+	Select  string
+	Action  string
+}
+
+// ParseDeepLink parses a command such as `pods -n payments /api- --follow-logs`.
+// Besides a resource and an optional namespace, a link may specify a filter,
+// a resource to select and an action to perform on the selection. Commands
+// that are not deep links are passed through as is.
+func ParseDeepLink(cmd string) DeepLink {
+	l, err := parseDeepLink(cmd)
+	if err != nil {
+		log.Debug().Msgf("Not a deep link %q -- %s", cmd, err)
+		return DeepLink{Command: strings.TrimSpace(cmd)}
+	}
+
+	return l
+}
+
+func parseDeepLink(cmd string) (DeepLink, error) {
+	var (
+		l  DeepLink
+		ns string
+	)
+	tokens := strings.Fields(cmd)
+	if len(tokens) == 0 {
+		return l, fmt.Errorf("no resource specified in %q", cmd)
+	}
+
+	next := func(i int) (string, error) {
+		if i+1 >= len(tokens) || strings.HasPrefix(tokens[i+1], "-") {
+			return "", fmt.Errorf("missing value for %s", tokens[i])
+		}
+		return tokens[i+1], nil
+	}
+	for i := 1; i < len(tokens); i++ {
+		t := tokens[i]
+		var err error
+		switch {
+		case t == "-n" || t == "--namespace":
+			ns, err = next(i)
+			i++
+		case strings.HasPrefix(t, "--namespace="):
+			ns = strings.TrimPrefix(t, "--namespace=")
+		case t == "-A" || t == "--all-namespaces":
+			ns = client.NamespaceAll
+		case t == "-l" || t == "--selector":
+			var sel string
+			sel, err = next(i)
+			l.Filter = "-l " + sel
+			i++
+		case t == "--select":
+			l.Select, err = next(i)
+			i++
+		case strings.HasPrefix(t, "/"):
+			l.Filter = t[1:]
+		case strings.HasPrefix(t, "-"):
+			if _, ok := deepLinkActions[t]; !ok {
+				return l, fmt.Errorf("unknown deep link option %q", t)
+			}
+			l.Action = t
+		case ns == "":
+			ns = t
+		default:
+			return l, fmt.Errorf("unexpected deep link argument %q", t)
+		}
+		if err != nil {
+			return l, err
+		}
+	}
+	l.Command = strings.TrimSpace(tokens[0] + " " + ns)
+
+	return l, nil
+}
+
+// IsNavigation checks if the link goes beyond a resource view.
+func (l DeepLink) IsNavigation() bool {
+	return l.Filter != "" || l.Select != "" || l.Action != ""
+}
+
+// followLink drives the active view to the deep link target.
+func (a *App) followLink(l DeepLink) {
+	if l.Filter != "" {
+		a.filterTop(l.Filter)
+	}
+	if l.Select == "" && l.Action == "" {
+		return
+	}
+
+	go func() {
+		for i := 0; i < deepLinkRetries; i++ {
+			<-time.After(deepLinkDelay)
+			done := make(chan bool, 1)
+			a.QueueUpdateDraw(func() {
+				done <- a.applyLink(l)
+			})
+			if <-done {
+				return
+			}
+		}
+		log.Warn().Msgf("Deep link target %q not found", l.Select)
+		a.QueueUpdateDraw(func() {
+			a.Flash().Warnf("Deep link target %q not found", l.Select)
+		})
+	}()
+}
+
+// applyLink selects the deep link target once loaded and runs the link action.
+func (a *App) applyLink(l DeepLink) bool {
+	v, ok := a.Content.Top().(TableViewer)
+	if !ok {
+		return false
+	}
+	t := v.GetTable()
+	row := linkRow(t, l.Select)
+	if row < 0 {
+		return false
+	}
+	t.SelectRow(row, true)
+	if l.Action == "" {
+		return true
+	}
+
+	key := deepLinkActions[l.Action]
+	action, ok := v.Actions()[key]
+	if !ok {
+		a.Flash().Warnf("Action %s is not available on %s", l.Action, v.Name())
+		return true
+	}
+	action.Action(tcell.NewEventKey(tcell.KeyRune, rune(key), tcell.ModNone))
+
+	return true
+}
+
+// linkRow returns the row of the named resource, or the first row if no
+// name is given. It returns -1 if no such row is loaded yet. Rows are matched
+// against the table data as rows of large tables are drawn on demand.
+func linkRow(t *Table, name string) int {
+	col := t.NameColIndex()
+	for r := 1; r < t.GetRowCount(); r++ {
+		row, ok := t.GetRow(r)
+		if !ok {
+			continue
+		}
+		if name == "" || (col < len(row.Fields) && row.Fields[col] == name) {
+			return r
+		}
+	}
+
+	return -1
+}
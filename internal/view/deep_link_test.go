@@ -0,0 +1,101 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDeepLink(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		e   DeepLink
+		err string
+	}{
+		"plain": {
+			cmd: "po",
+			e:   DeepLink{Command: "po"},
+		},
+		"legacyNS": {
+			cmd: "dp kube-system",
+			e:   DeepLink{Command: "dp kube-system"},
+		},
+		"full": {
+			cmd: "pods -n payments /api- --follow-logs",
+			e:   DeepLink{Command: "pods payments", Filter: "api-", Action: "--follow-logs"},
+		},
+		"nsEqual": {
+			cmd: "dp --namespace=fred --select blee --describe",
+			e:   DeepLink{Command: "dp fred", Select: "blee", Action: "--describe"},
+		},
+		"allNS": {
+			cmd: "po -A -l app=fred",
+			e:   DeepLink{Command: "po all", Filter: "-l app=fred"},
+		},
+		"empty": {
+			err: `no resource specified in ""`,
+		},
+		"missingNS": {
+			cmd: "po -n",
+			err: "missing value for -n",
+		},
+		"missingSelect": {
+			cmd: "po --select --yaml",
+			err: "missing value for --select",
+		},
+		"unknown": {
+			cmd: "po --blee",
+			err: `unknown deep link option "--blee"`,
+		},
+		"extra": {
+			cmd: "po fred blee",
+			err: `unexpected deep link argument "blee"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			l, err := parseDeepLink(u.cmd)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, l)
+		})
+	}
+}
+
+func TestParseDeepLinkPassThrough(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		e   DeepLink
+	}{
+		"link": {
+			cmd: "po -n fred --yaml",
+			e:   DeepLink{Command: "po fred", Action: "--yaml"},
+		},
+		"command": {
+			cmd: " xray deploy default ",
+			e:   DeepLink{Command: "xray deploy default"},
+		},
+		"unknown": {
+			cmd: "po --blee",
+			e:   DeepLink{Command: "po --blee"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ParseDeepLink(u.cmd))
+		})
+	}
+}
+
+func TestDeepLinkIsNavigation(t *testing.T) {
+	assert.False(t, DeepLink{Command: "po"}.IsNavigation())
+	assert.True(t, DeepLink{Command: "po", Filter: "fred"}.IsNavigation())
+	assert.True(t, DeepLink{Command: "po", Action: "--yaml"}.IsNavigation())
+}
@@ -0,0 +1,196 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	describeTitle   = "Describe"
+	sectionsMenuKey = "sections"
+)
+
+// Describe represents a resource description viewer with foldable sections.
+type Describe struct {
+	*Details
+
+	sections []model.DescribeSection
+	starts   []int
+	folded   map[string]bool
+}
+
+// NewDescribe returns a new description viewer.
+func NewDescribe(app *App, subject string) *Describe {
+	return &Describe{
+		Details: NewDetails(app, describeTitle, subject, true),
+		folded:  make(map[string]bool),
+	}
+}
+
+// Init initializes the viewer.
+func (d *Describe) Init(ctx context.Context) error {
+	if err := d.Details.Init(ctx); err != nil {
+		return err
+	}
+	d.SetWrap(false)
+	d.contentFn = func() string {
+		return strings.Join(d.model.Peek(), "\n")
+	}
+	d.app.Styles.RemoveListener(d.Details)
+	d.app.Styles.AddListener(d)
+	d.model.RemoveListener(d.Details)
+	d.model.AddListener(d)
+	d.bindKeys()
+	d.TextChanged(d.model.Peek())
+
+	return nil
+}
+
+// Update updates the view content.
+func (d *Describe) Update(buff string) *Describe {
+	d.Details.Update(buff)
+
+	return d
+}
+
+// Stop terminates the updater.
+func (d *Describe) Stop() {
+	d.app.Styles.RemoveListener(d)
+}
+
+// StylesChanged notifies the skin changed.
+func (d *Describe) StylesChanged(s *config.Styles) {
+	d.Details.StylesChanged(s)
+	d.render()
+}
+
+// TextChanged notifies the model changed.
+func (d *Describe) TextChanged(lines []string) {
+	d.sections = model.DescribeSections(lines)
+	d.render()
+	d.ScrollToBeginning()
+}
+
+// TextFiltered notifies when the filter changed. Sections are unfolded
+// while showing matches.
+func (d *Describe) TextFiltered(lines []string, matches fuzzy.Matches) {
+	if len(matches) == 0 {
+		d.currentRegion, d.maxRegions = 0, 0
+		d.render()
+		d.Highlight()
+		return
+	}
+	d.Details.TextFiltered(lines, matches)
+}
+
+func (d *Describe) bindKeys() {
+	d.actions.Add(ui.KeyActions{
+		ui.KeySpace:      ui.NewKeyAction("Toggle Section", d.toggleCmd, true),
+		ui.KeyShiftC:     ui.NewKeyAction("Collapse All", d.foldAllCmd(true), true),
+		ui.KeyShiftE:     ui.NewKeyAction("Expand All", d.foldAllCmd(false), true),
+		ui.KeyT:          ui.NewKeyAction("Sections", d.sectionsCmd, true),
+		tcell.KeyTab:     ui.NewKeyAction("Next Section", d.jumpCmd(1), true),
+		tcell.KeyBacktab: ui.NewKeyAction("Prev Section", d.jumpCmd(-1), true),
+	})
+}
+
+func (d *Describe) render() {
+	lines, starts := model.FoldDescribe(d.model.Peek(), d.sections, d.folded)
+	d.starts = starts
+	d.SetText(colorizeYAML(d.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+}
+
+// currentSection returns the section shown at the top of the view.
+func (d *Describe) currentSection() int {
+	row, _ := d.GetScrollOffset()
+
+	return model.SectionAt(d.starts, row)
+}
+
+// showSection scrolls to a given section.
+func (d *Describe) showSection(i int) {
+	d.render()
+	d.ScrollTo(d.starts[i], 0)
+}
+
+func (d *Describe) toggleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !d.cmdBuff.Empty() {
+		return evt
+	}
+	i := d.currentSection()
+	if i < 0 {
+		if len(d.sections) == 0 {
+			return nil
+		}
+		i = 0
+	}
+	name := d.sections[i].Name
+	d.folded[name] = !d.folded[name]
+	d.showSection(i)
+
+	return nil
+}
+
+func (d *Describe) foldAllCmd(fold bool) func(*tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if !d.cmdBuff.Empty() {
+			return evt
+		}
+		for _, s := range d.sections {
+			d.folded[s.Name] = fold
+		}
+		d.render()
+		d.ScrollToBeginning()
+
+		return nil
+	}
+}
+
+func (d *Describe) jumpCmd(dir int) func(*tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if !d.cmdBuff.Empty() || len(d.starts) == 0 {
+			return evt
+		}
+		row, _ := d.GetScrollOffset()
+		i := model.SectionAt(d.starts, row)
+		switch {
+		case dir > 0:
+			i++
+		case i >= 0 && d.starts[i] == row:
+			i--
+		}
+		if i < 0 || i >= len(d.starts) {
+			return nil
+		}
+		d.ScrollTo(d.starts[i], 0)
+
+		return nil
+	}
+}
+
+func (d *Describe) sectionsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !d.cmdBuff.Empty() {
+		return evt
+	}
+	if len(d.sections) == 0 {
+		d.app.Flash().Warn("No sections found")
+		return nil
+	}
+	items := make([]string, 0, len(d.sections))
+	for _, s := range d.sections {
+		items = append(items, fmt.Sprintf("%s (%d)", s.Name, s.Size()))
+	}
+	ShowListMenu(d.app, sectionsMenuKey, "Sections", items, func(i int) {
+		d.folded[d.sections[i].Name] = false
+		d.showSection(i)
+	})
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal/config"
@@ -14,7 +15,10 @@ import (
 	"github.com/sahilm/fuzzy"
 )
 
-const detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+const (
+	detailsTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-] "
+	crumbFmt        = "[count:bg:b]%s[fg:bg:-] "
+)
 
 // Details represents a generic text viewer.
 type Details struct {
@@ -27,6 +31,8 @@ type Details struct {
 	model                     *model.Text
 	currentRegion, maxRegions int
 	searchable                bool
+	contentFn                 func() string
+	crumb                     string
 }
 
 // NewDetails returns a details viewer.
@@ -239,11 +245,22 @@ func (d *Details) prevCmd(evt *tcell.EventKey) *tcell.EventKey {
 func (d *Details) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	d.model.Filter(d.cmdBuff.String())
 	d.cmdBuff.SetActive(false)
+	d.app.recordStep(filterStep(d.cmdBuff.String()))
 	d.updateTitle()
 
 	return nil
 }
 
+func (d *Details) setFilter(q string) {
+	d.cmdBuff.Set(q)
+	if q == "" {
+		d.model.ClearFilter()
+	} else {
+		d.model.Filter(q)
+	}
+	d.updateTitle()
+}
+
 func (d *Details) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if d.app.InCmdMode() {
 		return evt
@@ -284,33 +301,59 @@ func (d *Details) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	d.app.Flash().Info("Clearing filter...")
 	d.cmdBuff.SetActive(false)
 	d.cmdBuff.Reset()
+	d.app.recordStep(filterStep(""))
 	d.updateTitle()
 
 	return nil
 }
 
 func (d *Details) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
-	if path, err := saveYAML(d.app.Config.K9s.CurrentCluster, d.title, d.GetText(true)); err != nil {
-		d.app.Flash().Err(err)
-	} else {
-		d.app.Flash().Infof("Log %s saved successfully!", path)
-	}
+	path := savePath(d.app.Config.K9s.CurrentCluster, d.title, d.subject, time.Now())
+	ShowSave(d.app, d.title, path, d.title == yamlTitle, func(path string, strip bool) {
+		data := d.content()
+		if strip {
+			var err error
+			if data, err = stripYAML(data); err != nil {
+				d.app.Flash().Err(err)
+				return
+			}
+		}
+		if path, err := saveYAML(path, data); err != nil {
+			d.app.Flash().Err(err)
+		} else {
+			d.app.Flash().Infof("%s saved to %s", d.title, path)
+		}
+	})
+
 	return nil
 }
 
 func (d *Details) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	d.app.Flash().Info("Content copied to clipboard...")
-	if err := clipboard.WriteAll(d.GetText(true)); err != nil {
+	if err := clipboard.WriteAll(d.content()); err != nil {
 		d.app.Flash().Err(err)
 	}
 	return nil
 }
 
+// content returns the text to save or copy, the displayed text unless
+// specified otherwise.
+func (d *Details) content() string {
+	if d.contentFn != nil {
+		return d.contentFn()
+	}
+
+	return d.GetText(true)
+}
+
 func (d *Details) updateTitle() {
 	if d.title == "" {
 		return
 	}
 	fmat := fmt.Sprintf(detailsTitleFmt, d.title, d.subject)
+	if d.crumb != "" {
+		fmat += fmt.Sprintf(crumbFmt, tview.Escape(d.crumb))
+	}
 
 	buff := d.cmdBuff.String()
 	if buff == "" {
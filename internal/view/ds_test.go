@@ -13,5 +13,5 @@ func TestDaemonSet(t *testing.T) {
 
 	assert.Nil(t, v.Init(makeCtx()))
 	assert.Equal(t, "DaemonSets", v.Name())
-	assert.Equal(t, 13, len(v.Hints()))
+	assert.Equal(t, 14, len(v.Hints()))
 }
@@ -1,27 +1,135 @@
 package view
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"os/exec"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 )
 
+const cmdSubTimeout = 5 * time.Second
+
 // K9sEnv represent K9s available env variables.
 type K9sEnv map[string]string
 
-// EnvRX match $XXX custom arg.
-var envRX = regexp.MustCompile(`\$(\!?[\w]+)(\d*)`)
+var (
+	// EnvRX match $XXX or ${XXX} custom arg.
+	envRX = regexp.MustCompile(`\$(\!?[\w]+)(\d*)|\$\{(\!?[\w]+)\}`)
+	// CmdSubRX match $(command) substitutions.
+	cmdSubRX = regexp.MustCompile(`\$\(([^()]+)\)`)
+)
+
+// cmdSubFn runs a command substitution with the given extra env vars and
+// returns its output.
+var cmdSubFn = func(cmd string, env []string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cmdSubTimeout)
+	defer cancel()
+
+	c := exec.CommandContext(ctx, "sh", "-c", cmd)
+	c.Env = append(os.Environ(), env...)
+	out, err := c.Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// expand substitutes env vars and command substitutions in a given arg.
+// Command substitutions are evaluated on the raw arg so values, which may
+// come from the cluster or the user, are never interpreted by the shell.
+func (e K9sEnv) expand(ns, arg string) (string, error) {
+	var (
+		b    strings.Builder
+		last int
+	)
+	for _, loc := range cmdSubRX.FindAllStringSubmatchIndex(arg, -1) {
+		s, err := e.replaceEnv(ns, arg, arg[last:loc[0]])
+		if err != nil {
+			return "", err
+		}
+		b.WriteString(s)
+
+		cmd := arg[loc[2]:loc[3]]
+		script, env, err := e.shellFor(ns, arg, cmd)
+		if err != nil {
+			return "", err
+		}
+		out, err := cmdSubFn(script, env)
+		if err != nil {
+			return "", fmt.Errorf("command substitution %q failed -- %s", cmd, err)
+		}
+		b.WriteString(out)
+		last = loc[1]
+	}
+	s, err := e.replaceEnv(ns, arg, arg[last:])
+	if err != nil {
+		return "", err
+	}
+	b.WriteString(s)
+
+	return b.String(), nil
+}
+
+// shellFor rewrites the variables of a command substitution to reference
+// env vars holding their values.
+func (e K9sEnv) shellFor(ns, args, cmd string) (string, []string, error) {
+	var (
+		env []string
+		err error
+	)
+	script := envRX.ReplaceAllStringFunc(cmd, func(m string) string {
+		if err != nil {
+			return m
+		}
+		var v string
+		if v, err = e.valueFor(ns, args, envRX.FindStringSubmatch(m)); err != nil {
+			return m
+		}
+		k := fmt.Sprintf("K9S_VAR%d", len(env))
+		env = append(env, k+"="+v)
+		return `"${` + k + `}"`
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return script, env, nil
+}
 
 func (e K9sEnv) envFor(ns, args string) (string, error) {
-	envs := envRX.FindStringSubmatch(args)
-	if len(envs) == 0 {
-		return args, nil
+	return e.replaceEnv(ns, args, args)
+}
+
+// replaceEnv substitutes env vars in s, reporting errors against args.
+func (e K9sEnv) replaceEnv(ns, args, s string) (string, error) {
+	var err error
+	res := envRX.ReplaceAllStringFunc(s, func(m string) string {
+		if err != nil {
+			return m
+		}
+		var v string
+		v, err = e.valueFor(ns, args, envRX.FindStringSubmatch(m))
+		return v
+	})
+	if err != nil {
+		return "", err
 	}
 
+	return res, nil
+}
+
+func (e K9sEnv) valueFor(ns, args string, envs []string) (string, error) {
 	q := envs[1]
+	if q == "" {
+		return e.subOut(args, envs[3])
+	}
 	if envs[2] == "" {
 		return e.subOut(args, q)
 	}
@@ -48,7 +156,9 @@ func (e K9sEnv) subOut(args, q string) (string, error) {
 	}
 	env, ok := e[strings.ToUpper(q)]
 	if !ok {
-		return "", fmt.Errorf("no env vars exists for argument %q using key %q", args, q)
+		if env, ok = os.LookupEnv(q); !ok {
+			return "", fmt.Errorf("no env vars exists for argument %q using key %q", args, q)
+		}
 	}
 
 	if b, err := strconv.ParseBool(env); err == nil {
@@ -59,5 +169,5 @@ func (e K9sEnv) subOut(args, q string) (string, error) {
 		}
 	}
 
-	return envRX.ReplaceAllString(args, env), nil
+	return env, nil
 }
@@ -2,6 +2,8 @@ package view
 
 import (
 	"errors"
+	"fmt"
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -21,13 +23,20 @@ func TestK9sEnv(t *testing.T) {
 		"dash":    {q: "$col0", e: "fred"},
 		"mix":     {q: "$col0-blee", e: "fred-blee"},
 		"subs":    {q: `{"spec" : {"suspend" : $COL0 }}`, e: `{"spec" : {"suspend" : fred }}`},
+		"multi":   {q: "$A/$B", e: "10/blee"},
+		"braces":  {q: "${B}s", e: "blees"},
+		"negate":  {q: "$!C", e: "false"},
+		"osEnv":   {q: "$K9S_TEST_ENV/bin", e: "/zorg/bin"},
 	}
 
 	e := K9sEnv{
 		"A":    "10",
 		"B":    "blee",
 		"COL0": "fred",
+		"C":    "true",
 	}
+	os.Setenv("K9S_TEST_ENV", "/zorg")
+	defer os.Unsetenv("K9S_TEST_ENV")
 
 	for k := range uu {
 		u := uu[k]
@@ -38,3 +47,65 @@ func TestK9sEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestK9sEnvExpand(t *testing.T) {
+	uu := map[string]struct {
+		q   string
+		err error
+		e   string
+	}{
+		"plain":   {q: "blee", e: "blee"},
+		"env":     {q: "$NAME", e: "fred"},
+		"cmd":     {q: "$(echo $NAME)", e: `echo "${K9S_VAR0}"![K9S_VAR0=fred]`},
+		"mix":     {q: "--pod=$(echo $NAME)-$A", e: `--pod=echo "${K9S_VAR0}"![K9S_VAR0=fred]-10`},
+		"literal": {q: "$EVIL", e: "$(rm -rf /)"},
+		"cmdFail": {q: "$(toast)", err: errors.New(`command substitution "toast" failed -- boom`)},
+		"envFail": {q: "$(echo $BLEE)", err: errors.New(`no env vars exists for argument "$(echo $BLEE)" using key "BLEE"`)},
+	}
+
+	defer func(f func(string, []string) (string, error)) { cmdSubFn = f }(cmdSubFn)
+	cmdSubFn = func(cmd string, env []string) (string, error) {
+		if cmd == "toast" {
+			return "", errors.New("boom")
+		}
+		return fmt.Sprintf("%s!%v", cmd, env), nil
+	}
+	e := K9sEnv{
+		"A":    "10",
+		"NAME": "fred",
+		"EVIL": "$(rm -rf /)",
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a, err := e.expand("", u.q)
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.e, a)
+		})
+	}
+}
+
+func TestK9sEnvExpandShell(t *testing.T) {
+	uu := map[string]struct {
+		q, e string
+	}{
+		"cmdSub":    {q: "$(echo $COL0)", e: "$(echo pwned); `echo pwned` && echo pwned"},
+		"quoted":    {q: `$(echo "<$COL0>")`, e: "<$(echo pwned); `echo pwned` && echo pwned>"},
+		"mixed":     {q: "$COL0/$(echo $NAME)", e: "$(echo pwned); `echo pwned` && echo pwned/fred"},
+		"substOnly": {q: "$(printf %s $NAME | wc -c)", e: "4"},
+	}
+
+	e := K9sEnv{
+		"NAME": "fred",
+		"COL0": "$(echo pwned); `echo pwned` && echo pwned",
+	}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			a, err := e.expand("", u.q)
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, a)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/errlog"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	errSourceAPI     = "api"
+	errSourceCommand = "command"
+	errSourceFlash   = "flash"
+)
+
+// ErrorLog presents the error center, ie the most recent errors along with
+// the request that failed.
+type ErrorLog struct {
+	ResourceViewer
+}
+
+// NewErrorLog returns a new viewer.
+func NewErrorLog(gvr client.GVR) ResourceViewer {
+	e := ErrorLog{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	e.GetTable().SetBorderFocusColor(tcell.ColorOrangeRed)
+	e.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorOrangeRed, tcell.AttrNone)
+	e.GetTable().SetColorerFn(render.ErrorLog{}.ColorerFunc())
+	e.GetTable().SetSortCol(6, 0, true)
+	e.GetTable().SetEnterFn(e.viewEntry)
+	e.SetBindKeysFn(e.bindKeys)
+	e.SetContextFn(e.errorsContext)
+
+	return &e
+}
+
+func (e *ErrorLog) errorsContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyErrors, e.App().errLog)
+}
+
+func (e *ErrorLog) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftN, tcell.KeyCtrlS)
+	aa.Add(ui.KeyActions{
+		ui.KeyR:        ui.NewKeyAction("Retry", e.retryCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", e.deleteCmd, true),
+		ui.KeyShiftC:   ui.NewKeyAction("Clear All", e.clearCmd, true),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Request", e.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Source", e.GetTable().SortColCmd(0, true), false),
+	})
+}
+
+func (e *ErrorLog) viewEntry(app *App, _ ui.Tabular, _, id string) {
+	en, ok := app.errLog.Get(id)
+	if !ok {
+		app.Flash().Errf("Error entry %s not found", id)
+		return
+	}
+	raw, err := yaml.Marshal(en)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	details := NewDetails(app, "Error", id, true).Update(string(raw))
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (e *ErrorLog) retryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := e.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	en, _ := e.App().errLog.Get(id)
+	if err := e.App().errLog.Retry(id); err != nil {
+		e.App().Flash().Err(err)
+		return nil
+	}
+	e.App().Flash().Infof("Retrying %s", en.Request)
+
+	return nil
+}
+
+func (e *ErrorLog) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ids := e.GetTable().GetSelectedItems()
+	if len(ids) == 0 || ids[0] == "" {
+		return evt
+	}
+	for _, id := range ids {
+		e.App().errLog.Delete(id)
+	}
+	e.GetTable().ClearMarks()
+	e.Start()
+
+	return nil
+}
+
+func (e *ErrorLog) clearCmd(evt *tcell.EventKey) *tcell.EventKey {
+	e.App().errLog.Clear()
+	e.App().Flash().Info("Error log cleared")
+	e.Start()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+var _ model.FlashListener = (*errorRecorder)(nil)
+
+// errorRecorder records flashed errors.
+type errorRecorder struct {
+	app *App
+}
+
+// FlashChanged notifies the flash message changed.
+func (r errorRecorder) FlashChanged(l model.FlashLevel, msg string) {
+	if l != model.FlashErr {
+		return
+	}
+	r.app.recordErr(errSourceFlash, "", msg, nil)
+}
+
+// FlashCleared notifies the flash message was cleared.
+func (r errorRecorder) FlashCleared() {}
+
+// recordErr records an error in the error center. Errors carrying a retry
+// function may be retried from the error center.
+func (a *App) recordErr(src, req, msg string, retry errlog.RetryFunc) {
+	a.errLog.Add(src, a.Config.K9s.CurrentContext, req, msg, retry, time.Now())
+}
+
+// recordLoadErr records a failed resource listing, retried by reopening its view.
+func (a *App) recordLoadErr(gvr, ns string, err error) {
+	cmd := gvr
+	if !client.IsClusterScoped(ns) && !client.IsAllNamespaces(ns) {
+		cmd += " " + ns
+	}
+	a.recordErr(errSourceAPI, listRequest(gvr, ns), err.Error(), func() {
+		if err := a.gotoResource(cmd, "", false); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+}
+
+// listRequest describes a resource listing.
+func listRequest(gvr, ns string) string {
+	switch {
+	case client.IsClusterScoped(ns):
+		return "list " + gvr
+	case client.IsAllNamespaces(ns):
+		return "list " + gvr + " in all namespaces"
+	default:
+		return "list " + gvr + " in " + ns
+	}
+}
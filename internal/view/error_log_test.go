@@ -0,0 +1,26 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListRequest(t *testing.T) {
+	uu := map[string]struct {
+		gvr, ns, e string
+	}{
+		"namespaced": {gvr: "v1/pods", ns: "default", e: "list v1/pods in default"},
+		"all":        {gvr: "v1/pods", ns: client.AllNamespaces, e: "list v1/pods in all namespaces"},
+		"allAlias":   {gvr: "v1/pods", ns: client.NamespaceAll, e: "list v1/pods in all namespaces"},
+		"cluster":    {gvr: "v1/nodes", ns: client.ClusterScope, e: "list v1/nodes"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, listRequest(u.gvr, u.ns))
+		})
+	}
+}
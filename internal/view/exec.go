@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -73,6 +74,31 @@ func edit(a *App, opts shellOpts) bool {
 	return run(a, opts)
 }
 
+// editTemp opens a text in the editor via a temp file and returns the edited text.
+func editTemp(a *App, pattern, text string) ([]byte, error) {
+	f, err := ioutil.TempFile("", pattern)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := os.Remove(f.Name()); err != nil {
+			log.Warn().Err(err).Msgf("Unable to remove %s", f.Name())
+		}
+	}()
+	if _, err := f.WriteString(text); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	if !edit(a, shellOpts{clear: true, args: []string{f.Name()}}) {
+		return nil, errors.New("Failed to launch editor")
+	}
+
+	return ioutil.ReadFile(f.Name())
+}
+
 func execute(opts shellOpts) error {
 	if opts.clear {
 		clearScreen()
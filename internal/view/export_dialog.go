@@ -0,0 +1,60 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/tview"
+)
+
+const exportKey = "export"
+
+// TableExportFormats lists formats tables can be exported to.
+var TableExportFormats = []string{render.ExportCSV, render.ExportJSON, render.ExportYAML}
+
+// ExportFunc represents a table export callback.
+type ExportFunc func(format string)
+
+// ShowExport pops a table export dialog.
+func ShowExport(app *App, title string, okFn ExportFunc) {
+	styles := app.Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	format := TableExportFormats[0]
+	f.AddDropDown("Format:", TableExportFormats, 0, func(s string, _ int) {
+		format = s
+	})
+
+	pages := app.Content.Pages
+	f.AddButton("OK", func() {
+		DismissExport(app)
+		okFn(format)
+	})
+	f.AddButton("Cancel", func() {
+		DismissExport(app)
+	})
+
+	modal := tview.NewModalForm(fmt.Sprintf("<Export %s>", title), f)
+	modal.SetText("Exports visible columns and rows in their current order")
+	modal.SetDoneFunc(func(int, string) {
+		DismissExport(app)
+	})
+
+	pages.AddPage(exportKey, modal, false, true)
+	pages.ShowPage(exportKey)
+	app.SetFocus(pages.GetPrimitive(exportKey))
+}
+
+// DismissExport dismisses the export dialog.
+func DismissExport(app *App) {
+	pages := app.Content.Pages
+	pages.RemovePage(exportKey)
+	app.SetFocus(pages.CurrentPage().Item)
+}
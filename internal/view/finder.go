@@ -0,0 +1,166 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	finderKey     = "finder"
+	finderWidth   = 90
+	finderHeight  = 20
+	finderMaxHits = 50
+	finderResPad  = 24
+)
+
+func (a *App) finderCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() || a.inFilterMode() {
+		return evt
+	}
+	ShowFinder(a, a.factory.CachedRefs(), "")
+
+	return nil
+}
+
+// ShowFinder pops a fuzzy finder searching resources names across all cached
+// resources and namespaces.
+func ShowFinder(app *App, refs []watch.ObjectRef, q string) {
+	styles := app.Styles
+
+	input := tview.NewInputField()
+	input.SetLabel("> ")
+	input.SetLabelColor(styles.K9s.Info.FgColor.Color())
+	input.SetFieldBackgroundColor(styles.BgColor())
+	input.SetFieldTextColor(styles.FgColor())
+
+	l := tview.NewList()
+	l.ShowSecondaryText(false)
+	l.SetBackgroundColor(styles.BgColor())
+	l.SetMainTextColor(styles.FgColor())
+
+	var ranked []watch.ObjectRef
+	fill := func(q string) {
+		l.Clear()
+		ranked = rankRefs(refs, q, finderMaxHits)
+		for _, r := range ranked {
+			l.AddItem(refLabel(r), "", 0, nil)
+		}
+	}
+	input.SetChangedFunc(fill)
+	input.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		switch evt.Key() {
+		case tcell.KeyUp:
+			if i := l.GetCurrentItem(); i > 0 {
+				l.SetCurrentItem(i - 1)
+			}
+			return nil
+		case tcell.KeyDown:
+			if i := l.GetCurrentItem(); i < l.GetItemCount()-1 {
+				l.SetCurrentItem(i + 1)
+			}
+			return nil
+		}
+		return evt
+	})
+	input.SetDoneFunc(func(key tcell.Key) {
+		DismissFinder(app)
+		if key != tcell.KeyEnter || len(ranked) == 0 {
+			return
+		}
+		r := ranked[l.GetCurrentItem()]
+		if err := app.gotoPath(r.GVR, r.Path); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+	input.SetText(q)
+	fill(q)
+
+	f := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(input, 1, 0, true).
+		AddItem(l, 0, 1, false)
+	f.SetBorder(true)
+	f.SetBorderPadding(0, 0, 1, 1)
+	f.SetTitle(fmt.Sprintf(" Find [%d cached resources] ", len(refs)))
+	f.SetBackgroundColor(styles.BgColor())
+
+	pages := app.Content.Pages
+	pages.AddPage(finderKey, centered(f, finderWidth, finderHeight), true, true)
+	pages.ShowPage(finderKey)
+	app.SetFocus(input)
+}
+
+// DismissFinder dismisses the fuzzy finder.
+func DismissFinder(app *App) {
+	p := app.Content.Pages
+	p.RemovePage(finderKey)
+	app.SetFocus(p.CurrentPage().Item)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// refSource matches resources by path, ie namespace/name.
+type refSource []watch.ObjectRef
+
+// String returns the matched string at a given index.
+func (s refSource) String(i int) string {
+	return s[i].Path
+}
+
+// Len returns the number of resources.
+func (s refSource) Len() int {
+	return len(s)
+}
+
+// rankRefs orders resources matching a query, best match first.
+func rankRefs(refs []watch.ObjectRef, q string, max int) []watch.ObjectRef {
+	rr := make([]watch.ObjectRef, 0, max)
+	if strings.TrimSpace(q) == "" {
+		if len(refs) > max {
+			refs = refs[:max]
+		}
+		return append(rr, refs...)
+	}
+	mm := fuzzy.FindFrom(q, refSource(refs))
+	// Ties are kept in resource order.
+	sort.Slice(mm, func(i, j int) bool {
+		if mm[i].Score != mm[j].Score {
+			return mm[i].Score > mm[j].Score
+		}
+		return mm[i].Index < mm[j].Index
+	})
+	for _, m := range mm {
+		if len(rr) == max {
+			break
+		}
+		rr = append(rr, refs[m.Index])
+	}
+
+	return rr
+}
+
+func refLabel(r watch.ObjectRef) string {
+	return fmt.Sprintf("[gray::]%-*s[-::] %s", finderResPad, client.NewGVR(r.GVR).R(), r.Path)
+}
+
+// gotoPath shows the view of a given resource and selects it.
+func (a *App) gotoPath(gvr, path string) error {
+	ns, n := client.Namespaced(path)
+	cmd := gvr
+	if ns != "" {
+		cmd += " " + ns
+	}
+	if err := a.gotoResource(cmd, "", false); err != nil {
+		return err
+	}
+	a.followLink(DeepLink{Command: cmd, Select: n})
+
+	return nil
+}
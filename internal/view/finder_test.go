@@ -0,0 +1,57 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankRefs(t *testing.T) {
+	refs := []watch.ObjectRef{
+		{GVR: "apps/v1/deployments", Path: "prod/pay-api"},
+		{GVR: "extensions/v1beta1/ingresses", Path: "prod/pay-api"},
+		{GVR: "v1/nodes", Path: "n1"},
+		{GVR: "v1/pods", Path: "prod/pay-api-5f6d8-x2x9z"},
+		{GVR: "v1/pods", Path: "prod/web-6c7b9-k2l4m"},
+		{GVR: "v1/services", Path: "prod/pay-api"},
+	}
+
+	uu := map[string]struct {
+		q   string
+		max int
+		e   []string
+	}{
+		"blank": {
+			max: 2,
+			e:   []string{"apps/v1/deployments", "extensions/v1beta1/ingresses"},
+		},
+		"match": {
+			q:   "pay-api",
+			max: 10,
+			e:   []string{"apps/v1/deployments", "extensions/v1beta1/ingresses", "v1/services", "v1/pods"},
+		},
+		"capped": {
+			q:   "pay-api",
+			max: 1,
+			e:   []string{"apps/v1/deployments"},
+		},
+		"none": {
+			q:   "zorg",
+			max: 10,
+			e:   []string{},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr := rankRefs(refs, u.q, u.max)
+			gg := make([]string, 0, len(rr))
+			for _, r := range rr {
+				gg = append(gg, r.GVR)
+			}
+			assert.Equal(t, u.e, gg)
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const allContextsFlag = "--all-contexts"
+
+// FleetSpec represents a multi-context listing command.
+type FleetSpec struct {
+	Resource  string
+	Namespace string
+	Group     string
+	All       bool
+}
+
+// ParseFleet parses a command listing a resource across contexts, either
+// `res --all-contexts [ns]` or `res @group [ns]`. Returns false if the
+// command does not target several contexts.
+func ParseFleet(cmd string) (FleetSpec, bool, error) {
+	tokens := strings.Fields(cmd)
+	if len(tokens) < 2 {
+		return FleetSpec{}, false, nil
+	}
+
+	spec := FleetSpec{Resource: tokens[0]}
+	for _, t := range tokens[1:] {
+		switch {
+		case t == allContextsFlag:
+			spec.All = true
+		case strings.HasPrefix(t, "@"):
+			if spec.Group != "" {
+				return spec, true, fmt.Errorf("only one context group can be specified in %q", cmd)
+			}
+			spec.Group = t[1:]
+		case spec.Namespace == "":
+			spec.Namespace = t
+		default:
+			return spec, true, fmt.Errorf("invalid command %q", cmd)
+		}
+	}
+	if !spec.All && spec.Group == "" {
+		return FleetSpec{}, false, nil
+	}
+	if spec.All && spec.Group != "" {
+		return spec, true, fmt.Errorf("%s and a context group are mutually exclusive", allContextsFlag)
+	}
+
+	return spec, true, nil
+}
+
+// Fleet presents a resource listing across several contexts.
+type Fleet struct {
+	*Table
+
+	label    string
+	model    *model.Fleet
+	cancelFn context.CancelFunc
+}
+
+// NewFleet returns a new multi-context viewer.
+func NewFleet(gvr client.GVR, label string, m *model.Fleet) *Fleet {
+	return &Fleet{
+		Table: NewTable(gvr),
+		label: label,
+		model: m,
+	}
+}
+
+// Init initializes the view.
+func (f *Fleet) Init(ctx context.Context) error {
+	if err := f.Table.Init(ctx); err != nil {
+		return err
+	}
+	f.BaseTitle = fmt.Sprintf("%s@%s", f.gvr.R(), f.label)
+	f.GetModel().SetNamespace(f.model.GetNamespace())
+	rate, custom := f.app.Config.K9s.RefreshRateFor(f.gvr.String(), f.gvr.R())
+	f.model.SetRefreshRate(rate)
+	if custom {
+		f.SetRefreshIndicator(rate)
+	}
+	f.model.AddListener(f)
+
+	colorer := render.DefaultColorer
+	if m, ok := model.Registry[f.gvr.String()]; ok && m.Renderer != nil {
+		colorer = m.Renderer.ColorerFunc()
+	}
+	f.SetColorerFn(colorer)
+	f.Actions().Add(ui.KeyActions{
+		tcell.KeyEnter:  ui.NewKeyAction("Goto", f.gotoCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", f.app.PrevCmd, true),
+	})
+	f.app.remapComponent(f, f.gvr.R())
+
+	return nil
+}
+
+// Name returns the component name.
+func (f *Fleet) Name() string { return f.BaseTitle }
+
+// Start starts the listings updates.
+func (f *Fleet) Start() {
+	f.Stop()
+	f.Table.Start()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, internal.KeyGVR, f.gvr.String())
+	ctx = context.WithValue(ctx, internal.KeyPath, "")
+	ctx = context.WithValue(ctx, internal.KeyLabels, "")
+	if ui.IsLabelSelector(f.SearchBuff().String()) {
+		ctx = context.WithValue(ctx, internal.KeyLabels, ui.TrimLabelSelector(f.SearchBuff().String()))
+	}
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	if ui.IsFieldSelector(f.SearchBuff().String()) {
+		ctx = context.WithValue(ctx, internal.KeyFields, ui.TrimFieldSelector(f.SearchBuff().String()))
+	}
+	ctx = context.WithValue(ctx, internal.KeyNamespace, f.model.GetNamespace())
+	ctx = context.WithValue(ctx, internal.KeyScripts, f.app.scripts)
+	ctx, f.cancelFn = context.WithCancel(ctx)
+	f.model.Watch(ctx)
+}
+
+// Stop terminates the listings updates.
+func (f *Fleet) Stop() {
+	if f.cancelFn == nil {
+		return
+	}
+	f.Table.Stop()
+	f.cancelFn()
+	f.cancelFn = nil
+}
+
+// TableDataChanged notifies the model data changed.
+func (f *Fleet) TableDataChanged(data render.TableData) {
+	f.app.QueueUpdateDraw(func() {
+		f.Update(data)
+	})
+}
+
+// TableLoadFailed notifies the load failed.
+func (f *Fleet) TableLoadFailed(err error) {
+	f.app.QueueUpdateDraw(func() {
+		f.app.Flash().Err(err)
+	})
+}
+
+func (f *Fleet) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := f.GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+
+	ctx, path := model.ParseFleetPath(id)
+	if ctx != f.app.Config.K9s.CurrentContext {
+		if err := useContext(f.app, ctx); err != nil {
+			f.app.Flash().Err(err)
+			return nil
+		}
+	}
+	ns, n := client.Namespaced(path)
+	cmd := f.gvr.String()
+	if ns != "" {
+		cmd += " " + ns
+	}
+	if err := f.app.gotoResource(cmd, "", true); err != nil {
+		f.app.Flash().Err(err)
+		return nil
+	}
+	f.app.followLink(DeepLink{Command: cmd, Select: n})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (c *Command) fleetCmd(spec FleetSpec) error {
+	gvr, ok := c.alias.AsGVR(spec.Resource)
+	if !ok {
+		return fmt.Errorf("Huh? `%s` resource not found", spec.Resource)
+	}
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return err
+	}
+
+	a := c.app
+	label, contexts := "all", []string(nil)
+	if spec.All {
+		if contexts, err = a.Conn().Config().ContextNames(); err != nil {
+			return err
+		}
+		sort.Strings(contexts)
+	} else {
+		if contexts, ok = a.Config.K9s.ContextGroup(spec.Group); !ok {
+			return fmt.Errorf("unknown context group %q", spec.Group)
+		}
+		label = spec.Group
+	}
+
+	ns := a.Config.ActiveNamespace()
+	if spec.Namespace != "" {
+		ns = spec.Namespace
+	}
+	ns = client.CleanseNamespace(ns)
+	if !meta.Namespaced {
+		ns = client.ClusterScope
+	}
+
+	a.Flash().Infof("Connecting to %d contexts...", len(contexts))
+	go func() {
+		tt := a.fleetTargets(contexts)
+		a.QueueUpdateDraw(func() {
+			if len(tt) == 0 {
+				a.Flash().Err(errors.New("no reachable contexts"))
+				return
+			}
+			if skipped := len(contexts) - len(tt); skipped > 0 {
+				a.Flash().Warnf("Skipped %d unreachable contexts", skipped)
+			}
+			m := model.NewFleet(gvr.String(), tt)
+			m.SetNamespace(ns)
+			if err := a.inject(NewFleet(gvr, label, m)); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+// fleetTargets connects to the given contexts, skipping unreachable ones.
+func (a *App) fleetTargets(contexts []string) []model.FleetTarget {
+	var (
+		wg  sync.WaitGroup
+		mx  sync.Mutex
+		sem = make(chan struct{}, maxContextProbes)
+		ff  = make(map[string]dao.Factory, len(contexts))
+	)
+	current := a.Config.K9s.CurrentContext
+	for _, ctx := range contexts {
+		if ctx == current {
+			mx.Lock()
+			ff[ctx] = a.factory
+			mx.Unlock()
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(ctx string) {
+			defer func() { <-sem; wg.Done() }()
+			f, err := a.contexts.For(ctx)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Skipping context %q", ctx)
+				return
+			}
+			mx.Lock()
+			ff[ctx] = f
+			mx.Unlock()
+		}(ctx)
+	}
+	wg.Wait()
+
+	tt := make([]model.FleetTarget, 0, len(ff))
+	for _, ctx := range contexts {
+		if f, ok := ff[ctx]; ok {
+			tt = append(tt, model.FleetTarget{Context: ctx, Factory: f})
+		}
+	}
+
+	return tt
+}
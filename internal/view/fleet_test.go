@@ -0,0 +1,67 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFleet(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		ok  bool
+		e   FleetSpec
+		err string
+	}{
+		"plain": {
+			cmd: "po",
+		},
+		"namespace": {
+			cmd: "po fred",
+		},
+		"all": {
+			cmd: "po --all-contexts",
+			ok:  true,
+			e:   FleetSpec{Resource: "po", All: true},
+		},
+		"allNS": {
+			cmd: "dp --all-contexts kube-system",
+			ok:  true,
+			e:   FleetSpec{Resource: "dp", Namespace: "kube-system", All: true},
+		},
+		"group": {
+			cmd: "po fred @prod",
+			ok:  true,
+			e:   FleetSpec{Resource: "po", Namespace: "fred", Group: "prod"},
+		},
+		"both": {
+			cmd: "po @prod --all-contexts",
+			ok:  true,
+			err: "--all-contexts and a context group are mutually exclusive",
+		},
+		"groups": {
+			cmd: "po @prod @staging",
+			ok:  true,
+			err: `only one context group can be specified in "po @prod @staging"`,
+		},
+		"extra": {
+			cmd: "po @prod fred blee",
+			ok:  true,
+			err: `invalid command "po @prod fred blee"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			spec, ok, err := ParseFleet(u.cmd)
+			assert.Equal(t, u.ok, ok)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, spec)
+		})
+	}
+}
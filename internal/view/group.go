@@ -29,6 +29,7 @@ func (g *Group) bindKeys(aa ui.KeyActions) {
 	aa.Delete(ui.KeyShiftA, ui.KeyShiftP, tcell.KeyCtrlSpace, ui.KeySpace)
 	aa.Add(ui.KeyActions{
 		tcell.KeyEnter: ui.NewKeyAction("Rules", g.policyCmd, true),
+		ui.KeyP:        ui.NewKeyAction("Permissions", permissionsCmd(g, group), true),
 		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", g.GetTable().SortColCmd(1, true), false),
 	})
 }
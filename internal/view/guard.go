@@ -0,0 +1,59 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+type (
+	selectionFunc func() []string
+
+	appViewer interface {
+		App() *App
+	}
+)
+
+// guardCmd enforces the active context guard policy prior to running an action.
+// The app is resolved lazily since extenders bind keys prior to initialization.
+func guardCmd(v appViewer, verb string, sel selectionFunc, fn ui.ActionHandler) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		a := v.App()
+		k9s := a.Config.K9s
+		if k9s.IsBlocked(verb) {
+			a.Flash().Warnf("Action %s is blocked on context %s", verb, k9s.CurrentContext)
+			return nil
+		}
+		if !k9s.ActiveGuard().ShouldConfirmName(verb) {
+			return fn(evt)
+		}
+
+		msg, name := guardPrompt(verb, k9s.CurrentContext, sel())
+		if name == "" {
+			return nil
+		}
+		dialog.ShowConfirmName(a.Content.Pages, "Confirm "+verb, msg, name, func() {
+			fn(evt)
+		}, func() {})
+
+		return nil
+	}
+}
+
+// guardPrompt returns the confirmation message and the name to be typed in.
+// A single selection is confirmed by its name while multiple selections are
+// confirmed by the context name.
+func guardPrompt(verb, context string, sel []string) (string, string) {
+	switch len(sel) {
+	case 0:
+		return "", ""
+	case 1:
+		_, n := client.Namespaced(sel[0])
+		return fmt.Sprintf("You are about to %s %s on context %s.", verb, sel[0], context), n
+	default:
+		return fmt.Sprintf("You are about to %s %d resources on context %s.", verb, len(sel), context), context
+	}
+}
@@ -0,0 +1,40 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardPrompt(t *testing.T) {
+	uu := map[string]struct {
+		sel     []string
+		msg, nm string
+	}{
+		"none": {},
+		"single": {
+			sel: []string{"default/fred"},
+			msg: "You are about to delete default/fred on context prod.",
+			nm:  "fred",
+		},
+		"cluster": {
+			sel: []string{"fred"},
+			msg: "You are about to delete fred on context prod.",
+			nm:  "fred",
+		},
+		"multi": {
+			sel: []string{"default/fred", "default/blee"},
+			msg: "You are about to delete 2 resources on context prod.",
+			nm:  "prod",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			msg, nm := guardPrompt("delete", "prod", u.sel)
+			assert.Equal(t, u.msg, msg)
+			assert.Equal(t, u.nm, nm)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	headerWidgetsWidth = 24
+	headerTick         = 1 * time.Second
+	latencySlow        = 200 * time.Millisecond
+	latencyCritical    = 500 * time.Millisecond
+)
+
+var _ model.ClusterInfoListener = (*HeaderWidgets)(nil)
+
+// HeaderState tracks the data displayed by the header widgets.
+type HeaderState struct {
+	Context string
+	Now     time.Time
+	Alerts  int
+	Latency time.Duration
+}
+
+// HeaderWidgets represents user defined header widgets. In compact mode the
+// widgets are rendered on a single line along with the cluster info blocks.
+type HeaderWidgets struct {
+	*tview.TextView
+
+	app     *App
+	compact bool
+	prev    model.ClusterMeta
+	meta    model.ClusterMeta
+}
+
+// NewHeaderWidgets returns a new header widgets view.
+func NewHeaderWidgets(app *App) *HeaderWidgets {
+	h := HeaderWidgets{
+		TextView: tview.NewTextView(),
+		app:      app,
+		prev:     model.NewClusterMeta(),
+		meta:     model.NewClusterMeta(),
+	}
+	h.SetDynamicColors(true)
+	h.SetWrap(false)
+	h.SetBorderPadding(0, 0, 1, 1)
+	h.SetBackgroundColor(app.Styles.BgColor())
+	app.Styles.AddListener(&h)
+
+	return &h
+}
+
+// StylesChanged notifies the skin changed.
+func (h *HeaderWidgets) StylesChanged(s *config.Styles) {
+	h.SetBackgroundColor(s.BgColor())
+	h.refresh()
+}
+
+// SetCompact toggles the single line layout.
+func (h *HeaderWidgets) SetCompact(b bool) {
+	h.compact = b
+	if b {
+		h.SetTextAlign(tview.AlignLeft)
+	} else {
+		h.SetTextAlign(tview.AlignRight)
+	}
+	h.refresh()
+}
+
+// ClusterInfoUpdated notifies the cluster meta was updated.
+func (h *HeaderWidgets) ClusterInfoUpdated(data model.ClusterMeta) {
+	h.app.QueueUpdateDraw(func() {
+		h.prev, h.meta = data, data
+		h.refresh()
+	})
+}
+
+// ClusterInfoChanged notifies the cluster meta was changed.
+func (h *HeaderWidgets) ClusterInfoChanged(prev, curr model.ClusterMeta) {
+	h.app.QueueUpdateDraw(func() {
+		h.prev, h.meta = prev, curr
+		h.refresh()
+	})
+}
+
+func (h *HeaderWidgets) refresh() {
+	cfg := h.app.Config.K9s.HeaderConfig()
+	st := HeaderState{
+		Context: h.app.Config.K9s.CurrentContext,
+		Now:     time.Now(),
+		Alerts:  h.app.alerts.Active(time.Now()),
+		Latency: h.app.apiLatency(),
+	}
+	info := h.app.Styles.K9s.Info
+	if !h.compact {
+		h.SetText(strings.Join(headerWidgets(cfg, st), "\n"))
+		return
+	}
+
+	ss := make([]string, 0, len(cfg.Info)+len(cfg.Widgets))
+	cpu, mem := ui.AsPercDelta(h.prev.Cpu, h.meta.Cpu), ui.AsPercDelta(h.prev.Mem, h.meta.Mem)
+	for _, b := range cfg.Info {
+		ss = append(ss, fmt.Sprintf("[%s::]%s:[%s::b]%s[-::-]", info.FgColor, headerLabels[b], info.SectionColor, headerBlockValue(b, h.meta, cpu, mem)))
+	}
+	ss = append(ss, headerWidgets(cfg, st)...)
+	h.SetText(strings.Join(ss, " "))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (a *App) headerWidgets() *HeaderWidgets {
+	return a.Views()["headerWidgets"].(*HeaderWidgets)
+}
+
+// apiLatency returns the duration of the last api server connectivity check.
+func (a *App) apiLatency() time.Duration {
+	return time.Duration(atomic.LoadInt64(&a.latency))
+}
+
+// headerUpdater refreshes the header widgets while the clock ticks.
+func (a *App) headerUpdater(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug().Msg("Header updater canceled!")
+			return
+		case <-time.After(headerTick):
+			if !a.Config.K9s.HeaderConfig().HasWidget(config.WidgetClock) || !a.showHeader || a.zoomed {
+				continue
+			}
+			a.QueueUpdateDraw(a.headerWidgets().refresh)
+		}
+	}
+}
+
+// headerWidgets renders the configured widgets.
+func headerWidgets(cfg *config.Header, st HeaderState) []string {
+	ss := make([]string, 0, len(cfg.Widgets))
+	for _, w := range cfg.Widgets {
+		switch w {
+		case config.WidgetClock:
+			ss = append(ss, "[white::b]"+st.Now.Format(cfg.ClockFormat)+"[-::-]")
+		case config.WidgetContextTag:
+			ss = append(ss, contextTag(cfg, st.Context))
+		case config.WidgetAlerts:
+			color := "lawngreen"
+			if st.Alerts > 0 {
+				color = "orangered"
+			}
+			ss = append(ss, fmt.Sprintf("[%s::b]Alerts:%d[-::-]", color, st.Alerts))
+		case config.WidgetLatency:
+			ss = append(ss, latencyWidget(st.Latency))
+		}
+	}
+
+	return ss
+}
+
+func contextTag(cfg *config.Header, ctx string) string {
+	if ctx == "" {
+		ctx = render.NAValue
+	}
+	c, ok := cfg.ContextColor(ctx)
+	if !ok {
+		return "[white::b] " + ctx + " [-::-]"
+	}
+
+	return "[black:" + c + ":b] " + ctx + " [-:-:-]"
+}
+
+func latencyWidget(d time.Duration) string {
+	if d == 0 {
+		return "[gray::]API:" + render.NAValue + "[-::-]"
+	}
+	color := "lawngreen"
+	switch {
+	case d >= latencyCritical:
+		color = "orangered"
+	case d >= latencySlow:
+		color = "orange"
+	}
+
+	return "[" + color + "::b]API:" + strconv.FormatInt(d.Milliseconds(), 10) + "ms[-::-]"
+}
@@ -0,0 +1,86 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderWidgets(t *testing.T) {
+	now := time.Date(2020, 1, 1, 10, 20, 30, 0, time.UTC)
+	uu := map[string]struct {
+		h  config.Header
+		st HeaderState
+		e  []string
+	}{
+		"none": {
+			e: []string{},
+		},
+		"clock": {
+			h:  config.Header{Widgets: []string{config.WidgetClock}, ClockFormat: "15:04"},
+			st: HeaderState{Now: now},
+			e:  []string{"[white::b]10:20[-::-]"},
+		},
+		"tag": {
+			h: config.Header{
+				Widgets:       []string{config.WidgetContextTag},
+				ContextColors: map[string]string{"prod-*": "red"},
+			},
+			st: HeaderState{Context: "prod-us"},
+			e:  []string{"[black:red:b] prod-us [-:-:-]"},
+		},
+		"tag-plain": {
+			h:  config.Header{Widgets: []string{config.WidgetContextTag}},
+			st: HeaderState{Context: "dev"},
+			e:  []string{"[white::b] dev [-::-]"},
+		},
+		"alerts": {
+			h:  config.Header{Widgets: []string{config.WidgetAlerts}},
+			st: HeaderState{Alerts: 2},
+			e:  []string{"[orangered::b]Alerts:2[-::-]"},
+		},
+		"latency": {
+			h:  config.Header{Widgets: []string{config.WidgetLatency, config.WidgetAlerts}},
+			st: HeaderState{Latency: 250 * time.Millisecond},
+			e:  []string{"[orange::b]API:250ms[-::-]", "[lawngreen::b]Alerts:0[-::-]"},
+		},
+		"latency-na": {
+			h: config.Header{Widgets: []string{config.WidgetLatency}},
+			e: []string{"[gray::]API:n/a[-::-]"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.h.Validate()
+			assert.Equal(t, u.e, headerWidgets(&u.h, u.st))
+		})
+	}
+}
+
+func TestHeaderBlockValue(t *testing.T) {
+	m := model.ClusterMeta{Context: "c1", Cluster: "cl1", User: "u1", K9sVer: "0.1", K8sVer: "1.18"}
+	uu := map[string]struct {
+		b, e string
+	}{
+		"context": {b: config.HeaderContext, e: "c1"},
+		"cluster": {b: config.HeaderCluster, e: "cl1"},
+		"user":    {b: config.HeaderUser, e: "u1"},
+		"k9s":     {b: config.HeaderK9sRev, e: "0.1"},
+		"k8s":     {b: config.HeaderK8sRev, e: "1.18"},
+		"cpu":     {b: config.HeaderCPU, e: "10%"},
+		"mem":     {b: config.HeaderMEM, e: "20%"},
+		"unknown": {b: "fred", e: "n/a"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, headerBlockValue(u.b, m, "10%", "20%"))
+		})
+	}
+}
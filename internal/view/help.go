@@ -3,32 +3,56 @@ package view
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"runtime"
 	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
-	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
 )
 
 const (
-	helpTitle    = "Help"
-	helpTitleFmt = " [aqua::b]%s "
+	helpTitle      = "Help"
+	helpTitleFmt   = " [aqua::b]%s "
+	helpCheatsheet = "cheatsheet"
+	helpResource   = "RESOURCE"
+	helpSort       = "SORT"
+	helpView       = "VIEW"
+	helpGeneral    = "GENERAL"
+	helpNavigation = "NAVIGATION"
 )
 
-// HelpFunc processes menu hints.
-type HelpFunc func() model.MenuHints
+// helpCategories lists the help sections in display order.
+var helpCategories = []string{
+	helpResource,
+	helpSort,
+	helpView,
+	ui.PluginCategory,
+	helpGeneral,
+	helpNavigation,
+	ui.HotKeyCategory,
+}
+
+// HelpSection represents a group of key bindings.
+type HelpSection struct {
+	Title string
+	Hints model.MenuHints
+}
 
-// Help presents a help viewer.
+// Help presents a help viewer listing the bindings active for the current view.
 type Help struct {
 	*Table
 
+	view                     string
+	sections                 []HelpSection
+	filter                   string
 	maxKey, maxDesc, maxRows int
 }
 
@@ -49,21 +73,113 @@ func (h *Help) Init(ctx context.Context) error {
 	h.SetBorder(true)
 	h.SetBorderPadding(0, 0, 1, 1)
 	h.bindKeys()
+	h.SetInputCapture(h.keyboard(h.GetInputCapture()))
+	h.sections = h.collect()
 	h.build()
 	h.SetBackgroundColor(h.App().Styles.BgColor())
 
 	return nil
 }
 
+// Start runs the component.
+func (h *Help) Start() {
+	h.Table.Start()
+	h.SearchBuff().AddListener(h)
+}
+
+// Stop terminates the component.
+func (h *Help) Stop() {
+	h.SearchBuff().RemoveListener(h)
+	h.Table.Stop()
+}
+
+// BufferChanged indicates the search buffer was changed.
+func (h *Help) BufferChanged(s string) {
+	h.filter = strings.TrimSpace(s)
+	h.resetTitle()
+	h.build()
+}
+
 func (h *Help) bindKeys() {
-	h.Actions().Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlS)
+	h.Actions().Delete(
+		ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlY, ui.KeyShiftV,
+		tcell.KeyLeft, tcell.KeyRight, ui.KeyLessThan, ui.KeyGreaterThan, ui.KeyEqual, ui.KeyPipe,
+	)
 	h.Actions().Set(ui.KeyActions{
-		tcell.KeyEsc:   ui.NewKeyAction("Back", h.app.PrevCmd, false),
+		tcell.KeyEsc:   ui.NewKeyAction("Back", h.backCmd, false),
 		ui.KeyHelp:     ui.NewKeyAction("Back", h.app.PrevCmd, false),
-		tcell.KeyEnter: ui.NewKeyAction("Back", h.app.PrevCmd, false),
+		tcell.KeyEnter: ui.NewKeyAction("Back", h.enterCmd, false),
+		ui.KeySlash:    ui.NewSharedKeyAction("Search", h.activateCmd, false),
+		tcell.KeyCtrlS: ui.NewSharedKeyAction("Save Cheatsheet", h.saveCmd, false),
 	})
 }
 
+// keyboard feeds the search buffer while searching, the help rows being
+// built from the bindings rather than the table model.
+func (h *Help) keyboard(next func(*tcell.EventKey) *tcell.EventKey) func(*tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if evt.Key() == tcell.KeyRune && h.SearchBuff().IsActive() {
+			h.SearchBuff().Add(evt.Rune())
+			return nil
+		}
+		if next == nil {
+			return evt
+		}
+
+		return next(evt)
+	}
+}
+
+func (h *Help) backCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if h.SearchBuff().InCmdMode() {
+		h.SearchBuff().Reset()
+		return nil
+	}
+
+	return h.app.PrevCmd(evt)
+}
+
+func (h *Help) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if h.SearchBuff().IsActive() {
+		h.SearchBuff().SetActive(false)
+		return nil
+	}
+
+	return h.app.PrevCmd(evt)
+}
+
+// saveCmd exports the bindings active for the current view as a markdown cheatsheet.
+func (h *Help) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path, err := saveCheatsheet(h.app.Config.K9s.CurrentCluster, h.view, h.sections)
+	if err != nil {
+		h.app.Flash().Err(err)
+		return nil
+	}
+	h.app.Flash().Infof("Cheatsheet saved to %s", path)
+
+	return nil
+}
+
+// collect gathers the bindings of the view the help was requested from.
+func (h *Help) collect() []HelpSection {
+	top := h.app.Content.Top()
+	if top == nil {
+		return helpSections(nil, h.app.GetActions())
+	}
+	h.view = top.Name()
+	v, ok := top.(actionsHolder)
+	if !ok {
+		ss := helpSections(nil, h.app.GetActions())
+		return append([]HelpSection{{Title: helpResource, Hints: top.Hints()}}, ss...)
+	}
+	ss := helpSections(v.Actions(), h.app.GetActions())
+	if extras := top.ExtraHints(); len(extras) > 0 {
+		ss = withExtras(ss, extras)
+	}
+
+	return ss
+}
+
 func (h *Help) computeMaxes(hh model.MenuHints) {
 	h.maxKey, h.maxDesc = 0, 0
 	for _, hint := range hh {
@@ -77,77 +193,205 @@ func (h *Help) computeMaxes(hh model.MenuHints) {
 	h.maxKey += 2
 }
 
-func (h *Help) computeExtraMaxes(ee map[string]string) {
-	h.maxDesc = 0
-	for k := range ee {
-		if len(k) > h.maxDesc {
-			h.maxDesc = len(k)
+func (h *Help) build() {
+	h.Clear()
+
+	ss := filterSections(h.sections, h.filter)
+	h.maxRows = 0
+	for _, s := range ss {
+		if len(s.Hints) > h.maxRows {
+			h.maxRows = len(s.Hints)
 		}
 	}
+	var col int
+	for _, s := range ss {
+		h.computeMaxes(s.Hints)
+		h.addSection(col, s.Title, s.Hints)
+		col += 2
+	}
 }
 
-func (h *Help) build() {
-	h.Clear()
+func (h *Help) resetTitle() {
+	title := fmt.Sprintf(helpTitleFmt, helpTitle)
+	if h.filter != "" {
+		title += ui.SkinTitle(fmt.Sprintf(ui.SearchFmt, h.filter), h.app.Styles.Frame())
+	}
+	h.SetTitle(title)
+}
 
-	sections := []string{"RESOURCE", "GENERAL", "NAVIGATION", "HELP"}
+func (h *Help) addSpacer(c int) {
+	cell := tview.NewTableCell(render.Pad("", h.maxKey))
+	cell.SetBackgroundColor(h.App().Styles.BgColor())
+	cell.SetExpansion(1)
+	h.SetCell(0, c, cell)
+}
 
-	h.maxRows = len(h.showGeneral())
-	ff := []HelpFunc{
-		h.app.Content.Top().Hints,
-		h.showGeneral,
-		h.showNav,
-		h.showHelp,
+func (h *Help) addSection(c int, title string, hh model.MenuHints) {
+	if len(hh) > h.maxRows {
+		h.maxRows = len(hh)
 	}
-	var col int
-	extras := h.app.Content.Top().ExtraHints()
-	for i, section := range sections {
-		hh := ff[i]()
-		sort.Sort(hh)
-		h.computeMaxes(hh)
-		if extras != nil {
-			h.computeExtraMaxes(extras)
+	row := 0
+	h.SetCell(row, c, titleCell(title))
+	h.addSpacer(c + 1)
+	row++
+
+	for _, hint := range hh {
+		col := c
+		h.SetCell(row, col, keyCell(hint.Mnemonic, h.maxKey))
+		col++
+		h.SetCell(row, col, infoCell(hint.Description, h.maxDesc))
+		row++
+	}
+
+	if len(hh) >= h.maxRows {
+		return
+	}
+
+	for i := h.maxRows - len(hh); i > 0; i-- {
+		col := c
+		h.SetCell(row, col, padCell("", h.maxKey))
+		col++
+		h.SetCell(row, col, padCell("", h.maxDesc))
+		row++
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// helpSections groups the active bindings by category. Keys bound by both the
+// view and the app are listed once under the view.
+func helpSections(view, app ui.KeyActions) []HelpSection {
+	cats := make(map[string]model.MenuHints, len(helpCategories))
+	add := func(k tcell.Key, a ui.KeyAction, cat string) {
+		name, ok := tcell.KeyNames[k]
+		if !ok || a.Description == "" {
+			return
 		}
-		h.addSection(col, section, hh)
-		if i == 0 && extras != nil {
-			h.addExtras(extras, col, len(hh))
+		switch {
+		case a.Category != "":
+			cat = a.Category
+		case strings.HasPrefix(a.Description, "Sort "):
+			cat = helpSort
 		}
-		col += 2
+		for _, h := range cats[cat] {
+			if h.Description == a.Description {
+				return
+			}
+		}
+		cats[cat] = append(cats[cat], model.MenuHint{
+			Mnemonic:    name,
+			Description: a.Description,
+			Visible:     a.Visible,
+		})
+	}
+
+	for _, k := range sortedKeys(view) {
+		cat := helpResource
+		if view[k].Shared {
+			cat = helpView
+		}
+		add(k, view[k], cat)
+	}
+	for _, k := range sortedKeys(app) {
+		if _, ok := view[k]; ok {
+			continue
+		}
+		add(k, app[k], helpGeneral)
 	}
+	cats[helpNavigation] = navHints()
 
-	if hh, err := h.showHotKeys(); err == nil {
-		h.computeMaxes(hh)
-		h.addSection(col, "HOTKEYS", hh)
+	ss := make([]HelpSection, 0, len(helpCategories))
+	for _, c := range helpCategories {
+		if len(cats[c]) == 0 {
+			continue
+		}
+		sort.Sort(cats[c])
+		ss = append(ss, HelpSection{Title: c, Hints: cats[c]})
 	}
+
+	return ss
 }
 
-func (h *Help) addExtras(extras map[string]string, col, size int) {
+// withExtras adds a view extra hints to its resource section.
+func withExtras(ss []HelpSection, extras map[string]string) []HelpSection {
 	kk := make([]string, 0, len(extras))
 	for k := range extras {
 		kk = append(kk, k)
 	}
-	sort.StringSlice(kk).Sort()
-	row := size + 1
+	sort.Strings(kk)
+	hh := make(model.MenuHints, 0, len(kk))
 	for _, k := range kk {
-		h.SetCell(row, col, padCell(extras[k], h.maxKey))
-		h.SetCell(row, col+1, padCell(k, h.maxDesc))
-		row++
+		hh = append(hh, model.MenuHint{Mnemonic: extras[k], Description: k})
 	}
+	if len(ss) > 0 && ss[0].Title == helpResource {
+		ss[0].Hints = append(ss[0].Hints, hh...)
+		return ss
+	}
+
+	return append([]HelpSection{{Title: helpResource, Hints: hh}}, ss...)
 }
 
-func (h *Help) showHelp() model.MenuHints {
-	return model.MenuHints{
-		{
-			Mnemonic:    "?",
-			Description: "Help",
-		},
-		{
-			Mnemonic:    "Ctrl-a",
-			Description: "Aliases",
-		},
+// filterSections retains the bindings matching a query. Sections whose title
+// matches are kept whole.
+func filterSections(ss []HelpSection, q string) []HelpSection {
+	q = strings.ToLower(strings.TrimSpace(q))
+	if q == "" {
+		return ss
 	}
+	rr := make([]HelpSection, 0, len(ss))
+	for _, s := range ss {
+		if strings.Contains(strings.ToLower(s.Title), q) {
+			rr = append(rr, s)
+			continue
+		}
+		hh := make(model.MenuHints, 0, len(s.Hints))
+		for _, h := range s.Hints {
+			if strings.Contains(strings.ToLower(h.Mnemonic), q) || strings.Contains(strings.ToLower(h.Description), q) {
+				hh = append(hh, h)
+			}
+		}
+		if len(hh) > 0 {
+			rr = append(rr, HelpSection{Title: s.Title, Hints: hh})
+		}
+	}
+
+	return rr
 }
 
-func (h *Help) showNav() model.MenuHints {
+// helpMarkdown renders the help sections as a markdown cheatsheet.
+func helpMarkdown(view string, ss []HelpSection) string {
+	var b strings.Builder
+	if view == "" {
+		b.WriteString("# K9s Cheatsheet\n")
+	} else {
+		fmt.Fprintf(&b, "# K9s %s Cheatsheet\n", view)
+	}
+	for _, s := range ss {
+		fmt.Fprintf(&b, "\n## %s\n\n| Key | Action |\n| --- | --- |\n", s.Title)
+		for _, h := range s.Hints {
+			fmt.Fprintf(&b, "| `%s` | %s |\n", mdEscape(toMnemonic(h.Mnemonic)), mdEscape(h.Description))
+		}
+	}
+
+	return b.String()
+}
+
+func saveCheatsheet(cluster, view string, ss []HelpSection) (string, error) {
+	path, err := computeFilename(cluster, client.ClusterScope, helpCheatsheet, view, "md")
+	if err != nil {
+		return "", err
+	}
+	log.Debug().Msgf("Saving cheatsheet to %s", path)
+	if err := ioutil.WriteFile(path, []byte(helpMarkdown(view, ss)), 0600); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
+
+// navHints lists the navigation keys handled by the views.
+func navHints() model.MenuHints {
 	return model.MenuHints{
 		{
 			Mnemonic:    "g",
@@ -183,124 +427,22 @@ func (h *Help) showNav() model.MenuHints {
 	}
 }
 
-func (h *Help) showHotKeys() (model.MenuHints, error) {
-	hh := config.NewHotKeys()
-	if err := hh.Load(); err != nil {
-		return nil, fmt.Errorf("no hotkey configuration found")
-	}
-	kk := make(sort.StringSlice, 0, len(hh.HotKey))
-	for k := range hh.HotKey {
-		kk = append(kk, k)
-	}
-	kk.Sort()
-	mm := make(model.MenuHints, 0, len(hh.HotKey))
-	for _, k := range kk {
-		mm = append(mm, model.MenuHint{
-			Mnemonic:    hh.HotKey[k].ShortCut,
-			Description: hh.HotKey[k].Description,
-		})
-	}
-
-	return mm, nil
-}
-
-func (h *Help) showGeneral() model.MenuHints {
-	return model.MenuHints{
-		{
-			Mnemonic:    ":cmd",
-			Description: "Command mode",
-		},
-		{
-			Mnemonic:    "/term",
-			Description: "Filter mode",
-		},
-		{
-			Mnemonic:    "esc",
-			Description: "Back/Clear",
-		},
-		{
-			Mnemonic:    "tab",
-			Description: "Next Field",
-		},
-		{
-			Mnemonic:    "backtab",
-			Description: "Previous Field",
-		},
-		{
-			Mnemonic:    "Ctrl-r",
-			Description: "Reload",
-		},
-		{
-			Mnemonic:    "Ctrl-u",
-			Description: "Clear command",
-		},
-		{
-			Mnemonic:    "Ctrl-e",
-			Description: "Toggle Header",
-		},
-		{
-			Mnemonic:    "q",
-			Description: "Quit",
-		},
-		{
-			Mnemonic:    "space",
-			Description: "Mark",
-		},
-		{
-			Mnemonic:    "Ctrl-space",
-			Description: "Clear Marks",
-		},
-		{
-			Mnemonic:    "Ctrl-s",
-			Description: "Save",
-		},
-	}
-}
-
-func (h *Help) resetTitle() {
-	h.SetTitle(fmt.Sprintf(helpTitleFmt, helpTitle))
+func mdEscape(s string) string {
+	return strings.Replace(s, "|", `\|`, -1)
 }
 
-func (h *Help) addSpacer(c int) {
-	cell := tview.NewTableCell(render.Pad("", h.maxKey))
-	cell.SetBackgroundColor(h.App().Styles.BgColor())
-	cell.SetExpansion(1)
-	h.SetCell(0, c, cell)
-}
-
-func (h *Help) addSection(c int, title string, hh model.MenuHints) {
-	if len(hh) > h.maxRows {
-		h.maxRows = len(hh)
-	}
-	row := 0
-	h.SetCell(row, c, titleCell(title))
-	h.addSpacer(c + 1)
-	row++
-
-	for _, hint := range hh {
-		col := c
-		h.SetCell(row, col, keyCell(hint.Mnemonic, h.maxKey))
-		col++
-		h.SetCell(row, col, infoCell(hint.Description, h.maxDesc))
-		row++
-	}
-
-	if len(hh) >= h.maxRows {
-		return
+func sortedKeys(aa ui.KeyActions) []tcell.Key {
+	kk := make([]tcell.Key, 0, len(aa))
+	for k := range aa {
+		kk = append(kk, k)
 	}
+	sort.Slice(kk, func(i, j int) bool {
+		return kk[i] < kk[j]
+	})
 
-	for i := h.maxRows - len(hh); i > 0; i-- {
-		col := c
-		h.SetCell(row, col, padCell("", h.maxKey))
-		col++
-		h.SetCell(row, col, padCell("", h.maxDesc))
-		row++
-	}
+	return kk
 }
 
-// ----------------------------------------------------------------------------
-// Helpers...
-
 func toMnemonic(s string) string {
 	if len(s) == 0 {
 		return s
@@ -0,0 +1,128 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHelpSections(t *testing.T) {
+	plugin := ui.NewKeyAction("Dive", nil, true)
+	plugin.Category = ui.PluginCategory
+	view := ui.KeyActions{
+		tcell.KeyCtrlD:      ui.NewKeyAction("Delete", nil, true),
+		ui.KeyShiftN:        ui.NewKeyAction("Sort Name", nil, false),
+		tcell.KeyCtrlS:      ui.NewSharedKeyAction("Save", nil, false),
+		tcell.KeyBackspace:  ui.NewSharedKeyAction("Erase", nil, false),
+		tcell.KeyBackspace2: ui.NewSharedKeyAction("Erase", nil, false),
+		ui.KeyX:             plugin,
+	}
+	app := ui.KeyActions{
+		ui.KeyHelp:     ui.NewSharedKeyAction("Help", nil, false),
+		tcell.KeyCtrlS: ui.NewSharedKeyAction("Snapshot", nil, false),
+		tcell.KeyCtrlC: ui.NewKeyAction("Quit", nil, false),
+	}
+
+	ss := helpSections(view, app)
+	assert.Equal(t, 6, len(ss))
+	assert.Equal(t, HelpSection{Title: helpResource, Hints: model.MenuHints{
+		{Mnemonic: "Ctrl-D", Description: "Delete", Visible: true},
+	}}, ss[0])
+	assert.Equal(t, HelpSection{Title: helpSort, Hints: model.MenuHints{
+		{Mnemonic: "Shift-N", Description: "Sort Name"},
+	}}, ss[1])
+	assert.Equal(t, HelpSection{Title: helpView, Hints: model.MenuHints{
+		{Mnemonic: "Backspace", Description: "Erase"},
+		{Mnemonic: "Ctrl-S", Description: "Save"},
+	}}, ss[2])
+	assert.Equal(t, HelpSection{Title: ui.PluginCategory, Hints: model.MenuHints{
+		{Mnemonic: "x", Description: "Dive", Visible: true},
+	}}, ss[3])
+	assert.Equal(t, HelpSection{Title: helpGeneral, Hints: model.MenuHints{
+		{Mnemonic: "?", Description: "Help"},
+		{Mnemonic: "Ctrl-C", Description: "Quit"},
+	}}, ss[4])
+	assert.Equal(t, helpNavigation, ss[5].Title)
+}
+
+func TestFilterSections(t *testing.T) {
+	ss := []HelpSection{
+		{Title: helpResource, Hints: model.MenuHints{
+			{Mnemonic: "Ctrl-D", Description: "Delete"},
+			{Mnemonic: "d", Description: "Describe"},
+		}},
+		{Title: helpSort, Hints: model.MenuHints{
+			{Mnemonic: "Shift-N", Description: "Sort Name"},
+		}},
+		{Title: helpGeneral, Hints: model.MenuHints{
+			{Mnemonic: "Ctrl-C", Description: "Quit"},
+		}},
+	}
+
+	uu := map[string]struct {
+		q string
+		e []HelpSection
+	}{
+		"none":  {q: "", e: ss},
+		"blank": {q: "  ", e: ss},
+		"title": {q: "sort", e: ss[1:2]},
+		"desc": {q: "DEL", e: []HelpSection{
+			{Title: helpResource, Hints: model.MenuHints{{Mnemonic: "Ctrl-D", Description: "Delete"}}},
+		}},
+		"key": {q: "ctrl-c", e: []HelpSection{
+			{Title: helpGeneral, Hints: model.MenuHints{{Mnemonic: "Ctrl-C", Description: "Quit"}}},
+		}},
+		"miss": {q: "zorg", e: []HelpSection{}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, filterSections(ss, u.q))
+		})
+	}
+}
+
+func TestWithExtras(t *testing.T) {
+	ss := withExtras(nil, map[string]string{"Zoom": "z", "Copy": "c"})
+
+	assert.Equal(t, []HelpSection{
+		{Title: helpResource, Hints: model.MenuHints{
+			{Mnemonic: "c", Description: "Copy"},
+			{Mnemonic: "z", Description: "Zoom"},
+		}},
+	}, ss)
+}
+
+func TestHelpMarkdown(t *testing.T) {
+	ss := []HelpSection{
+		{Title: helpResource, Hints: model.MenuHints{
+			{Mnemonic: "Ctrl-D", Description: "Delete"},
+		}},
+		{Title: helpView, Hints: model.MenuHints{
+			{Mnemonic: "|", Description: "Freeze Name"},
+		}},
+	}
+
+	uu := map[string]struct {
+		view, e string
+	}{
+		"view": {
+			view: "Pods",
+			e:    "# K9s Pods Cheatsheet\n\n## RESOURCE\n\n| Key | Action |\n| --- | --- |\n| `<ctrl-d>` | Delete |\n\n## VIEW\n\n| Key | Action |\n| --- | --- |\n| `<\\|>` | Freeze Name |\n",
+		},
+		"none": {
+			e: "# K9s Cheatsheet\n\n## RESOURCE\n\n| Key | Action |\n| --- | --- |\n| `<ctrl-d>` | Delete |\n\n## VIEW\n\n| Key | Action |\n| --- | --- |\n| `<\\|>` | Freeze Name |\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, helpMarkdown(u.view, ss))
+		})
+	}
+}
@@ -21,8 +21,8 @@ func TestHelp(t *testing.T) {
 	v := view.NewHelp()
 
 	assert.Nil(t, v.Init(ctx))
-	assert.Equal(t, 23, v.GetRowCount())
-	assert.Equal(t, 8, v.GetColumnCount())
+	assert.Equal(t, "RESOURCE", v.GetCell(0, 0).Text)
+	assert.Equal(t, "SORT", v.GetCell(0, 2).Text)
 	assert.Equal(t, "<a>", strings.TrimSpace(v.GetCell(1, 0).Text))
 	assert.Equal(t, "Attach", strings.TrimSpace(v.GetCell(1, 1).Text))
 }
@@ -72,7 +72,7 @@ func describeResource(app *App, model ui.Tabular, gvr, path string) {
 		return
 	}
 
-	details := NewDetails(app, "Describe", path, true).Update(yaml)
+	details := NewDescribe(app, path).Update(yaml)
 	if err := app.inject(details); err != nil {
 		app.Flash().Err(err)
 	}
@@ -90,7 +90,8 @@ func showPods(app *App, path, labelSel, fieldSel string) {
 	app.switchNS(client.AllNamespaces)
 
 	v := NewPod(client.NewGVR("v1/pods"))
-	v.SetContextFn(podCtx(app, path, labelSel, fieldSel))
+	v.SetSelectors(labelSel, fieldSel)
+	v.SetContextFn(podCtx(app, path))
 	v.GetTable().SetColorerFn(render.Pod{}.ColorerFunc())
 
 	ns, _ := client.Namespaced(path)
@@ -102,20 +103,18 @@ func showPods(app *App, path, labelSel, fieldSel string) {
 	}
 }
 
-func podCtx(app *App, path, labelSel, fieldSel string) ContextFunc {
+func podCtx(app *App, path string) ContextFunc {
 	return func(ctx context.Context) context.Context {
 		ctx = context.WithValue(ctx, internal.KeyPath, path)
-		ctx = context.WithValue(ctx, internal.KeyLabels, labelSel)
 
 		ns, _ := client.Namespaced(path)
-		mx := client.NewMetricsServer(app.factory.Client())
+		mx := client.DialMetrics(app.factory.Client())
 		nmx, err := mx.FetchPodsMetrics(ns)
 		if err != nil {
 			log.Warn().Err(err).Msgf("No pods metrics")
 		}
-		ctx = context.WithValue(ctx, internal.KeyMetrics, nmx)
 
-		return context.WithValue(ctx, internal.KeyFields, fieldSel)
+		return context.WithValue(ctx, internal.KeyMetrics, nmx)
 	}
 }
 
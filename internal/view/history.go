@@ -0,0 +1,89 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// CmdHistory tracks the command prompt history and reverse searches.
+type CmdHistory struct {
+	*config.History
+
+	query     string
+	index     int
+	searching bool
+	setting   bool
+}
+
+// NewCmdHistory returns a new command history.
+func NewCmdHistory() *CmdHistory {
+	return &CmdHistory{History: config.NewHistory()}
+}
+
+// BufferChanged indicates the buffer was changed.
+func (h *CmdHistory) BufferChanged(string) {
+	if !h.setting {
+		h.searching = false
+	}
+}
+
+// BufferActive indicates the buff activity changed.
+func (h *CmdHistory) BufferActive(state bool, _ ui.BufferKind) {
+	if !state {
+		h.searching = false
+	}
+}
+
+// Next returns the next older command matching the current search.
+func (h *CmdHistory) Next(context, current string) (string, bool) {
+	from := 0
+	if h.searching {
+		from = h.index + 1
+	} else {
+		h.query, h.searching = current, true
+	}
+	cmd, index, ok := h.Search(context, h.query, from)
+	if !ok {
+		return "", false
+	}
+	h.index = index
+
+	return cmd, true
+}
+
+func (a *App) initHistory() {
+	if err := a.history.Load(); err != nil {
+		log.Error().Err(err).Msg("Command history load failed")
+	}
+	a.CmdBuff().AddListener(a.history)
+}
+
+func (a *App) pushHistory(cmd string) {
+	if isRecordCmd(cmd) {
+		return
+	}
+	a.history.Push(a.Config.K9s.CurrentContext, cmd)
+	if err := a.history.Save(); err != nil {
+		log.Error().Err(err).Msg("Command history save failed")
+	}
+}
+
+func (a *App) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !a.CmdBuff().IsActive() {
+		a.Draw()
+		return evt
+	}
+
+	cmd, ok := a.history.Next(a.Config.K9s.CurrentContext, a.GetCmd())
+	if !ok {
+		a.Flash().Warnf("No history matching %q", a.history.query)
+		return nil
+	}
+	a.history.setting = true
+	a.CmdBuff().Set(cmd)
+	a.history.setting = false
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCmdHistoryNext(t *testing.T) {
+	h := NewCmdHistory()
+	for _, c := range []string{"po kube-system", "dp", "po default"} {
+		h.Push("ctx1", c)
+	}
+
+	cmd, ok := h.Next("ctx1", "po")
+	assert.True(t, ok)
+	assert.Equal(t, "po default", cmd)
+
+	h.setting = true
+	h.BufferChanged(cmd)
+	h.setting = false
+	cmd, ok = h.Next("ctx1", cmd)
+	assert.True(t, ok)
+	assert.Equal(t, "po kube-system", cmd)
+
+	_, ok = h.Next("ctx1", cmd)
+	assert.False(t, ok)
+
+	h.BufferChanged("d")
+	cmd, ok = h.Next("ctx1", "d")
+	assert.True(t, ok)
+	assert.Equal(t, "po default", cmd)
+}
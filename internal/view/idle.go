@@ -0,0 +1,28 @@
+package view
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+)
+
+// initPacer slows down refreshes while no user input is detected. Terminal
+// focus events are not reported by the screen so inactivity is used instead.
+func (a *App) initPacer() {
+	cfg := a.Config.K9s.IdleConfig()
+	if !cfg.Enabled() {
+		model.Pace.Configure(0, 0)
+		return
+	}
+
+	rate := time.Duration(cfg.RefreshRate) * time.Second
+	model.Pace.Configure(time.Duration(cfg.Timeout)*time.Second, rate)
+	model.Pace.SetIdleFn(func(idle bool) {
+		if !idle {
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			a.Flash().Infof("Idle -- refreshing every %v until next key press", rate)
+		})
+	})
+}
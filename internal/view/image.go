@@ -0,0 +1,106 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/cosign"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
+)
+
+// Image presents the container images run by pods along with their
+// signature and SBOM attestation verification.
+type Image struct {
+	ResourceViewer
+
+	path string
+}
+
+// NewImage returns a new viewer.
+func NewImage(gvr client.GVR) ResourceViewer {
+	return newImage(gvr, "")
+}
+
+// newPodImages returns a viewer scoped to the images of a given pod.
+func newPodImages(path string) ResourceViewer {
+	return newImage(client.NewGVR("images"), path)
+}
+
+func newImage(gvr client.GVR, path string) *Image {
+	i := Image{
+		ResourceViewer: NewBrowser(gvr),
+		path:           path,
+	}
+	i.SetBindKeysFn(i.bindKeys)
+	i.GetTable().SetColorerFn(render.Image{}.ColorerFunc())
+	i.GetTable().SetSortCol(2, 0, false)
+	i.GetTable().SetEnterFn(i.showVerification)
+	i.SetContextFn(i.imageContext)
+
+	return &i
+}
+
+func (i *Image) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyV:      ui.NewKeyAction("Verify", i.verifyCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Signature", i.GetTable().SortColCmd(2, true), false),
+		ui.KeyShiftB: ui.NewKeyAction("Sort SBOM", i.GetTable().SortColCmd(3, true), false),
+	})
+}
+
+func (i *Image) imageContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyImageVerifier, i.App().imageVerifier)
+	if i.path == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, internal.KeyPath, i.path)
+}
+
+func (i *Image) verifyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sels := i.GetTable().GetSelectedItems()
+	if len(sels) == 0 || i.App().imageVerifier == nil {
+		return evt
+	}
+	for _, img := range sels {
+		i.App().imageVerifier.Forget(img)
+	}
+	i.App().Flash().Infof("Verifying %d image(s)...", len(sels))
+	i.Refresh()
+
+	return nil
+}
+
+func (i *Image) showVerification(app *App, _ ui.Tabular, _, image string) {
+	if app.imageVerifier == nil {
+		return
+	}
+	raw, err := yaml.Marshal(app.imageVerifier.Result(image))
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	details := NewDetails(app, "Image Verification", image, true).Update(string(raw))
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// initImageVerifier enables images signatures verification when configured.
+func (a *App) initImageVerifier() {
+	cfg := a.Config.K9s.ImageVerifyConfig()
+	if !cfg.Enabled() {
+		return
+	}
+	a.imageVerifier = cosign.NewVerifier(cfg)
+	log.Info().Msgf("Verifying images signatures via %s", cfg.Binary)
+}
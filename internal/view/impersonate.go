@@ -0,0 +1,75 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+// impersonateCmd acts as another user and groups, ie `:impersonate fred dev ops`.
+// Without a user, K9s drops back to the kubeconfig identity.
+func (a *App) impersonateCmd(cmd string) error {
+	user, groups := parseImpersonate(cmd)
+	cfg := a.Conn().Config()
+	prevUser, prevGroups := impersonation(cfg)
+	if user == prevUser && strings.Join(groups, ",") == strings.Join(prevGroups, ",") {
+		return nil
+	}
+
+	cfg.Impersonate(user, groups)
+	if err := a.refreshCredentials(); err != nil {
+		cfg.Impersonate(prevUser, prevGroups)
+		if e := a.refreshCredentials(); e != nil {
+			log.Error().Err(e).Msg("Unable to restore identity")
+		}
+		return fmt.Errorf("impersonation failed -- %s", err)
+	}
+	if err := a.command.Reset(true); err != nil {
+		return err
+	}
+	if err := a.command.defaultCmd(); err != nil {
+		return err
+	}
+	if user == "" {
+		a.Flash().Info("Impersonation dropped")
+		return nil
+	}
+	a.Flash().Infof("Impersonating %s", impersonationLabel(user, groups))
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseImpersonate(cmd string) (string, []string) {
+	tokens := strings.Fields(cmd)
+	if len(tokens) < 2 {
+		return "", nil
+	}
+
+	return tokens[1], tokens[2:]
+}
+
+func impersonation(cfg *client.Config) (string, []string) {
+	if !cfg.Impersonating() {
+		return "", nil
+	}
+	user, _ := cfg.ImpersonateUser()
+	groups, err := cfg.ImpersonateGroups()
+	if err != nil {
+		return user, nil
+	}
+
+	return user, strings.Split(groups, ",")
+}
+
+func impersonationLabel(user string, groups []string) string {
+	if len(groups) == 0 {
+		return user
+	}
+
+	return fmt.Sprintf("%s (%s)", user, strings.Join(groups, ","))
+}
@@ -0,0 +1,44 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseImpersonate(t *testing.T) {
+	uu := map[string]struct {
+		cmd    string
+		user   string
+		groups []string
+		label  string
+	}{
+		"none": {
+			cmd: "impersonate",
+		},
+		"user": {
+			cmd:    "impersonate system:serviceaccount:ci:deployer",
+			user:   "system:serviceaccount:ci:deployer",
+			groups: []string{},
+			label:  "system:serviceaccount:ci:deployer",
+		},
+		"groups": {
+			cmd:    "impersonate  fred dev  ops",
+			user:   "fred",
+			groups: []string{"dev", "ops"},
+			label:  "fred (dev,ops)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			user, groups := parseImpersonate(u.cmd)
+			assert.Equal(t, u.user, user)
+			assert.Equal(t, u.groups, groups)
+			if user != "" {
+				assert.Equal(t, u.label, impersonationLabel(user, groups))
+			}
+		})
+	}
+}
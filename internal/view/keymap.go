@@ -0,0 +1,131 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const appKeyMapView = "app"
+
+type actionsHolder interface {
+	Actions() ui.KeyActions
+}
+
+// remapActions rebinds or disables actions based on user key bindings.
+// Actions are matched by id and remapping is idempotent so it can be
+// reapplied each time a view refreshes its actions. When several actions
+// match a binding, the one on the lowest key wins. Returns the built-in
+// actions overridden by a binding.
+func remapActions(aa ui.KeyActions, km *config.KeyMap, names ...string) []string {
+	if km == nil {
+		return nil
+	}
+	var conflicts []string
+	for _, b := range km.Bindings {
+		if !b.InView(names...) {
+			continue
+		}
+		var key tcell.Key
+		if !b.Disable {
+			var err error
+			if key, err = asKey(b.Key); err != nil {
+				log.Warn().Err(err).Msgf("KEYMAP Unable to map key for action %q", b.Action)
+				continue
+			}
+		}
+
+		id := ui.ActionID(b.Action)
+		var matches []ui.KeyAction
+		for _, k := range aa.Keys() {
+			if aa[k].ID != id || (!b.Disable && k == key) {
+				continue
+			}
+			matches = append(matches, aa[k])
+			delete(aa, k)
+		}
+		if len(matches) == 0 || b.Disable {
+			continue
+		}
+		if len(matches) > 1 {
+			log.Debug().Msgf("KEYMAP %d actions match %q, using the one bound to the lowest key", len(matches), b.Action)
+		}
+		prev, ok := aa[key]
+		if ok && prev.ID == id {
+			continue
+		}
+		if ok {
+			conflicts = append(conflicts, fmt.Sprintf("%s on %s overrides %s", matches[0].Description, b.Key, prev.Description))
+		}
+		aa[key] = matches[0]
+	}
+
+	return conflicts
+}
+
+// validateKeyMap checks all keys are valid and no key is bound to several
+// actions in the same view.
+func validateKeyMap(km *config.KeyMap) error {
+	keys := make(map[tcell.Key][]config.KeyBinding, len(km.Bindings))
+	for _, b := range km.Bindings {
+		if b.Disable {
+			continue
+		}
+		key, err := asKey(b.Key)
+		if err != nil {
+			return err
+		}
+		for _, prev := range keys[key] {
+			if ui.ActionID(prev.Action) != ui.ActionID(b.Action) && overlaps(prev, b) {
+				return fmt.Errorf("key %s is bound to both %q and %q", b.Key, prev.Action, b.Action)
+			}
+		}
+		keys[key] = append(keys[key], b)
+	}
+
+	return nil
+}
+
+// overlaps checks if two bindings apply to a common view.
+func overlaps(b1, b2 config.KeyBinding) bool {
+	if len(b1.Views) == 0 || len(b2.Views) == 0 {
+		return true
+	}
+
+	return b1.InView(b2.Views...)
+}
+
+func (a *App) loadKeyMap() error {
+	km := config.NewKeyMap()
+	if err := km.Load(); err != nil {
+		return err
+	}
+	if err := validateKeyMap(km); err != nil {
+		return err
+	}
+	a.keyMap, a.keyConflicts = km, make(map[string]struct{})
+
+	return nil
+}
+
+// remap applies the user key bindings to some actions and reports the
+// built-in actions they override, once per key map.
+func (a *App) remap(aa ui.KeyActions, names ...string) {
+	for _, c := range remapActions(aa, a.keyMap, names...) {
+		if _, ok := a.keyConflicts[c]; ok {
+			continue
+		}
+		a.keyConflicts[c] = struct{}{}
+		log.Warn().Msgf("KEYMAP %s", c)
+		a.Flash().Warnf("Keymap conflict: %s", c)
+	}
+}
+
+func (a *App) remapComponent(c interface{}, names ...string) {
+	if h, ok := c.(actionsHolder); ok {
+		a.remap(h.Actions(), names...)
+	}
+}
@@ -0,0 +1,134 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemapActions(t *testing.T) {
+	km := config.KeyMap{
+		Bindings: []config.KeyBinding{
+			{Action: "Port-Forward", Key: "Shift-P", Views: []string{"pods"}},
+			{Action: "delete", Disable: true},
+			{Action: "Logs", Key: "Shift-L", Views: []string{"svc"}},
+		},
+	}
+
+	aa := ui.KeyActions{
+		ui.KeyShiftF:   ui.NewKeyAction("Port-Forward", nil, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", nil, true),
+		ui.KeyL:        ui.NewKeyAction("Logs", nil, true),
+	}
+	remapActions(aa, &km, "Pod", "pods")
+
+	assert.Equal(t, 2, len(aa))
+	assert.Equal(t, "Port-Forward", aa[ui.KeyShiftP].Description)
+	assert.Equal(t, "Logs", aa[ui.KeyL].Description)
+
+	// Reapplying after the original bindings got restored must be idempotent.
+	aa[ui.KeyShiftF] = ui.NewKeyAction("Port-Forward", nil, true)
+	remapActions(aa, &km, "Pod", "pods")
+	assert.Equal(t, 2, len(aa))
+	_, ok := aa[ui.KeyShiftF]
+	assert.False(t, ok)
+	assert.Equal(t, "Port-Forward", aa[ui.KeyShiftP].Description)
+}
+
+func TestRemapActionsAmbiguous(t *testing.T) {
+	km := config.KeyMap{
+		Bindings: []config.KeyBinding{
+			{Action: "sort", Key: "Shift-Z"},
+		},
+	}
+
+	for i := 0; i < 10; i++ {
+		aa := ui.KeyActions{
+			ui.KeyShiftN: ui.NewKeyAction("Sort", nil, true),
+			ui.KeyShiftA: ui.NewKeyAction("Sort", nil, false),
+		}
+		remapActions(aa, &km, "pods")
+		assert.Equal(t, 1, len(aa))
+		assert.False(t, aa[ui.KeyShiftZ].Visible)
+
+		remapActions(aa, &km, "pods")
+		assert.Equal(t, 1, len(aa))
+		assert.False(t, aa[ui.KeyShiftZ].Visible)
+	}
+}
+
+func TestRemapActionsID(t *testing.T) {
+	km := config.KeyMap{
+		Bindings: []config.KeyBinding{
+			{Action: "port-forward", Key: "Shift-P"},
+		},
+	}
+
+	a := ui.NewKeyAction("Port-Forward", nil, true)
+	a.Description = "Forward a port"
+	aa := ui.KeyActions{ui.KeyShiftF: a}
+	remapActions(aa, &km, "pods")
+	assert.Equal(t, "Forward a port", aa[ui.KeyShiftP].Description)
+}
+
+func TestRemapActionsConflicts(t *testing.T) {
+	km := config.KeyMap{
+		Bindings: []config.KeyBinding{
+			{Action: "Logs", Key: "Ctrl-D"},
+		},
+	}
+
+	aa := ui.KeyActions{
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", nil, true),
+		ui.KeyL:        ui.NewKeyAction("Logs", nil, true),
+	}
+	assert.Equal(t, []string{"Logs on Ctrl-D overrides Delete"}, remapActions(aa, &km, "pods"))
+	assert.Equal(t, "Logs", aa[tcell.KeyCtrlD].Description)
+	assert.Nil(t, remapActions(aa, &km, "pods"))
+}
+
+func TestValidateKeyMap(t *testing.T) {
+	km := config.KeyMap{
+		Bindings: []config.KeyBinding{
+			{Action: "Logs", Key: "Shift-L"},
+			{Action: "Delete", Disable: true},
+		},
+	}
+	assert.Nil(t, validateKeyMap(&km))
+
+	km.Bindings = append(km.Bindings, config.KeyBinding{Action: "Blee", Key: "Bozo"})
+	assert.NotNil(t, validateKeyMap(&km))
+}
+
+func TestValidateKeyMapConflicts(t *testing.T) {
+	uu := map[string]struct {
+		bb  []config.KeyBinding
+		err bool
+	}{
+		"global": {
+			bb:  []config.KeyBinding{{Action: "Logs", Key: "Shift-L"}, {Action: "Shell", Key: "Shift-L", Views: []string{"pods"}}},
+			err: true,
+		},
+		"sameView": {
+			bb:  []config.KeyBinding{{Action: "Logs", Key: "Shift-L", Views: []string{"pods"}}, {Action: "Shell", Key: "Shift-L", Views: []string{"Pods"}}},
+			err: true,
+		},
+		"otherViews": {
+			bb: []config.KeyBinding{{Action: "Logs", Key: "Shift-L", Views: []string{"pods"}}, {Action: "Shell", Key: "Shift-L", Views: []string{"svc"}}},
+		},
+		"sameAction": {
+			bb: []config.KeyBinding{{Action: "Logs", Key: "Shift-L"}, {Action: "logs", Key: "Shift-L", Views: []string{"pods"}}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := validateKeyMap(&config.KeyMap{Bindings: u.bb})
+			assert.Equal(t, u.err, err != nil)
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/cis"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	kubeBenchRunTimeout = 10 * time.Minute
+	kubeBenchFileFmt    = "kube-bench-20060102-150405.json"
+	kubeBenchNamespace  = "default"
+)
+
+// KubeBench presents kube-bench CIS benchmark sections.
+type KubeBench struct {
+	ResourceViewer
+
+	path string
+}
+
+// NewKubeBench returns a new viewer.
+func NewKubeBench(path string) ResourceViewer {
+	k := KubeBench{
+		ResourceViewer: NewBrowser(client.NewGVR("kubebench")),
+		path:           path,
+	}
+	k.GetTable().SetColorerFn(render.KubeBench{}.ColorerFunc())
+	k.GetTable().SetSortCol(0, 0, true)
+	k.GetTable().SetEnterFn(k.showChecks)
+	k.SetContextFn(k.benchContext)
+
+	return &k
+}
+
+func (k *KubeBench) benchContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, k.path)
+}
+
+func (k *KubeBench) showChecks(app *App, _ ui.Tabular, _, section string) {
+	if err := app.inject(NewKubeBenchCheck(k.path, section)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// KubeBenchCheck presents the checks of a kube-bench CIS benchmark section.
+type KubeBenchCheck struct {
+	ResourceViewer
+
+	path, section string
+}
+
+// NewKubeBenchCheck returns a new viewer.
+func NewKubeBenchCheck(path, section string) ResourceViewer {
+	k := KubeBenchCheck{
+		ResourceViewer: NewBrowser(client.NewGVR("kubebenchchecks")),
+		path:           path,
+		section:        section,
+	}
+	k.GetTable().SetColorerFn(render.KubeBenchCheck{}.ColorerFunc())
+	k.GetTable().SetSortCol(0, 0, true)
+	k.GetTable().SetEnterFn(k.showCheck)
+	k.SetContextFn(k.checkContext)
+
+	return &k
+}
+
+func (k *KubeBenchCheck) checkContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, k.path)
+	return context.WithValue(ctx, internal.KeySubject, k.section)
+}
+
+func (k *KubeBenchCheck) showCheck(app *App, _ ui.Tabular, _, id string) {
+	r, err := cis.Load(k.path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	c, ok := r.Check(id)
+	if !ok {
+		app.Flash().Errf("No kube-bench check %q found", id)
+		return
+	}
+	raw, err := yaml.Marshal(c)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	details := NewDetails(app, "Check", id, true).Update(string(raw))
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// kubeBenchCmd shows kube-bench results. `kubebench` shows the latest results
+// for the active cluster, `kubebench run [namespace]` benchmarks the cluster
+// and `kubebench path` loads existing results.
+func (a *App) kubeBenchCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	switch {
+	case len(tokens) == 1:
+		path, err := latestKubeBench(kubeBenchDir(a.Config))
+		if err != nil {
+			return err
+		}
+		return a.inject(NewKubeBench(path))
+	case tokens[1] == "run":
+		if len(tokens) > 3 {
+			return fmt.Errorf("invalid kube-bench command %q", cmd)
+		}
+		ns := kubeBenchNamespace
+		if len(tokens) == 3 {
+			ns = tokens[2]
+		}
+		return a.runKubeBench(ns)
+	case len(tokens) == 2:
+		path, err := expandPath(tokens[1])
+		if err != nil {
+			return err
+		}
+		if _, err := cis.Load(path); err != nil {
+			return err
+		}
+		return a.inject(NewKubeBench(path))
+	default:
+		return fmt.Errorf("invalid kube-bench command %q", cmd)
+	}
+}
+
+// runKubeBench runs kube-bench once confirmed since its job runs privileged,
+// sharing the host pid namespace and mounting host paths.
+func (a *App) runKubeBench(ns string) error {
+	if a.Config.K9s.IsBlocked(config.VerbKubeBench) {
+		return errors.New("kube-bench runs are disabled on this context")
+	}
+
+	image := a.Config.K9s.KubeBenchConfig().Image
+	msg := fmt.Sprintf("Run kube-bench image %s as a privileged job with host pid and host paths mounted in namespace %s?", image, ns)
+	dialog.ShowConfirm(a.Content.Pages, "Confirm Kube-Bench", msg, func() {
+		a.startKubeBench(ns, image)
+	}, func() {})
+
+	return nil
+}
+
+func (a *App) startKubeBench(ns, image string) {
+	var kb dao.KubeBench
+	kb.Init(a.factory, client.NewGVR("kubebench"))
+	dir := kubeBenchDir(a.Config)
+	a.Flash().Infof("Running kube-bench in namespace %s...", ns)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), kubeBenchRunTimeout)
+		defer cancel()
+
+		raw, err := kb.Run(ctx, ns, image)
+		path := filepath.Join(dir, time.Now().Format(kubeBenchFileFmt))
+		if err == nil {
+			if err = os.MkdirAll(dir, 0700); err == nil {
+				err = cis.Save(path, raw)
+			}
+		}
+		a.audit(config.VerbKubeBench, "batch/v1/jobs", ns, err)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Errf("kube-bench failed -- %s", err)
+				return
+			}
+			a.Flash().Info("kube-bench completed")
+			if err := a.inject(NewKubeBench(path)); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+func kubeBenchDir(cfg *config.Config) string {
+	return cfg.K9s.KubeBenchConfig().Dir(cfg.K9s.CurrentCluster)
+}
+
+// latestKubeBench returns the most recent kube-bench results in a directory.
+func latestKubeBench(dir string) (string, error) {
+	ff, err := ioutil.ReadDir(dir)
+	if err != nil || len(ff) == 0 {
+		return "", errors.New("no kube-bench results found. Use `kubebench run` to benchmark the cluster")
+	}
+	nn := make([]string, 0, len(ff))
+	for _, f := range ff {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".json") {
+			nn = append(nn, f.Name())
+		}
+	}
+	if len(nn) == 0 {
+		return "", errors.New("no kube-bench results found. Use `kubebench run` to benchmark the cluster")
+	}
+	sort.Strings(nn)
+
+	return filepath.Join(dir, nn[len(nn)-1]), nil
+}
@@ -0,0 +1,33 @@
+package view
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatestKubeBench(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-kube-bench")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = latestKubeBench(filepath.Join(dir, "fred"))
+	assert.NotNil(t, err)
+	_, err = latestKubeBench(dir)
+	assert.NotNil(t, err)
+
+	for _, f := range []string{
+		"kube-bench-20200102-030405.json",
+		"kube-bench-20200203-030405.json",
+		"kube-bench-20200101-030405.json",
+		"notes.txt",
+	} {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(dir, f), []byte("{}"), 0600))
+	}
+	path, err := latestKubeBench(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, filepath.Join(dir, "kube-bench-20200203-030405.json"), path)
+}
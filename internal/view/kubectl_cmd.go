@@ -0,0 +1,100 @@
+package view
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/gdamore/tcell"
+)
+
+const kubectlBin = "kubectl"
+
+func (b *Browser) cpKubectlCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if b.GetSelectedItem() == "" {
+		return evt
+	}
+	cmd := getCommandLine(b.App().Config.K9s.CurrentContext, client.NewGVR(b.GVR()), b.GetSelectedItems())
+	copyKubectl(b.App(), cmd)
+
+	return nil
+}
+
+func (l *Log) cpKubectlCmd(evt *tcell.EventKey) *tcell.EventKey {
+	cmd := logsCommandLine(l.app.Config.K9s.CurrentContext, l.model.GetGVR(), l.model.GetPath(), l.model.GetContainer(), l.model.IsPrevious())
+	copyKubectl(l.app, cmd)
+
+	return nil
+}
+
+func copyKubectl(a *App, cmd string) {
+	if err := clipboard.WriteAll(cmd); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	a.Flash().Infof("Copied to clipboard: %s", cmd)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// kubectlCommandLine returns a kubectl command line targeting a given context
+// and namespace.
+func kubectlCommandLine(ctx, ns string, args ...string) string {
+	aa := make([]string, 0, len(args)+4)
+	if ns != "" {
+		aa = append(aa, "-n", ns)
+	}
+	if ctx != "" {
+		aa = append(aa, "--context", ctx)
+	}
+
+	return commandLine(kubectlBin, append(aa, args...))
+}
+
+// getCommandLine returns the kubectl commands getting the given resources,
+// one per namespace.
+func getCommandLine(ctx string, gvr client.GVR, paths []string) string {
+	names := make(map[string][]string)
+	for _, p := range paths {
+		ns, n := client.Namespaced(p)
+		names[ns] = append(names[ns], n)
+	}
+	nss := make([]string, 0, len(names))
+	for ns := range names {
+		nss = append(nss, ns)
+	}
+	sort.Strings(nss)
+
+	cmds := make([]string, 0, len(nss))
+	for _, ns := range nss {
+		nn := names[ns]
+		sort.Strings(nn)
+		cmds = append(cmds, kubectlCommandLine(ctx, ns, append([]string{"get", dao.KubectlResource(gvr)}, nn...)...))
+	}
+
+	return strings.Join(cmds, "\n")
+}
+
+// logsCommandLine returns the kubectl command following a resource logs.
+func logsCommandLine(ctx string, gvr client.GVR, path, co string, prev bool) string {
+	ns, n := client.Namespaced(path)
+	if gvr.String() != "v1/pods" {
+		n = dao.KubectlResource(gvr) + "/" + n
+	}
+	args := []string{"logs", n}
+	if co != "" {
+		args = append(args, "-c", co)
+	} else {
+		args = append(args, "--all-containers")
+	}
+	if prev {
+		args = append(args, "--previous")
+	}
+	args = append(args, "--tail", strconv.Itoa(tailLineCount), "-f")
+
+	return kubectlCommandLine(ctx, ns, args...)
+}
@@ -0,0 +1,75 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCommandLine(t *testing.T) {
+	uu := map[string]struct {
+		ctx, gvr string
+		paths    []string
+		e        string
+	}{
+		"single": {
+			ctx:   "prod",
+			gvr:   "apps/v1/deployments",
+			paths: []string{"payments/api"},
+			e:     "kubectl -n payments --context prod get deployments.apps api",
+		},
+		"clusterScoped": {
+			ctx:   "prod",
+			gvr:   "v1/nodes",
+			paths: []string{"n2", "n1"},
+			e:     "kubectl --context prod get nodes n1 n2",
+		},
+		"namespaces": {
+			gvr:   "v1/pods",
+			paths: []string{"ns2/p1", "ns1/p2", "ns1/p1"},
+			e:     "kubectl -n ns1 get pods p1 p2\nkubectl -n ns2 get pods p1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, getCommandLine(u.ctx, client.NewGVR(u.gvr), u.paths))
+		})
+	}
+}
+
+func TestLogsCommandLine(t *testing.T) {
+	uu := map[string]struct {
+		gvr, path, co string
+		prev          bool
+		e             string
+	}{
+		"container": {
+			gvr:  "v1/pods",
+			path: "payments/api-7f9c",
+			co:   "app",
+			e:    "kubectl -n payments --context prod logs api-7f9c -c app --tail 1000 -f",
+		},
+		"previous": {
+			gvr:  "v1/pods",
+			path: "payments/api-7f9c",
+			prev: true,
+			e:    "kubectl -n payments --context prod logs api-7f9c --all-containers --previous --tail 1000 -f",
+		},
+		"deployment": {
+			gvr:  "apps/v1/deployments",
+			path: "payments/api",
+			co:   "app",
+			e:    "kubectl -n payments --context prod logs deployments.apps/api -c app --tail 1000 -f",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, logsCommandLine("prod", client.NewGVR(u.gvr), u.path, u.co, u.prev))
+		})
+	}
+}
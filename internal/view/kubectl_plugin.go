@@ -0,0 +1,60 @@
+package view
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+const kubectlPluginsMenuKey = "kubectlPlugins"
+
+func (b *Browser) kubectlPluginsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	installed := dao.InstalledKubectlPlugins(os.Getenv("PATH"))
+	pp := dao.KubectlPluginsFor(installed, client.NewGVR(b.GVR()), path, b.App().Config.K9s.CurrentContext)
+	if len(pp) == 0 {
+		b.App().Flash().Warnf("No kubectl plugins found for %s", b.GVR())
+		return nil
+	}
+	items := make([]string, 0, len(pp))
+	for _, p := range pp {
+		items = append(items, p.Description+" ("+p.Name+")")
+	}
+	ShowListMenu(b.App(), kubectlPluginsMenuKey, "Kubectl Plugins", items, func(i int) {
+		b.runKubectlPlugin(pp[i], path)
+	})
+
+	return nil
+}
+
+// runKubectlPlugin runs a kubectl plugin. Plugins printing secret values go
+// through the same checks as secret reveals.
+func (b *Browser) runKubectlPlugin(p dao.KubectlPlugin, path string) {
+	run := func() {
+		if err := b.App().inject(NewPluginOutput(b.App(), p.Bin, p.Args)); err != nil {
+			b.App().Flash().Err(err)
+		}
+	}
+	if !p.Reveals || !b.App().Config.K9s.MaskSecrets() {
+		run()
+		return
+	}
+	if b.App().Config.K9s.IsBlocked(config.VerbReveal) {
+		b.App().Flash().Errf("Secret reveals are not allowed on context %s", b.App().Config.K9s.CurrentContext)
+		return
+	}
+	msg := fmt.Sprintf("kubectl %s prints the values of secret %s. Reveal them?", p.Name, path)
+	dialog.ShowConfirm(b.App().Content.Pages, "Confirm Reveal", msg, func() {
+		b.App().audit(config.VerbReveal, b.GVR(), path, nil)
+		run()
+	}, func() {})
+}
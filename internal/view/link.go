@@ -0,0 +1,155 @@
+package view
+
+import (
+	"fmt"
+	"net/url"
+	"os/exec"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const linksMenuKey = "links"
+
+var labelKeyRX = regexp.MustCompile(`[^A-Z0-9_]`)
+
+// openURL opens a url using the platform default browser.
+var openURL = func(u string) error {
+	var bin string
+	var args []string
+	switch runtime.GOOS {
+	case "darwin":
+		bin = "open"
+	case "windows":
+		bin, args = "rundll32", []string{"url.dll,FileProtocolHandler"}
+	default:
+		bin = "xdg-open"
+	}
+
+	return exec.Command(bin, append(args, u)...).Start()
+}
+
+func (b *Browser) openLinkCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ll := config.NewLinks()
+	if err := ll.Load(); err != nil {
+		b.App().Flash().Errf("unable to load links -- %s", err)
+		return nil
+	}
+	k9s := b.App().Config.K9s
+	links := linksFor(ll, append(b.Aliases(), b.GVR()), k9s.CurrentContext, k9s.CurrentCluster)
+	if len(links) == 0 {
+		b.App().Flash().Warnf("No links configured for %s", b.GVR())
+		return nil
+	}
+
+	env := b.EnvFn()()
+	for k, v := range b.labelsEnv(path) {
+		env[k] = v
+	}
+	ns, _ := client.Namespaced(path)
+	open := func(i int) {
+		u, err := linkURL(env, ns, links[i].URL)
+		if err != nil {
+			b.App().Flash().Errf("Link %q failed -- %s", links[i].Description, err)
+			return
+		}
+		if err := openURL(u); err != nil {
+			b.App().Flash().Errf("unable to open %s -- %s", u, err)
+			return
+		}
+		b.App().Flash().Infof("Opening %s...", u)
+	}
+	if len(links) == 1 {
+		open(0)
+		return nil
+	}
+	items := make([]string, 0, len(links))
+	for _, l := range links {
+		items = append(items, l.Description)
+	}
+	ShowListMenu(b.App(), linksMenuKey, "Links", items, open)
+
+	return nil
+}
+
+// labelsEnv exposes the selected resource labels as LABEL_XXX env vars.
+func (b *Browser) labelsEnv(path string) K9sEnv {
+	o, err := b.GetModel().Get(b.defaultContext(), path)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to get resource %q", path)
+		return nil
+	}
+	m, err := model.ObjectMap(o)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to convert resource %q", path)
+		return nil
+	}
+	meta, _ := m["metadata"].(map[string]interface{})
+	labels, _ := meta["labels"].(map[string]interface{})
+
+	return labelsEnv(labels)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// linksFor returns the links applicable to a resource sorted by description.
+func linksFor(ll config.Links, aliases []string, ctx, cluster string) []config.Link {
+	links := make([]config.Link, 0, len(ll.Link))
+	for _, l := range ll.Link {
+		if !inScope(l.Scopes, aliases) || !l.InContext(ctx, cluster) {
+			continue
+		}
+		links = append(links, l)
+	}
+	sort.Slice(links, func(i, j int) bool {
+		return links[i].Description < links[j].Description
+	})
+
+	return links
+}
+
+// labelsEnv converts labels to env vars, ie app.kubernetes.io/name is
+// available as LABEL_APP_KUBERNETES_IO_NAME.
+func labelsEnv(labels map[string]interface{}) K9sEnv {
+	env := make(K9sEnv, len(labels))
+	for k, v := range labels {
+		env["LABEL_"+labelKeyRX.ReplaceAllString(strings.ToUpper(k), "_")] = fmt.Sprintf("%v", v)
+	}
+
+	return env
+}
+
+// linkURL expands a link template using query escaped env values. Only http
+// and https links are allowed.
+func linkURL(env K9sEnv, ns, tpl string) (string, error) {
+	escaped := make(K9sEnv, len(env))
+	for k, v := range env {
+		escaped[k] = url.QueryEscape(v)
+	}
+	u, err := escaped.envFor(ns, tpl)
+	if err != nil {
+		return "", err
+	}
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "", err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", fmt.Errorf("unsupported link scheme %q", parsed.Scheme)
+	}
+
+	return u, nil
+}
@@ -0,0 +1,77 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLinksFor(t *testing.T) {
+	ll := config.NewLinks()
+	ll.Link["grafana"] = config.Link{Description: "Grafana", Scopes: []string{"apps/v1/deployments"}}
+	ll.Link["argo"] = config.Link{Description: "ArgoCD", Scopes: []string{"dp"}}
+	ll.Link["runbook"] = config.Link{Description: "Runbook", Scopes: []string{"all"}}
+	ll.Link["prod"] = config.Link{
+		ContextScope: config.ContextScope{Clusters: []string{"prod"}},
+		Description:  "Prod",
+		Scopes:       []string{"all"},
+	}
+	ll.Link["pods"] = config.Link{Description: "Pods", Scopes: []string{"po"}}
+
+	var dd []string
+	for _, l := range linksFor(ll, []string{"dp", "deployment", "deployments", "apps/v1/deployments"}, "fred", "dev") {
+		dd = append(dd, l.Description)
+	}
+	assert.Equal(t, []string{"ArgoCD", "Grafana", "Runbook"}, dd)
+}
+
+func TestLinkURL(t *testing.T) {
+	env := K9sEnv{
+		"NAMESPACE": "default",
+		"NAME":      "fred",
+		"CLUSTER":   "dev",
+		"COL0":      "fred",
+	}
+	for k, v := range labelsEnv(map[string]interface{}{"app.kubernetes.io/name": "blee zorg", "tier": "web"}) {
+		env[k] = v
+	}
+
+	uu := map[string]struct {
+		tpl, e, err string
+	}{
+		"plain": {
+			tpl: "https://grafana.example.com/d/pods?var-cluster=$CLUSTER&var-ns=$NAMESPACE&var-pod=${NAME}",
+			e:   "https://grafana.example.com/d/pods?var-cluster=dev&var-ns=default&var-pod=fred",
+		},
+		"labels": {
+			tpl: "https://argo.example.com/applications/$LABEL_APP_KUBERNETES_IO_NAME?tier=$LABEL_TIER",
+			e:   "https://argo.example.com/applications/blee+zorg?tier=web",
+		},
+		"column": {
+			tpl: "http://wiki/$COL0",
+			e:   "http://wiki/fred",
+		},
+		"missing": {
+			tpl: "https://wiki/$LABEL_BLEE_DUH",
+			err: `no env vars exists for argument "https://wiki/$LABEL_BLEE_DUH" using key "LABEL_BLEE_DUH"`,
+		},
+		"scheme": {
+			tpl: "file:///etc/$NAME",
+			err: `unsupported link scheme "file"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := linkURL(env, "default", u.tpl)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package view
+
+import (
+	"sort"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	actionsMenuKey = "actionsMenu"
+	listMenuWidth  = 40
+)
+
+// ListMenuFunc represents a list menu selection callback.
+type ListMenuFunc func(index int)
+
+// ShowListMenu pops a menu listing a collection of items.
+func ShowListMenu(app *App, key, title string, items []string, okFn ListMenuFunc) {
+	l := tview.NewList()
+	l.ShowSecondaryText(false)
+	l.SetBorder(true)
+	l.SetTitle(" " + title + " ")
+	l.SetBackgroundColor(app.Styles.BgColor())
+	l.SetMainTextColor(app.Styles.FgColor())
+	l.SetShortcutColor(app.Styles.K9s.Info.FgColor.Color())
+
+	for i, item := range items {
+		i := i
+		l.AddItem(item, "", 0, func() {
+			DismissListMenu(app, key)
+			okFn(i)
+		})
+	}
+	l.SetDoneFunc(func() {
+		DismissListMenu(app, key)
+	})
+
+	pages := app.Content.Pages
+	pages.AddPage(key, centered(l, listMenuWidth, len(items)+2), true, true)
+	pages.ShowPage(key)
+	app.SetFocus(l)
+}
+
+// DismissListMenu dismisses a list menu.
+func DismissListMenu(app *App, key string) {
+	p := app.Content.Pages
+	p.RemovePage(key)
+	app.SetFocus(p.CurrentPage().Item)
+}
+
+// ShowActionsMenu pops a context menu listing the visible actions of a view.
+func ShowActionsMenu(app *App, aa ui.KeyActions) {
+	kk := visibleKeys(aa)
+	items := make([]string, 0, len(kk))
+	for _, k := range kk {
+		items = append(items, "<"+tcell.KeyNames[k]+"> "+aa[k].Description)
+	}
+	ShowListMenu(app, actionsMenuKey, "Actions", items, func(i int) {
+		if err := app.fireKey(kk[i]); err != nil {
+			app.Flash().Err(err)
+		}
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func visibleKeys(aa ui.KeyActions) []tcell.Key {
+	kk := make([]tcell.Key, 0, len(aa))
+	for k, a := range aa {
+		if !a.Visible {
+			continue
+		}
+		if _, ok := tcell.KeyNames[k]; ok {
+			kk = append(kk, k)
+		}
+	}
+	sort.Slice(kk, func(i, j int) bool {
+		return aa[kk[i]].Description < aa[kk[j]].Description
+	})
+
+	return kk
+}
+
+func centered(p tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
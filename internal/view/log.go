@@ -173,6 +173,7 @@ func (l *Log) bindKeys() {
 		ui.KeyS:             ui.NewKeyAction("Toggle AutoScroll", l.ToggleAutoScrollCmd, true),
 		ui.KeyF:             ui.NewKeyAction("FullScreen", l.fullScreenCmd, true),
 		ui.KeyW:             ui.NewKeyAction("Toggle Wrap", l.textWrapCmd, true),
+		ui.KeyX:             ui.NewKeyAction("Copy Kubectl", l.cpKubectlCmd, true),
 		tcell.KeyCtrlS:      ui.NewKeyAction("Save", l.SaveCmd, true),
 		ui.KeySlash:         ui.NewSharedKeyAction("Filter Mode", l.activateCmd, false),
 		tcell.KeyCtrlU:      ui.NewSharedKeyAction("Clear Filter", l.resetCmd, false),
@@ -254,11 +255,22 @@ func (l *Log) filterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if err := l.model.Filter(l.cmdBuff.String()); err != nil {
 		l.app.Flash().Err(err)
 	}
+	l.app.recordStep(filterStep(l.cmdBuff.String()))
 	l.updateTitle()
 
 	return nil
 }
 
+func (l *Log) setFilter(q string) {
+	l.cmdBuff.Set(q)
+	if q == "" {
+		l.model.ClearFilter()
+	} else if err := l.model.Filter(q); err != nil {
+		l.app.Flash().Err(err)
+	}
+	l.updateTitle()
+}
+
 func (l *Log) activateCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if l.app.InCmdMode() {
 		return evt
@@ -294,6 +306,7 @@ func (l *Log) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	l.app.Flash().Info("Clearing filter...")
 	l.cmdBuff.SetActive(false)
 	l.cmdBuff.Reset()
+	l.app.recordStep(filterStep(""))
 	l.updateTitle()
 
 	return nil
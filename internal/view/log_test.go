@@ -34,7 +34,7 @@ func TestLogAutoScroll(t *testing.T) {
 	v.GetModel().Set([]string{"blee", "bozo"})
 	v.GetModel().Notify(true)
 
-	assert.Equal(t, 6, len(v.Hints()))
+	assert.Equal(t, 7, len(v.Hints()))
 
 	v.ToggleAutoScrollCmd(nil)
 	assert.Equal(t, " Autoscroll: Off  FullScreen: Off  Wrap: Off       ", v.Indicator().GetText(true))
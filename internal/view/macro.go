@@ -0,0 +1,233 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	macroPollDelay   = 100 * time.Millisecond
+	macroDataTimeout = 10 * time.Second
+)
+
+// MacroRecorder tracks user commands and filters as macro steps.
+type MacroRecorder struct {
+	name  string
+	steps []config.MacroStep
+}
+
+// NewMacroRecorder returns a new recorder.
+func NewMacroRecorder(name string) *MacroRecorder {
+	return &MacroRecorder{name: name}
+}
+
+// Record adds a new step.
+func (m *MacroRecorder) Record(s config.MacroStep) {
+	m.steps = append(m.steps, s)
+}
+
+// Macro returns the recorded macro.
+func (m *MacroRecorder) Macro() config.Macro {
+	return config.Macro{
+		Description: "Recorded macro " + m.name,
+		Steps:       m.steps,
+	}
+}
+
+// filterer represents a non table view supporting filters.
+type filterer interface {
+	setFilter(q string)
+}
+
+// filterStep returns a step applying a given filter or clearing it.
+func filterStep(q string) config.MacroStep {
+	if q == "" {
+		return config.MacroStep{ClearFilter: true}
+	}
+
+	return config.MacroStep{Filter: q}
+}
+
+// loadMacros loads and caches the user macros. Macros are reloaded by the
+// config reloader whenever the macro file changes.
+func (a *App) loadMacros() error {
+	mm := config.NewMacros()
+	if err := mm.Load(); err != nil {
+		return err
+	}
+	if err := mm.Validate(); err != nil {
+		return err
+	}
+	a.macros = mm
+
+	return nil
+}
+
+func (a *App) macro(name string) (config.Macro, bool) {
+	m, ok := a.macros.Macro[name]
+
+	return m, ok
+}
+
+func isRecordCmd(cmd string) bool {
+	switch strings.Split(cmd, " ")[0] {
+	case "rec", "record":
+		return true
+	default:
+		return false
+	}
+}
+
+func (a *App) recordCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	if a.recorder == nil {
+		if len(tokens) < 2 {
+			return errors.New("You must specify a macro name")
+		}
+		a.recorder = NewMacroRecorder(tokens[1])
+		a.Flash().Infof("Recording macro %s...", tokens[1])
+		return nil
+	}
+
+	rec := a.recorder
+	a.recorder = nil
+	m := rec.Macro()
+	if err := m.Validate(); err != nil {
+		return fmt.Errorf("macro %s not saved -- %s", rec.name, err)
+	}
+	mm, err := config.UserMacros(config.K9sMacros)
+	if err != nil {
+		return err
+	}
+	mm.Macro[rec.name] = m
+	if err := mm.Save(); err != nil {
+		return err
+	}
+	a.macros.Macro[rec.name] = m
+	a.Flash().Infof("Macro %s saved with %d steps", rec.name, len(m.Steps))
+
+	return nil
+}
+
+func (a *App) recordStep(s config.MacroStep) {
+	if a.recorder == nil || s.Validate() != nil {
+		return
+	}
+	if s.Command != "" {
+		if isRecordCmd(s.Command) {
+			return
+		}
+		if _, ok := a.macro(strings.Split(s.Command, " ")[0]); ok {
+			return
+		}
+	}
+	a.recorder.Record(s)
+}
+
+func (a *App) runMacro(name string, m config.Macro) error {
+	if err := m.Validate(); err != nil {
+		return fmt.Errorf("invalid macro %s -- %s", name, err)
+	}
+
+	a.Flash().Infof("Running macro %s...", name)
+	go func() {
+		for i, s := range m.Steps {
+			if err := a.runMacroStep(s); err != nil {
+				log.Error().Err(err).Msgf("Macro %s step #%d failed", name, i+1)
+				a.QueueUpdateDraw(func() {
+					a.Flash().Errf("Macro %s step #%d failed -- %s", name, i+1, err)
+				})
+				return
+			}
+		}
+		a.QueueUpdateDraw(func() {
+			a.Flash().Infof("Macro %s completed", name)
+		})
+	}()
+
+	return nil
+}
+
+func (a *App) runMacroStep(s config.MacroStep) error {
+	switch {
+	case s.Command != "":
+		return a.queueStep(func() error {
+			if _, ok := a.macro(strings.Split(s.Command, " ")[0]); ok {
+				return errors.New("nested macros are not supported")
+			}
+			return a.gotoResource(s.Command, "", true)
+		})
+	case s.Filter != "", s.ClearFilter:
+		return a.queueStep(func() error {
+			a.filterTop(s.Filter)
+			return nil
+		})
+	default:
+		key, err := asKey(s.Key)
+		if err != nil {
+			return err
+		}
+		if err := a.waitForData(); err != nil {
+			return err
+		}
+		return a.queueStep(func() error {
+			return a.fireKey(key)
+		})
+	}
+}
+
+// queueStep runs a macro step on the event loop and waits for its completion.
+func (a *App) queueStep(f func() error) error {
+	errc := make(chan error, 1)
+	a.QueueUpdateDraw(func() {
+		errc <- f()
+	})
+
+	return <-errc
+}
+
+// waitForData waits for the top table view to have rows.
+func (a *App) waitForData() error {
+	for start := time.Now(); time.Since(start) < macroDataTimeout; time.Sleep(macroPollDelay) {
+		ready := make(chan bool, 1)
+		a.QueueUpdate(func() {
+			v, ok := a.Content.Top().(TableViewer)
+			ready <- !ok || v.GetTable().GetRowCount() > 1
+		})
+		if <-ready {
+			return nil
+		}
+	}
+
+	return errors.New("timed out waiting for view data")
+}
+
+// fireKey triggers the top view action bound to a given key.
+func (a *App) fireKey(key tcell.Key) error {
+	v, ok := a.Content.Top().(Viewer)
+	if !ok {
+		return errors.New("no active view")
+	}
+	act, ok := v.Actions()[key]
+	if !ok {
+		return fmt.Errorf("no action bound to key %s", tcell.KeyNames[key])
+	}
+	act.Action(keyEvent(key))
+
+	return nil
+}
+
+func keyEvent(key tcell.Key) *tcell.EventKey {
+	if key >= ui.KeySpace && key < tcell.KeyRune && key != tcell.KeyDEL {
+		return tcell.NewEventKey(tcell.KeyRune, rune(key), tcell.ModNone)
+	}
+
+	return tcell.NewEventKey(key, 0, tcell.ModNone)
+}
@@ -0,0 +1,32 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterStep(t *testing.T) {
+	assert.Equal(t, config.MacroStep{Filter: "api"}, filterStep("api"))
+	assert.Equal(t, config.MacroStep{ClearFilter: true}, filterStep(""))
+}
+
+func TestRecordStep(t *testing.T) {
+	a := NewApp(config.NewConfig(ks{}))
+	a.macros.Macro["incident"] = config.Macro{Steps: []config.MacroStep{{Command: "po"}}}
+	a.recorder = NewMacroRecorder("fred")
+
+	a.recordStep(config.MacroStep{Command: "dp"})
+	a.recordStep(filterStep("api"))
+	a.recordStep(filterStep(""))
+	a.recordStep(config.MacroStep{Command: "incident"})
+	a.recordStep(config.MacroStep{Command: "record"})
+	a.recordStep(config.MacroStep{})
+
+	assert.Equal(t, []config.MacroStep{
+		{Command: "dp"},
+		{Filter: "api"},
+		{ClearFilter: true},
+	}, a.recorder.Macro().Steps)
+}
@@ -0,0 +1,171 @@
+package view
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/gdamore/tcell"
+)
+
+const marksMenuKey = "marks"
+
+// Mark tracks a marked location, ie a view along with its selected row.
+// Rows are tracked by object path so marks survive filters and refreshes.
+type Mark struct {
+	Context string
+	Entry   NavEntry
+}
+
+// Title returns a mark summary.
+func (m Mark) Title() string {
+	return m.Context + " " + m.Entry.Title()
+}
+
+// Marks tracks marked locations by letter.
+type Marks struct {
+	marks map[rune]Mark
+}
+
+// NewMarks returns a new marks tracker.
+func NewMarks() *Marks {
+	return &Marks{marks: make(map[rune]Mark)}
+}
+
+// IsMarkKey checks if a key may name a mark, ie a letter.
+func IsMarkKey(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+// Set marks a location, overriding any previous location for that letter.
+func (m *Marks) Set(r rune, mk Mark) error {
+	if !IsMarkKey(r) {
+		return fmt.Errorf("invalid mark %q. Expecting a letter", r)
+	}
+	m.marks[r] = mk
+
+	return nil
+}
+
+// Get returns a marked location.
+func (m *Marks) Get(r rune) (Mark, bool) {
+	mk, ok := m.marks[r]
+
+	return mk, ok
+}
+
+// Keys returns the set marks in alphabetical order.
+func (m *Marks) Keys() []rune {
+	rr := make([]rune, 0, len(m.marks))
+	for r := range m.marks {
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i] < rr[j]
+	})
+
+	return rr
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// setMarkCmd marks the current location with the next letter typed.
+func (a *App) setMarkCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() || a.inFilterMode() {
+		return evt
+	}
+	e, ok := a.navEntry(a.Content.Top())
+	if !ok {
+		a.Flash().Warn("Current view can not be marked")
+		return nil
+	}
+	a.Flash().Info("Mark: press a letter...")
+	a.AwaitKey(func(evt *tcell.EventKey) {
+		if evt.Key() != tcell.KeyRune {
+			a.Flash().Info("Mark canceled")
+			return
+		}
+		if err := a.marks.Set(evt.Rune(), Mark{Context: a.Config.K9s.CurrentContext, Entry: e}); err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.Flash().Infof("Mark %c set on %s", evt.Rune(), e.Title())
+	})
+
+	return nil
+}
+
+// jumpMarkCmd jumps to the location marked with the next letter typed.
+func (a *App) jumpMarkCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() || a.inFilterMode() {
+		return evt
+	}
+	if len(a.marks.Keys()) == 0 {
+		a.Flash().Info("No marks set. Use m+letter to set one")
+		return nil
+	}
+	a.Flash().Info("Jump to mark: press a letter...")
+	a.AwaitKey(func(evt *tcell.EventKey) {
+		if evt.Key() != tcell.KeyRune {
+			a.Flash().Info("Jump canceled")
+			return
+		}
+		if err := a.jumpMark(evt.Rune()); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+
+	return nil
+}
+
+// marksCmd pops a picker listing the set marks.
+func (a *App) marksCmd() {
+	rr := a.marks.Keys()
+	if len(rr) == 0 {
+		a.Flash().Info("No marks set. Use m+letter to set one")
+		return
+	}
+	items := make([]string, 0, len(rr))
+	for _, r := range rr {
+		mk, _ := a.marks.Get(r)
+		items = append(items, fmt.Sprintf("%c: %s", r, mk.Title()))
+	}
+	ShowListMenu(a, marksMenuKey, "Marks", items, func(i int) {
+		if err := a.jumpMark(rr[i]); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+}
+
+// jumpMark restores a marked location. Marked rows are selected in place when
+// the view is already shown, clearing the filter should the row be hidden.
+func (a *App) jumpMark(r rune) error {
+	mk, ok := a.marks.Get(r)
+	if !ok {
+		return fmt.Errorf("mark %c is not set", r)
+	}
+	if mk.Context != "" && mk.Context != a.Config.K9s.CurrentContext {
+		if err := useContext(a, mk.Context); err != nil {
+			return err
+		}
+	}
+
+	sel := mk.Entry.View.Selection
+	cur, ok := a.navEntry(a.Content.Top())
+	v, isTable := a.Content.Top().(TableViewer)
+	if !ok || !isTable || !cur.sameLocation(mk.Entry) || cur.Namespace != mk.Entry.Namespace || sel == "" {
+		a.snapshotNav()
+		a.navigateTo(mk.Entry)
+		return nil
+	}
+
+	if v.GetTable().SelectItem(sel) {
+		return nil
+	}
+	a.applyFilter(v, "")
+	if !v.GetTable().SelectItem(sel) {
+		return fmt.Errorf("mark %c -- %s is no longer listed", r, sel)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarksSet(t *testing.T) {
+	m := NewMarks()
+	assert.Equal(t, []rune{}, m.Keys())
+
+	assert.Nil(t, m.Set('b', Mark{Context: "c1", Entry: navLoc("v1/pods", "")}))
+	assert.Nil(t, m.Set('A', Mark{Context: "c1", Entry: navLoc("v1/services", "")}))
+	assert.Nil(t, m.Set('b', Mark{Context: "c2", Entry: navLoc("apps/v1/deployments", "")}))
+	assert.Error(t, m.Set('1', Mark{}))
+	assert.Equal(t, []rune{'A', 'b'}, m.Keys())
+
+	mk, ok := m.Get('b')
+	assert.True(t, ok)
+	assert.Equal(t, "c2", mk.Context)
+	assert.Equal(t, "apps/v1/deployments", mk.Entry.View.GVR)
+
+	_, ok = m.Get('z')
+	assert.False(t, ok)
+}
+
+func TestIsMarkKey(t *testing.T) {
+	uu := map[string]struct {
+		r rune
+		e bool
+	}{
+		"lower": {r: 'a', e: true},
+		"upper": {r: 'Z', e: true},
+		"digit": {r: '1'},
+		"quote": {r: '\''},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, IsMarkKey(u.r))
+		})
+	}
+}
+
+func TestMarkTitle(t *testing.T) {
+	mk := Mark{
+		Context: "c1",
+		Entry: NavEntry{
+			Namespace: "default",
+			View:      config.SessionView{GVR: "v1/pods", Selection: "default/p1"},
+		},
+	}
+
+	assert.Equal(t, "c1 v1/pods (default) ► default/p1", mk.Title())
+}
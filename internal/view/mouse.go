@@ -0,0 +1,208 @@
+package view
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	doubleClickDelay = 400 * time.Millisecond
+	wheelScrollLines = 3
+)
+
+type mouseState struct {
+	buttons   tcell.ButtonMask
+	lastClick time.Time
+	lastRow   int
+}
+
+// initMouse enables mouse support if configured.
+func (a *App) initMouse() error {
+	if !a.Config.K9s.MouseConfig().Enable {
+		return nil
+	}
+	s, err := tcell.NewScreen()
+	if err != nil {
+		return err
+	}
+	a.SetScreen(ui.NewMouseScreen(s, func(evt *tcell.EventMouse) bool {
+		model.Pace.Touch()
+		handled := make(chan bool, 1)
+		a.QueueUpdateDraw(func() {
+			handled <- a.mouseEvent(evt)
+		})
+		return <-handled
+	}))
+
+	return nil
+}
+
+// mouseEvent handles a mouse event and reports whether it got consumed.
+func (a *App) mouseEvent(evt *tcell.EventMouse) bool {
+	if a.dialogShown() {
+		return false
+	}
+
+	cfg := a.Config.K9s.MouseConfig()
+	btns, prev := evt.Buttons(), a.mouse.buttons
+	a.mouse.buttons = btns & (tcell.Button1 | tcell.Button2 | tcell.Button3)
+	x, y := evt.Position()
+	top := a.Content.Top()
+
+	switch {
+	case btns&tcell.WheelUp != 0:
+		return a.mouseWheel(top, cfg, -1)
+	case btns&tcell.WheelDown != 0:
+		return a.mouseWheel(top, cfg, 1)
+	case btns&tcell.Button1 != 0 && prev&tcell.Button1 == 0:
+		return a.mouseClick(top, cfg, x, y)
+	case btns&tcell.Button3 != 0 && prev&tcell.Button3 == 0:
+		if _, ok := top.(TableViewer); ok {
+			return a.mouseTableAction(top, cfg.Table.RightClick, x, y)
+		}
+		return a.mouseTextAction(top, cfg.Logs.RightClick, x, y)
+	default:
+		return false
+	}
+}
+
+func (a *App) mouseClick(top model.Component, cfg *config.Mouse, x, y int) bool {
+	bindings, action := cfg.Logs, a.mouseTextAction
+	row := y
+	if _, ok := top.(TableViewer); ok {
+		var t *Table
+		if t, row = tableRowAt(top, x, y); t == nil {
+			return false
+		}
+		bindings, action = cfg.Table, a.mouseTableAction
+	}
+
+	behavior := bindings.Click
+	if row == a.mouse.lastRow && time.Since(a.mouse.lastClick) < doubleClickDelay {
+		behavior = bindings.DoubleClick
+		a.mouse.lastClick = time.Time{}
+	} else {
+		a.mouse.lastClick, a.mouse.lastRow = time.Now(), row
+	}
+
+	return action(top, behavior, x, y)
+}
+
+func (a *App) mouseTableAction(top model.Component, behavior string, x, y int) bool {
+	t, row := tableRowAt(top, x, y)
+	if t == nil || behavior == config.MouseNone {
+		return false
+	}
+
+	t.Select(row, 0)
+	switch behavior {
+	case config.MouseDrill:
+		if err := a.fireKey(tcell.KeyEnter); err != nil {
+			log.Debug().Err(err).Msg("Mouse drill down failed")
+		}
+	case config.MouseMenu:
+		if v, ok := top.(Viewer); ok {
+			ShowActionsMenu(a, v.Actions())
+		}
+	}
+
+	return true
+}
+
+func (a *App) mouseTextAction(top model.Component, behavior string, x, y int) bool {
+	d := textDetails(top)
+	if d == nil || !ui.InRect(d, x, y) || behavior != config.MouseMenu {
+		return false
+	}
+	v, ok := top.(Viewer)
+	if !ok {
+		return false
+	}
+	ShowActionsMenu(a, v.Actions())
+
+	return true
+}
+
+func (a *App) mouseWheel(top model.Component, cfg *config.Mouse, delta int) bool {
+	if v, ok := top.(TableViewer); ok {
+		t := v.GetTable()
+		switch cfg.Table.Wheel {
+		case config.MouseSelect:
+			row, _ := t.GetSelection()
+			if row+delta > 0 && row+delta < t.GetRowCount() {
+				t.Select(row+delta, 0)
+			}
+		case config.MouseScroll:
+			row, col := t.GetOffset()
+			if row+delta >= 0 {
+				t.SetOffset(row+delta, col)
+			}
+		default:
+			return false
+		}
+		return true
+	}
+
+	d := textDetails(top)
+	if d == nil || cfg.Logs.Wheel != config.MouseScroll {
+		return false
+	}
+	row, col := d.GetScrollOffset()
+	if row += delta * wheelScrollLines; row < 0 {
+		row = 0
+	}
+	d.ScrollTo(row, col)
+
+	return true
+}
+
+// textDetails returns the text area of a text view if any.
+func textDetails(top model.Component) *Details {
+	switch v := top.(type) {
+	case *Log:
+		return v.Logs()
+	case *Details:
+		return v
+	case *PluginOutput:
+		return v.Details
+	case *Describe:
+		return v.Details
+	case *YAML:
+		return v.Details
+	default:
+		return nil
+	}
+}
+
+// dialogShown checks if a dialog currently sits on top of the views.
+func (a *App) dialogShown() bool {
+	p := a.Content.CurrentPage()
+	if p == nil {
+		return false
+	}
+	_, ok := p.Item.(model.Component)
+
+	return !ok
+}
+
+func tableRowAt(top model.Component, x, y int) (*Table, int) {
+	v, ok := top.(TableViewer)
+	if !ok {
+		return nil, -1
+	}
+	t := v.GetTable()
+	if !ui.InRect(t, x, y) {
+		return nil, -1
+	}
+	row := ui.TableRowAt(t.SelectTable.Table, 1, y)
+	if row < 1 {
+		return nil, -1
+	}
+
+	return t, row
+}
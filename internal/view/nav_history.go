@@ -0,0 +1,271 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	navMaxHistory = 50
+	navMenuKey    = "navHistory"
+	navCurrent    = "► "
+)
+
+// NavEntry tracks a visited location.
+type NavEntry struct {
+	Namespace string
+	View      config.SessionView
+}
+
+// Title returns a location summary, ie pods (default) /fred ► default/p1.
+func (e NavEntry) Title() string {
+	s := e.View.GVR
+	if e.View.Logs != nil {
+		s = "logs"
+	}
+	if e.Namespace != "" {
+		s += " (" + e.Namespace + ")"
+	}
+	if e.View.Path != "" {
+		s += " " + e.View.Path
+	}
+	if e.View.Filter != "" {
+		s += " /" + e.View.Filter
+	}
+	if e.View.Selection != "" {
+		s += " " + navCurrent + e.View.Selection
+	}
+
+	return s
+}
+
+// sameLocation checks if two entries point to the same view.
+func (e NavEntry) sameLocation(o NavEntry) bool {
+	return e.View.GVR == o.View.GVR && e.View.Path == o.View.Path && (e.View.Logs == nil) == (o.View.Logs == nil)
+}
+
+// NavHistory tracks visited locations, browser style.
+type NavHistory struct {
+	entries []NavEntry
+	index   int
+}
+
+// NewNavHistory returns a new navigation history.
+func NewNavHistory() *NavHistory {
+	return &NavHistory{index: -1}
+}
+
+// Record adds a visited location, dropping the locations ahead of the
+// current one. Revisiting the current location updates it.
+func (h *NavHistory) Record(e NavEntry) {
+	if c, ok := h.Current(); ok && c.sameLocation(e) {
+		h.entries[h.index] = e
+		return
+	}
+	h.entries = append(h.entries[:h.index+1], e)
+	if len(h.entries) > navMaxHistory {
+		h.entries = h.entries[len(h.entries)-navMaxHistory:]
+	}
+	h.index = len(h.entries) - 1
+}
+
+// Update refreshes the state of the current location.
+func (h *NavHistory) Update(e NavEntry) {
+	if c, ok := h.Current(); ok && c.sameLocation(e) {
+		h.entries[h.index] = e
+	}
+}
+
+// Current returns the current location.
+func (h *NavHistory) Current() (NavEntry, bool) {
+	if h.index < 0 || h.index >= len(h.entries) {
+		return NavEntry{}, false
+	}
+
+	return h.entries[h.index], true
+}
+
+// Back moves to the previous location.
+func (h *NavHistory) Back() (NavEntry, bool) {
+	return h.Goto(h.index - 1)
+}
+
+// Forward moves to the next location.
+func (h *NavHistory) Forward() (NavEntry, bool) {
+	return h.Goto(h.index + 1)
+}
+
+// Goto moves to a given location.
+func (h *NavHistory) Goto(i int) (NavEntry, bool) {
+	if i < 0 || i >= len(h.entries) {
+		return NavEntry{}, false
+	}
+	h.index = i
+
+	return h.entries[i], true
+}
+
+// Entries returns all locations, oldest first, and the current location index.
+func (h *NavHistory) Entries() ([]NavEntry, int) {
+	return h.entries, h.index
+}
+
+// Clear drops all locations.
+func (h *NavHistory) Clear() {
+	h.entries, h.index = nil, -1
+}
+
+// ----------------------------------------------------------------------------
+
+// navRecorder records the navigation history as views get pushed and popped.
+type navRecorder struct {
+	app        *App
+	pending    bool
+	navigating bool
+}
+
+var _ model.StackListener = (*navRecorder)(nil)
+
+// StackPushed notifies a new view was pushed.
+func (r *navRecorder) StackPushed(model.Component) {
+	r.changed(r.app.Content.Stack.Previous())
+}
+
+// StackPopped notifies a view was popped.
+func (r *navRecorder) StackPopped(old, _ model.Component) {
+	r.changed(old)
+}
+
+// StackTop notifies the top view.
+func (*navRecorder) StackTop(model.Component) {}
+
+// changed snapshots the view being left and records the new top view once the
+// stack settles, so clearing the stack prior to a push records a single location.
+func (r *navRecorder) changed(left model.Component) {
+	if r.navigating || r.pending {
+		return
+	}
+	if e, ok := r.app.navEntry(left); ok {
+		r.app.navHistory.Update(e)
+	}
+	r.pending = true
+	go r.app.QueueUpdate(func() {
+		r.pending = false
+		if e, ok := r.app.navEntry(r.app.Content.Top()); ok {
+			r.app.navHistory.Record(e)
+		}
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (a *App) initNavHistory() {
+	a.navRecorder = &navRecorder{app: a}
+	a.Content.Stack.AddListener(a.navRecorder)
+}
+
+// navEntry snapshots a view location.
+func (a *App) navEntry(c model.Component) (NavEntry, bool) {
+	if c == nil {
+		return NavEntry{}, false
+	}
+	v, ok := sessionView(c)
+	if !ok {
+		return NavEntry{}, false
+	}
+
+	return NavEntry{Namespace: a.Config.ActiveNamespace(), View: v}, true
+}
+
+func (a *App) navBackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() || a.inFilterMode() {
+		return evt
+	}
+	a.snapshotNav()
+	e, ok := a.navHistory.Back()
+	if !ok {
+		a.Flash().Info("No previous location")
+		return nil
+	}
+	a.navigateTo(e)
+
+	return nil
+}
+
+func (a *App) navForwardCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() || a.inFilterMode() {
+		return evt
+	}
+	a.snapshotNav()
+	e, ok := a.navHistory.Forward()
+	if !ok {
+		a.Flash().Info("No next location")
+		return nil
+	}
+	a.navigateTo(e)
+
+	return nil
+}
+
+// navHistoryCmd pops a picker listing visited locations, most recent first.
+func (a *App) navHistoryCmd() {
+	a.snapshotNav()
+	ee, current := a.navHistory.Entries()
+	if len(ee) == 0 {
+		a.Flash().Info("No navigation history")
+		return
+	}
+
+	items := make([]string, 0, len(ee))
+	for i := len(ee) - 1; i >= 0; i-- {
+		prefix := "  "
+		if i == current {
+			prefix = navCurrent
+		}
+		items = append(items, prefix+ee[i].Title())
+	}
+	ShowListMenu(a, navMenuKey, "Navigation History", items, func(i int) {
+		if e, ok := a.navHistory.Goto(len(ee) - 1 - i); ok {
+			a.navigateTo(e)
+		}
+	})
+}
+
+// inFilterMode checks if the top view is capturing a filter.
+func (a *App) inFilterMode() bool {
+	switch v := a.Content.Top().(type) {
+	case TableViewer:
+		return v.GetTable().SearchBuff().IsActive()
+	case *Log:
+		return v.cmdBuff.IsActive()
+	case *Details:
+		return v.cmdBuff.IsActive()
+	case *Help:
+		return v.SearchBuff().IsActive()
+	default:
+		return false
+	}
+}
+
+// snapshotNav refreshes the current location state prior to leaving it.
+func (a *App) snapshotNav() {
+	if e, ok := a.navEntry(a.Content.Top()); ok {
+		a.navHistory.Update(e)
+	}
+}
+
+// navigateTo restores a visited location.
+func (a *App) navigateTo(e NavEntry) {
+	a.navRecorder.navigating = true
+	defer func() { a.navRecorder.navigating = false }()
+
+	if e.Namespace != "" && e.Namespace != a.Config.ActiveNamespace() {
+		a.switchNS(e.Namespace)
+	}
+	a.Content.Stack.Clear()
+	if err := a.restoreView(e.View); err != nil {
+		a.Flash().Err(err)
+	}
+}
@@ -0,0 +1,116 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNavHistoryRecord(t *testing.T) {
+	h := NewNavHistory()
+	_, ok := h.Current()
+	assert.False(t, ok)
+
+	h.Record(navLoc("v1/pods", ""))
+	h.Record(navLoc("apps/v1/deployments", ""))
+	h.Record(navLoc("apps/v1/deployments", "fred"))
+	ee, i := h.Entries()
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, 1, i)
+	assert.Equal(t, "fred", ee[1].View.Filter)
+
+	e, ok := h.Back()
+	assert.True(t, ok)
+	assert.Equal(t, "v1/pods", e.View.GVR)
+	_, ok = h.Back()
+	assert.False(t, ok)
+
+	e, ok = h.Forward()
+	assert.True(t, ok)
+	assert.Equal(t, "apps/v1/deployments", e.View.GVR)
+	_, ok = h.Forward()
+	assert.False(t, ok)
+
+	h.Back()
+	h.Record(navLoc("v1/services", ""))
+	ee, i = h.Entries()
+	assert.Equal(t, []string{"v1/pods", "v1/services"}, navGVRs(ee))
+	assert.Equal(t, 1, i)
+}
+
+func TestNavHistoryUpdate(t *testing.T) {
+	h := NewNavHistory()
+	h.Record(navLoc("v1/pods", ""))
+	h.Update(navLoc("v1/services", "blee"))
+	h.Update(navLoc("v1/pods", "fred"))
+
+	e, ok := h.Current()
+	assert.True(t, ok)
+	assert.Equal(t, "v1/pods", e.View.GVR)
+	assert.Equal(t, "fred", e.View.Filter)
+}
+
+func TestNavHistoryMax(t *testing.T) {
+	h := NewNavHistory()
+	for i := 0; i < navMaxHistory+10; i++ {
+		h.Record(navLoc("v1/pods", "")) // same location gets updated
+		h.Record(navLoc("v1/services", ""))
+	}
+	ee, i := h.Entries()
+	assert.Equal(t, navMaxHistory, len(ee))
+	assert.Equal(t, navMaxHistory-1, i)
+
+	h.Clear()
+	_, ok := h.Current()
+	assert.False(t, ok)
+}
+
+func TestNavEntryTitle(t *testing.T) {
+	uu := map[string]struct {
+		e NavEntry
+		t string
+	}{
+		"plain": {
+			e: NavEntry{View: config.SessionView{GVR: "v1/pods"}},
+			t: "v1/pods",
+		},
+		"full": {
+			e: NavEntry{
+				Namespace: "default",
+				View:      config.SessionView{GVR: "v1/pods", Path: "default/dp1", Filter: "fred", Selection: "default/p1"},
+			},
+			t: "v1/pods (default) default/dp1 /fred ► default/p1",
+		},
+		"logs": {
+			e: NavEntry{
+				Namespace: "default",
+				View:      config.SessionView{GVR: "v1/pods", Path: "default/p1", Logs: &config.SessionLogs{}},
+			},
+			t: "logs (default) default/p1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.t, u.e.Title())
+		})
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func navLoc(gvr, filter string) NavEntry {
+	return NavEntry{Namespace: "default", View: config.SessionView{GVR: gvr, Filter: filter}}
+}
+
+func navGVRs(ee []NavEntry) []string {
+	ss := make([]string, 0, len(ee))
+	for _, e := range ee {
+		ss = append(ss, e.View.GVR)
+	}
+
+	return ss
+}
@@ -0,0 +1,202 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	newMenuKey = "newMenu"
+	// newDefaultNS tracks the namespace of new resources when viewing all namespaces.
+	newDefaultNS = "default"
+)
+
+// newCmd creates a resource from a template, ie new deploy.
+func (a *App) newCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	if len(tokens) != 2 {
+		return fmt.Errorf("invalid new command %q. Expecting new kind", cmd)
+	}
+	k9s := a.Config.K9s
+	if k9s.IsBlocked(config.VerbCreate) {
+		return fmt.Errorf("Action %s is blocked on context %s", config.VerbCreate, k9s.CurrentContext)
+	}
+	gvr, ok := a.command.alias.AsGVR(tokens[1])
+	if !ok {
+		return fmt.Errorf("Huh? `%s` resource not found", tokens[1])
+	}
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return err
+	}
+	if !dao.IsK8sMeta(meta) || !config.InList(meta.Verbs, client.CreateVerb) {
+		return fmt.Errorf("%s can not be created", gvr)
+	}
+
+	tt := config.NewTemplates()
+	if err := tt.Load(); err != nil {
+		log.Warn().Err(err).Msg("Unable to load templates")
+	}
+	aliases := append([]string{gvr.String(), meta.Name, meta.SingularName}, meta.ShortNames...)
+	templates := templatesFor(tt, aliases, k9s.CurrentContext, k9s.CurrentCluster)
+	if len(templates) == 0 {
+		if !client.IsBuiltIn(gvr) && a.showCRForm(gvr, meta) {
+			return nil
+		}
+		templates = append(templates, config.SkeletonTemplate(gvr.GV().String(), meta.Kind, meta.Namespaced))
+	}
+	if len(templates) == 1 {
+		a.createFromTemplate(gvr, meta, templates[0])
+		return nil
+	}
+
+	items := make([]string, 0, len(templates))
+	for _, t := range templates {
+		items = append(items, t.Description)
+	}
+	ShowListMenu(a, newMenuKey, "New "+meta.Kind, items, func(i int) {
+		a.createFromTemplate(gvr, meta, templates[i])
+	})
+
+	return nil
+}
+
+// createFromTemplate opens a rendered template in the editor and creates the
+// resulting resource.
+func (a *App) createFromTemplate(gvr client.GVR, meta metav1.APIResource, t config.Template) {
+	a.createFromManifest(gvr, meta, t.Render(a.templateVars(meta)))
+}
+
+// createFromManifest opens a manifest in the editor and creates the resulting resource.
+func (a *App) createFromManifest(gvr client.GVR, meta metav1.APIResource, manifest string) {
+	raw, err := editTemp(a, "k9s-new-*.yml", manifest)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	if strings.TrimSpace(string(raw)) == "" {
+		a.Flash().Info("Create canceled")
+		return
+	}
+	o, err := manifestFor(raw, meta.Kind)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	a.createObject(gvr, meta, o)
+}
+
+// createObject creates a resource once a server side dry run succeeds and is confirmed.
+func (a *App) createObject(gvr client.GVR, meta metav1.APIResource, o *unstructured.Unstructured) {
+	ns, path := client.ClusterScope, o.GetName()
+	if meta.Namespaced {
+		if o.GetNamespace() == "" {
+			o.SetNamespace(a.newNamespace())
+		}
+		ns = o.GetNamespace()
+		path = client.FQN(ns, o.GetName())
+	}
+
+	var g dao.Generic
+	g.Init(a.factory, gvr)
+	if _, err := g.Create(ns, o, true); err != nil {
+		a.Flash().Errf("Create dry run failed -- %s", err)
+		return
+	}
+
+	msg := fmt.Sprintf("Create %s %s on context %s?", meta.Kind, path, a.Config.K9s.CurrentContext)
+	dialog.ShowConfirm(a.Content.Pages, "Confirm Create", msg, func() {
+		_, err := g.Create(ns, o, false)
+		a.audit(config.VerbCreate, gvr.String(), path, err)
+		if err != nil {
+			a.Flash().Errf("Create failed -- %s", err)
+			return
+		}
+		a.Flash().Infof("%s %s created successfully", meta.Kind, path)
+	}, func() {})
+}
+
+// newNamespace returns the namespace of new resources.
+func (a *App) newNamespace() string {
+	ns := a.Config.ActiveNamespace()
+	if client.IsAllNamespaces(ns) {
+		return newDefaultNS
+	}
+
+	return ns
+}
+
+// templateVars returns the placeholders values available to templates.
+func (a *App) templateVars(meta metav1.APIResource) map[string]string {
+	name := meta.SingularName
+	if name == "" {
+		name = strings.ToLower(meta.Kind)
+	}
+	vars := map[string]string{
+		"NAME":      "new-" + name,
+		"NAMESPACE": a.newNamespace(),
+		"CONTEXT":   a.Config.K9s.CurrentContext,
+		"CLUSTER":   a.Config.K9s.CurrentCluster,
+	}
+	if a.Conn() != nil {
+		if user, err := a.Conn().Config().CurrentUserName(); err == nil {
+			vars["USER"] = user
+		}
+	}
+
+	return vars
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// templatesFor returns the templates applicable to a resource sorted by description.
+func templatesFor(tt config.Templates, aliases []string, ctx, cluster string) []config.Template {
+	templates := make([]config.Template, 0, len(tt.Template))
+	for k, t := range tt.Template {
+		if !inScope(t.Scopes, aliases) || !t.InContext(ctx, cluster) {
+			continue
+		}
+		if err := t.Validate(); err != nil {
+			log.Warn().Err(err).Msgf("Invalid template %q", k)
+			continue
+		}
+		templates = append(templates, t)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Description < templates[j].Description
+	})
+
+	return templates
+}
+
+// manifestFor converts an edited manifest to a resource of the given kind.
+func manifestFor(raw []byte, kind string) (*unstructured.Unstructured, error) {
+	data, err := yaml.YAMLToJSON(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid manifest -- %s", err)
+	}
+	var o unstructured.Unstructured
+	if err := o.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("invalid manifest -- %s", err)
+	}
+	if o.GetKind() != kind {
+		return nil, fmt.Errorf("expecting a %s manifest but got %q", kind, o.GetKind())
+	}
+	if o.GetName() == "" && o.GetGenerateName() == "" {
+		return nil, errors.New("invalid manifest -- no name specified")
+	}
+
+	return &o, nil
+}
@@ -0,0 +1,71 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplatesFor(t *testing.T) {
+	tt := config.NewTemplates()
+	tt.Template["web"] = config.Template{Description: "Web", Scopes: []string{"dp"}, Manifest: "kind: Deployment"}
+	tt.Template["api"] = config.Template{Description: "Api", Scopes: []string{"apps/v1/deployments"}, Manifest: "kind: Deployment"}
+	tt.Template["empty"] = config.Template{Description: "Empty", Scopes: []string{"dp"}}
+	tt.Template["prod"] = config.Template{
+		ContextScope: config.ContextScope{Contexts: []string{"prod"}},
+		Description:  "Prod",
+		Scopes:       []string{"dp"},
+		Manifest:     "kind: Deployment",
+	}
+	tt.Template["pod"] = config.Template{Description: "Pod", Scopes: []string{"po"}, Manifest: "kind: Pod"}
+
+	var dd []string
+	for _, t := range templatesFor(tt, []string{"apps/v1/deployments", "deployments", "deployment", "dp"}, "fred", "dev") {
+		dd = append(dd, t.Description)
+	}
+	assert.Equal(t, []string{"Api", "Web"}, dd)
+}
+
+func TestManifestFor(t *testing.T) {
+	uu := map[string]struct {
+		raw, kind, ns, name string
+		err                 string
+	}{
+		"namespaced": {
+			raw:  "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: fred\n  namespace: blee\n",
+			kind: "ConfigMap",
+			ns:   "blee",
+			name: "fred",
+		},
+		"noNamespace": {
+			raw:  "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: fred\n",
+			kind: "Namespace",
+			name: "fred",
+		},
+		"wrongKind": {
+			raw:  "apiVersion: v1\nkind: Secret\nmetadata:\n  name: fred\n",
+			kind: "ConfigMap",
+			err:  `expecting a ConfigMap manifest but got "Secret"`,
+		},
+		"noName": {
+			raw:  "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  namespace: blee\n",
+			kind: "ConfigMap",
+			err:  "invalid manifest -- no name specified",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o, err := manifestFor([]byte(u.raw), u.kind)
+			if u.err != "" {
+				assert.Equal(t, u.err, err.Error())
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.ns, o.GetNamespace())
+			assert.Equal(t, u.name, o.GetName())
+		})
+	}
+}
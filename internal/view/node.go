@@ -1,13 +1,26 @@
 package view
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/fatih/color"
 	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	nodeShellWait    = 1 * time.Second
+	nodeShellTimeout = 60 * time.Second
+	nodeBannerFmt    = "<<K9s-Shell>> Node: %s | Pod: %s \n"
+)
+
 // Node represents a node view.
 type Node struct {
 	ResourceViewer
@@ -24,8 +37,18 @@ func NewNode(gvr client.GVR) ResourceViewer {
 	return &n
 }
 
+func (n *Node) bindDangerousKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyS: ui.NewKeyAction("Shell", guardCmd(n, config.VerbShell, n.GetTable().GetSelectedItems, n.shellCmd), true),
+	})
+}
+
 func (n *Node) bindKeys(aa ui.KeyActions) {
 	aa.Delete(ui.KeySpace, tcell.KeyCtrlSpace, tcell.KeyCtrlD)
+	k9s := n.App().Config.K9s
+	if !k9s.GetReadOnly() && k9s.ActiveCluster().FeatureGates.NodeShell {
+		n.bindDangerousKeys(aa)
+	}
 	aa.Add(ui.KeyActions{
 		ui.KeyY:      ui.NewKeyAction("YAML", n.viewCmd, true),
 		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(7, false), false),
@@ -59,10 +82,98 @@ func (n *Node) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(n.App(), "YAML", sel, true).Update(raw)
+	details := NewYAML(n.App(), sel).Update(raw)
 	if err := n.App().inject(details); err != nil {
 		n.App().Flash().Err(err)
 	}
 
 	return nil
 }
+
+func (n *Node) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
+	node := n.GetTable().GetSelectedItem()
+	if node == "" {
+		return evt
+	}
+
+	if err := nodeShellIn(n.App(), n, node); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// nodeShellIn launches a shell pod on a node and shells into it once running.
+func nodeShellIn(a *App, v ResourceViewer, node string) error {
+	res, err := dao.AccessorFor(a.factory, client.NewGVR("v1/nodes"))
+	if err != nil {
+		return err
+	}
+	shell, ok := res.(dao.NodeShell)
+	if !ok {
+		return fmt.Errorf("expecting a node shell resource for %q", v.GVR())
+	}
+
+	cfg := a.Config.K9s.ActiveCluster().ShellPod
+	path, err := shell.LaunchShell(node, cfg)
+	if err != nil {
+		a.audit(config.VerbShell, "v1/nodes", node, err)
+		return err
+	}
+	a.Flash().Infof("Launching node shell pod %s...", path)
+
+	go func() {
+		if err := waitForShell(shell, path); err != nil {
+			a.QueueUpdateDraw(func() {
+				a.Flash().Err(err)
+			})
+			deleteShell(shell, path)
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			v.Stop()
+			defer v.Start()
+			nodeShell(a, node, path, cfg.ShellCommand())
+			deleteShell(shell, path)
+		})
+	}()
+
+	return nil
+}
+
+func nodeShell(a *App, node, path string, cmd []string) {
+	args := buildShellArgs("exec", path, dao.ShellContainer, a.Config.K9s.CurrentContext, a.Conn().Config().Flags().KubeConfig)
+	args = append(append(args, "--"), cmd...)
+
+	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	var err error
+	if !runK(a, shellOpts{clear: true, banner: c.Sprintf(nodeBannerFmt, node, path), args: args}) {
+		err = errors.New("Node shell exec failed")
+		a.Flash().Err(err)
+	}
+	a.audit(config.VerbShell, "v1/nodes", node, err)
+}
+
+func waitForShell(shell dao.NodeShell, path string) error {
+	for start := time.Now(); time.Since(start) < nodeShellTimeout; {
+		ok, err := shell.ShellReady(path)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		<-time.After(nodeShellWait)
+	}
+
+	return fmt.Errorf("timed out waiting for node shell pod %s", path)
+}
+
+func deleteShell(shell dao.NodeShell, path string) {
+	if err := shell.DeleteShell(path); err != nil {
+		log.Error().Err(err).Msgf("Unable to delete node shell pod %s", path)
+	}
+}
@@ -0,0 +1,20 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/notify"
+	"github.com/rs/zerolog/log"
+)
+
+// notify emits a desktop notification when enabled for the given event.
+func (a *App) notify(event, title, body string) {
+	cfg := a.Config.K9s.NotifyConfig()
+	if !cfg.Notifies(event) {
+		return
+	}
+	go func() {
+		n := notify.NewNotifier(cfg.Method, a.Terminal())
+		if err := n.Send(title, body); err != nil {
+			log.Warn().Err(err).Msgf("Desktop notification %s failed", event)
+		}
+	}()
+}
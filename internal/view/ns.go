@@ -13,6 +13,7 @@ import (
 
 const (
 	favNSIndicator     = "+"
+	pinnedNSIndicator  = "^"
 	defaultNSIndicator = "(*)"
 )
 
@@ -37,9 +38,29 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 func (n *Namespace) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyU: ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyP: ui.NewKeyAction("Pin", n.pinNsCmd, true),
 	})
 }
 
+func (n *Namespace) pinNsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" || path == client.NamespaceAll {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	if n.App().Config.K9s.ActiveCluster().Namespace.TogglePin(ns) {
+		n.App().Flash().Infof("Namespace %s pinned", ns)
+	} else {
+		n.App().Flash().Infof("Namespace %s unpinned", ns)
+	}
+	if err := n.App().Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config file save failed!")
+	}
+	n.Refresh()
+
+	return nil
+}
+
 func (n *Namespace) switchNs(app *App, model ui.Tabular, gvr, path string) {
 	n.useNamespace(path)
 	if err := app.gotoResource("pods", "", true); err != nil {
@@ -90,7 +111,12 @@ func (n *Namespace) decorate(data render.TableData) render.TableData {
 		)
 	}
 
+	pinned := n.App().Config.K9s.ActiveCluster().Namespace.Pinned
 	for _, re := range data.RowEvents {
+		if config.InList(pinned, re.Row.ID) {
+			re.Row.Fields[0] += pinnedNSIndicator
+			re.Kind = render.EventUnchanged
+		}
 		if config.InList(n.App().Config.FavNamespaces(), re.Row.ID) {
 			re.Row.Fields[0] += favNSIndicator
 			re.Kind = render.EventUnchanged
@@ -0,0 +1,161 @@
+package view
+
+import (
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"github.com/sahilm/fuzzy"
+)
+
+const (
+	nsPickerKey    = "nsPicker"
+	nsPickerWidth  = 50
+	nsPickerHeight = 15
+)
+
+func (a *App) nsPickCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Cmd().InCmdMode() {
+		return evt
+	}
+	nns, err := a.Conn().ValidNamespaces()
+	if err != nil {
+		a.Flash().Err(err)
+		return nil
+	}
+	names := a.Conn().Config().NamespaceNames(nns)
+	ShowNamespacePicker(a, rankNamespaces(a.Config.K9s.ActiveCluster().Namespace.Ranked(time.Now()), names))
+
+	return nil
+}
+
+// ShowNamespacePicker pops a fuzzy finder to quickly switch namespaces.
+func ShowNamespacePicker(app *App, names []string) {
+	styles := app.Styles
+
+	input := tview.NewInputField()
+	input.SetLabel("> ")
+	input.SetLabelColor(styles.K9s.Info.FgColor.Color())
+	input.SetFieldBackgroundColor(styles.BgColor())
+	input.SetFieldTextColor(styles.FgColor())
+
+	l := tview.NewList()
+	l.ShowSecondaryText(false)
+	l.SetBackgroundColor(styles.BgColor())
+	l.SetMainTextColor(styles.FgColor())
+
+	matches := names
+	fill := func(q string) {
+		l.Clear()
+		matches = names
+		if q != "" {
+			matches = nil
+			for _, m := range fuzzy.Find(q, names) {
+				matches = append(matches, m.Str)
+			}
+		}
+		for _, n := range matches {
+			l.AddItem(namespaceLabel(app, n), "", 0, nil)
+		}
+	}
+	input.SetChangedFunc(fill)
+	input.SetInputCapture(func(evt *tcell.EventKey) *tcell.EventKey {
+		switch evt.Key() {
+		case tcell.KeyUp:
+			if i := l.GetCurrentItem(); i > 0 {
+				l.SetCurrentItem(i - 1)
+			}
+			return nil
+		case tcell.KeyDown:
+			if i := l.GetCurrentItem(); i < l.GetItemCount()-1 {
+				l.SetCurrentItem(i + 1)
+			}
+			return nil
+		}
+		return evt
+	})
+	input.SetDoneFunc(func(key tcell.Key) {
+		DismissNamespacePicker(app)
+		if key != tcell.KeyEnter || len(matches) == 0 {
+			return
+		}
+		app.pickNamespace(matches[l.GetCurrentItem()])
+	})
+	fill("")
+
+	f := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(input, 1, 0, true).
+		AddItem(l, 0, 1, false)
+	f.SetBorder(true)
+	f.SetBorderPadding(0, 0, 1, 1)
+	f.SetTitle(" Switch Namespace ")
+	f.SetBackgroundColor(styles.BgColor())
+
+	pages := app.Content.Pages
+	pages.AddPage(nsPickerKey, centered(f, nsPickerWidth, nsPickerHeight), true, true)
+	pages.ShowPage(nsPickerKey)
+	app.SetFocus(input)
+}
+
+// DismissNamespacePicker dismisses the namespace picker.
+func DismissNamespacePicker(app *App) {
+	p := app.Content.Pages
+	p.RemovePage(nsPickerKey)
+	app.SetFocus(p.CurrentPage().Item)
+}
+
+// pickNamespace activates a namespace, reloading the current resource view
+// in that namespace if it is namespaced.
+func (a *App) pickNamespace(ns string) {
+	if v, ok := a.Content.Top().(ResourceViewer); ok && v.GetTable().Path == "" {
+		if meta, err := dao.MetaAccess.MetaFor(client.NewGVR(v.GVR())); err == nil && meta.Namespaced {
+			if err := a.gotoResource(v.GVR()+" "+ns, "", true); err != nil {
+				a.Flash().Err(err)
+				return
+			}
+		}
+	}
+	if !a.switchNS(ns) {
+		a.Flash().Errf("namespace switch failed for ns %q", ns)
+		return
+	}
+	a.Flash().Infof("Namespace %s is now active!", ns)
+	if err := a.Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config save failed!")
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// rankNamespaces lists the ranked namespaces first followed by the remaining
+// ones in alphabetical order.
+func rankNamespaces(ranked, names []string) []string {
+	rest := make([]string, 0, len(names))
+	for _, n := range names {
+		if !config.InList(ranked, n) {
+			rest = append(rest, n)
+		}
+	}
+	sort.Strings(rest)
+
+	return append(append(make([]string, 0, len(ranked)+len(rest)), ranked...), rest...)
+}
+
+func namespaceLabel(app *App, n string) string {
+	ns := app.Config.K9s.ActiveCluster().Namespace
+	label := "  " + n
+	if ns.IsPinned(n) {
+		label = pinnedNSIndicator + " " + n
+	}
+	if n == ns.Active {
+		label += defaultNSIndicator
+	}
+
+	return label
+}
@@ -0,0 +1,30 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankNamespaces(t *testing.T) {
+	uu := map[string]struct {
+		ranked, names, e []string
+	}{
+		"none": {
+			names: []string{"kube-system", "default", "fred"},
+			e:     []string{"default", "fred", "kube-system"},
+		},
+		"ranked": {
+			ranked: []string{"fred", "all", "default"},
+			names:  []string{"kube-system", "default", "fred", "blee"},
+			e:      []string{"fred", "all", "default", "blee", "kube-system"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rankNamespaces(u.ranked, u.names))
+		})
+	}
+}
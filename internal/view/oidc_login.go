@@ -0,0 +1,104 @@
+package view
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/atotto/clipboard"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+// oidcLogin runs an OIDC device login for the current context and calls
+// done once the cluster is reachable with the new tokens.
+func (a *App) oidcLogin(done func()) {
+	if !atomic.CompareAndSwapInt32(&a.loggingIn, 0, 1) {
+		return
+	}
+	p, ok := a.Conn().Config().OIDCProvider()
+	if !ok {
+		atomic.StoreInt32(&a.loggingIn, 0)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		defer func() {
+			cancel()
+			atomic.StoreInt32(&a.loggingIn, 0)
+		}()
+
+		flow := client.NewDeviceFlow(p)
+		code, err := flow.Start(ctx)
+		if err != nil {
+			a.loginFailed(err)
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			a.showDeviceCode(code, cancel)
+		})
+		tokens, err := flow.Wait(ctx, code)
+		a.QueueUpdateDraw(func() {
+			dialog.DismissDeviceCode(a.Content.Pages)
+		})
+		if err != nil {
+			a.loginFailed(err)
+			return
+		}
+		if err := a.Conn().Config().SaveOIDCTokens(tokens); err != nil {
+			a.loginFailed(err)
+			return
+		}
+		if err := a.refreshCredentials(); err != nil {
+			a.loginFailed(err)
+			return
+		}
+		atomic.StoreInt32(&a.conRetry, 0)
+		log.Info().Msg("OIDC login succeeded")
+		a.QueueUpdateDraw(func() {
+			a.Flash().Info("Login succeeded")
+			if done != nil {
+				done()
+			}
+		})
+	}()
+}
+
+func (a *App) showDeviceCode(code *client.DeviceCode, cancel context.CancelFunc) {
+	url := code.URL()
+	if code.VerificationURIComplete != "" {
+		url = code.VerificationURIComplete
+	}
+	copyFn := func() {
+		if err := clipboard.WriteAll(code.UserCode); err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.Flash().Info("Login code copied to clipboard...")
+	}
+	dialog.ShowDeviceCode(a.Content.Pages, url, code.UserCode, copyFn, func() {
+		cancel()
+	})
+}
+
+// loginFailed reports a failed login. The connection check resumes its
+// countdown so a canceled login does not prompt again.
+func (a *App) loginFailed(err error) {
+	log.Warn().Err(err).Msg("OIDC login failed")
+	atomic.AddInt32(&a.conRetry, 1)
+	a.QueueUpdateDraw(func() {
+		a.Flash().Errf("Login failed -- %s", err)
+	})
+}
+
+// resumeLogin restores the views once logged in at startup.
+func (a *App) resumeLogin() {
+	if err := a.command.Reset(true); err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	if err := a.command.defaultCmd(); err != nil {
+		a.Flash().Err(err)
+	}
+}
@@ -0,0 +1,92 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const paramsKey = "params"
+
+// ParamsFunc represents a params callback function.
+type ParamsFunc func(params map[string]string)
+
+// ShowParams pops a dialog to collect plugin or hotkey arguments.
+func ShowParams(app *App, title, text string, pp []config.PluginParam, okFn ParamsFunc) {
+	styles := app.Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	params := make(map[string]string, len(pp))
+	for _, p := range pp {
+		name := p.Name
+		label := paramLabel(p)
+		if len(p.Enum) == 0 {
+			params[name] = p.Default
+			f.AddInputField(label, p.Default, 30, nil, func(v string) {
+				params[name] = v
+			})
+			continue
+		}
+		// Seeds the param with the option shown as selected.
+		i := enumIndex(p.Enum, p.Default)
+		params[name] = p.Enum[i]
+		f.AddDropDown(label, p.Enum, i, func(v string, _ int) {
+			params[name] = v
+		})
+	}
+
+	pages := app.Content.Pages
+	f.AddButton("OK", func() {
+		DismissParams(app, pages)
+		okFn(params)
+	})
+	f.AddButton("Cancel", func() {
+		DismissParams(app, pages)
+	})
+
+	modal := tview.NewModalForm(fmt.Sprintf("<%s>", title), f)
+	modal.SetText(text)
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissParams(app, pages)
+	})
+
+	pages.AddPage(paramsKey, modal, false, true)
+	pages.ShowPage(paramsKey)
+	app.SetFocus(pages.GetPrimitive(paramsKey))
+}
+
+// DismissParams dismiss the arguments dialog.
+func DismissParams(app *App, p *ui.Pages) {
+	p.RemovePage(paramsKey)
+	app.SetFocus(p.CurrentPage().Item)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func paramLabel(p config.PluginParam) string {
+	if p.Description != "" {
+		return p.Description + ":"
+	}
+
+	return p.Name + ":"
+}
+
+func enumIndex(enum []string, v string) int {
+	for i, e := range enum {
+		if e == v {
+			return i
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,216 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// maxPreviewLines tracks the maximum number of diff lines in a patch preview.
+const maxPreviewLines = 20
+
+func patchActions(b *Browser, aa ui.KeyActions) {
+	pp := config.NewPatches()
+	if err := pp.Load(); err != nil {
+		log.Warn().Err(err).Msg("Unable to load patches")
+	}
+
+	ctx, cl := b.app.Config.K9s.CurrentContext, b.app.Config.K9s.CurrentCluster
+	for k, p := range pp.Patch {
+		if !inScope(p.Scopes, b.Aliases()) || !p.InContext(ctx, cl) {
+			continue
+		}
+		if err := p.Validate(); err != nil {
+			log.Warn().Err(err).Msgf("Invalid patch %q", k)
+			continue
+		}
+		key, err := asKey(p.ShortCut)
+		if err != nil {
+			log.Warn().Err(err).Msg("Unable to map patch shortcut to a key")
+			continue
+		}
+		if _, ok := aa[key]; ok {
+			log.Warn().Err(fmt.Errorf("Doh! you are trying to overide an existing command `%s", k)).Msg("Invalid shortcut")
+			continue
+		}
+		aa[key] = ui.NewKeyAction(
+			p.Description,
+			guardCmd(b, config.VerbPatch, b.GetSelectedItems, patchCmd(b, p)),
+			true)
+	}
+}
+
+func patchCmd(b *Browser, p config.Patch) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := b.GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		if len(p.Params) == 0 {
+			runPatch(b, p, path, nil)
+			return nil
+		}
+		ShowParams(b.app, p.Description, "Patch Arguments", p.Params, func(params map[string]string) {
+			runPatch(b, p, path, params)
+		})
+
+		return nil
+	}
+}
+
+// runPatch expands a quick patch and applies it once confirmed.
+func runPatch(b *Browser, p config.Patch, path string, params map[string]string) {
+	ns, _ := client.Namespaced(path)
+	env := K9sEnv{}
+	if b.EnvFn() != nil {
+		env = b.EnvFn()()
+	}
+	for k, v := range params {
+		env[strings.ToUpper(k)] = v
+	}
+	data, err := patchData(env, ns, p)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+
+	confirmPatch(b, p.Description, path, patchType(p.PatchType()), data, nil)
+}
+
+// confirmPatch previews a patch using a server side dry run and applies it once confirmed.
+func confirmPatch(b *Browser, desc, path string, pt types.PatchType, data []byte, done func()) {
+	res, err := dao.AccessorFor(b.app.factory, client.NewGVR(b.GVR()))
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	patcher, ok := res.(dao.Patchable)
+	if !ok {
+		b.app.Flash().Errf("resource %s is not patchable", b.GVR())
+		return
+	}
+	preview, err := patchPreview(res, patcher, path, pt, data)
+	if err != nil {
+		b.app.Flash().Errf("Patch dry run failed -- %s", err)
+		return
+	}
+
+	msg := fmt.Sprintf("Apply %s to %s?\n\n%s", desc, path, preview)
+	dialog.ShowConfirm(b.app.Content.Pages, "Confirm Patch", msg, func() {
+		_, err := patcher.Patch(path, pt, data, false)
+		b.app.audit(config.VerbPatch, b.GVR(), path, err)
+		if err != nil {
+			b.app.Flash().Errf("Patch failed -- %s", err)
+			return
+		}
+		if done != nil {
+			done()
+		}
+		b.app.Flash().Infof("%s patched successfully", path)
+		b.refresh()
+	}, func() {})
+}
+
+// patchData expands a patch placeholders and converts it to JSON.
+func patchData(env K9sEnv, ns string, p config.Patch) ([]byte, error) {
+	body, err := env.expand(ns, p.Patch)
+	if err != nil {
+		return nil, err
+	}
+	data, err := yaml.YAMLToJSON([]byte(body))
+	if err != nil {
+		return nil, fmt.Errorf("invalid patch %q -- %s", p.Description, err)
+	}
+
+	return data, nil
+}
+
+// patchPreview returns the resource changes reported by a patch dry run.
+func patchPreview(g dao.Getter, patcher dao.Patchable, path string, pt types.PatchType, data []byte) (string, error) {
+	o, err := g.Get(context.Background(), path)
+	if err != nil {
+		return "", err
+	}
+	before, err := dao.ToYAML(o)
+	if err != nil {
+		return "", err
+	}
+	if o, err = patcher.Patch(path, pt, data, true); err != nil {
+		return "", err
+	}
+	after, err := dao.ToYAML(o)
+	if err != nil {
+		return "", err
+	}
+
+	dd := diffLines(strings.Split(before, "\n"), strings.Split(after, "\n"))
+	if len(dd) == 0 {
+		return "No changes", nil
+	}
+	if len(dd) > maxPreviewLines {
+		dd = append(dd[:maxPreviewLines], fmt.Sprintf("... %d more", len(dd)-maxPreviewLines))
+	}
+
+	return strings.Join(dd, "\n"), nil
+}
+
+func patchType(t string) types.PatchType {
+	switch t {
+	case config.PatchMerge:
+		return types.MergePatchType
+	case config.PatchJSON:
+		return types.JSONPatchType
+	default:
+		return types.StrategicMergePatchType
+	}
+}
+
+// diffLines returns the removed and added lines between two texts.
+func diffLines(a, b []string) []string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var dd []string
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			i, j = i+1, j+1
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			dd, i = append(dd, "- "+a[i]), i+1
+		default:
+			dd, j = append(dd, "+ "+b[j]), j+1
+		}
+	}
+	for ; i < len(a); i++ {
+		dd = append(dd, "- "+a[i])
+	}
+	for ; j < len(b); j++ {
+		dd = append(dd, "+ "+b[j])
+	}
+
+	return dd
+}
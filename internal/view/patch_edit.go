@@ -0,0 +1,102 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/gdamore/tcell"
+	"sigs.k8s.io/yaml"
+)
+
+const patchMenuKey = "patchMenu"
+
+// patchTypes lists the patch types offered when editing a patch.
+var patchTypes = []string{config.PatchStrategic, config.PatchMerge, config.PatchJSON}
+
+// patchEditCmd prompts for a patch type and edits a patch for the selected resource.
+func (b *Browser) patchEditCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	items := make([]string, 0, len(patchTypes))
+	for _, t := range patchTypes {
+		items = append(items, patchTypeLabel(t))
+	}
+	ShowListMenu(b.app, patchMenuKey, "Patch Type", items, func(i int) {
+		b.editPatch(path, patchTypes[i])
+	})
+
+	return nil
+}
+
+// editPatch opens a patch in the editor, previews its dry run against the
+// live resource and applies it once confirmed. The last edited patch of each
+// type is kept so a rejected patch can be amended.
+func (b *Browser) editPatch(path, t string) {
+	if b.patches == nil {
+		b.patches = make(map[string]string, len(patchTypes))
+	}
+	body, ok := b.patches[t]
+	if !ok {
+		body = patchSkeleton(path, t)
+	}
+	raw, err := editTemp(b.app, "k9s-patch-*.yml", body)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return
+	}
+	if isBlankPatch(string(raw)) {
+		delete(b.patches, t)
+		b.app.Flash().Info("Patch canceled")
+		return
+	}
+	b.patches[t] = string(raw)
+
+	data, err := yaml.YAMLToJSON(raw)
+	if err != nil {
+		b.app.Flash().Errf("Invalid patch -- %s", err)
+		return
+	}
+	confirmPatch(b, patchTypeLabel(t), path, patchType(t), data, func() {
+		delete(b.patches, t)
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func patchTypeLabel(t string) string {
+	switch t {
+	case config.PatchMerge:
+		return "JSON Merge Patch"
+	case config.PatchJSON:
+		return "JSON Patch"
+	default:
+		return "Strategic Merge Patch"
+	}
+}
+
+// patchSkeleton returns a commented out sample patch of a given type.
+func patchSkeleton(path, t string) string {
+	header := fmt.Sprintf("# %s for %s.\n# Commented lines are ignored, leave the patch empty to cancel.\n", patchTypeLabel(t), path)
+	if t == config.PatchJSON {
+		return header + "# - op: replace\n#   path: /metadata/labels/app\n#   value: fred\n"
+	}
+
+	return header + "# metadata:\n#   labels:\n#     app: fred\n"
+}
+
+// isBlankPatch checks if a patch only holds comments or blank lines.
+func isBlankPatch(s string) bool {
+	for _, l := range strings.Split(s, "\n") {
+		l = strings.TrimSpace(l)
+		if l != "" && !strings.HasPrefix(l, "#") {
+			return false
+		}
+	}
+
+	return true
+}
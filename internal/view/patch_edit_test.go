@@ -0,0 +1,55 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsBlankPatch(t *testing.T) {
+	uu := map[string]struct {
+		patch string
+		e     bool
+	}{
+		"empty":    {patch: "", e: true},
+		"comments": {patch: "# fred\n  # blee\n\n", e: true},
+		"patch":    {patch: "# fred\nspec:\n  replicas: 1\n"},
+		"json":     {patch: `[{"op": "remove", "path": "/spec/paused"}]`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isBlankPatch(u.patch))
+		})
+	}
+}
+
+func TestPatchSkeleton(t *testing.T) {
+	uu := map[string]struct {
+		t, e string
+	}{
+		"strategic": {
+			t: config.PatchStrategic,
+			e: "# Strategic Merge Patch for fred/blee.\n# Commented lines are ignored, leave the patch empty to cancel.\n# metadata:\n#   labels:\n#     app: fred\n",
+		},
+		"merge": {
+			t: config.PatchMerge,
+			e: "# JSON Merge Patch for fred/blee.\n# Commented lines are ignored, leave the patch empty to cancel.\n# metadata:\n#   labels:\n#     app: fred\n",
+		},
+		"json": {
+			t: config.PatchJSON,
+			e: "# JSON Patch for fred/blee.\n# Commented lines are ignored, leave the patch empty to cancel.\n# - op: replace\n#   path: /metadata/labels/app\n#   value: fred\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s := patchSkeleton("fred/blee", u.t)
+			assert.Equal(t, u.e, s)
+			assert.True(t, isBlankPatch(s))
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestPatchData(t *testing.T) {
+	uu := map[string]struct {
+		p   config.Patch
+		e   string
+		err string
+	}{
+		"yaml": {
+			p: config.Patch{Patch: "metadata:\n  annotations:\n    level: $LEVEL\n"},
+			e: `{"metadata":{"annotations":{"level":"debug"}}}`,
+		},
+		"json": {
+			p: config.Patch{Type: config.PatchJSON, Patch: `[{"op": "remove", "path": "/metadata/labels/$NAME"}]`},
+			e: `[{"op":"remove","path":"/metadata/labels/fred"}]`,
+		},
+		"toast": {
+			p:   config.Patch{Description: "blee", Patch: "a: [b"},
+			err: `invalid patch "blee"`,
+		},
+	}
+
+	env := K9sEnv{"NAME": "fred", "LEVEL": "debug"}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			data, err := patchData(env, "default", u.p)
+			if u.err != "" {
+				assert.Contains(t, err.Error(), u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, string(data))
+		})
+	}
+}
+
+func TestPatchType(t *testing.T) {
+	uu := map[string]struct {
+		t string
+		e types.PatchType
+	}{
+		"strategic": {t: config.PatchStrategic, e: types.StrategicMergePatchType},
+		"merge":     {t: config.PatchMerge, e: types.MergePatchType},
+		"json":      {t: config.PatchJSON, e: types.JSONPatchType},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, patchType(u.t))
+		})
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	uu := map[string]struct {
+		a, b, e []string
+	}{
+		"same": {
+			a: []string{"a", "b"},
+			b: []string{"a", "b"},
+		},
+		"changed": {
+			a: []string{"a", "b", "c"},
+			b: []string{"a", "B", "c"},
+			e: []string{"- b", "+ B"},
+		},
+		"added": {
+			a: []string{"a"},
+			b: []string{"a", "b", "c"},
+			e: []string{"+ b", "+ c"},
+		},
+		"removed": {
+			a: []string{"a", "b", "c"},
+			b: []string{"c"},
+			e: []string{"- a", "- b"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, diffLines(u.a, u.b))
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tracing"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	perfRefresh            = 1 * time.Second
+	maxPerfRates           = 3
+	tracingShutdownTimeout = 5 * time.Second
+)
+
+// PerfOverlay presents K9s runtime performance.
+type PerfOverlay struct {
+	*tview.TextView
+
+	app      *App
+	cancelFn context.CancelFunc
+}
+
+// NewPerfOverlay returns a new performance overlay.
+func NewPerfOverlay(app *App) *PerfOverlay {
+	p := PerfOverlay{
+		TextView: tview.NewTextView(),
+		app:      app,
+	}
+	p.SetTextAlign(tview.AlignCenter)
+	p.SetDynamicColors(true)
+	p.StylesChanged(app.Styles)
+	app.Styles.AddListener(&p)
+
+	return &p
+}
+
+// StylesChanged notifies the skin changed.
+func (p *PerfOverlay) StylesChanged(s *config.Styles) {
+	p.SetBackgroundColor(s.BgColor())
+	p.SetTextColor(s.K9s.Info.FgColor.Color())
+}
+
+// IsOn checks if the overlay is shown.
+func (p *PerfOverlay) IsOn() bool {
+	return p.cancelFn != nil
+}
+
+// Start starts sampling performance.
+func (p *PerfOverlay) Start() {
+	p.Stop()
+
+	var ctx context.Context
+	ctx, p.cancelFn = context.WithCancel(context.Background())
+	perf.Stats.Sample(time.Now())
+	p.SetText("Sampling...")
+	go p.update(ctx)
+}
+
+// Stop stops sampling performance.
+func (p *PerfOverlay) Stop() {
+	if p.cancelFn == nil {
+		return
+	}
+	p.cancelFn()
+	p.cancelFn = nil
+}
+
+func (p *PerfOverlay) update(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-time.After(perfRefresh):
+			line := perfLine(perf.Stats.Sample(now))
+			p.app.QueueUpdateDraw(func() {
+				p.SetText(line)
+			})
+		}
+	}
+}
+
+// perfLine formats a performance sample.
+func perfLine(s perf.Sample) string {
+	line := fmt.Sprintf(
+		"FPS %.1f | Draw %v | Goroutines %d | Heap %s",
+		s.FPS,
+		s.DrawTime.Round(10*time.Microsecond),
+		s.Goroutines,
+		render.ToBytes(int64(s.HeapAlloc)),
+	)
+
+	gg := make([]string, 0, len(s.EventRates))
+	for gvr := range s.EventRates {
+		gg = append(gg, gvr)
+	}
+	sort.Slice(gg, func(i, j int) bool {
+		ri, rj := s.EventRates[gg[i]], s.EventRates[gg[j]]
+		if ri == rj {
+			return gg[i] < gg[j]
+		}
+		return ri > rj
+	})
+	if len(gg) > maxPerfRates {
+		gg = gg[:maxPerfRates]
+	}
+	rr := make([]string, 0, len(gg))
+	for _, gvr := range gg {
+		rr = append(rr, fmt.Sprintf("%s %.1f/s", gvr, s.EventRates[gvr]))
+	}
+	if len(rr) == 0 {
+		return line
+	}
+
+	return line + " | Events " + strings.Join(rr, ", ")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (a *App) perfOverlay() *PerfOverlay {
+	return a.Views()["perf"].(*PerfOverlay)
+}
+
+func (a *App) togglePerfCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Cmd().InCmdMode() {
+		return evt
+	}
+
+	flex, ok := a.Main.GetPrimitive("main").(*tview.Flex)
+	if !ok {
+		log.Error().Msg("Expecting valid flex view")
+		return nil
+	}
+	p := a.perfOverlay()
+	if p.IsOn() {
+		p.Stop()
+		flex.RemoveItem(p)
+		return nil
+	}
+	flex.AddItemAtIndex(2, p, 1, 1, false)
+	p.Start()
+
+	return nil
+}
+
+// initProfiler serves pprof profiles when enabled.
+func (a *App) initProfiler(ctx context.Context) {
+	cfg := a.Config.K9s.DebugConfig()
+	if !cfg.Profiling() {
+		return
+	}
+	if err := perf.ServeProfiler(ctx, cfg.PprofPort); err != nil {
+		log.Error().Err(err).Msg("Profiler start failed")
+	}
+}
+
+// initMetrics serves prometheus metrics when enabled.
+func (a *App) initMetrics(ctx context.Context) {
+	cfg := a.Config.K9s.DebugConfig()
+	if !cfg.Exporting() {
+		return
+	}
+	err := perf.ServeMetrics(ctx, cfg.MetricsPort,
+		perf.APIMetrics,
+		perf.Stats.EventMetrics,
+		a.factory.Metrics,
+	)
+	if err != nil {
+		log.Error().Err(err).Msg("Metrics start failed")
+	}
+}
+
+// initTracing exports api calls and refreshes traces when enabled.
+func (a *App) initTracing(ctx context.Context) {
+	cfg := a.Config.K9s.TracingConfig()
+	if !cfg.Enabled() {
+		return
+	}
+	shutdown, err := tracing.Init(ctx, cfg.Endpoint, cfg.Headers)
+	if err != nil {
+		log.Error().Err(err).Msg("Tracing start failed")
+		return
+	}
+	log.Info().Msgf("Exporting traces to %s", cfg.Endpoint)
+	go func() {
+		<-ctx.Done()
+		sctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+		if err := shutdown(sctx); err != nil {
+			log.Warn().Err(err).Msg("Flushing traces failed")
+		}
+	}()
+}
@@ -0,0 +1,42 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPerfLine(t *testing.T) {
+	uu := map[string]struct {
+		s perf.Sample
+		e string
+	}{
+		"empty": {
+			e: "FPS 0.0 | Draw 0s | Goroutines 0 | Heap 0B",
+		},
+		"events": {
+			s: perf.Sample{
+				FPS:        12.5,
+				DrawTime:   3200 * time.Microsecond,
+				Goroutines: 145,
+				HeapAlloc:  2 * 1024 * 1024,
+				EventRates: map[string]float64{
+					"v1/pods":             12,
+					"v1/events":           30,
+					"apps/v1/deployments": 1,
+					"v1/nodes":            1,
+				},
+			},
+			e: "FPS 12.5 | Draw 3.2ms | Goroutines 145 | Heap 2.0MiB | Events v1/events 30.0/s, v1/pods 12.0/s, apps/v1/deployments 1.0/s",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, perfLine(u.s))
+		})
+	}
+}
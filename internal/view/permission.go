@@ -0,0 +1,62 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// Permission presents the effective permissions of a user, group or sa.
+type Permission struct {
+	ResourceViewer
+
+	subjectKind, subjectName string
+}
+
+// NewPermission returns a new viewer.
+func NewPermission(app *App, subject, name string) *Permission {
+	p := Permission{
+		ResourceViewer: NewBrowser(client.NewGVR("permissions")),
+		subjectKind:    subject,
+		subjectName:    name,
+	}
+	p.GetTable().SetColorerFn(render.Permission{}.ColorerFunc())
+	p.SetBindKeysFn(p.bindKeys)
+	p.SetContextFn(p.subjectCtx)
+	p.GetTable().SetEnterFn(blankEnterFn)
+
+	return &p
+}
+
+func (p *Permission) subjectCtx(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeySubjectKind, mapSubject(p.subjectKind))
+	ctx = context.WithValue(ctx, internal.KeyPath, mapSubject(p.subjectKind)+":"+p.subjectName)
+	return context.WithValue(ctx, internal.KeySubjectName, p.subjectName)
+}
+
+func (p *Permission) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", p.GetTable().SortColCmd(1, true), false),
+		ui.KeyShiftO: ui.NewKeyAction("Sort Group", p.GetTable().SortColCmd(2, true), false),
+	})
+}
+
+// permissionsCmd shows the effective permissions of the selected subject.
+func permissionsCmd(v ResourceViewer, kind string) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := v.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		if err := v.App().inject(NewPermission(v.App(), kind, path)); err != nil {
+			v.App().Flash().Err(err)
+		}
+
+		return nil
+	}
+}
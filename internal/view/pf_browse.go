@@ -0,0 +1,207 @@
+package view
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	browseKey     = "browse"
+	browseMenuKey = "browseMenu"
+
+	browseReadyTimeout = 10 * time.Second
+)
+
+// httpPortNames tracks port names hinting at an http server.
+var httpPortNames = []string{"http", "web", "ui", "dashboard", "console"}
+
+// httpPortNumbers tracks well known http ports.
+var httpPortNumbers = map[int32]struct{}{
+	80: {}, 443: {}, 3000: {}, 5000: {}, 8000: {}, 8080: {}, 8443: {}, 8888: {}, 9090: {},
+}
+
+// browsePort represents a container port served over http.
+type browsePort struct {
+	container string
+	port      v1.ContainerPort
+}
+
+func (b browsePort) String() string {
+	s := b.container
+	if b.port.Name != "" {
+		s += "/" + b.port.Name
+	}
+
+	return s + ":" + strconv.Itoa(int(b.port.ContainerPort))
+}
+
+// url returns the url to browse for a given local port.
+func (b browsePort) url(localPort string) string {
+	scheme := "http"
+	if strings.Contains(strings.ToLower(b.port.Name), "https") || b.port.ContainerPort == 443 || b.port.ContainerPort == 8443 {
+		scheme = "https"
+	}
+
+	return fmt.Sprintf("%s://localhost:%s", scheme, localPort)
+}
+
+func (p *PortForwardExtender) browseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	pod, err := p.fetchPodName(path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	mm, err := fetchPodPorts(p.App().factory, pod)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	pp := httpPorts(mm)
+	switch len(pp) {
+	case 0:
+		p.App().Flash().Errf("no http ports found on %s", pod)
+	case 1:
+		browseForward(p.App(), pod, pp[0])
+	default:
+		items := make([]string, 0, len(pp))
+		for _, bp := range pp {
+			items = append(items, bp.String())
+		}
+		ShowListMenu(p.App(), browseMenuKey, "Browse", items, func(i int) {
+			browseForward(p.App(), pod, pp[i])
+		})
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// browseForward forwards a free local port to a pod http port and opens it in
+// the default browser once ready. The forward lasts until the browse dialog
+// is dismissed.
+func browseForward(a *App, path string, bp browsePort) {
+	port, err := freePort()
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+	t := client.PortTunnel{
+		Address:       "localhost",
+		LocalPort:     port,
+		ContainerPort: strconv.Itoa(int(bp.port.ContainerPort)),
+	}
+	pf, fwd, err := launchForward(a, path, bp.container, t)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+
+	u := bp.url(port)
+	go func() {
+		select {
+		case <-fwd.Ready:
+		case <-time.After(browseReadyTimeout):
+			a.QueueUpdateDraw(func() {
+				a.factory.DeleteForwarder(pf.FQN())
+				a.Flash().Errf("Port-forward on %s is not ready", pf.Path())
+			})
+			return
+		}
+		a.QueueUpdateDraw(func() {
+			openBrowser(a, u)
+			showBrowseDialog(a, u, pf)
+		})
+	}()
+}
+
+func openBrowser(a *App, u string) {
+	if err := openURL(u); err != nil {
+		a.Flash().Errf("unable to open %s -- %s", u, err)
+		return
+	}
+	a.Flash().Infof("Opening %s...", u)
+}
+
+// showBrowseDialog pops a dialog tearing the port-forward down once dismissed.
+func showBrowseDialog(a *App, u string, pf *dao.PortForwarder) {
+	pages := a.Content.Pages
+	m := tview.NewModal().
+		AddButtons([]string{"Open", "Stop"}).
+		SetTextColor(tcell.ColorFuchsia).
+		SetText(fmt.Sprintf("Browsing %s via %s.\nDismiss to stop the port-forward.", u, pf.Path())).
+		SetDoneFunc(func(_ int, b string) {
+			if b == "Open" {
+				openBrowser(a, u)
+				return
+			}
+			pages.RemovePage(browseKey)
+			a.SetFocus(pages.CurrentPage().Item)
+			a.factory.DeleteForwarder(pf.FQN())
+			a.Flash().Infof("PortForward %s stopped", pf.Path())
+		})
+	m.SetTitle("<Browse>")
+	pages.AddPage(browseKey, m, false, false)
+	pages.ShowPage(browseKey)
+}
+
+// httpPorts returns the tcp ports likely served over http, either named
+// after http or well known.
+func httpPorts(mm map[string][]v1.ContainerPort) []browsePort {
+	var pp []browsePort
+	for co, ports := range mm {
+		for _, p := range ports {
+			if p.Protocol != v1.ProtocolTCP || !isHTTPPort(p) {
+				continue
+			}
+			pp = append(pp, browsePort{container: co, port: p})
+		}
+	}
+	sort.Slice(pp, func(i, j int) bool {
+		if pp[i].container != pp[j].container {
+			return pp[i].container < pp[j].container
+		}
+		return pp[i].port.ContainerPort < pp[j].port.ContainerPort
+	})
+
+	return pp
+}
+
+func isHTTPPort(p v1.ContainerPort) bool {
+	n := strings.ToLower(p.Name)
+	for _, h := range httpPortNames {
+		if strings.Contains(n, h) {
+			return true
+		}
+	}
+	_, ok := httpPortNumbers[p.ContainerPort]
+
+	return ok
+}
+
+// freePort returns a local port available for listening.
+func freePort() (string, error) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return "", err
+	}
+	defer l.Close()
+
+	return strconv.Itoa(l.Addr().(*net.TCPAddr).Port), nil
+}
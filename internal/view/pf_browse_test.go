@@ -0,0 +1,36 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestHTTPPorts(t *testing.T) {
+	mm := map[string][]v1.ContainerPort{
+		"nginx": {
+			{Name: "https", ContainerPort: 8443, Protocol: v1.ProtocolTCP},
+			{Name: "http", ContainerPort: 80, Protocol: v1.ProtocolTCP},
+			{Name: "dns", ContainerPort: 53, Protocol: v1.ProtocolUDP},
+		},
+		"envoy": {
+			{ContainerPort: 9090, Protocol: v1.ProtocolTCP},
+			{Name: "admin", ContainerPort: 9901, Protocol: v1.ProtocolTCP},
+			{Name: "grpc", ContainerPort: 50051, Protocol: v1.ProtocolTCP},
+		},
+	}
+
+	var ss, uu []string
+	for _, p := range httpPorts(mm) {
+		ss, uu = append(ss, p.String()), append(uu, p.url("1234"))
+	}
+	assert.Equal(t, []string{"envoy:9090", "nginx/http:80", "nginx/https:8443"}, ss)
+	assert.Equal(t, []string{"http://localhost:1234", "http://localhost:1234", "https://localhost:1234"}, uu)
+}
+
+func TestFreePort(t *testing.T) {
+	p, err := freePort()
+	assert.Nil(t, err)
+	assert.Nil(t, tryListenPort(p))
+}
@@ -7,6 +7,7 @@ import (
 	"strconv"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
@@ -34,7 +35,8 @@ func NewPortForwardExtender(r ResourceViewer) ResourceViewer {
 
 func (p *PortForwardExtender) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyShiftF: ui.NewKeyAction("Port-Forward", p.portFwdCmd, true),
+		ui.KeyShiftF: ui.NewKeyAction("Port-Forward", guardCmd(p, config.VerbPortForward, p.GetTable().GetSelectedItems, p.portFwdCmd), true),
+		ui.KeyW:      ui.NewKeyAction("Browse", guardCmd(p, config.VerbPortForward, p.GetTable().GetSelectedItems, p.browseCmd), true),
 	})
 }
 
@@ -80,47 +82,62 @@ func tryListenPort(port string) error {
 	return server.Close()
 }
 
-func runForward(v ResourceViewer, pf watch.Forwarder, f *portforward.PortForwarder) {
-	v.App().factory.AddForwarder(pf)
+func runForward(a *App, pf watch.Forwarder, f *portforward.PortForwarder) {
+	a.factory.AddForwarder(pf)
 
-	v.App().QueueUpdateDraw(func() {
-		v.App().Flash().Infof("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
-		DismissPortForwards(v.App(), v.App().Content.Pages)
+	a.QueueUpdateDraw(func() {
+		a.Flash().Infof("PortForward activated %s:%s", pf.Path(), pf.Ports()[0])
+		DismissPortForwards(a, a.Content.Pages)
 	})
 
 	pf.SetActive(true)
 	if err := f.ForwardPorts(); err != nil {
-		v.App().Flash().Err(err)
+		a.QueueUpdateDraw(func() {
+			a.factory.DeleteForwarder(pf.FQN())
+			a.Flash().Err(err)
+		})
+		a.notify(config.NotifyPortForward, "Port-forward died", fmt.Sprintf("%s -- %s", pf.Path(), err))
 		return
 	}
 
-	v.App().QueueUpdateDraw(func() {
-		v.App().factory.DeleteForwarder(pf.FQN())
+	a.QueueUpdateDraw(func() {
+		a.factory.DeleteForwarder(pf.FQN())
 		pf.SetActive(false)
 	})
 }
 
 func startFwdCB(v ResourceViewer, path, co string, t client.PortTunnel) {
-	err := tryListenPort(t.LocalPort)
-	if err != nil {
+	if err := startForward(v.App(), path, co, t); err != nil {
 		v.App().Flash().Err(err)
-		return
 	}
+}
 
-	if _, ok := v.App().factory.ForwarderFor(dao.PortForwardID(path, co)); ok {
-		v.App().Flash().Err(errors.New("A port-forward is already active on this pod"))
-		return
+func startForward(a *App, path, co string, t client.PortTunnel) error {
+	_, _, err := launchForward(a, path, co, t)
+
+	return err
+}
+
+// launchForward starts a port-forward in the background.
+func launchForward(a *App, path, co string, t client.PortTunnel) (*dao.PortForwarder, *portforward.PortForwarder, error) {
+	if err := tryListenPort(t.LocalPort); err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := a.factory.ForwarderFor(dao.PortForwardID(path, co)); ok {
+		return nil, nil, errors.New("A port-forward is already active on this pod")
 	}
 
-	pf := dao.NewPortForwarder(v.App().factory)
+	pf := dao.NewPortForwarder(a.factory)
 	fwd, err := pf.Start(path, co, t)
 	if err != nil {
-		v.App().Flash().Err(err)
-		return
+		return nil, nil, err
 	}
 
 	log.Debug().Msgf(">>> Starting port forward %q %#v", path, t)
-	go runForward(v, pf, fwd)
+	go runForward(a, pf, fwd)
+
+	return pf, fwd, nil
 }
 
 func showFwdDialog(v ResourceViewer, path string, cb PortForwardFunc) error {
@@ -0,0 +1,193 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pluginOutputTitle = "Plugin"
+	pluginRunning     = "running..."
+	pluginKilled      = "killed"
+	pluginExitFmt     = "exit %d"
+)
+
+// PluginOutput streams an async plugin command output.
+type PluginOutput struct {
+	*Details
+
+	bin        string
+	args       []string
+	ansiWriter io.Writer
+	cancelFn   context.CancelFunc
+	runID      int
+	queueFn    func(func())
+}
+
+// NewPluginOutput returns a new plugin output viewer.
+func NewPluginOutput(app *App, bin string, args []string) *PluginOutput {
+	return &PluginOutput{
+		Details: NewDetails(app, pluginOutputTitle, bin, false),
+		bin:     bin,
+		args:    args,
+		queueFn: func(f func()) { app.QueueUpdateDraw(f) },
+	}
+}
+
+// Init initializes the viewer.
+func (p *PluginOutput) Init(ctx context.Context) error {
+	if err := p.Details.Init(ctx); err != nil {
+		return err
+	}
+	p.SetWrap(false)
+	p.SetMaxBuffer(p.app.Config.K9s.LogBufferSize)
+	p.ansiWriter = tview.ANSIWriter(p.TextView, p.app.Styles.Views().Log.FgColor.String(), p.app.Styles.Views().Log.BgColor.String())
+	p.bindKeys()
+
+	return nil
+}
+
+// Name returns the component name.
+func (p *PluginOutput) Name() string { return pluginOutputTitle }
+
+// Start runs the plugin command unless it already ran.
+func (p *PluginOutput) Start() {
+	if p.runID > 0 {
+		return
+	}
+	p.run()
+}
+
+// Stop terminates the plugin command if still running.
+func (p *PluginOutput) Stop() {
+	p.kill()
+	p.Details.Stop()
+}
+
+func (p *PluginOutput) bindKeys() {
+	p.Actions().Delete(ui.KeySlash, tcell.KeyCtrlU, tcell.KeyEnter)
+	p.Actions().Add(ui.KeyActions{
+		ui.KeyR:        ui.NewKeyAction("Rerun", p.rerunCmd, true),
+		tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
+	})
+}
+
+func (p *PluginOutput) rerunCmd(evt *tcell.EventKey) *tcell.EventKey {
+	p.app.Flash().Infof("Rerunning plugin %s...", p.bin)
+	p.run()
+
+	return nil
+}
+
+func (p *PluginOutput) killCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if p.cancelFn == nil {
+		return evt
+	}
+	p.app.Flash().Infof("Killing plugin %s...", p.bin)
+	p.kill()
+	p.setStatus(pluginKilled)
+
+	return nil
+}
+
+// kill terminates the current run. Its remaining output is dropped.
+func (p *PluginOutput) kill() {
+	if p.cancelFn == nil {
+		return
+	}
+	p.cancelFn()
+	p.cancelFn = nil
+	p.runID++
+}
+
+func (p *PluginOutput) run() {
+	p.kill()
+	p.Clear()
+	p.setStatus(pluginRunning)
+	p.runID++
+	id := p.runID
+
+	var ctx context.Context
+	ctx, p.cancelFn = context.WithCancel(context.Background())
+
+	log.Debug().Msgf("Running async plugin> %s %s", p.bin, strings.Join(p.args, " "))
+	cmd := exec.CommandContext(ctx, p.bin, p.args...)
+	w := p.writer(id)
+	cmd.Stdout, cmd.Stderr = w, w
+	if err := cmd.Start(); err != nil {
+		p.app.Flash().Errf("Plugin command failed: %s", err)
+		p.setStatus(err.Error())
+		return
+	}
+
+	go func() {
+		err := cmd.Wait()
+		p.queueFn(func() {
+			if id != p.runID {
+				return
+			}
+			p.cancelFn()
+			p.cancelFn = nil
+			p.setStatus(fmt.Sprintf(pluginExitFmt, exitCode(err)))
+		})
+	}()
+}
+
+// writer returns a writer streaming a given run output to the viewer.
+func (p *PluginOutput) writer(id int) io.Writer {
+	return runWriter{id: id, output: p}
+}
+
+func (p *PluginOutput) setStatus(s string) {
+	p.SetSubject(p.bin + " " + s)
+	p.updateTitle()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// runWriter streams a plugin run output. Output from a killed or superseded
+// run is dropped.
+type runWriter struct {
+	id     int
+	output *PluginOutput
+}
+
+// Write streams command output to the viewer.
+func (w runWriter) Write(bb []byte) (int, error) {
+	buff := make([]byte, len(bb))
+	copy(buff, bb)
+	p := w.output
+	p.queueFn(func() {
+		if w.id != p.runID {
+			return
+		}
+		if _, err := p.ansiWriter.Write(buff); err != nil {
+			log.Error().Err(err).Msg("Plugin output write failed")
+		}
+		p.ScrollToEnd()
+	})
+
+	return len(bb), nil
+}
+
+func exitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+
+	return -1
+}
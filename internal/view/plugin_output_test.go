@@ -0,0 +1,122 @@
+package view
+
+import (
+	"errors"
+	"os/exec"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPluginOutputExitCode(t *testing.T) {
+	p, mx := makePluginOutput(t, "sh", "-c", "echo fred; exit 3")
+	mx.Lock()
+	p.Start()
+	mx.Unlock()
+
+	waitPluginStatus(t, p, mx, "sh exit 3")
+	mx.Lock()
+	defer mx.Unlock()
+	assert.Equal(t, "fred\n", p.GetText(true))
+	assert.Nil(t, p.cancelFn)
+}
+
+func TestPluginOutputKill(t *testing.T) {
+	p, mx := makePluginOutput(t, "sleep", "10")
+	mx.Lock()
+	p.Start()
+	assert.Equal(t, "sleep "+pluginRunning, p.subject)
+	p.killCmd(nil)
+	assert.Equal(t, "sleep "+pluginKilled, p.subject)
+	mx.Unlock()
+
+	// The killed run exit status must not override the kill status.
+	time.Sleep(100 * time.Millisecond)
+	mx.Lock()
+	defer mx.Unlock()
+	assert.Equal(t, "sleep "+pluginKilled, p.subject)
+}
+
+func TestPluginOutputRerun(t *testing.T) {
+	p, mx := makePluginOutput(t, "sh", "-c", "echo fred")
+	mx.Lock()
+	p.Start()
+	mx.Unlock()
+	waitPluginStatus(t, p, mx, "sh exit 0")
+
+	mx.Lock()
+	stale := p.writer(p.runID)
+	p.rerunCmd(nil)
+	mx.Unlock()
+	waitPluginStatus(t, p, mx, "sh exit 0")
+
+	_, err := stale.Write([]byte("blee\n"))
+	assert.Nil(t, err)
+	mx.Lock()
+	defer mx.Unlock()
+	assert.Equal(t, "fred\n", p.GetText(true))
+}
+
+func TestPluginOutputStaleWriter(t *testing.T) {
+	p, _ := makePluginOutput(t, "sh")
+	p.runID = 2
+
+	_, err := p.writer(1).Write([]byte("stale\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "", p.GetText(true))
+
+	_, err = p.writer(2).Write([]byte("fresh\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, "fresh\n", p.GetText(true))
+}
+
+func TestExitCode(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   int
+	}{
+		"ok":    {e: 0},
+		"other": {err: errors.New("boom"), e: -1},
+		"exit":  {err: exec.Command("sh", "-c", "exit 2").Run(), e: 2},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, exitCode(u.err))
+		})
+	}
+}
+
+// Helpers...
+
+// makePluginOutput returns a plugin output running updates synchronously
+// under a lock standing in for the UI thread.
+func makePluginOutput(t *testing.T, bin string, args ...string) (*PluginOutput, *sync.Mutex) {
+	var mx sync.Mutex
+	p := NewPluginOutput(NewApp(config.NewConfig(ks{})), bin, args)
+	assert.Nil(t, p.Init(makeContext()))
+	p.queueFn = func(f func()) {
+		mx.Lock()
+		defer mx.Unlock()
+		f()
+	}
+
+	return p, &mx
+}
+
+func waitPluginStatus(t *testing.T, p *PluginOutput, mx *sync.Mutex, status string) {
+	for i := 0; i < 100; i++ {
+		mx.Lock()
+		s := p.subject
+		mx.Unlock()
+		if s == status {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	t.Fatalf("plugin status %q never reached", status)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
@@ -41,9 +42,9 @@ func NewPod(gvr client.GVR) ResourceViewer {
 
 func (p *Pod) bindDangerousKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
-		ui.KeyS:        ui.NewKeyAction("Shell", p.shellCmd, true),
-		ui.KeyA:        ui.NewKeyAction("Attach", p.attachCmd, true),
+		tcell.KeyCtrlK: ui.NewKeyAction("Kill", guardCmd(p, config.VerbKill, p.GetTable().GetSelectedItems, p.killCmd), true),
+		ui.KeyS:        ui.NewKeyAction("Shell", guardCmd(p, config.VerbShell, p.GetTable().GetSelectedItems, p.shellCmd), true),
+		ui.KeyA:        ui.NewKeyAction("Attach", guardCmd(p, config.VerbAttach, p.GetTable().GetSelectedItems, p.attachCmd), true),
 	})
 }
 
@@ -65,6 +66,11 @@ func (p *Pod) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftI:   ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd(10, true), false),
 		ui.KeyShiftO:   ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd(11, true), false),
 	})
+	if p.App().imageVerifier != nil {
+		aa.Add(ui.KeyActions{
+			ui.KeyI: ui.NewKeyAction("Images", p.imagesCmd, true),
+		})
+	}
 }
 
 func (p *Pod) showContainers(app *App, model ui.Tabular, gvr, path string) {
@@ -81,6 +87,18 @@ func (p *Pod) coContext(ctx context.Context) context.Context {
 
 // Commands...
 
+func (p *Pod) imagesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := p.App().inject(newPodImages(path)); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	sels := p.GetTable().GetSelectedItems()
 	if len(sels) == 0 {
@@ -100,7 +118,9 @@ func (p *Pod) killCmd(evt *tcell.EventKey) *tcell.EventKey {
 	p.GetTable().ShowDeleted()
 	for _, res := range sels {
 		p.App().Flash().Infof("Delete resource %s -- %s", p.GVR(), res)
-		if err := nuker.Delete(res, true, true); err != nil {
+		err := nuker.Delete(res, true, true)
+		p.App().audit(config.VerbKill, p.GVR(), res, err)
+		if err != nil {
 			p.App().Flash().Errf("Delete failed with %s", err)
 		} else {
 			p.App().factory.DeleteForwarder(res)
@@ -191,9 +211,12 @@ func shellIn(a *App, path, co string) {
 	args := computeShellArgs(path, co, a.Config.K9s.CurrentContext, a.Conn().Config().Flags().KubeConfig)
 
 	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	var err error
 	if !runK(a, shellOpts{clear: true, banner: c.Sprintf(bannerFmt, path, co), args: args}) {
-		a.Flash().Err(errors.New("Shell exec failed"))
+		err = errors.New("Shell exec failed")
+		a.Flash().Err(err)
 	}
+	a.audit(config.VerbShell, "v1/pods", path, err)
 }
 
 func containerAttachIn(a *App, comp model.Component, path, co string) error {
@@ -232,9 +255,12 @@ func resumeAttachIn(a *App, c model.Component, path, co string) {
 func attachIn(a *App, path, co string) {
 	args := buildShellArgs("attach", path, co, a.Config.K9s.CurrentContext, a.Conn().Config().Flags().KubeConfig)
 	c := color.New(color.BgGreen).Add(color.FgBlack).Add(color.Bold)
+	var err error
 	if !runK(a, shellOpts{clear: true, banner: c.Sprintf(bannerFmt, path, co), args: args}) {
-		a.Flash().Err(errors.New("Attach exec failed"))
+		err = errors.New("Attach exec failed")
+		a.Flash().Err(err)
 	}
+	a.audit(config.VerbAttach, "v1/pods", path, err)
 }
 
 func computeShellArgs(path, co, context string, kcfg *string) []string {
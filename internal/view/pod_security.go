@@ -0,0 +1,51 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"gopkg.in/yaml.v2"
+)
+
+// PodSecurity presents workloads evaluated against the Pod Security Standards.
+type PodSecurity struct {
+	ResourceViewer
+}
+
+// NewPodSecurity returns a new viewer.
+func NewPodSecurity(gvr client.GVR) ResourceViewer {
+	p := PodSecurity{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetColorerFn(render.PodSecurity{}.ColorerFunc())
+	p.GetTable().SetSortCol(5, 0, false)
+	p.GetTable().SetEnterFn(p.showViolations)
+
+	return &p
+}
+
+func (p *PodSecurity) showViolations(app *App, _ ui.Tabular, _, id string) {
+	gvr, path := render.ParsePodSecurityID(id)
+	var ps dao.PodSecurity
+	ps.Init(app.factory, client.NewGVR("podsecurity"))
+	vv, err := ps.Violations(gvr, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if len(vv) == 0 {
+		app.Flash().Infof("%s meets the restricted level", path)
+		return
+	}
+	raw, err := yaml.Marshal(vv)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	details := NewDetails(app, "Pod Security", path, true).Update(string(raw))
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
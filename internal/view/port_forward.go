@@ -2,11 +2,13 @@ package view
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/perf"
@@ -49,7 +51,8 @@ func (p *PortForward) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyEnter: ui.NewKeyAction("View Benchmarks", p.showBenchCmd, true),
 		tcell.KeyCtrlB: ui.NewKeyAction("Bench Run/Stop", p.toggleBenchCmd, true),
-		tcell.KeyCtrlD: ui.NewKeyAction("Delete", p.deleteCmd, true),
+		ui.KeyB:        ui.NewKeyAction("Bench Config", p.benchConfigCmd, true),
+		tcell.KeyCtrlD: ui.NewKeyAction("Delete", guardCmd(p, config.VerbDelete, p.GetTable().GetSelectedItems, p.deleteCmd), true),
 		ui.KeyShiftP:   ui.NewKeyAction("Sort Ports", p.GetTable().SortColCmd(2, true), false),
 		ui.KeyShiftU:   ui.NewKeyAction("Sort URL", p.GetTable().SortColCmd(4, true), false),
 	})
@@ -75,17 +78,45 @@ func (p *PortForward) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if path == "" {
 		return nil
 	}
-	cfg := dao.BenchConfigFor(p.App().BenchFile, path)
+	if err := p.startBenchmark(path, dao.BenchConfigFor(p.App().BenchFile, path)); err != nil {
+		p.App().Flash().Errf("Bench failed %v", err)
+		p.App().ClearStatus(false)
+	}
+
+	return nil
+}
+
+func (p *PortForward) benchConfigCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	file := p.App().BenchFile
+	save := func(cfg config.BenchConfig) error {
+		return saveBench(file, func(b *config.Bench) {
+			b.SetContainer(dao.PodToKey(path), cfg)
+		})
+	}
+	test := func(cfg config.BenchConfig) error {
+		return p.startBenchmark(path, cfg)
+	}
+	ShowBenchConfig(p.App(), path, dao.BenchConfigFor(file, path), save, test)
+
+	return nil
+}
+
+func (p *PortForward) startBenchmark(path string, cfg config.BenchConfig) error {
+	if p.bench != nil {
+		return errors.New("A benchmark is already in progress")
+	}
 	cfg.Name = path
 
 	r, _ := p.GetTable().GetSelection()
 	base := ui.TrimCell(p.GetTable().SelectTable, r, 4)
 	var err error
-	p.bench, err = perf.NewBenchmark(base, p.App().version, cfg)
-	if err != nil {
-		p.App().Flash().Errf("Bench failed %v", err)
-		p.App().ClearStatus(false)
-		return nil
+	if p.bench, err = perf.NewBenchmark(base, p.App().version, cfg); err != nil {
+		return err
 	}
 
 	p.App().Status(model.FlashWarn, "Benchmark in progress...")
@@ -104,6 +135,7 @@ func (p *PortForward) runBenchmark() {
 				p.App().Status(model.FlashInfo, "Benchmark canceled")
 			} else {
 				p.App().Status(model.FlashInfo, "Benchmark Completed!")
+				p.App().notify(config.NotifyBenchmark, "Benchmark completed", p.bench.Name())
 				p.bench.Cancel()
 			}
 			p.bench = nil
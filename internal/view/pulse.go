@@ -241,6 +241,12 @@ func (p *Pulse) SetBindKeysFn(BindKeysFunc) {}
 // SetContextFn sets custom context.
 func (p *Pulse) SetContextFn(ContextFunc) {}
 
+// SetSelectors sets drill down selectors.
+func (p *Pulse) SetSelectors(string, string) {}
+
+// Selectors returns drill down selectors.
+func (p *Pulse) Selectors() (string, string) { return "", "" }
+
 // GetTable return the view table if any.
 func (p *Pulse) GetTable() *Table {
 	return nil
@@ -0,0 +1,220 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/jq"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	queryTitle    = "Query"
+	maxQueryItems = 500
+)
+
+// Query evaluates jq expressions against resources as they are typed.
+type Query struct {
+	*Details
+
+	queryBuff *ui.CmdBuff
+	objects   []interface{}
+	subject   string
+}
+
+// NewQuery returns a new query viewer.
+func NewQuery(app *App, subject string, objects []interface{}) *Query {
+	return &Query{
+		Details:   NewDetails(app, queryTitle, subject, true),
+		queryBuff: ui.NewCmdBuff('.', ui.FilterBuff),
+		objects:   objects,
+		subject:   subject,
+	}
+}
+
+// Init initializes the viewer.
+func (q *Query) Init(ctx context.Context) error {
+	if err := q.Details.Init(ctx); err != nil {
+		return err
+	}
+	q.SetWrap(false)
+	q.queryBuff.AddListener(q.app.Cmd())
+	q.queryBuff.AddListener(q)
+	q.SetInputCapture(q.keyboard)
+	q.bindKeys()
+
+	return nil
+}
+
+// Name returns the component name.
+func (q *Query) Name() string { return queryTitle }
+
+// Start evaluates the identity query and activates the query prompt.
+func (q *Query) Start() {
+	q.eval("")
+	q.queryBuff.SetActive(true)
+}
+
+// Stop terminates the viewer.
+func (q *Query) Stop() {
+	q.queryBuff.RemoveListener(q.app.Cmd())
+	q.queryBuff.RemoveListener(q)
+	q.Details.Stop()
+}
+
+// BufferChanged evaluates the query as it is typed.
+func (q *Query) BufferChanged(s string) {
+	q.eval(s)
+}
+
+// BufferActive indicates the buff activity changed.
+func (q *Query) BufferActive(state bool, k ui.BufferKind) {
+	q.app.BufferActive(state, k)
+}
+
+func (q *Query) bindKeys() {
+	q.Actions().Add(ui.KeyActions{
+		ui.KeyQ: ui.NewKeyAction("Query", q.activateQueryCmd, true),
+	})
+}
+
+func (q *Query) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if !q.queryBuff.IsActive() {
+		return q.Details.keyboard(evt)
+	}
+
+	switch evt.Key() {
+	case tcell.KeyRune:
+		q.queryBuff.Add(evt.Rune())
+	case tcell.KeyBackspace2, tcell.KeyBackspace, tcell.KeyDelete:
+		q.queryBuff.Delete()
+	case tcell.KeyCtrlU:
+		q.queryBuff.Clear()
+	case tcell.KeyEnter, tcell.KeyEscape:
+		q.queryBuff.SetActive(false)
+	default:
+		return evt
+	}
+
+	return nil
+}
+
+func (q *Query) activateQueryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if q.app.InCmdMode() {
+		return evt
+	}
+	q.queryBuff.SetActive(true)
+
+	return nil
+}
+
+func (q *Query) eval(expr string) {
+	if strings.TrimSpace(expr) == "" {
+		expr = "."
+	}
+	res, err := runQuery(expr, q.objects)
+	if err != nil {
+		q.setStatus(err.Error())
+		return
+	}
+	q.setStatus(expr)
+	q.Update(res)
+}
+
+func (q *Query) setStatus(s string) {
+	q.SetSubject(q.subject + " " + s)
+	q.updateTitle()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// runQuery evaluates a jq expression against each object and returns the
+// results as indented JSON documents.
+func runQuery(expr string, objects []interface{}) (string, error) {
+	query, err := jq.Compile(expr)
+	if err != nil {
+		return "", err
+	}
+
+	var lines []string
+	for _, o := range objects {
+		vv, err := query.Run(o)
+		if err != nil {
+			return "", err
+		}
+		for _, v := range vv {
+			raw, err := json.MarshalIndent(v, "", "  ")
+			if err != nil {
+				return "", err
+			}
+			lines = append(lines, string(raw))
+		}
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+func (b *Browser) queryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	b.showQuery(b.GetSelectedItems())
+
+	return nil
+}
+
+func (b *Browser) queryAllCmd(evt *tcell.EventKey) *tcell.EventKey {
+	data := b.GetTable().GetVisibleData()
+	if len(data.RowEvents) == 0 {
+		return evt
+	}
+	paths := make([]string, 0, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		paths = append(paths, re.Row.ID)
+	}
+	if len(paths) > maxQueryItems {
+		b.App().Flash().Warnf("Querying the first %d of %d resources", maxQueryItems, len(paths))
+		paths = paths[:maxQueryItems]
+	}
+	b.showQuery(paths)
+
+	return nil
+}
+
+func (b *Browser) showQuery(paths []string) {
+	sort.Strings(paths)
+	subject := paths[0]
+	if len(paths) > 1 {
+		subject = fmt.Sprintf("%d %s", len(paths), b.GVR())
+	}
+
+	ctx := b.defaultContext()
+	oo := make([]interface{}, 0, len(paths))
+	for _, path := range paths {
+		o, err := b.GetModel().Get(ctx, path)
+		if err != nil {
+			b.App().Flash().Errf("unable to get resource %q -- %s", path, err)
+			return
+		}
+		m, err := model.ObjectMap(o)
+		if err != nil {
+			b.App().Flash().Err(err)
+			return
+		}
+		if b.GVR() == secretGVR && b.App().Config.K9s.MaskSecrets() {
+			m = maskSecretMap(m)
+		}
+		oo = append(oo, m)
+	}
+
+	if err := b.App().inject(NewQuery(b.App(), subject, oo)); err != nil {
+		b.App().Flash().Err(err)
+	}
+}
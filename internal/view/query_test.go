@@ -0,0 +1,49 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunQuery(t *testing.T) {
+	oo := []interface{}{
+		map[string]interface{}{"metadata": map[string]interface{}{"name": "fred", "labels": map[string]interface{}{"app": "blee"}}},
+		map[string]interface{}{"metadata": map[string]interface{}{"name": "zorg"}},
+	}
+
+	uu := map[string]struct {
+		expr, e string
+		err     string
+	}{
+		"scalars": {
+			expr: ".metadata.name",
+			e:    "\"fred\"\n\"zorg\"",
+		},
+		"objects": {
+			expr: ".metadata.labels",
+			e:    "{\n  \"app\": \"blee\"\n}\nnull",
+		},
+		"select": {
+			expr: `select(.metadata.labels.app == "blee") | .metadata.name`,
+			e:    `"fred"`,
+		},
+		"invalid": {
+			expr: ".metadata[",
+			err:  "unexpected end of expression",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := runQuery(u.expr, oo)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 )
 
@@ -45,6 +46,9 @@ func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/secrets")] = MetaViewer{
 		viewerFn: NewSecret,
 	}
+	vv[client.NewGVR("v1/serviceaccounts")] = MetaViewer{
+		viewerFn: NewServiceAccount,
+	}
 }
 
 func miscViewers(vv MetaViewers) {
@@ -69,6 +73,39 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("aliases")] = MetaViewer{
 		viewerFn: NewAlias,
 	}
+	vv[client.NewGVR("audit")] = MetaViewer{
+		viewerFn: NewAudit,
+	}
+	vv[client.NewGVR("alerts")] = MetaViewer{
+		viewerFn: NewAlert,
+	}
+	vv[client.NewGVR("auditevents")] = MetaViewer{
+		viewerFn: NewAuditEvent,
+	}
+	vv[client.NewGVR("caches")] = MetaViewer{
+		viewerFn: NewCache,
+	}
+	vv[client.NewGVR("tlscerts")] = MetaViewer{
+		viewerFn: NewTLSCert,
+	}
+	vv[client.NewGVR("violations")] = MetaViewer{
+		viewerFn: NewViolation,
+	}
+	vv[client.NewGVR("images")] = MetaViewer{
+		viewerFn: NewImage,
+	}
+	vv[client.NewGVR("timelines")] = MetaViewer{
+		viewerFn: NewTimeline,
+	}
+	vv[client.NewGVR("watchlist")] = MetaViewer{
+		viewerFn: NewWatchlist,
+	}
+	vv[client.NewGVR("errors")] = MetaViewer{
+		viewerFn: NewErrorLog,
+	}
+	vv[client.NewGVR("podsecurity")] = MetaViewer{
+		viewerFn: NewPodSecurity,
+	}
 	vv[client.NewGVR("pulses")] = MetaViewer{
 		viewerFn: NewPulse,
 	}
@@ -90,6 +127,9 @@ func appsViewers(vv MetaViewers) {
 	vv[client.NewGVR("extensions/v1beta1/daemonsets")] = MetaViewer{
 		viewerFn: NewDaemonSet,
 	}
+	vv[client.NewGVR(dao.RolloutGVR)] = MetaViewer{
+		viewerFn: NewRollout,
+	}
 }
 
 func rbacViewers(vv MetaViewers) {
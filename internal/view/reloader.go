@@ -0,0 +1,277 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/fsnotify/fsnotify"
+	"github.com/rs/zerolog/log"
+)
+
+const reloadDelay = 300 * time.Millisecond
+
+type configKind string
+
+const (
+	skinConfig   configKind = "skins"
+	pluginConfig configKind = "plugins"
+	patchConfig  configKind = "patches"
+	hotKeyConfig configKind = "hotkeys"
+	aliasConfig  configKind = "aliases"
+	macroConfig  configKind = "macros"
+	scriptConfig configKind = "scripts"
+	keyMapConfig configKind = "keymap"
+	alertConfig  configKind = "alerts"
+	k9sConfig    configKind = "config"
+)
+
+type configChanges map[configKind][]string
+
+type actionsRefresher interface {
+	refreshActions()
+}
+
+// watchedDirs returns the config dirs to watch across the system, user and
+// project config layers.
+func watchedDirs() []string {
+	var dd []string
+	for _, d := range []string{
+		config.K9sHome,
+		config.K9sPluginsDir,
+		config.K9sPatchesDir,
+		config.K9sHotKeysDir,
+		config.K9sSkinsDir,
+		config.K9sScriptsDir,
+	} {
+		dd = append(dd, config.ConfigLayers(d)...)
+	}
+
+	return dd
+}
+
+// skinDirs returns the dirs of skins located outside of the watched dirs.
+func skinDirs(skins []string) []string {
+	var dd []string
+	for _, f := range skins {
+		if d := filepath.Dir(f); !config.InList(dd, d) {
+			dd = append(dd, d)
+		}
+	}
+
+	return dd
+}
+
+func watchDirs(w *fsnotify.Watcher, dd []string) {
+	for _, d := range dd {
+		if _, err := os.Stat(d); err != nil {
+			continue
+		}
+		if err := w.Add(d); err != nil {
+			log.Warn().Err(err).Msgf("Unable to watch config dir %s", d)
+		}
+	}
+}
+
+// configKindFor returns the kind of a config file given the mapped skin files.
+func configKindFor(path string, skins []string) (configKind, bool) {
+	if config.InList(skins, path) {
+		return skinConfig, true
+	}
+	path = config.HomePath(path)
+	dir, base := filepath.Dir(path), filepath.Base(path)
+	switch {
+	case path == config.K9sConfigFile:
+		return k9sConfig, true
+	case path == config.K9sPlugins || dir == config.K9sPluginsDir:
+		return pluginConfig, true
+	case path == config.K9sPatches || dir == config.K9sPatchesDir:
+		return patchConfig, true
+	case path == config.K9sHotKeys || dir == config.K9sHotKeysDir:
+		return hotKeyConfig, true
+	case path == config.K9sAlias:
+		return aliasConfig, true
+	case path == config.K9sMacros:
+		return macroConfig, true
+	case path == config.K9sKeyMap:
+		return keyMapConfig, true
+	case path == config.K9sAlerts:
+		return alertConfig, true
+	case dir == config.K9sScriptsDir:
+		return scriptConfig, true
+	case path == config.K9sStylesFile || dir == config.K9sSkinsDir || strings.HasSuffix(base, "_skin.yml"):
+		return skinConfig, true
+	default:
+		return "", false
+	}
+}
+
+// configUpdater watches K9s configuration files and reloads them as they change.
+func (a *App) configUpdater(ctx context.Context) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	skins := a.Config.K9s.Skins.Files()
+	watchDirs(w, append(watchedDirs(), skinDirs(skins)...))
+
+	// Skin mappings may change as the config reloads.
+	skinsc := make(chan []string, 1)
+	go func() {
+		var (
+			changes = make(configChanges)
+			timer   <-chan time.Time
+		)
+		for {
+			select {
+			case skins = <-skinsc:
+				watchDirs(w, skinDirs(skins))
+			case evt := <-w.Events:
+				if evt.Op&fsnotify.Create != 0 && config.InList(watchedDirs(), evt.Name) {
+					if err := w.Add(evt.Name); err != nil {
+						log.Warn().Err(err).Msgf("Unable to watch config dir %s", evt.Name)
+					}
+					continue
+				}
+				k, ok := configKindFor(evt.Name, skins)
+				if !ok {
+					continue
+				}
+				changes[k] = append(changes[k], evt.Name)
+				if timer == nil {
+					timer = time.After(reloadDelay)
+				}
+			case <-timer:
+				cc := changes
+				changes, timer = make(configChanges), nil
+				a.QueueUpdateDraw(func() {
+					a.reloadConfig(cc)
+					select {
+					case skinsc <- a.Config.K9s.Skins.Files():
+					default:
+					}
+				})
+			case err := <-w.Errors:
+				log.Info().Err(err).Msg("Config watcher failed")
+				return
+			case <-ctx.Done():
+				log.Debug().Msg("ConfigWatcher Done!")
+				if err := w.Close(); err != nil {
+					log.Error().Err(err).Msg("Closing config watcher")
+				}
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (a *App) reloadConfig(cc configChanges) {
+	// K9s saves its own config, only reload it when edited.
+	if _, ok := cc[k9sConfig]; ok && !a.Config.Changed(config.K9sConfigFile) {
+		delete(cc, k9sConfig)
+	}
+	kk := make([]string, 0, len(cc))
+	for k := range cc {
+		kk = append(kk, string(k))
+	}
+	sort.Strings(kk)
+
+	var reloaded, errs []string
+	for _, k := range kk {
+		if err := a.reload(configKind(k), cc[configKind(k)]); err != nil {
+			log.Error().Err(err).Msgf("Reload %s failed", k)
+			errs = append(errs, fmt.Sprintf("%s: %s", k, err))
+			continue
+		}
+		reloaded = append(reloaded, k)
+	}
+
+	if len(reloaded) > 0 {
+		if r, ok := a.Content.Top().(actionsRefresher); ok {
+			r.refreshActions()
+		}
+		a.Flash().Infof("Reloaded %s", strings.Join(reloaded, ", "))
+	}
+	if len(errs) > 0 {
+		dialog.ShowError(a.Content.Pages, "Configuration Errors", strings.Join(errs, "\n"))
+	}
+}
+
+func (a *App) reload(k configKind, files []string) error {
+	switch k {
+	case skinConfig:
+		for _, f := range files {
+			if _, err := os.Stat(f); err != nil {
+				continue
+			}
+			if err := config.NewStyles().Load(f); err != nil {
+				return fmt.Errorf("%s -- %s", filepath.Base(f), err)
+			}
+		}
+		a.ReloadStyles(a.Config.K9s.CurrentContext)
+	case pluginConfig:
+		pp := config.NewPlugins()
+		if err := pp.Load(); err != nil {
+			return err
+		}
+		for n, p := range pp.Plugin {
+			if err := p.Validate(); err != nil {
+				return fmt.Errorf("plugin %s -- %s", n, err)
+			}
+		}
+	case patchConfig:
+		pp := config.NewPatches()
+		if err := pp.Load(); err != nil {
+			return err
+		}
+		for n, p := range pp.Patch {
+			if err := p.Validate(); err != nil {
+				return fmt.Errorf("patch %s -- %s", n, err)
+			}
+		}
+	case hotKeyConfig:
+		hh := config.NewHotKeys()
+		if err := hh.Load(); err != nil {
+			return err
+		}
+		for n, h := range hh.HotKey {
+			if err := h.Validate(); err != nil {
+				return fmt.Errorf("hotkey %s -- %s", n, err)
+			}
+		}
+	case aliasConfig:
+		if err := config.NewAliases().LoadFileAliases(config.K9sAlias); err != nil {
+			return err
+		}
+		// Reloads run on the draw thread so aliases can be reset in place.
+		if err := a.command.Reset(true); err != nil {
+			return err
+		}
+	case macroConfig:
+		return a.loadMacros()
+	case scriptConfig:
+		return a.scripts.Load(config.K9sScriptsDir)
+	case keyMapConfig:
+		if err := a.loadKeyMap(); err != nil {
+			return err
+		}
+		a.remap(a.GetActions(), appKeyMapView)
+	case alertConfig:
+		return a.loadAlerts()
+	case k9sConfig:
+		if err := a.Config.Reload(config.K9sConfigFile); err != nil {
+			return err
+		}
+		a.ReloadStyles(a.Config.K9s.CurrentContext)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package view
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigKindFor(t *testing.T) {
+	uu := map[string]struct {
+		path string
+		kind configKind
+		ok   bool
+	}{
+		"plugin":    {path: config.K9sPlugins, kind: pluginConfig, ok: true},
+		"pluginDir": {path: filepath.Join(config.K9sPluginsDir, "prod.yml"), kind: pluginConfig, ok: true},
+		"patch":     {path: config.K9sPatches, kind: patchConfig, ok: true},
+		"patchDir":  {path: filepath.Join(config.K9sPatchesDir, "dp.yml"), kind: patchConfig, ok: true},
+		"hotkey":    {path: config.K9sHotKeys, kind: hotKeyConfig, ok: true},
+		"hotkeyDir": {path: filepath.Join(config.K9sHotKeysDir, "prod.yml"), kind: hotKeyConfig, ok: true},
+		"alias":     {path: config.K9sAlias, kind: aliasConfig, ok: true},
+		"macro":     {path: config.K9sMacros, kind: macroConfig, ok: true},
+		"keymap":    {path: config.K9sKeyMap, kind: keyMapConfig, ok: true},
+		"alert":     {path: config.K9sAlerts, kind: alertConfig, ok: true},
+		"script":    {path: filepath.Join(config.K9sScriptsDir, "pods.star"), kind: scriptConfig, ok: true},
+		"skin":      {path: config.K9sStylesFile, kind: skinConfig, ok: true},
+		"ctxSkin":   {path: filepath.Join(config.K9sHome, "prod_skin.yml"), kind: skinConfig, ok: true},
+		"namedSkin": {path: filepath.Join(config.K9sSkinsDir, "red.yml"), kind: skinConfig, ok: true},
+		"config":    {path: config.K9sConfigFile, kind: k9sConfig, ok: true},
+		"bench":     {path: filepath.Join(config.K9sHome, "bench-fred.yml")},
+		"absSkin":   {path: "/tmp/skins/prod.yml", kind: skinConfig, ok: true},
+		"otherFile": {path: "/tmp/skins/dev.yml"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			kind, ok := configKindFor(u.path, []string{"/tmp/skins/prod.yml"})
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.kind, kind)
+		})
+	}
+}
+
+func TestSkinDirs(t *testing.T) {
+	skins := []string{"/tmp/skins/prod.yml", "/tmp/skins/dev.yml", filepath.Join(config.K9sSkinsDir, "red.yml")}
+	assert.Equal(t, []string{"/tmp/skins", config.K9sSkinsDir}, skinDirs(skins))
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -26,7 +27,7 @@ func NewRestartExtender(v ResourceViewer) ResourceViewer {
 // BindKeys creates additional menu actions.
 func (r *RestartExtender) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		tcell.KeyCtrlT: ui.NewKeyAction("Restart", r.restartCmd, true),
+		tcell.KeyCtrlT: ui.NewKeyAction("Restart", guardCmd(r, config.VerbRestart, r.GetTable().GetSelectedItems, r.restartCmd), true),
 	})
 }
 
@@ -40,7 +41,9 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 	defer r.Start()
 	msg := "Please confirm rollout restart for " + path
 	dialog.ShowConfirm(r.App().Content.Pages, "<Confirm Restart>", msg, func() {
-		if err := r.restartRollout(path); err != nil {
+		err := r.restartRollout(path)
+		r.App().audit(config.VerbRestart, r.GVR(), path, err)
+		if err != nil {
 			r.App().Flash().Err(err)
 		} else {
 			r.App().Flash().Infof("Rollout restart in progress for `%s...", path)
@@ -0,0 +1,181 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/gdamore/tcell"
+)
+
+// Rollout represents an Argo rollout view.
+type Rollout struct {
+	ResourceViewer
+}
+
+// NewRollout returns a new rollout view.
+func NewRollout(gvr client.GVR) ResourceViewer {
+	r := Rollout{
+		ResourceViewer: NewLogsExtender(NewBrowser(gvr), nil),
+	}
+	r.SetBindKeysFn(r.bindKeys)
+	r.GetTable().SetEnterFn(r.showPods)
+	r.GetTable().SetColorerFn(render.Rollout{}.ColorerFunc())
+
+	return &r
+}
+
+func (r *Rollout) bindKeys(aa ui.KeyActions) {
+	sel := r.GetTable().GetSelectedItems
+	aa.Add(ui.KeyActions{
+		ui.KeyI:      ui.NewKeyAction("Status", r.statusCmd, true),
+		ui.KeyP:      ui.NewKeyAction("Promote", guardCmd(r, config.VerbPromote, sel, r.promoteCmd(false)), true),
+		ui.KeyShiftF: ui.NewKeyAction("Promote Full", guardCmd(r, config.VerbPromote, sel, r.promoteCmd(true)), true),
+		ui.KeyA:      ui.NewKeyAction("Abort", guardCmd(r, config.VerbAbort, sel, r.abortCmd), true),
+		ui.KeyR:      ui.NewKeyAction("Retry", guardCmd(r, config.VerbRetry, sel, r.retryCmd), true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", r.GetTable().SortColCmd(2, true), false),
+	})
+}
+
+func (r *Rollout) showPods(app *App, _ ui.Tabular, _, path string) {
+	ro, err := r.rollout()
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	sel, err := ro.Selector(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	showPodsFromSelector(app, path, sel)
+}
+
+func (r *Rollout) statusCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ro, err := r.rollout()
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	s, err := ro.Status(path)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+
+	details := NewDetails(r.App(), "Rollout Status", path, true).Update(rolloutStatusText(s))
+	if err := r.App().inject(details); err != nil {
+		r.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (r *Rollout) promoteCmd(full bool) ui.ActionHandler {
+	verb, title := "promote", "<Confirm Promote>"
+	if full {
+		verb, title = "fully promote", "<Confirm Full Promote>"
+	}
+
+	return r.confirmCmd(config.VerbPromote, verb, title, func(ro *dao.Rollout, path string) error {
+		return ro.Promote(path, full)
+	})
+}
+
+func (r *Rollout) abortCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return r.confirmCmd(config.VerbAbort, "abort", "<Confirm Abort>", (*dao.Rollout).Abort)(evt)
+}
+
+func (r *Rollout) retryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	return r.confirmCmd(config.VerbRetry, "retry", "<Confirm Retry>", (*dao.Rollout).Retry)(evt)
+}
+
+// confirmCmd runs a rollout action on the selected rollout once confirmed.
+func (r *Rollout) confirmCmd(verb, action, title string, fn func(*dao.Rollout, string) error) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := r.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		msg := fmt.Sprintf("Please confirm %s for rollout %s", action, path)
+		dialog.ShowConfirm(r.App().Content.Pages, title, msg, func() {
+			ro, err := r.rollout()
+			if err == nil {
+				err = fn(ro, path)
+			}
+			r.App().audit(verb, r.GVR(), path, err)
+			if err != nil {
+				r.App().Flash().Err(err)
+				return
+			}
+			r.App().Flash().Infof("Requested %s for rollout %s", action, path)
+		}, func() {})
+
+		return nil
+	}
+}
+
+func (r *Rollout) rollout() (*dao.Rollout, error) {
+	res, err := dao.AccessorFor(r.App().factory, client.NewGVR(r.GVR()))
+	if err != nil {
+		return nil, err
+	}
+	ro, ok := res.(*dao.Rollout)
+	if !ok {
+		return nil, errors.New("expecting a rollout resource")
+	}
+
+	return ro, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// rolloutStatusText renders a rollout progress along with its canary steps.
+func rolloutStatusText(s render.RolloutStatus) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%-10s %s\n", "Strategy:", s.Strategy)
+	fmt.Fprintf(&b, "%-10s %s\n", "Status:", s.Phase)
+	if s.Message != "" {
+		fmt.Fprintf(&b, "%-10s %s\n", "Message:", s.Message)
+	}
+	if s.Aborted {
+		fmt.Fprintf(&b, "%-10s %s\n", "Aborted:", "true")
+	}
+	fmt.Fprintf(&b, "%-10s %d desired | %d current | %d updated | %d available\n",
+		"Replicas:", s.Desired, s.Current, s.Updated, s.Available)
+	if s.Strategy != render.RolloutCanary {
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "%-10s %d/%d\n", "Step:", s.Step, len(s.Steps))
+	fmt.Fprintf(&b, "%-10s %d%% set | %d%% actual\n", "Weight:", s.SetWeight, s.ActualWeight)
+	if len(s.Steps) == 0 {
+		return b.String()
+	}
+	b.WriteString("\nSteps:\n")
+	for i, st := range s.Steps {
+		marker := " "
+		switch {
+		case i < s.Step:
+			marker = "✓"
+		case i == s.Step:
+			marker = "►"
+		}
+		fmt.Fprintf(&b, "  %s %2d: %s\n", marker, i, st)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,53 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutStatusText(t *testing.T) {
+	uu := map[string]struct {
+		s render.RolloutStatus
+		e string
+	}{
+		"blueGreen": {
+			s: render.RolloutStatus{Strategy: render.RolloutBlueGreen, Phase: render.RolloutHealthy, Desired: 1, Current: 1, Updated: 1, Available: 1},
+			e: "Strategy:  BlueGreen\n" +
+				"Status:    Healthy\n" +
+				"Replicas:  1 desired | 1 current | 1 updated | 1 available\n",
+		},
+		"canary": {
+			s: render.RolloutStatus{
+				Strategy:     render.RolloutCanary,
+				Phase:        render.RolloutPaused,
+				Paused:       true,
+				Step:         1,
+				Steps:        []string{"setWeight: 20", "pause", "setWeight: 50"},
+				SetWeight:    20,
+				ActualWeight: 25,
+				Desired:      4,
+				Current:      4,
+				Updated:      1,
+				Available:    4,
+			},
+			e: "Strategy:  Canary\n" +
+				"Status:    Paused\n" +
+				"Replicas:  4 desired | 4 current | 1 updated | 4 available\n" +
+				"Step:      1/3\n" +
+				"Weight:    20% set | 25% actual\n" +
+				"\nSteps:\n" +
+				"  ✓  0: setWeight: 20\n" +
+				"  ►  1: pause\n" +
+				"     2: setWeight: 50\n",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rolloutStatusText(u.s))
+		})
+	}
+}
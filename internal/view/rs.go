@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/watch"
@@ -44,7 +45,7 @@ func (r *ReplicaSet) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftD:   ui.NewKeyAction("Sort Desired", r.GetTable().SortColCmd(1, true), false),
 		ui.KeyShiftC:   ui.NewKeyAction("Sort Current", r.GetTable().SortColCmd(2, true), false),
 		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", r.GetTable().SortColCmd(3, true), false),
-		tcell.KeyCtrlL: ui.NewKeyAction("Rollback", r.rollbackCmd, true),
+		tcell.KeyCtrlL: ui.NewKeyAction("Rollback", guardCmd(r, config.VerbRollback, r.GetTable().GetSelectedItems, r.rollbackCmd), true),
 	})
 }
 
@@ -73,7 +74,9 @@ func (r *ReplicaSet) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
 	r.showModal(fmt.Sprintf("Rollback %s %s?", r.GVR(), sel), func(_ int, button string) {
 		if button == "OK" {
 			r.App().Flash().Infof("Rolling back %s %s", r.GVR(), sel)
-			if res, err := rollback(r.App().factory, sel); err != nil {
+			res, err := rollback(r.App().factory, sel)
+			r.App().audit(config.VerbRollback, r.GVR(), sel, err)
+			if err != nil {
 				r.App().Flash().Err(err)
 			} else {
 				r.App().Flash().Info(res)
@@ -0,0 +1,27 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// ServiceAccount presents a service account viewer.
+type ServiceAccount struct {
+	ResourceViewer
+}
+
+// NewServiceAccount returns a new viewer.
+func NewServiceAccount(gvr client.GVR) ResourceViewer {
+	s := ServiceAccount{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.SetBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *ServiceAccount) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyP: ui.NewKeyAction("Permissions", permissionsCmd(s, sa), true),
+	})
+}
@@ -0,0 +1,61 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/tview"
+)
+
+const saveKey = "save"
+
+// SaveFunc represents a save callback.
+type SaveFunc func(path string, strip bool)
+
+// ShowSave pops a dialog to save a viewer content to a given path.
+func ShowSave(app *App, title, path string, strippable bool, okFn SaveFunc) {
+	styles := app.Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	f.AddInputField("Path:", path, 60, nil, func(s string) {
+		path = s
+	})
+	var strip bool
+	if strippable {
+		f.AddCheckbox("Strip managedFields/status:", strip, func(b bool) {
+			strip = b
+		})
+	}
+
+	pages := app.Content.Pages
+	f.AddButton("OK", func() {
+		DismissSave(app)
+		okFn(path, strip)
+	})
+	f.AddButton("Cancel", func() {
+		DismissSave(app)
+	})
+
+	modal := tview.NewModalForm(fmt.Sprintf("<Save %s>", title), f)
+	modal.SetText("Saves the content to the given file, overwriting it if present")
+	modal.SetDoneFunc(func(int, string) {
+		DismissSave(app)
+	})
+
+	pages.AddPage(saveKey, modal, false, true)
+	pages.ShowPage(saveKey)
+	app.SetFocus(pages.GetPrimitive(saveKey))
+}
+
+// DismissSave dismisses the save dialog.
+func DismissSave(app *App) {
+	pages := app.Content.Pages
+	pages.RemovePage(saveKey)
+	app.SetFocus(pages.CurrentPage().Item)
+}
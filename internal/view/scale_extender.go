@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
@@ -28,7 +29,7 @@ func NewScaleExtender(r ResourceViewer) ResourceViewer {
 
 func (s *ScaleExtender) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyS: ui.NewKeyAction("Scale", s.scaleCmd, true),
+		ui.KeyS: ui.NewKeyAction("Scale", guardCmd(s, config.VerbScale, s.GetTable().GetSelectedItems, s.scaleCmd), true),
 	})
 }
 
@@ -74,7 +75,9 @@ func (s *ScaleExtender) makeScaleForm(sel string) *tview.Form {
 			s.App().Flash().Err(err)
 			return
 		}
-		if err := s.scale(sel, count); err != nil {
+		err = s.scale(sel, count)
+		s.App().audit(config.VerbScale, s.GVR(), sel, err)
+		if err != nil {
 			log.Error().Err(err).Msgf("DP %s scaling failed", sel)
 			s.App().Flash().Err(err)
 		} else {
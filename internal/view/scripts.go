@@ -0,0 +1,106 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/script"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func scriptActions(b *Browser, aa ui.KeyActions) {
+	if !dao.IsK8sMeta(b.meta) {
+		return
+	}
+
+	for _, a := range b.app.scripts.Actions(b.GVR()) {
+		key, err := asKey(a.ShortCut)
+		if err != nil {
+			log.Warn().Err(err).Msg("SCRIPT Unable to map action shortcut to a key")
+			continue
+		}
+		if _, ok := aa[key]; ok {
+			log.Warn().Err(fmt.Errorf("SCRIPT Doh! you are trying to overide an existing command `%s", a.Description)).Msg("Invalid shortcut")
+			continue
+		}
+		aa[key] = ui.NewKeyAction(a.Description, scriptActionCmd(b, a), true)
+	}
+}
+
+func scriptActionCmd(b *Browser, a script.Action) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := b.GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		o, err := scriptObject(b, path, true)
+		if err != nil {
+			b.app.Flash().Err(err)
+			return nil
+		}
+		msg, err := a.Run(o)
+		if err != nil {
+			b.app.Flash().Errf("Script action %q failed: %s", a.Description, err)
+			return nil
+		}
+		if msg != "" {
+			b.app.Flash().Info(msg)
+		}
+
+		return nil
+	}
+}
+
+func scriptSelectHook(b *Browser) ui.SelectedRowFunc {
+	return func(r int) {
+		path := b.GetSelectedItem()
+		if path == "" {
+			return
+		}
+		o, err := scriptObject(b, path, false)
+		if err != nil {
+			log.Debug().Err(err).Msgf("Script select hook skipped for %q", path)
+			return
+		}
+		msg, err := b.app.scripts.OnSelect(b.GVR(), o)
+		if err != nil {
+			b.app.Flash().Errf("Script select hook failed: %s", err)
+			return
+		}
+		if msg != "" {
+			b.app.Flash().Info(msg)
+		}
+	}
+}
+
+func scriptConfirmDelete(b *Browser, selections []string) error {
+	if !dao.IsK8sMeta(b.meta) || !b.app.scripts.HasHook(b.GVR(), script.OnDeleteConfirm) {
+		return nil
+	}
+
+	for _, sel := range selections {
+		o, err := scriptObject(b, sel, true)
+		if err != nil {
+			return err
+		}
+		if err := b.app.scripts.ConfirmDelete(b.GVR(), o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func scriptObject(b *Browser, path string, wait bool) (map[string]interface{}, error) {
+	o, err := b.app.factory.Get(b.GVR(), path, wait, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ObjectMap(o)
+}
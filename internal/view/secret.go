@@ -62,7 +62,17 @@ func (s *Secret) decodeCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(s.App(), "Secret Decoder", path, false).Update(string(raw))
+	details := NewDetails(s.App(), "Secret Decoder", path, false)
+	if s.App().Config.K9s.MaskSecrets() {
+		masked, err := yaml.Marshal(maskStrings(d))
+		if err != nil {
+			s.App().Flash().Errf("Error decoding secret %s", err)
+			return nil
+		}
+		s.App().maskDetails(details, s.GVR(), path, string(masked), string(raw))
+	} else {
+		details.Update(string(raw))
+	}
 	if err := s.App().inject(details); err != nil {
 		s.App().Flash().Err(err)
 	}
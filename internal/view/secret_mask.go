@@ -0,0 +1,198 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	secretGVR  = "v1/secrets"
+	secretMask = "********"
+
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+// secretDataKeys tracks secret fields holding sensitive values.
+var secretDataKeys = []string{"data", "stringData"}
+
+// yamlDetails returns a YAML viewer. Secret values are masked until revealed.
+func yamlDetails(app *App, gvr, path, raw string) (*YAML, error) {
+	details := NewYAML(app, path)
+	if gvr != secretGVR || !app.Config.K9s.MaskSecrets() {
+		return details.Update(raw), nil
+	}
+	masked, err := maskSecretYAML(raw)
+	if err != nil {
+		return nil, err
+	}
+	app.maskDetails(details.Details, gvr, path, masked, raw)
+
+	return details, nil
+}
+
+// maskDetails shows masked content and binds a reveal action unless reveals
+// are blocked on the current context. Reveals are recorded in the audit log.
+func (a *App) maskDetails(d *Details, gvr, path, masked, raw string) *Details {
+	d.Update(masked)
+	if a.Config.K9s.IsBlocked(config.VerbReveal) {
+		return d
+	}
+
+	d.Actions().Add(ui.KeyActions{
+		ui.KeyX: ui.NewKeyAction("Reveal", func(evt *tcell.EventKey) *tcell.EventKey {
+			d.Update(raw)
+			d.Actions().Delete(ui.KeyX)
+			a.Menu().HydrateMenu(d.Hints())
+			a.audit(config.VerbReveal, gvr, path, nil)
+			a.Flash().Warn("Secret values revealed!")
+			return nil
+		}, true),
+	})
+
+	return d
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// maskSecretYAML masks the values of a secret manifest.
+func maskSecretYAML(raw string) (string, error) {
+	var m yaml.MapSlice
+	if err := yaml.Unmarshal([]byte(raw), &m); err != nil {
+		return "", fmt.Errorf("unable to mask secret -- %s", err)
+	}
+	for i, item := range m {
+		switch {
+		case item.Key == "metadata":
+			if meta, ok := item.Value.(yaml.MapSlice); ok {
+				m[i].Value = maskLastApplied(meta)
+			}
+		case isSecretDataKey(item.Key):
+			m[i].Value = maskValues(item.Value)
+		}
+	}
+	out, err := yaml.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
+
+// maskSecretMap returns a copy of a secret object with masked values. The
+// given object is left untouched as it may be shared with the cache.
+func maskSecretMap(m map[string]interface{}) map[string]interface{} {
+	res := copyMap(m)
+	for _, k := range secretDataKeys {
+		if dd, ok := m[k].(map[string]interface{}); ok {
+			masked := make(map[string]interface{}, len(dd))
+			for kk := range dd {
+				masked[kk] = secretMask
+			}
+			res[k] = masked
+		}
+	}
+	meta, ok := m["metadata"].(map[string]interface{})
+	if !ok {
+		return res
+	}
+	aa, ok := meta["annotations"].(map[string]interface{})
+	if _, found := aa[lastAppliedAnnotation]; !ok || !found {
+		return res
+	}
+	meta, aa = copyMap(meta), copyMap(aa)
+	aa[lastAppliedAnnotation] = secretMask
+	meta["annotations"], res["metadata"] = aa, meta
+
+	return res
+}
+
+// maskSecretPayload masks a secret or secret list audited payload. Payloads
+// of other shapes, ie json patches, are masked altogether.
+func maskSecretPayload(v interface{}) interface{} {
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case map[string]interface{}:
+		items, ok := v["items"].([]interface{})
+		if !ok {
+			return maskSecretMap(v)
+		}
+		masked := make([]interface{}, len(items))
+		for i, item := range items {
+			masked[i] = maskSecretPayload(item)
+		}
+		res := copyMap(v)
+		res["items"] = masked
+		return res
+	default:
+		return secretMask
+	}
+}
+
+func copyMap(m map[string]interface{}) map[string]interface{} {
+	res := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+
+	return res
+}
+
+// maskStrings masks decoded secret values.
+func maskStrings(m map[string]string) map[string]string {
+	res := make(map[string]string, len(m))
+	for k := range m {
+		res[k] = secretMask
+	}
+
+	return res
+}
+
+func isSecretDataKey(k interface{}) bool {
+	for _, s := range secretDataKeys {
+		if k == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func maskValues(v interface{}) interface{} {
+	dd, ok := v.(yaml.MapSlice)
+	if !ok {
+		return v
+	}
+	for i := range dd {
+		dd[i].Value = secretMask
+	}
+
+	return dd
+}
+
+// maskLastApplied masks the last applied configuration as it holds the
+// secret values.
+func maskLastApplied(meta yaml.MapSlice) yaml.MapSlice {
+	for i, item := range meta {
+		if item.Key != "annotations" {
+			continue
+		}
+		aa, ok := item.Value.(yaml.MapSlice)
+		if !ok {
+			continue
+		}
+		for j := range aa {
+			if aa[j].Key == lastAppliedAnnotation {
+				aa[j].Value = secretMask
+			}
+		}
+		meta[i].Value = aa
+	}
+
+	return meta
+}
@@ -0,0 +1,107 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskSecretYAML(t *testing.T) {
+	raw := `apiVersion: v1
+data:
+  password: c2VjcmV0
+  user: ZnJlZA==
+kind: Secret
+metadata:
+  annotations:
+    blee: duh
+    kubectl.kubernetes.io/last-applied-configuration: '{"data":{"password":"c2VjcmV0"}}'
+  name: fred
+stringData:
+  token: zorg
+type: Opaque
+`
+	e := `apiVersion: v1
+data:
+  password: '********'
+  user: '********'
+kind: Secret
+metadata:
+  annotations:
+    blee: duh
+    kubectl.kubernetes.io/last-applied-configuration: '********'
+  name: fred
+stringData:
+  token: '********'
+type: Opaque
+`
+
+	s, err := maskSecretYAML(raw)
+	assert.Nil(t, err)
+	assert.Equal(t, e, s)
+
+	_, err = maskSecretYAML("- [")
+	assert.NotNil(t, err)
+}
+
+func TestMaskSecretMap(t *testing.T) {
+	m := map[string]interface{}{
+		"kind": "Secret",
+		"data": map[string]interface{}{"password": "c2VjcmV0"},
+		"metadata": map[string]interface{}{
+			"name": "fred",
+			"annotations": map[string]interface{}{
+				lastAppliedAnnotation: `{"data":{"password":"c2VjcmV0"}}`,
+			},
+		},
+	}
+
+	masked := maskSecretMap(m)
+	assert.Equal(t, map[string]interface{}{
+		"kind": "Secret",
+		"data": map[string]interface{}{"password": secretMask},
+		"metadata": map[string]interface{}{
+			"name":        "fred",
+			"annotations": map[string]interface{}{lastAppliedAnnotation: secretMask},
+		},
+	}, masked)
+	assert.Equal(t, "c2VjcmV0", m["data"].(map[string]interface{})["password"])
+	assert.Equal(t, `{"data":{"password":"c2VjcmV0"}}`, m["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})[lastAppliedAnnotation])
+}
+
+func TestMaskSecretPayload(t *testing.T) {
+	uu := map[string]struct {
+		v, e interface{}
+	}{
+		"none": {},
+		"secret": {
+			v: map[string]interface{}{"kind": "Secret", "stringData": map[string]interface{}{"token": "fred"}},
+			e: map[string]interface{}{"kind": "Secret", "stringData": map[string]interface{}{"token": secretMask}},
+		},
+		"list": {
+			v: map[string]interface{}{
+				"kind":  "SecretList",
+				"items": []interface{}{map[string]interface{}{"data": map[string]interface{}{"token": "ZnJlZA=="}}},
+			},
+			e: map[string]interface{}{
+				"kind":  "SecretList",
+				"items": []interface{}{map[string]interface{}{"data": map[string]interface{}{"token": secretMask}}},
+			},
+		},
+		"jsonPatch": {
+			v: []interface{}{map[string]interface{}{"op": "replace", "path": "/data/token", "value": "ZnJlZA=="}},
+			e: secretMask,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, maskSecretPayload(u.v))
+		})
+	}
+}
+
+func TestMaskStrings(t *testing.T) {
+	assert.Equal(t, map[string]string{"a": secretMask, "b": secretMask}, maskStrings(map[string]string{"a": "blee", "b": "duh"}))
+}
@@ -0,0 +1,130 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/rs/zerolog/log"
+)
+
+func (a *App) initSessions() {
+	if err := a.sessions.Load(); err != nil {
+		log.Error().Err(err).Msg("Sessions load failed")
+	}
+}
+
+// saveSession records the current navigation session for the active context.
+func (a *App) saveSession() {
+	if !a.Config.K9s.RestoreSession {
+		return
+	}
+
+	ss := a.currentSession()
+	a.sessions.Set(a.Config.K9s.CurrentContext, &ss)
+	if err := a.sessions.Save(); err != nil {
+		log.Error().Err(err).Msg("Session save failed")
+	}
+}
+
+// restoreSession restores the last navigation session of the active context.
+// Returns false if there is no session to restore.
+func (a *App) restoreSession() bool {
+	if !a.Config.K9s.ShouldRestoreSession() {
+		return false
+	}
+	ss, ok := a.sessions.Get(a.Config.K9s.CurrentContext)
+	if !ok || !a.loadSession(ss) {
+		return false
+	}
+	a.Flash().Info("Session restored")
+
+	return true
+}
+
+// currentSession snapshots the current navigation session.
+func (a *App) currentSession() config.Session {
+	ss := config.Session{Namespace: a.Config.ActiveNamespace()}
+	for _, c := range a.Content.Stack.Peek() {
+		if v, ok := sessionView(c); ok {
+			ss.Views = append(ss.Views, v)
+		}
+	}
+
+	return ss
+}
+
+// loadSession replaces the view stack with the views of a session.
+// Returns false if no views were restored.
+func (a *App) loadSession(ss *config.Session) bool {
+	if ss.Namespace != "" && !a.switchNS(ss.Namespace) {
+		log.Warn().Msgf("Session namespace %q restore failed", ss.Namespace)
+	}
+	a.Content.Stack.Clear()
+	for _, v := range ss.Views {
+		if err := a.restoreView(v); err != nil {
+			log.Error().Err(err).Msgf("Session view %q restore failed", v.GVR)
+		}
+	}
+
+	return !a.Content.Stack.Empty()
+}
+
+func (a *App) restoreView(v config.SessionView) error {
+	gvr := client.NewGVR(v.GVR)
+	if v.Logs != nil {
+		return a.inject(NewLog(gvr, v.Path, v.Logs.Container, v.Logs.Previous))
+	}
+
+	view := a.command.componentFor(v.GVR, v.Path, a.command.metaFor(gvr))
+	view.SetSelectors(v.Labels, v.Fields)
+	if v.Sort != nil {
+		view.GetTable().SetSortCol(v.Sort.Column, v.Sort.ColCount, v.Sort.Asc)
+	}
+	if v.Selection != "" {
+		view.GetTable().SelectOnLoad(v.Selection)
+	}
+	if err := a.inject(view); err != nil {
+		return err
+	}
+	if v.Filter != "" {
+		a.applyFilter(view, v.Filter)
+	}
+
+	return nil
+}
+
+// sessionView snapshots a view state. Only resource and log views are tracked.
+func sessionView(c model.Component) (config.SessionView, bool) {
+	switch v := c.(type) {
+	case *Log:
+		m := v.GetModel()
+		return config.SessionView{
+			GVR:  m.GetGVR().String(),
+			Path: m.GetPath(),
+			Logs: &config.SessionLogs{
+				Container: m.GetContainer(),
+				Previous:  m.IsPrevious(),
+			},
+		}, true
+	case *Xray, *Pulse:
+		return config.SessionView{}, false
+	case ResourceViewer:
+		if v.GVR() == "contexts" {
+			return config.SessionView{}, false
+		}
+		t := v.GetTable()
+		sv := config.SessionView{
+			GVR:       v.GVR(),
+			Path:      t.GetModel().GetInstance(),
+			Filter:    t.SearchBuff().String(),
+			Selection: t.GetSelectedItem(),
+		}
+		sv.Labels, sv.Fields = v.Selectors()
+		if col, count, asc := t.GetSortCol(); col >= 0 {
+			sv.Sort = &config.SessionSort{Column: col, ColCount: count, Asc: asc}
+		}
+		return sv, true
+	default:
+		return config.SessionView{}, false
+	}
+}
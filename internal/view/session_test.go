@@ -0,0 +1,20 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionViewSelectors(t *testing.T) {
+	v := NewPod(client.NewGVR("v1/pods"))
+	v.SetSelectors("app=blee", "spec.nodeName=n1")
+	assert.Nil(t, v.Init(makeContext()))
+
+	sv, ok := sessionView(v)
+	assert.True(t, ok)
+	assert.Equal(t, "v1/pods", sv.GVR)
+	assert.Equal(t, "app=blee", sv.Labels)
+	assert.Equal(t, "spec.nodeName=n1", sv.Fields)
+}
@@ -0,0 +1,253 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	skinTitle     = "Skin"
+	skinsMenuKey  = "skins"
+	stockSkin     = "stock"
+	currentSkin   = "current"
+	colorSwatch   = "████"
+	modifiedIndic = "*"
+)
+
+// Skin presents a skin editor previewing changes live.
+type Skin struct {
+	*Table
+
+	name  string
+	dirty bool
+}
+
+// NewSkin returns a new skin editor.
+func NewSkin() *Skin {
+	return &Skin{
+		Table: NewTable(client.NewGVR("skins")),
+		name:  currentSkin,
+	}
+}
+
+// Init initializes the component.
+func (s *Skin) Init(ctx context.Context) error {
+	if err := s.Table.Init(ctx); err != nil {
+		return err
+	}
+	s.bindKeys()
+	s.build()
+	s.SelectFirstRow()
+
+	return nil
+}
+
+// Start runs the component.
+func (s *Skin) Start() {
+	s.Stop()
+	s.Styles().AddListener(s)
+}
+
+// Stop terminates the component.
+func (s *Skin) Stop() {
+	s.Styles().RemoveListener(s)
+}
+
+// StylesChanged notifies the skin changed.
+func (s *Skin) StylesChanged(st *config.Styles) {
+	s.SetBackgroundColor(st.Table().BgColor.Color())
+	s.SetBorderColor(st.Table().FgColor.Color())
+	s.SetBorderFocusColor(st.Frame().Border.FocusColor.Color())
+	s.build()
+}
+
+func (s *Skin) bindKeys() {
+	s.Actions().Delete(ui.KeySpace, tcell.KeyCtrlSpace, ui.KeySlash, tcell.KeyCtrlU, ui.KeyShiftN, ui.KeyShiftA, ui.KeyShiftP, tcell.KeyCtrlZ, tcell.KeyCtrlW)
+	s.Actions().Set(ui.KeyActions{
+		tcell.KeyEnter:  ui.NewKeyAction("Edit", s.editCmd, true),
+		ui.KeyL:         ui.NewKeyAction("Load", s.loadCmd, true),
+		tcell.KeyCtrlS:  ui.NewKeyAction("Save As", s.saveAsCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", s.backCmd, true),
+	})
+}
+
+func (s *Skin) build() {
+	row, _ := s.GetSelection()
+	s.Clear()
+
+	st := s.app.Styles
+	h := st.Table().Header
+	for i, c := range []string{"KEY", "COLOR"} {
+		cell := tview.NewTableCell(c)
+		cell.SetTextColor(h.FgColor.Color())
+		cell.SetBackgroundColor(h.BgColor.Color())
+		cell.SetAttributes(tcell.AttrBold)
+		cell.SetSelectable(false)
+		if i == 1 {
+			cell.SetExpansion(1)
+		}
+		s.SetCell(0, i, cell)
+	}
+
+	for i, k := range st.ColorKeys() {
+		c, _ := st.GetColor(k)
+		key := tview.NewTableCell(k)
+		key.SetTextColor(st.Table().FgColor.Color())
+		s.SetCell(i+1, 0, key)
+		color := tview.NewTableCell(colorSwatch + " " + c.String())
+		color.SetTextColor(c.Color())
+		color.SetExpansion(1)
+		s.SetCell(i+1, 1, color)
+	}
+	if row > 0 && row < s.GetRowCount() {
+		s.Select(row, 0)
+	}
+	s.updateTitle()
+}
+
+func (s *Skin) updateTitle() {
+	name := s.name
+	if s.dirty {
+		name += modifiedIndic
+	}
+	title := fmt.Sprintf(ui.NSTitleFmt, skinTitle, name, s.GetRowCount()-1)
+	s.SetTitle(ui.SkinTitle(title, s.app.Styles.Frame()))
+}
+
+func (s *Skin) selectedKey() string {
+	r, _ := s.GetSelection()
+	if r <= 0 {
+		return ""
+	}
+
+	return s.GetCell(r, 0).Text
+}
+
+// apply previews the current styles on screen.
+func (s *Skin) apply() {
+	s.app.Styles.Update()
+	ui.SetRenderColors(s.app.Styles)
+}
+
+func (s *Skin) editCmd(evt *tcell.EventKey) *tcell.EventKey {
+	key := s.selectedKey()
+	if key == "" {
+		return nil
+	}
+	c, _ := s.app.Styles.GetColor(key)
+	pp := []config.PluginParam{{Name: "color", Description: "Color", Default: c.String()}}
+	text := "Use a color name, a #rrggbb hex value, `default` or `-` for transparent"
+	ShowParams(s.app, key, text, pp, func(params map[string]string) {
+		color := strings.TrimSpace(params["color"])
+		if !isColor(color) {
+			s.app.Flash().Errf("Invalid color %q", color)
+			return
+		}
+		if err := s.app.Styles.SetColor(key, config.NewColor(color)); err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		s.dirty = true
+		s.apply()
+	})
+
+	return nil
+}
+
+func (s *Skin) loadCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ss, err := config.ListSkins(config.K9sSkinsDir)
+	if err != nil {
+		s.app.Flash().Err(err)
+		return nil
+	}
+	skins := append([]string{stockSkin}, ss...)
+	ShowListMenu(s.app, skinsMenuKey, "Skins", skins, func(i int) {
+		if err := s.load(skins[i]); err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		s.app.Flash().Infof("Previewing skin %s", skins[i])
+	})
+
+	return nil
+}
+
+func (s *Skin) load(name string) error {
+	st := s.app.Styles
+	st.Reset()
+	if name != stockSkin {
+		if err := st.Load(config.SkinFile(name)); err != nil {
+			return fmt.Errorf("unable to load skin %q -- %s", name, err)
+		}
+	}
+	s.name, s.dirty = name, true
+	s.apply()
+
+	return nil
+}
+
+func (s *Skin) saveAsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	pp := []config.PluginParam{{Name: "name", Description: "Name", Default: s.name}}
+	text := fmt.Sprintf("The skin is saved in %s", config.K9sSkinsDir)
+	ShowParams(s.app, "Save Skin", text, pp, func(params map[string]string) {
+		name := strings.TrimSpace(params["name"])
+		if err := validSkinName(name); err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		path := config.SkinFile(name)
+		if err := s.app.Styles.Save(path); err != nil {
+			s.app.Flash().Err(err)
+			return
+		}
+		s.name, s.dirty = name, false
+		s.updateTitle()
+		s.app.Flash().Infof("Skin saved to %s", path)
+	})
+
+	return nil
+}
+
+func (s *Skin) backCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if s.dirty {
+		s.app.ReloadStyles(s.app.Config.K9s.CurrentContext)
+		s.app.Flash().Info("Skin changes discarded")
+	}
+
+	return s.app.PrevCmd(evt)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isColor(c string) bool {
+	switch config.Color(c) {
+	case config.DefaultColor, config.TransparentColor:
+		return true
+	}
+	if _, ok := tcell.ColorNames[c]; ok {
+		return true
+	}
+
+	return strings.HasPrefix(c, "#") && tcell.GetColor(c) != tcell.ColorDefault
+}
+
+func validSkinName(n string) error {
+	if n == "" {
+		return errors.New("skin name must be specified")
+	}
+	if n == stockSkin || strings.ContainsAny(n, `/\`) || filepath.Ext(n) != "" {
+		return fmt.Errorf("invalid skin name %q", n)
+	}
+
+	return nil
+}
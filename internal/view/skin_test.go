@@ -0,0 +1,20 @@
+package view_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkinNew(t *testing.T) {
+	s := view.NewSkin()
+
+	assert.Nil(t, s.Init(makeCtx()))
+	assert.Equal(t, "skins", s.Name())
+	assert.Equal(t, 4, len(s.Hints()))
+	assert.Equal(t, len(config.NewStyles().ColorKeys())+1, s.GetRowCount())
+	assert.Equal(t, "body.fgColor", s.GetCell(1, 0).Text)
+	assert.Equal(t, "████ cadetblue", s.GetCell(1, 1).Text)
+}
@@ -0,0 +1,55 @@
+package view
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+func (a *App) snapshotCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.Cmd().InCmdMode() {
+		return evt
+	}
+
+	cluster, title := a.Config.K9s.CurrentCluster, "k9s"
+	if top := a.Content.Top(); top != nil {
+		title = top.Name()
+	}
+	a.Snapshot(func(s ui.Snapshot) {
+		path, err := saveSnapshot(cluster, title, s)
+		if err != nil {
+			a.Flash().Err(err)
+			return
+		}
+		a.Flash().Infof("Snapshot saved to %s.{ansi,html}", path)
+	})
+	a.Draw()
+
+	return nil
+}
+
+// saveSnapshot writes out a screen snapshot as ansi text and html. Returns
+// the files path sans extension.
+func saveSnapshot(cluster, title string, s ui.Snapshot) (string, error) {
+	dir := filepath.Join(config.K9sDumpDir, cluster)
+	if err := ensureDir(dir); err != nil {
+		return "", err
+	}
+
+	name := fmt.Sprintf("%s-snapshot-%d", strings.Replace(title, "/", "-", -1), time.Now().UnixNano())
+	path := strings.ToLower(filepath.Join(dir, name))
+	if err := ioutil.WriteFile(path+".ansi", []byte(s.ANSI()), 0600); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(path+".html", []byte(s.HTML(title)), 0600); err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
@@ -0,0 +1,299 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+)
+
+const splitTitle = "Split"
+
+// SplitSpec represents a split view target.
+type SplitSpec struct {
+	Context   string
+	Resource  string
+	Namespace string
+}
+
+// ParseSplit parses a split command of the form `split ctx [resource] [ns]`.
+func ParseSplit(cmd string) (SplitSpec, error) {
+	tokens := strings.Fields(cmd)
+	if len(tokens) < 2 {
+		return SplitSpec{}, errors.New("You must specify a context")
+	}
+	if len(tokens) > 4 {
+		return SplitSpec{}, fmt.Errorf("invalid split command %q", cmd)
+	}
+	spec := SplitSpec{Context: tokens[1]}
+	if len(tokens) > 2 {
+		spec.Resource = tokens[2]
+	}
+	if len(tokens) > 3 {
+		spec.Namespace = tokens[3]
+	}
+
+	return spec, nil
+}
+
+// Split presents two resource listings side by side, either from different
+// contexts or from different namespaces of the same context.
+type Split struct {
+	*tview.Flex
+
+	app   *App
+	gvr   client.GVR
+	panes []*splitPane
+	focus int
+}
+
+// NewSplit returns a new split view.
+func NewSplit(gvr client.GVR, left, right splitTarget) *Split {
+	s := Split{
+		Flex: tview.NewFlex(),
+		gvr:  gvr,
+	}
+	s.SetDirection(tview.FlexColumn)
+	s.panes = []*splitPane{
+		newSplitPane(&s, gvr, left),
+		newSplitPane(&s, gvr, right),
+	}
+
+	return &s
+}
+
+// Init initializes the view.
+func (s *Split) Init(ctx context.Context) error {
+	var err error
+	if s.app, err = extractApp(ctx); err != nil {
+		return err
+	}
+
+	meta, err := dao.MetaAccess.MetaFor(s.gvr)
+	if err != nil {
+		return err
+	}
+	for i, p := range s.panes {
+		if err := p.Init(ctx); err != nil {
+			return err
+		}
+		ns := client.CleanseNamespace(p.target.namespace)
+		if !meta.Namespaced {
+			ns = client.ClusterScope
+		}
+		p.GetModel().SetNamespace(ns)
+		s.AddItem(p, 0, 1, i == 0)
+	}
+
+	return nil
+}
+
+// Name returns the component name.
+func (s *Split) Name() string { return splitTitle }
+
+// Start starts the panes updates.
+func (s *Split) Start() {
+	for _, p := range s.panes {
+		p.Start()
+	}
+}
+
+// Stop terminates the panes updates.
+func (s *Split) Stop() {
+	for _, p := range s.panes {
+		p.Stop()
+	}
+}
+
+// Hints returns the focused pane menu hints.
+func (s *Split) Hints() model.MenuHints {
+	return s.panes[s.focus].Hints()
+}
+
+// ExtraHints returns additional hints.
+func (s *Split) ExtraHints() map[string]string {
+	return nil
+}
+
+func (s *Split) focusCmd(evt *tcell.EventKey) *tcell.EventKey {
+	s.focus = (s.focus + 1) % len(s.panes)
+	s.app.SetFocus(s.panes[s.focus])
+	s.app.Menu().HydrateMenu(s.Hints())
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Pane...
+
+type splitTarget struct {
+	context   string
+	namespace string
+	factory   *watch.Factory
+}
+
+// key identifies the pane listing.
+func (t splitTarget) key() string {
+	return t.context + "/" + client.CleanseNamespace(t.namespace)
+}
+
+// title returns the pane title.
+func (t splitTarget) title(gvr client.GVR) string {
+	if client.IsAllNamespaces(t.namespace) {
+		return fmt.Sprintf("%s@%s", gvr.R(), t.context)
+	}
+
+	return fmt.Sprintf("%s(%s)@%s", gvr.R(), t.namespace, t.context)
+}
+
+type splitPane struct {
+	*Table
+
+	split    *Split
+	target   splitTarget
+	cancelFn context.CancelFunc
+}
+
+func newSplitPane(s *Split, gvr client.GVR, t splitTarget) *splitPane {
+	return &splitPane{
+		Table:  NewTable(gvr),
+		split:  s,
+		target: t,
+	}
+}
+
+// Init initializes the pane.
+func (p *splitPane) Init(ctx context.Context) error {
+	if err := p.Table.Init(ctx); err != nil {
+		return err
+	}
+	p.BaseTitle = p.target.title(p.gvr)
+	p.SetColorerFn(p.colorer())
+	p.Actions().Add(ui.KeyActions{
+		tcell.KeyTab:    ui.NewKeyAction("Switch Pane", p.split.focusCmd, true),
+		tcell.KeyEscape: ui.NewKeyAction("Back", p.app.PrevCmd, true),
+	})
+	p.app.remapComponent(p, p.gvr.R(), splitTitle)
+	p.GetModel().AddListener(p)
+
+	return nil
+}
+
+// Start starts the pane updates.
+func (p *splitPane) Start() {
+	p.Stop()
+	p.Table.Start()
+
+	ctx := context.Background()
+	ctx = context.WithValue(ctx, internal.KeyFactory, p.target.factory)
+	ctx = context.WithValue(ctx, internal.KeyGVR, p.gvr.String())
+	ctx = context.WithValue(ctx, internal.KeyPath, "")
+	ctx = context.WithValue(ctx, internal.KeyLabels, "")
+	if ui.IsLabelSelector(p.SearchBuff().String()) {
+		ctx = context.WithValue(ctx, internal.KeyLabels, ui.TrimLabelSelector(p.SearchBuff().String()))
+	}
+	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	if ui.IsFieldSelector(p.SearchBuff().String()) {
+		ctx = context.WithValue(ctx, internal.KeyFields, ui.TrimFieldSelector(p.SearchBuff().String()))
+	}
+	ctx = context.WithValue(ctx, internal.KeyNamespace, p.GetModel().GetNamespace())
+	ctx = context.WithValue(ctx, internal.KeyScripts, p.app.scripts)
+	ctx, p.cancelFn = context.WithCancel(ctx)
+	p.GetModel().Watch(ctx)
+}
+
+// Stop terminates the pane updates.
+func (p *splitPane) Stop() {
+	if p.cancelFn == nil {
+		return
+	}
+	p.Table.Stop()
+	p.cancelFn()
+	p.cancelFn = nil
+}
+
+// TableDataChanged notifies the model data changed.
+func (p *splitPane) TableDataChanged(data render.TableData) {
+	p.app.QueueUpdateDraw(func() {
+		p.Update(data)
+	})
+}
+
+// TableLoadFailed notifies the load failed.
+func (p *splitPane) TableLoadFailed(err error) {
+	p.app.QueueUpdateDraw(func() {
+		p.app.Flash().Errf("%s -- %s", p.target.key(), err)
+	})
+}
+
+func (p *splitPane) colorer() render.ColorerFunc {
+	if m, ok := model.Registry[p.gvr.String()]; ok && m.Renderer != nil {
+		return m.Renderer.ColorerFunc()
+	}
+
+	return render.DefaultColorer
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (a *App) splitCmd(cmd string) error {
+	spec, err := ParseSplit(cmd)
+	if err != nil {
+		return err
+	}
+
+	gvr := client.NewGVR("v1/pods")
+	if v, ok := a.Content.Top().(ResourceViewer); ok && v.GetTable() != nil {
+		gvr = client.NewGVR(v.GVR())
+	}
+	if spec.Resource != "" {
+		var ok bool
+		if gvr, ok = a.command.alias.AsGVR(spec.Resource); !ok {
+			return fmt.Errorf("Huh? `%s` resource not found", spec.Resource)
+		}
+	}
+	left := splitTarget{
+		context:   a.Config.K9s.CurrentContext,
+		namespace: a.Config.ActiveNamespace(),
+		factory:   a.factory,
+	}
+	right := splitTarget{context: spec.Context, namespace: left.namespace}
+	if spec.Namespace != "" {
+		right.namespace = spec.Namespace
+	}
+	if right.key() == left.key() {
+		return fmt.Errorf("%s is already active", right.key())
+	}
+	if right.context == left.context {
+		right.factory = a.factory
+		return a.inject(NewSplit(gvr, left, right))
+	}
+
+	a.Flash().Infof("Connecting to context %s...", right.context)
+	go func() {
+		f, err := a.contexts.For(right.context)
+		a.QueueUpdateDraw(func() {
+			if err != nil {
+				a.Flash().Err(err)
+				return
+			}
+			right.factory = f
+			if err := a.inject(NewSplit(gvr, left, right)); err != nil {
+				a.Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
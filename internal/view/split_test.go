@@ -0,0 +1,76 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSplit(t *testing.T) {
+	uu := map[string]struct {
+		cmd string
+		e   SplitSpec
+		err string
+	}{
+		"context": {
+			cmd: "split staging",
+			e:   SplitSpec{Context: "staging"},
+		},
+		"resource": {
+			cmd: "split staging dp",
+			e:   SplitSpec{Context: "staging", Resource: "dp"},
+		},
+		"full": {
+			cmd: "split  staging dp  kube-system",
+			e:   SplitSpec{Context: "staging", Resource: "dp", Namespace: "kube-system"},
+		},
+		"missing": {
+			cmd: "split",
+			err: "You must specify a context",
+		},
+		"extra": {
+			cmd: "split staging dp fred blee",
+			err: `invalid split command "split staging dp fred blee"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			spec, err := ParseSplit(u.cmd)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, spec)
+		})
+	}
+}
+
+func TestSplitTargetKey(t *testing.T) {
+	uu := map[string]struct {
+		t        splitTarget
+		key, ttl string
+	}{
+		"all": {
+			t:   splitTarget{context: "c1", namespace: "all"},
+			key: "c1/",
+			ttl: "pods@c1",
+		},
+		"ns": {
+			t:   splitTarget{context: "c1", namespace: "fred"},
+			key: "c1/fred",
+			ttl: "pods(fred)@c1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.key, u.t.key())
+			assert.Equal(t, u.ttl, u.t.title(client.NewGVR("v1/pods")))
+		})
+	}
+}
@@ -41,6 +41,7 @@ func NewService(gvr client.GVR) ResourceViewer {
 func (s *Service) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlB: ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
+		ui.KeyB:        ui.NewKeyAction("Bench Config", s.benchConfigCmd, true),
 		ui.KeyShiftT:   ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd(1, true), false),
 	})
 }
@@ -107,23 +108,58 @@ func (s *Service) toggleBenchCmd(evt *tcell.EventKey) *tcell.EventKey {
 		s.App().Flash().Errf("No bench config found for service %s", sel)
 		return nil
 	}
+	if err := s.startBenchmark(sel, cfg); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (s *Service) benchConfigCmd(evt *tcell.EventKey) *tcell.EventKey {
+	sel := s.GetTable().GetSelectedItem()
+	if sel == "" {
+		return evt
+	}
+
+	file := s.App().BenchFile
+	cfg := config.DefaultBenchSpec()
+	if cust, err := config.NewBench(file); err == nil {
+		if c, ok := cust.Benchmarks.Services[sel]; ok {
+			cfg = c
+		}
+	}
+	save := func(cfg config.BenchConfig) error {
+		return saveBench(file, func(b *config.Bench) {
+			b.SetService(sel, cfg)
+		})
+	}
+	test := func(cfg config.BenchConfig) error {
+		return s.startBenchmark(sel, cfg)
+	}
+	ShowBenchConfig(s.App(), sel, cfg, save, test)
+
+	return nil
+}
+
+func (s *Service) startBenchmark(sel string, cfg config.BenchConfig) error {
+	if s.bench != nil {
+		return errors.New("A benchmark is already in progress")
+	}
 	cfg.Name = sel
 	log.Debug().Msgf("Benchmark config %#v", cfg)
 
 	row := s.GetTable().GetSelectedRowIndex()
-	if e := s.checkSvc(row); e != nil {
-		s.App().Flash().Err(e)
-		return nil
+	if err := s.checkSvc(row); err != nil {
+		return err
 	}
 	port, err := s.getExternalPort(row)
 	if err != nil {
-		s.App().Flash().Err(err)
-		return nil
+		return err
 	}
 	if err := s.runBenchmark(port, cfg); err != nil {
-		s.App().Flash().Errf("Benchmark failed %v", err)
 		s.App().ClearStatus(false)
 		s.bench = nil
+		return fmt.Errorf("Benchmark failed %v", err)
 	}
 
 	return nil
@@ -155,6 +191,7 @@ func (s *Service) benchDone() {
 			s.App().Status(model.FlashInfo, "Benchmark canceled")
 		} else {
 			s.App().Status(model.FlashInfo, "Benchmark Completed!")
+			s.App().notify(config.NotifyBenchmark, "Benchmark completed", s.bench.Name())
 			s.bench.Cancel()
 		}
 		s.bench = nil
@@ -2,11 +2,13 @@ package view
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/atotto/clipboard"
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/gdamore/tcell"
 	"github.com/rs/zerolog/log"
@@ -21,6 +23,8 @@ type Table struct {
 	enterFn    EnterFunc
 	envFn      EnvFunc
 	bindKeysFn BindKeysFunc
+	pendingSel string
+	announced  string
 }
 
 // NewTable returns a new viewer.
@@ -41,6 +45,13 @@ func (t *Table) Init(ctx context.Context) (err error) {
 	}
 	ctx = context.WithValue(ctx, internal.KeyStyles, t.app.Styles)
 	t.Table.Init(ctx)
+	l := t.app.Config.K9s.ActiveCluster().View.Layout(t.GVR())
+	t.SetColumnWidths(l.Widths)
+	t.SetFrozen(l.Freeze)
+	t.SetStatusGlyphs(t.app.Config.K9s.AccessibilityConfig().StatusGlyphs)
+	if t.app.announcer != nil {
+		t.AddSelectedRowFn(t.announceSelection)
+	}
 	t.bindKeys()
 	t.GetModel().SetRefreshRate(time.Duration(t.app.Config.K9s.GetRefreshRate()) * time.Second)
 
@@ -53,6 +64,18 @@ func (t *Table) Name() string { return t.BaseTitle }
 // GVR returns a resource descriptor.
 func (t *Table) GVR() string { return t.gvr.String() }
 
+// SelectOnLoad selects a given item once the table data is loaded.
+func (t *Table) SelectOnLoad(id string) { t.pendingSel = id }
+
+// selectPending selects the pending item, if still listed, on first load.
+func (t *Table) selectPending() {
+	if t.pendingSel == "" || t.GetRowCount() <= 1 {
+		return
+	}
+	t.SelectItem(t.pendingSel)
+	t.pendingSel = ""
+}
+
 // SetBindKeysFn adds additional key bindings.
 func (t *Table) SetBindKeysFn(f BindKeysFunc) { t.bindKeysFn = f }
 
@@ -121,11 +144,26 @@ func (t *Table) saveCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (t *Table) exportCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ShowExport(t.app, t.BaseTitle, func(format string) {
+		path, err := exportTable(t.app.Config.K9s.CurrentCluster, t.BaseTitle, t.Path, format, t.Table)
+		if err != nil {
+			t.app.Flash().Err(err)
+			return
+		}
+		t.app.Flash().Infof("Table exported to %s", path)
+	})
+
+	return nil
+}
+
 func (t *Table) bindKeys() {
 	t.Actions().Add(ui.KeyActions{
 		ui.KeySpace:         ui.NewSharedKeyAction("Mark", t.markCmd, false),
 		tcell.KeyCtrlSpace:  ui.NewSharedKeyAction("Marks Clear", t.clearMarksCmd, false),
 		tcell.KeyCtrlS:      ui.NewSharedKeyAction("Save", t.saveCmd, false),
+		tcell.KeyCtrlY:      ui.NewSharedKeyAction("Export", t.exportCmd, false),
+		ui.KeyShiftV:        ui.NewSharedKeyAction("Copy Values", t.cpValuesCmd, false),
 		ui.KeySlash:         ui.NewSharedKeyAction("Filter Mode", t.activateCmd, false),
 		tcell.KeyCtrlU:      ui.NewSharedKeyAction("Clear Filter", t.clearCmd, false),
 		tcell.KeyBackspace2: ui.NewSharedKeyAction("Erase", t.eraseCmd, false),
@@ -135,7 +173,78 @@ func (t *Table) bindKeys() {
 		tcell.KeyCtrlZ:      ui.NewKeyAction("Toggle Faults", t.toggleFaultCmd, false),
 		ui.KeyShiftA:        ui.NewKeyAction("Sort Age", t.SortColCmd(-1, true), false),
 		tcell.KeyCtrlW:      ui.NewKeyAction("Show Wide", t.toggleWideCmd, false),
+		tcell.KeyLeft:       ui.NewSharedKeyAction("Column Left", t.columnCursorCmd(-1), false),
+		tcell.KeyRight:      ui.NewSharedKeyAction("Column Right", t.columnCursorCmd(1), false),
+		ui.KeyLessThan:      ui.NewSharedKeyAction("Shrink Column", t.resizeColumnCmd(-ui.ColumnStep), false),
+		ui.KeyGreaterThan:   ui.NewSharedKeyAction("Widen Column", t.resizeColumnCmd(ui.ColumnStep), false),
+		ui.KeyEqual:         ui.NewSharedKeyAction("Reset Column", t.resetColumnCmd, false),
+		ui.KeyPipe:          ui.NewSharedKeyAction("Freeze Name", t.freezeCmd, false),
+	})
+}
+
+func (t *Table) columnCursorCmd(delta int) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if t.SearchBuff().IsActive() {
+			return evt
+		}
+		t.MoveColumnCursor(delta)
+
+		return nil
+	}
+}
+
+func (t *Table) resizeColumnCmd(delta int) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if t.SearchBuff().IsActive() {
+			return evt
+		}
+		name, w := t.ResizeColumn(delta)
+		if name == "" {
+			return nil
+		}
+		t.app.Flash().Infof("Column %s width set to %d", name, w)
+		t.saveLayout()
+
+		return nil
+	}
+}
+
+func (t *Table) resetColumnCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if t.SearchBuff().IsActive() {
+		return evt
+	}
+	if name := t.ResetColumn(); name != "" {
+		t.app.Flash().Infof("Column %s width reset", name)
+		t.saveLayout()
+	}
+
+	return nil
+}
+
+func (t *Table) freezeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if t.SearchBuff().IsActive() {
+		return evt
+	}
+	t.SetFrozen(!t.IsFrozen())
+	if t.IsFrozen() {
+		t.app.Flash().Info("Name column frozen")
+	} else {
+		t.app.Flash().Info("Name column unfrozen")
+	}
+	t.saveLayout()
+
+	return nil
+}
+
+// saveLayout persists the view column layout in the cluster configuration.
+func (t *Table) saveLayout() {
+	t.app.Config.K9s.ActiveCluster().View.SetLayout(t.GVR(), config.ColumnLayout{
+		Widths: t.ColumnWidths(),
+		Freeze: t.IsFrozen(),
 	})
+	if err := t.app.Config.Save(); err != nil {
+		log.Error().Err(err).Msg("Config file save failed!")
+	}
 }
 
 func (t *Table) toggleFaultCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -166,6 +275,43 @@ func (t *Table) cpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (t *Table) cpValuesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := t.GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+
+	data := t.GetVisibleData()
+	fields, ok := rowFields(data, id)
+	if !ok {
+		return evt
+	}
+	ShowListMenu(t.app, copyMenuKey, "Copy", copyModes, func(mode int) {
+		if copyModes[mode] == copyRow {
+			t.copyValues("row", strings.Join(fields, "\t"))
+			return
+		}
+		cols := data.Header.Columns()
+		ShowListMenu(t.app, copyMenuKey, "Copy "+copyModes[mode], cols, func(col int) {
+			if copyModes[mode] == copyColumn {
+				t.copyValues(cols[col]+" column", columnValues(data, col))
+				return
+			}
+			t.copyValues(cols[col], fields[col])
+		})
+	})
+
+	return nil
+}
+
+func (t *Table) copyValues(what, s string) {
+	if err := clipboard.WriteAll(s); err != nil {
+		t.app.Flash().Err(err)
+		return
+	}
+	t.app.Flash().Infof("Copied %s to clipboard", strings.ToLower(what))
+}
+
 func (t *Table) markCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := t.GetSelectedItem()
 	if path == "" {
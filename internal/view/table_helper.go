@@ -15,11 +15,20 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
+const (
+	copyMenuKey = "copyMenu"
+	copyCell    = "Cell"
+	copyColumn  = "Column"
+	copyRow     = "Row (TSV)"
+)
+
+var copyModes = []string{copyCell, copyColumn, copyRow}
+
 func trimCellRelative(t *Table, row, col int) string {
 	return ui.TrimCell(t.SelectTable, row, t.NameColIndex()+col)
 }
 
-func computeFilename(cluster, ns, title, path string) (string, error) {
+func computeFilename(cluster, ns, title, path, ext string) (string, error) {
 	now := time.Now().UnixNano()
 
 	dir := filepath.Join(config.K9sDumpDir, cluster)
@@ -34,9 +43,9 @@ func computeFilename(cluster, ns, title, path string) (string, error) {
 
 	var fName string
 	if ns == client.ClusterScope {
-		fName = fmt.Sprintf(ui.NoNSFmat, name, now)
+		fName = fmt.Sprintf(ui.NoNSFmat, name, now, ext)
 	} else {
-		fName = fmt.Sprintf(ui.FullFmat, name, ns, now)
+		fName = fmt.Sprintf(ui.FullFmat, name, ns, now, ext)
 	}
 
 	return strings.ToLower(filepath.Join(dir, fName)), nil
@@ -48,7 +57,7 @@ func saveTable(cluster, title, path string, data render.TableData) (string, erro
 		ns = client.NamespaceAll
 	}
 
-	fPath, err := computeFilename(cluster, ns, title, path)
+	fPath, err := computeFilename(cluster, ns, title, path, render.ExportCSV)
 	if err != nil {
 		return "", err
 	}
@@ -82,3 +91,55 @@ func saveTable(cluster, title, path string, data render.TableData) (string, erro
 
 	return fPath, nil
 }
+
+func exportTable(cluster, title, path, format string, t *ui.Table) (string, error) {
+	ns := t.GetModel().GetNamespace()
+	if client.IsClusterWide(ns) {
+		ns = client.NamespaceAll
+	}
+
+	fPath, err := computeFilename(cluster, ns, title, path, format)
+	if err != nil {
+		return "", err
+	}
+	log.Debug().Msgf("Exporting Table to %s", fPath)
+
+	out, err := os.OpenFile(fPath, os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := out.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing file")
+		}
+	}()
+
+	if err := t.Export(out, format); err != nil {
+		return "", err
+	}
+
+	return fPath, nil
+}
+
+// rowFields returns the fields of a given row.
+func rowFields(data render.TableData, id string) ([]string, bool) {
+	for _, re := range data.RowEvents {
+		if re.Row.ID == id {
+			return re.Row.Fields, true
+		}
+	}
+
+	return nil, false
+}
+
+// columnValues returns a column values, one per line.
+func columnValues(data render.TableData, col int) string {
+	vv := make([]string, 0, len(data.RowEvents))
+	for _, re := range data.RowEvents {
+		if col < len(re.Row.Fields) {
+			vv = append(vv, re.Row.Fields[col])
+		}
+	}
+
+	return strings.Join(vv, "\n")
+}
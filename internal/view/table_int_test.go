@@ -84,6 +84,26 @@ func TestTableViewSort(t *testing.T) {
 	assert.Equal(t, "fred", v.GetCell(1, 1).Text)
 }
 
+func TestRowFields(t *testing.T) {
+	data := makeTableData()
+	data.RowEvents[0].Row.ID, data.RowEvents[1].Row.ID = "ns1/blee", "ns1/fred"
+
+	ff, ok := rowFields(data, "ns1/fred")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"ns1", "fred", "15", "1m"}, ff)
+
+	_, ok = rowFields(data, "ns1/zorg")
+	assert.False(t, ok)
+}
+
+func TestColumnValues(t *testing.T) {
+	data := makeTableData()
+
+	assert.Equal(t, "blee\nfred", columnValues(data, 1))
+	assert.Equal(t, "10\n15", columnValues(data, 2))
+	assert.Equal(t, "", columnValues(data, 10))
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -92,6 +112,7 @@ type testTableModel struct{}
 var _ ui.Tabular = &testTableModel{}
 
 func (t *testTableModel) SetInstance(string)              {}
+func (t *testTableModel) GetInstance() string             { return "" }
 func (t *testTableModel) Empty() bool                     { return false }
 func (t *testTableModel) Peek() render.TableData          { return makeTableData() }
 func (t *testTableModel) ClusterWide() bool               { return false }
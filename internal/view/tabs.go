@@ -0,0 +1,280 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+const (
+	maxTabs    = 9
+	tabMenuKey = "tabs"
+)
+
+// Tab tracks a workspace, ie a view stack along with its context and namespace.
+type Tab struct {
+	Context string
+	Session config.Session
+	nav     *NavHistory
+}
+
+// Title returns a tab summary, ie ctx (default) v1/pods.
+func (t *Tab) Title() string {
+	s := t.Context
+	if t.Session.Namespace != "" {
+		s += " (" + t.Session.Namespace + ")"
+	}
+	if n := len(t.Session.Views); n > 0 {
+		s += " " + NavEntry{View: t.Session.Views[n-1]}.Title()
+	}
+
+	return s
+}
+
+// Tabs tracks open workspaces. Only the active tab is live, the others are
+// snapshots restored when switched to.
+type Tabs struct {
+	tabs   []*Tab
+	active int
+}
+
+// NewTabs returns a new tabs tracker with a single tab.
+func NewTabs() *Tabs {
+	return &Tabs{tabs: []*Tab{{}}}
+}
+
+// Add opens a new tab and returns its index.
+func (t *Tabs) Add(tab *Tab) (int, error) {
+	if len(t.tabs) >= maxTabs {
+		return 0, fmt.Errorf("no more than %d tabs may be opened", maxTabs)
+	}
+	t.tabs = append(t.tabs, tab)
+
+	return len(t.tabs) - 1, nil
+}
+
+// Remove closes a tab. The active tab moves to the next tab if any.
+func (t *Tabs) Remove(i int) error {
+	if len(t.tabs) == 1 {
+		return errors.New("the last tab can not be closed")
+	}
+	if i < 0 || i >= len(t.tabs) {
+		return fmt.Errorf("no tab %d", i+1)
+	}
+	t.tabs = append(t.tabs[:i], t.tabs[i+1:]...)
+	if t.active > i || t.active >= len(t.tabs) {
+		t.active--
+	}
+
+	return nil
+}
+
+// Get returns the tab at the given index.
+func (t *Tabs) Get(i int) (*Tab, bool) {
+	if i < 0 || i >= len(t.tabs) {
+		return nil, false
+	}
+
+	return t.tabs[i], true
+}
+
+// Active returns the active tab index.
+func (t *Tabs) Active() int {
+	return t.active
+}
+
+// SetActive activates the tab at the given index.
+func (t *Tabs) SetActive(i int) bool {
+	if _, ok := t.Get(i); !ok {
+		return false
+	}
+	t.active = i
+
+	return true
+}
+
+// Len returns the number of open tabs.
+func (t *Tabs) Len() int {
+	return len(t.tabs)
+}
+
+// Contexts returns the context of each tab.
+func (t *Tabs) Contexts() []string {
+	cc := make([]string, 0, len(t.tabs))
+	for _, tab := range t.tabs {
+		cc = append(cc, tab.Context)
+	}
+
+	return cc
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// tabKeys binds Alt-1 to Alt-9 to the tabs.
+func (a *App) tabKeys() ui.KeyActions {
+	aa := make(ui.KeyActions, maxTabs)
+	for i := 1; i <= maxTabs; i++ {
+		aa[ui.AltNumKeys[i]] = ui.NewSharedKeyAction(fmt.Sprintf("Tab %d", i), a.gotoTabCmd(i-1), false)
+	}
+
+	return aa
+}
+
+func (a *App) gotoTabCmd(i int) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if a.InCmdMode() || a.inFilterMode() {
+			return evt
+		}
+		if err := a.gotoTab(i); err != nil {
+			a.Flash().Err(err)
+		}
+
+		return nil
+	}
+}
+
+// tabCmd manages tabs, ie tab new, tab close or tab 2.
+func (a *App) tabCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	if len(tokens) == 1 {
+		a.tabsMenu()
+		return nil
+	}
+
+	switch tokens[1] {
+	case "new":
+		return a.newTab()
+	case "close":
+		return a.closeTab()
+	default:
+		i, err := strconv.Atoi(tokens[1])
+		if err != nil {
+			return fmt.Errorf("invalid tab command %q. Expecting tab [new|close|1-%d]", cmd, maxTabs)
+		}
+		return a.gotoTab(i - 1)
+	}
+}
+
+// tabsMenu pops a picker listing the open tabs.
+func (a *App) tabsMenu() {
+	a.saveTab()
+	items := make([]string, 0, a.tabs.Len())
+	for i := 0; i < a.tabs.Len(); i++ {
+		prefix := "  "
+		if i == a.tabs.Active() {
+			prefix = navCurrent
+		}
+		tab, _ := a.tabs.Get(i)
+		items = append(items, fmt.Sprintf("%s%d: %s", prefix, i+1, tab.Title()))
+	}
+	ShowListMenu(a, tabMenuKey, "Tabs", items, func(i int) {
+		if err := a.gotoTab(i); err != nil {
+			a.Flash().Err(err)
+		}
+	})
+}
+
+// newTab opens a new tab on the current location.
+func (a *App) newTab() error {
+	a.saveTab()
+	cur, _ := a.tabs.Get(a.tabs.Active())
+	tab := Tab{Context: cur.Context, Session: cur.Session, nav: NewNavHistory()}
+	i, err := a.tabs.Add(&tab)
+	if err != nil {
+		return err
+	}
+	a.tabs.SetActive(i)
+	a.navHistory = tab.nav
+	if e, ok := a.navEntry(a.Content.Top()); ok {
+		a.navHistory.Record(e)
+	}
+	a.refreshTabs()
+	a.Flash().Infof("Tab %d opened", i+1)
+
+	return nil
+}
+
+// closeTab closes the active tab and switches to the next one.
+func (a *App) closeTab() error {
+	closed := a.tabs.Active()
+	if err := a.tabs.Remove(closed); err != nil {
+		return err
+	}
+	if err := a.loadTab(a.tabs.Active()); err != nil {
+		return err
+	}
+	a.Flash().Infof("Tab %d closed", closed+1)
+
+	return nil
+}
+
+// gotoTab switches to the tab at the given index.
+func (a *App) gotoTab(i int) error {
+	if i == a.tabs.Active() {
+		return nil
+	}
+	if _, ok := a.tabs.Get(i); !ok {
+		return fmt.Errorf("no tab %d", i+1)
+	}
+	a.saveTab()
+	if err := a.loadTab(i); err != nil {
+		return err
+	}
+	a.Flash().Infof("Switched to tab %d", i+1)
+
+	return nil
+}
+
+// saveTab snapshots the active tab.
+func (a *App) saveTab() {
+	tab, ok := a.tabs.Get(a.tabs.Active())
+	if !ok {
+		return
+	}
+	a.snapshotNav()
+	tab.Context = a.Config.K9s.CurrentContext
+	tab.Session = a.currentSession()
+	tab.nav = a.navHistory
+}
+
+// loadTab restores a tab, switching context if need be.
+func (a *App) loadTab(i int) error {
+	tab, ok := a.tabs.Get(i)
+	if !ok {
+		return fmt.Errorf("no tab %d", i+1)
+	}
+
+	a.navRecorder.navigating = true
+	defer func() { a.navRecorder.navigating = false }()
+
+	// Context switches reset the navigation history, shield the tabs histories.
+	a.navHistory = NewNavHistory()
+	if tab.Context != "" && tab.Context != a.Config.K9s.CurrentContext {
+		if err := useContext(a, tab.Context); err != nil {
+			return err
+		}
+	}
+	a.tabs.SetActive(i)
+	if !a.loadSession(&tab.Session) {
+		if err := a.command.defaultCmd(); err != nil {
+			return err
+		}
+	}
+	a.navHistory = tab.nav
+	a.refreshTabs()
+
+	return nil
+}
+
+// refreshTabs lists the open tabs in the crumbs.
+func (a *App) refreshTabs() {
+	cc := a.tabs.Contexts()
+	cc[a.tabs.Active()] = a.Config.K9s.CurrentContext
+	a.Crumbs().SetTabs(cc, a.tabs.Active())
+}
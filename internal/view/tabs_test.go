@@ -0,0 +1,106 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTabsAdd(t *testing.T) {
+	tt := NewTabs()
+	assert.Equal(t, 1, tt.Len())
+	assert.Equal(t, 0, tt.Active())
+
+	for i := 1; i < maxTabs; i++ {
+		idx, err := tt.Add(&Tab{Context: "fred"})
+		assert.Nil(t, err)
+		assert.Equal(t, i, idx)
+	}
+	_, err := tt.Add(&Tab{Context: "fred"})
+	assert.NotNil(t, err)
+	assert.Equal(t, maxTabs, tt.Len())
+
+	assert.True(t, tt.SetActive(2))
+	assert.False(t, tt.SetActive(maxTabs))
+	assert.Equal(t, 2, tt.Active())
+}
+
+func TestTabsRemove(t *testing.T) {
+	uu := map[string]struct {
+		active, remove, expected int
+		contexts                 []string
+		err                      bool
+	}{
+		"last": {
+			active: 2, remove: 2, expected: 1,
+			contexts: []string{"c1", "c2"},
+		},
+		"middle": {
+			active: 1, remove: 1, expected: 1,
+			contexts: []string{"c1", "c3"},
+		},
+		"before": {
+			active: 2, remove: 0, expected: 1,
+			contexts: []string{"c2", "c3"},
+		},
+		"after": {
+			active: 0, remove: 1, expected: 0,
+			contexts: []string{"c1", "c3"},
+		},
+		"out": {
+			active: 0, remove: 3, expected: 0, err: true,
+			contexts: []string{"c1", "c2", "c3"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tt := Tabs{tabs: []*Tab{{Context: "c1"}, {Context: "c2"}, {Context: "c3"}}}
+			tt.SetActive(u.active)
+			err := tt.Remove(u.remove)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.expected, tt.Active())
+			assert.Equal(t, u.contexts, tt.Contexts())
+		})
+	}
+}
+
+func TestTabsRemoveLast(t *testing.T) {
+	tt := NewTabs()
+	assert.NotNil(t, tt.Remove(0))
+	assert.Equal(t, 1, tt.Len())
+}
+
+func TestTabTitle(t *testing.T) {
+	uu := map[string]struct {
+		t Tab
+		e string
+	}{
+		"empty": {
+			t: Tab{Context: "c1"},
+			e: "c1",
+		},
+		"views": {
+			t: Tab{
+				Context: "c1",
+				Session: config.Session{
+					Namespace: "default",
+					Views: []config.SessionView{
+						{GVR: "v1/pods"},
+						{GVR: "v1/pods", Path: "default/p1", Logs: &config.SessionLogs{}},
+					},
+				},
+			},
+			e: "c1 (default) logs default/p1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.t.Title())
+		})
+	}
+}
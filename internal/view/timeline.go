@@ -0,0 +1,134 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// Timeline presents the recorded changes of an object.
+type Timeline struct {
+	ResourceViewer
+
+	target, path string
+	recording    bool
+}
+
+// NewTimeline returns a new viewer.
+func NewTimeline(gvr client.GVR) ResourceViewer {
+	return newTimeline(gvr, "", "")
+}
+
+// newObjectTimeline returns a viewer listing the changes of a given object.
+func newObjectTimeline(target, path string) ResourceViewer {
+	return newTimeline(client.NewGVR("timelines"), target, path)
+}
+
+func newTimeline(gvr client.GVR, target, path string) *Timeline {
+	t := Timeline{
+		ResourceViewer: NewBrowser(gvr),
+		target:         target,
+		path:           path,
+	}
+	t.SetBindKeysFn(t.bindKeys)
+	t.GetTable().SetColorerFn(render.Timeline{}.ColorerFunc())
+	t.GetTable().SetSortCol(0, 0, false)
+	t.GetTable().SetEnterFn(t.showRevision)
+	t.SetContextFn(t.timelineContext)
+
+	return &t
+}
+
+// Start records the object changes while its timeline is shown.
+func (t *Timeline) Start() {
+	if !t.recording && t.target != "" {
+		t.App().factory.Timeline().Watch(t.target, t.path)
+		t.recording = true
+	}
+	t.ResourceViewer.Start()
+}
+
+// Stop terminates updates.
+func (t *Timeline) Stop() {
+	if t.recording {
+		t.App().factory.Timeline().Unwatch(t.target, t.path)
+		t.recording = false
+	}
+	t.ResourceViewer.Stop()
+}
+
+func (t *Timeline) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Revision", t.GetTable().SortColCmd(0, false), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Changes", t.GetTable().SortColCmd(3, false), false),
+	})
+}
+
+func (t *Timeline) timelineContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyTimeline, t.App().factory.Timeline())
+	ctx = context.WithValue(ctx, internal.KeyTimelineGVR, t.target)
+
+	return context.WithValue(ctx, internal.KeyPath, t.path)
+}
+
+func (t *Timeline) showRevision(app *App, _ ui.Tabular, _, id string) {
+	i, err := strconv.Atoi(id)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	rr := app.factory.Timeline().Revisions(t.target, t.path)
+	if t.target == secretGVR && app.Config.K9s.MaskSecrets() {
+		for j := range rr {
+			rr[j].Object = maskSecretMap(rr[j].Object)
+		}
+	}
+	diff, err := dao.RevisionDiff(rr, i)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if diff == "" {
+		app.Flash().Info("No differences found")
+		return
+	}
+
+	details := NewDetails(app, "Revision", fmt.Sprintf("%s #%d", t.path, i), true).Update(diff)
+	if err := app.inject(details); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (b *Browser) timelineCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := b.app.inject(newObjectTimeline(b.GVR(), path)); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+// initTimeline configures the resources change timeline.
+func (a *App) initTimeline() {
+	cfg := a.Config.K9s.TimelineConfig()
+	dir := cfg.Dir(a.Config.K9s.CurrentCluster)
+	a.factory.Timeline().Configure(cfg.MaxRevisions, dir)
+	if dir != "" {
+		log.Info().Msgf("Persisting resources timeline to %s", dir)
+	}
+}
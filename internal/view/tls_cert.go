@@ -0,0 +1,53 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+)
+
+// TLSCert presents a TLS certificates expiry viewer.
+type TLSCert struct {
+	ResourceViewer
+}
+
+// NewTLSCert returns a new viewer.
+func NewTLSCert(gvr client.GVR) ResourceViewer {
+	c := TLSCert{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSeaGreen)
+	c.GetTable().SetSelectedStyle(tcell.ColorWhite, tcell.ColorMediumSeaGreen, tcell.AttrNone)
+	c.GetTable().SetColorerFn(render.TLSCert{}.ColorerFunc())
+	c.GetTable().SetSortCol(8, 0, true)
+	c.GetTable().SetEnterFn(c.gotoSource)
+
+	return &c
+}
+
+// gotoSource navigates to the secret or certificate backing a row.
+func (c *TLSCert) gotoSource(app *App, _ ui.Tabular, _, path string) {
+	ff, ok := rowFields(c.GetTable().GetVisibleData(), path)
+	if !ok {
+		return
+	}
+	cmd := "v1/secrets"
+	if ff[2] == dao.CertSourceCertificate {
+		gvr, ok := dao.CertManagerGVR()
+		if !ok {
+			return
+		}
+		cmd = gvr
+	}
+	ns, n := client.Namespaced(path)
+	if ns != "" {
+		cmd += " " + ns
+	}
+	if err := app.gotoResource(cmd, "", false); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.followLink(DeepLink{Command: cmd, Select: n})
+}
@@ -75,6 +75,12 @@ type ResourceViewer interface {
 	// SetContextFn provision a custom context.
 	SetContextFn(ContextFunc)
 
+	// SetSelectors sets the label and field selectors of a drill down view.
+	SetSelectors(labels, fields string)
+
+	// Selectors returns the drill down label and field selectors.
+	Selectors() (string, string)
+
 	// SetBindKeys provision additional key bindings.
 	SetBindKeysFn(BindKeysFunc)
 
@@ -0,0 +1,78 @@
+package view
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Violation presents policy engines violations grouped by policy and namespace.
+type Violation struct {
+	ResourceViewer
+}
+
+// NewViolation returns a new viewer.
+func NewViolation(gvr client.GVR) ResourceViewer {
+	v := Violation{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.GetTable().SetColorerFn(render.Violation{}.ColorerFunc())
+	v.GetTable().SetSortCol(3, 0, false)
+	v.GetTable().SetEnterFn(v.showObjects)
+
+	return &v
+}
+
+func (v *Violation) showObjects(app *App, _ ui.Tabular, _, id string) {
+	if err := app.inject(NewViolationObject(id)); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ViolationObject presents the objects violating a policy.
+type ViolationObject struct {
+	ResourceViewer
+
+	id string
+}
+
+// NewViolationObject returns a new viewer.
+func NewViolationObject(id string) ResourceViewer {
+	v := ViolationObject{
+		ResourceViewer: NewBrowser(client.NewGVR("violationobjects")),
+		id:             id,
+	}
+	v.GetTable().SetColorerFn(render.ViolationObject{}.ColorerFunc())
+	v.GetTable().SetEnterFn(v.gotoObject)
+	v.SetContextFn(v.violationContext)
+
+	return &v
+}
+
+func (v *ViolationObject) violationContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, v.id)
+}
+
+// gotoObject navigates to a violating object.
+func (v *ViolationObject) gotoObject(app *App, _ ui.Tabular, _, id string) {
+	kind, path := render.ParseViolationObjectID(id)
+	gvr, ok := app.command.alias.AsGVR(strings.ToLower(kind))
+	if !ok {
+		app.Flash().Errf("No resource found for kind %q", kind)
+		return
+	}
+	cmd := gvr.String()
+	ns, n := client.Namespaced(path)
+	if ns != "" {
+		cmd += " " + ns
+	}
+	if err := app.gotoResource(cmd, "", false); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.followLink(DeepLink{Command: cmd, Select: n})
+}
@@ -0,0 +1,119 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// Watchlist presents pinned resources across namespaces and kinds.
+type Watchlist struct {
+	ResourceViewer
+}
+
+// NewWatchlist returns a new viewer.
+func NewWatchlist(gvr client.GVR) ResourceViewer {
+	w := Watchlist{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	w.GetTable().SetColorerFn(render.Watchlist{}.ColorerFunc())
+	w.GetTable().SetEnterFn(w.gotoResource)
+	w.SetBindKeysFn(w.bindKeys)
+	w.SetContextFn(w.watchlistContext)
+
+	return &w
+}
+
+func (w *Watchlist) watchlistContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyWatchlist, w.App().watchlist.Items(w.App().Config.K9s.CurrentContext))
+}
+
+func (w *Watchlist) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftW: ui.NewKeyAction("Unpin", w.unpinCmd, true),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Resource", w.GetTable().SortColCmd(0, true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", w.GetTable().SortColCmd(4, true), false),
+	})
+}
+
+func (w *Watchlist) gotoResource(app *App, _ ui.Tabular, _, id string) {
+	item, ok := config.WatchItemFor(id)
+	if !ok {
+		return
+	}
+	if err := app.gotoPath(item.GVR, item.Path); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (w *Watchlist) unpinCmd(evt *tcell.EventKey) *tcell.EventKey {
+	ids := w.GetTable().GetSelectedItems()
+	if len(ids) == 0 {
+		return evt
+	}
+	ctx := w.App().Config.K9s.CurrentContext
+	for _, id := range ids {
+		if item, ok := config.WatchItemFor(id); ok && w.App().watchlist.IsPinned(ctx, item) {
+			w.App().watchlist.Toggle(ctx, item)
+		}
+	}
+	w.App().saveWatchlist()
+	w.GetTable().ClearMarks()
+	w.App().Flash().Infof("Unpinned %d resource(s)", len(ids))
+	w.Start()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (a *App) initWatchlist() {
+	if err := a.watchlist.Load(); err != nil {
+		log.Error().Err(err).Msg("Watchlist load failed")
+	}
+}
+
+func (a *App) saveWatchlist() {
+	if err := a.watchlist.Save(); err != nil {
+		log.Error().Err(err).Msg("Watchlist save failed")
+		a.Flash().Errf("Watchlist save failed -- %s", err)
+	}
+}
+
+// pinCmd pins or unpins the selected resources to the watchlist.
+func (b *Browser) pinCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := b.GetSelectedItems()
+	if len(paths) == 0 {
+		return evt
+	}
+
+	ctx := b.app.Config.K9s.CurrentContext
+	var pinned, unpinned int
+	for _, p := range paths {
+		if b.app.watchlist.Toggle(ctx, config.WatchItem{GVR: b.GVR(), Path: p}) {
+			pinned++
+		} else {
+			unpinned++
+		}
+	}
+	b.app.saveWatchlist()
+
+	switch {
+	case len(paths) == 1 && pinned == 1:
+		b.app.Flash().Infof("Pinned %s to the watchlist", paths[0])
+	case len(paths) == 1:
+		b.app.Flash().Infof("Unpinned %s from the watchlist", paths[0])
+	default:
+		b.app.Flash().Infof("Pinned %d and unpinned %d resource(s)", pinned, unpinned)
+	}
+
+	return nil
+}
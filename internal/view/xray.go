@@ -128,6 +128,7 @@ func (x *Xray) refreshActions() {
 		hotKeyActions(x, aa)
 
 		x.Actions().Add(aa)
+		x.app.remap(x.Actions(), x.Name())
 		x.app.Menu().HydrateMenu(x.Hints())
 	}()
 
@@ -149,10 +150,10 @@ func (x *Xray) refreshActions() {
 	}
 
 	if client.Can(x.meta.Verbs, "edit") {
-		aa[ui.KeyE] = ui.NewKeyAction("Edit", x.editCmd, true)
+		aa[ui.KeyE] = ui.NewKeyAction("Edit", guardCmd(x, config.VerbEdit, x.selection, x.editCmd), true)
 	}
 	if client.Can(x.meta.Verbs, "delete") {
-		aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", x.deleteCmd, true)
+		aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", guardCmd(x, config.VerbDelete, x.selection, x.deleteCmd), true)
 	}
 	if !dao.IsK9sMeta(x.meta) {
 		aa[ui.KeyY] = ui.NewKeyAction("YAML", x.viewCmd, true)
@@ -164,12 +165,12 @@ func (x *Xray) refreshActions() {
 		x.Actions().Delete(tcell.KeyEnter)
 	case "containers":
 		x.Actions().Delete(tcell.KeyEnter)
-		aa[ui.KeyS] = ui.NewKeyAction("Shell", x.shellCmd, true)
+		aa[ui.KeyS] = ui.NewKeyAction("Shell", guardCmd(x, config.VerbShell, x.selection, x.shellCmd), true)
 		aa[ui.KeyL] = ui.NewKeyAction("Logs", x.logsCmd(false), true)
 		aa[ui.KeyShiftL] = ui.NewKeyAction("Logs Previous", x.logsCmd(true), true)
 	case "v1/pods":
-		aa[ui.KeyS] = ui.NewKeyAction("Shell", x.shellCmd, true)
-		aa[ui.KeyA] = ui.NewKeyAction("Attach", x.attachCmd, true)
+		aa[ui.KeyS] = ui.NewKeyAction("Shell", guardCmd(x, config.VerbShell, x.selection, x.shellCmd), true)
+		aa[ui.KeyA] = ui.NewKeyAction("Attach", guardCmd(x, config.VerbAttach, x.selection, x.attachCmd), true)
 		aa[ui.KeyL] = ui.NewKeyAction("Logs", x.logsCmd(false), true)
 		aa[ui.KeyShiftL] = ui.NewKeyAction("Logs Previous", x.logsCmd(true), true)
 	}
@@ -185,6 +186,14 @@ func (x *Xray) GetSelectedPath() string {
 	return spec.Path()
 }
 
+func (x *Xray) selection() []string {
+	if path := x.GetSelectedPath(); path != "" {
+		return []string{path}
+	}
+
+	return nil
+}
+
 func (x *Xray) selectedSpec() *xray.NodeSpec {
 	node := x.GetCurrentNode()
 	if node == nil {
@@ -331,7 +340,11 @@ func (x *Xray) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 		return nil
 	}
 
-	details := NewDetails(x.app, "YAML", spec.Path(), true).Update(raw)
+	details, err := yamlDetails(x.app, spec.GVR(), spec.Path(), raw)
+	if err != nil {
+		x.app.Flash().Err(err)
+		return nil
+	}
 	if err := x.app.inject(details); err != nil {
 		x.app.Flash().Err(err)
 	}
@@ -381,7 +394,7 @@ func (x *Xray) describe(gvr, path string) {
 		return
 	}
 
-	details := NewDetails(x.app, "Describe", path, true).Update(yaml)
+	details := NewDescribe(x.app, path).Update(yaml)
 	if err := x.app.inject(details); err != nil {
 		x.app.Flash().Err(err)
 	}
@@ -405,9 +418,12 @@ func (x *Xray) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 		if cfg := x.app.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
 			args = append(args, "--kubeconfig", *cfg)
 		}
+		var err error
 		if !runK(x.app, shellOpts{args: append(args, n)}) {
-			x.app.Flash().Err(errors.New("Edit exec failed"))
+			err = errors.New("Edit exec failed")
+			x.app.Flash().Err(err)
 		}
+		x.app.audit(config.VerbEdit, spec.GVR(), spec.Path(), err)
 	}
 
 	return evt
@@ -452,16 +468,26 @@ func (x *Xray) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	x.app.Flash().Info("Clearing filter...")
 	x.CmdBuff().Reset()
 	x.model.ClearFilter()
+	x.app.recordStep(filterStep(""))
 	x.Start()
 
 	return nil
 }
 
+func (x *Xray) setFilter(q string) {
+	x.CmdBuff().Set(q)
+	if q == "" {
+		x.model.ClearFilter()
+	}
+	x.Start()
+}
+
 func (x *Xray) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if x.CmdBuff().IsActive() {
 		if ui.IsLabelSelector(x.CmdBuff().String()) {
 			x.Start()
 		}
+		x.app.recordStep(filterStep(x.CmdBuff().String()))
 		x.CmdBuff().SetActive(false)
 		x.GetRoot().ExpandAll()
 
@@ -626,6 +652,12 @@ func (x *Xray) SetBindKeysFn(BindKeysFunc) {}
 // SetContextFn sets custom context.
 func (x *Xray) SetContextFn(ContextFunc) {}
 
+// SetSelectors sets drill down selectors.
+func (x *Xray) SetSelectors(string, string) {}
+
+// Selectors returns drill down selectors.
+func (x *Xray) Selectors() (string, string) { return "", "" }
+
 // Name returns the component name.
 func (x *Xray) Name() string { return "XRay" }
 
@@ -687,7 +719,9 @@ func (x *Xray) resourceDelete(gvr client.GVR, spec *xray.NodeSpec, msg string) {
 			x.app.Flash().Errf("Invalid nuker %T", accessor)
 			return
 		}
-		if err := nuker.Delete(spec.Path(), true, true); err != nil {
+		err = nuker.Delete(spec.Path(), true, true)
+		x.app.audit(config.VerbDelete, gvr.String(), spec.Path(), err)
+		if err != nil {
 			x.app.Flash().Errf("Delete failed with `%s", err)
 		} else {
 			x.app.Flash().Infof("%s `%s deleted successfully", x.GVR(), spec.Path())
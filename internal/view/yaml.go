@@ -1,7 +1,9 @@
 package view
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -10,7 +12,7 @@ import (
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/tview"
-	"github.com/rs/zerolog/log"
+	"gopkg.in/yaml.v2"
 )
 
 var (
@@ -19,6 +21,8 @@ var (
 )
 
 const (
+	yamlTitle = "YAML"
+
 	yamlFullFmt  = "%s[key::b]%s[colon::-]: [val::]%s"
 	yamlKeyFmt   = "%s[key::b]%s[colon::-]:"
 	yamlValueFmt = "[val::]%s"
@@ -61,28 +65,80 @@ func enableRegion(str string) string {
 	return strings.ReplaceAll(strings.ReplaceAll(str, "<<<", "["), ">>>", "]")
 }
 
-func saveYAML(cluster, name, data string) (string, error) {
-	dir := filepath.Join(config.K9sDumpDir, cluster)
-	if err := ensureDir(dir); err != nil {
-		return "", err
+// savePath returns the default path for saving a viewer content.
+func savePath(cluster, title, subject string, now time.Time) string {
+	name := strings.ToLower(strings.Replace(title, " ", "-", -1))
+	if subject != "" {
+		name = strings.Replace(subject, "/", "-", -1) + "-" + name
+	}
+
+	return filepath.Join(config.K9sDumpDir, cluster, fmt.Sprintf("%s-%s.yml", name, now.Format("20060102-150405")))
+}
+
+// expandPath resolves env vars and a leading ~ in a user supplied path.
+func expandPath(path string) (string, error) {
+	path = os.ExpandEnv(strings.TrimSpace(path))
+	if path == "" {
+		return "", errors.New("a file path is required")
+	}
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(home, path[1:])
 	}
 
-	now := time.Now().UnixNano()
-	fName := fmt.Sprintf("%s-%d.yml", strings.Replace(name, "/", "-", -1), now)
+	return filepath.Clean(path), nil
+}
+
+// stripYAML removes managedFields and status from a resource manifest.
+func stripYAML(raw string) (string, error) {
+	var m yaml.MapSlice
+	if err := yaml.Unmarshal([]byte(raw), &m); err != nil {
+		return "", fmt.Errorf("unable to strip manifest -- %s", err)
+	}
 
-	path := filepath.Join(dir, fName)
-	mod := os.O_CREATE | os.O_WRONLY
-	file, err := os.OpenFile(path, mod, 0600)
+	res := make(yaml.MapSlice, 0, len(m))
+	for _, item := range m {
+		switch item.Key {
+		case "status":
+			continue
+		case "metadata":
+			if meta, ok := item.Value.(yaml.MapSlice); ok {
+				item.Value = dropKey(meta, "managedFields")
+			}
+		}
+		res = append(res, item)
+	}
+	out, err := yaml.Marshal(res)
 	if err != nil {
-		log.Error().Err(err).Msgf("YAML create %s", path)
-		return "", nil
+		return "", err
 	}
-	defer func() {
-		if err := file.Close(); err != nil {
-			log.Error().Err(err).Msg("Closing yaml file")
+
+	return string(out), nil
+}
+
+func dropKey(m yaml.MapSlice, key string) yaml.MapSlice {
+	res := make(yaml.MapSlice, 0, len(m))
+	for _, item := range m {
+		if item.Key != key {
+			res = append(res, item)
 		}
-	}()
-	if _, err := file.Write([]byte(data)); err != nil {
+	}
+
+	return res
+}
+
+func saveYAML(path, data string) (string, error) {
+	path, err := expandPath(path)
+	if err != nil {
+		return "", err
+	}
+	if err := ensureDir(filepath.Dir(path)); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(path, []byte(data), 0600); err != nil {
 		return "", err
 	}
 
@@ -1,7 +1,10 @@
 package view
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/derailed/k9s/internal/config"
 	"github.com/stretchr/testify/assert"
@@ -60,3 +63,71 @@ func TestYaml(t *testing.T) {
 		assert.Equal(t, u.e, colorizeYAML(s.Views().Yaml, u.s))
 	}
 }
+
+func TestSavePath(t *testing.T) {
+	now := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	assert.Equal(t, filepath.Join(config.K9sDumpDir, "c1", "default-fred-yaml-20200304-050607.yml"), savePath("c1", "YAML", "default/fred", now))
+	assert.Equal(t, filepath.Join(config.K9sDumpDir, "c1", "secret-decoder-20200304-050607.yml"), savePath("c1", "Secret Decoder", "", now))
+}
+
+func TestExpandPath(t *testing.T) {
+	home, err := os.UserHomeDir()
+	assert.Nil(t, err)
+	os.Setenv("K9S_BLEE", "/tmp/blee")
+	defer os.Unsetenv("K9S_BLEE")
+
+	uu := map[string]struct {
+		path, e, err string
+	}{
+		"plain": {path: "/tmp/fred.yml", e: "/tmp/fred.yml"},
+		"home":  {path: "~/fred.yml", e: filepath.Join(home, "fred.yml")},
+		"env":   {path: " $K9S_BLEE/../fred.yml ", e: "/tmp/fred.yml"},
+		"empty": {path: " ", err: "a file path is required"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			path, err := expandPath(u.path)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, path)
+		})
+	}
+}
+
+func TestStripYAML(t *testing.T) {
+	raw := `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+  managedFields:
+  - manager: kubectl
+  namespace: default
+spec:
+  containers:
+  - name: c1
+status:
+  phase: Running
+`
+	e := `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+  namespace: default
+spec:
+  containers:
+  - name: c1
+`
+
+	s, err := stripYAML(raw)
+	assert.Nil(t, err)
+	assert.Equal(t, e, s)
+
+	_, err = stripYAML("- [")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,227 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/gdamore/tcell"
+	"github.com/sahilm/fuzzy"
+)
+
+// YAML represents a YAML manifest viewer with foldable nodes. The path of
+// the top most line is shown as a breadcrumb.
+type YAML struct {
+	*Details
+
+	outline []model.YAMLLine
+	rows    []int
+	folded  map[string]bool
+}
+
+// NewYAML returns a new manifest viewer.
+func NewYAML(app *App, subject string) *YAML {
+	return &YAML{
+		Details: NewDetails(app, yamlTitle, subject, true),
+		folded:  make(map[string]bool),
+	}
+}
+
+// Init initializes the viewer.
+func (y *YAML) Init(ctx context.Context) error {
+	if err := y.Details.Init(ctx); err != nil {
+		return err
+	}
+	y.SetWrap(false)
+	y.contentFn = func() string {
+		return strings.Join(y.model.Peek(), "\n")
+	}
+	y.app.Styles.RemoveListener(y.Details)
+	y.app.Styles.AddListener(y)
+	y.model.RemoveListener(y.Details)
+	y.model.AddListener(y)
+	y.bindKeys()
+	y.TextChanged(y.model.Peek())
+
+	return nil
+}
+
+// Update updates the view content.
+func (y *YAML) Update(buff string) *YAML {
+	y.Details.Update(buff)
+
+	return y
+}
+
+// Stop terminates the updater.
+func (y *YAML) Stop() {
+	y.app.Styles.RemoveListener(y)
+}
+
+// Draw updates the breadcrumb prior to drawing the manifest.
+func (y *YAML) Draw(screen tcell.Screen) {
+	if p := y.currentPath(); p != y.crumb {
+		y.crumb = p
+		y.updateTitle()
+	}
+	y.Details.Draw(screen)
+}
+
+// StylesChanged notifies the skin changed.
+func (y *YAML) StylesChanged(s *config.Styles) {
+	y.Details.StylesChanged(s)
+	y.render()
+}
+
+// TextChanged notifies the model changed.
+func (y *YAML) TextChanged(lines []string) {
+	y.outline = model.YAMLOutline(lines)
+	y.render()
+	y.ScrollToBeginning()
+}
+
+// TextFiltered notifies when the filter changed. Nodes are unfolded while
+// showing matches.
+func (y *YAML) TextFiltered(lines []string, matches fuzzy.Matches) {
+	if len(matches) == 0 {
+		y.currentRegion, y.maxRegions = 0, 0
+		y.render()
+		y.Highlight()
+		return
+	}
+	y.rows = nil
+	y.Details.TextFiltered(lines, matches)
+}
+
+// GotoPath scrolls to a given manifest path, ie spec.containers[0].
+func (y *YAML) GotoPath(path string) error {
+	line := model.FindYAMLPath(y.outline, path)
+	if line < 0 {
+		return fmt.Errorf("no path %q found", path)
+	}
+	model.UnfoldYAMLPath(y.folded, y.outline[line].Node)
+	y.showLine(line)
+
+	return nil
+}
+
+func (y *YAML) bindKeys() {
+	y.actions.Add(ui.KeyActions{
+		ui.KeySpace:  ui.NewKeyAction("Toggle Fold", y.toggleCmd, true),
+		ui.KeyShiftC: ui.NewKeyAction("Collapse All", y.foldAllCmd(true), true),
+		ui.KeyShiftE: ui.NewKeyAction("Expand All", y.foldAllCmd(false), true),
+		ui.KeyP:      ui.NewKeyAction("Copy Path", y.cpPathCmd, true),
+	})
+}
+
+func (y *YAML) render() {
+	lines, rows := model.FoldYAML(y.model.Peek(), y.outline, y.folded)
+	y.rows = rows
+	y.SetText(colorizeYAML(y.app.Styles.Views().Yaml, strings.Join(lines, "\n")))
+}
+
+// currentLine returns the manifest line shown at the top of the view.
+func (y *YAML) currentLine() int {
+	row, _ := y.GetScrollOffset()
+	switch {
+	case y.rows == nil:
+		if row < len(y.outline) {
+			return row
+		}
+		return -1
+	case row < len(y.rows):
+		return y.rows[row]
+	default:
+		return -1
+	}
+}
+
+func (y *YAML) currentPath() string {
+	if l := y.currentLine(); l >= 0 {
+		return y.outline[l].Path
+	}
+
+	return ""
+}
+
+// showLine renders the manifest and scrolls to a given line.
+func (y *YAML) showLine(line int) {
+	y.render()
+	for row, l := range y.rows {
+		if l == line {
+			y.ScrollTo(row, 0)
+			return
+		}
+	}
+}
+
+func (y *YAML) toggleCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if !y.cmdBuff.Empty() {
+		return evt
+	}
+	line := model.FoldableAt(y.outline, y.currentLine())
+	if line < 0 {
+		return nil
+	}
+	node := y.outline[line].Node
+	y.folded[node] = !y.folded[node]
+	y.showLine(line)
+
+	return nil
+}
+
+func (y *YAML) foldAllCmd(fold bool) func(*tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		if !y.cmdBuff.Empty() {
+			return evt
+		}
+		y.folded = make(map[string]bool)
+		if fold {
+			for i, o := range y.outline {
+				if o.Foldable(i) {
+					y.folded[o.Node] = true
+				}
+			}
+		}
+		y.render()
+		y.ScrollToBeginning()
+
+		return nil
+	}
+}
+
+func (y *YAML) cpPathCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := y.currentPath()
+	if path == "" {
+		return evt
+	}
+	if err := clipboard.WriteAll(path); err != nil {
+		y.app.Flash().Err(err)
+		return nil
+	}
+	y.app.Flash().Infof("Path %s copied to clipboard...", path)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// gotoPathCmd navigates to a path of the YAML manifest in view.
+func (a *App) gotoPathCmd(cmd string) error {
+	tokens := strings.SplitN(strings.TrimSpace(cmd), " ", 2)
+	if len(tokens) != 2 || strings.TrimSpace(tokens[1]) == "" {
+		return fmt.Errorf("invalid goto command %q. Expecting goto path", cmd)
+	}
+	y, ok := a.Content.Top().(*YAML)
+	if !ok {
+		return errors.New("goto is only available in the YAML view")
+	}
+
+	return y.GotoPath(tokens[1])
+}
@@ -0,0 +1,69 @@
+package view
+
+import (
+	"github.com/derailed/tview"
+	"github.com/gdamore/tcell"
+	"github.com/rs/zerolog/log"
+)
+
+// zoomChromeCount tracks the number of items trailing the content, ie crumbs and flash.
+const zoomChromeCount = 2
+
+// zoomCmd maximizes the current view to the full terminal or restores the layout.
+func (a *App) zoomCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if a.InCmdMode() {
+		return evt
+	}
+	a.zoom(!a.zoomed)
+	a.Draw()
+
+	return nil
+}
+
+// zoom hides or restores the header, crumbs and flash surrounding the content.
+// Hidden items are swapped for empty placeholders so the prompt and the perf
+// overlay still land at their usual spots.
+func (a *App) zoom(flag bool) {
+	if a.zoomed == flag {
+		return
+	}
+	flex, ok := a.Main.GetPrimitive("main").(*tview.Flex)
+	if !ok {
+		log.Error().Msg("Expecting valid flex view")
+		return
+	}
+	a.zoomed = flag
+
+	if flag {
+		a.zoomChrome = a.zoomChrome[:0]
+		for i := 0; i < zoomChromeCount; i++ {
+			n := flexItemCount(flex) - 1
+			a.zoomChrome = append([]tview.Primitive{flex.ItemAt(n)}, a.zoomChrome...)
+			flex.RemoveItemAtIndex(n)
+		}
+		for i := 0; i < zoomChromeCount; i++ {
+			flex.AddItem(tview.NewBox(), 0, 0, false)
+		}
+		flex.RemoveItemAtIndex(0)
+		flex.AddItemAtIndex(0, tview.NewBox(), 0, 0, false)
+		return
+	}
+
+	for i := 0; i < zoomChromeCount; i++ {
+		flex.RemoveItemAtIndex(flexItemCount(flex) - 1)
+	}
+	for _, p := range a.zoomChrome {
+		flex.AddItem(p, 1, 1, false)
+	}
+	a.toggleHeader(a.showHeader)
+}
+
+// flexItemCount returns the number of items held by the given flex.
+func flexItemCount(f *tview.Flex) int {
+	var n int
+	for f.ItemAt(n) != nil {
+		n++
+	}
+
+	return n
+}
@@ -0,0 +1,143 @@
+package watch
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+// evictGrace represents the time recently used informers are safe from
+// eviction.
+const evictGrace = time.Minute
+
+// CacheStat represents an informer cache usage.
+type CacheStat struct {
+	Namespace string
+	GVR       string
+	Selector  string
+	Items     int
+	Bytes     int64
+	LastUsed  time.Time
+}
+
+// SetMemoryBudget sets the approximate memory in bytes informer caches may
+// use. Least recently used informers are evicted past the budget. A budget
+// <= 0 means no limit.
+func (f *Factory) SetMemoryBudget(b int64) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.budget = b
+}
+
+// MemoryBudget returns the informer caches memory budget or 0 if unlimited.
+func (f *Factory) MemoryBudget() int64 {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	if f.budget < 0 {
+		return 0
+	}
+
+	return f.budget
+}
+
+// MemoryUsage returns the approximate memory held by informer caches.
+func (f *Factory) MemoryUsage() int64 {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	return f.memoryUsage()
+}
+
+// CacheStats returns the usage of all informer caches, largest first.
+func (f *Factory) CacheStats() []CacheStat {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	var ss []CacheStat
+	for ns, ii := range f.informers {
+		for key, inf := range ii {
+			ss = append(ss, CacheStat{
+				Namespace: ns,
+				GVR:       keyGVR(key),
+				Selector:  keySelector(key),
+				Items:     len(inf.Informer().GetStore().ListKeys()),
+				Bytes:     inf.size.get(),
+				LastUsed:  inf.used(),
+			})
+		}
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		return ss[i].Bytes > ss[j].Bytes
+	})
+
+	return ss
+}
+
+func (f *Factory) memoryUsage() int64 {
+	var n int64
+	for _, ii := range f.informers {
+		for _, inf := range ii {
+			n += inf.size.get()
+		}
+	}
+
+	return n
+}
+
+// evict stops least recently used informers while caches exceed the memory
+// budget. Informers used within the grace period are kept.
+func (f *Factory) evict(now time.Time) {
+	if f.budget <= 0 {
+		return
+	}
+	usage := f.memoryUsage()
+	if usage <= f.budget {
+		return
+	}
+
+	type entry struct {
+		ns, key string
+		inf     *informer
+	}
+	var ee []entry
+	for ns, ii := range f.informers {
+		for key, inf := range ii {
+			ee = append(ee, entry{ns: ns, key: key, inf: inf})
+		}
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].inf.used().Before(ee[j].inf.used())
+	})
+	for _, e := range ee {
+		if usage <= f.budget || now.Sub(e.inf.used()) < evictGrace {
+			break
+		}
+		log.Debug().Msgf("Evicting informer for %q:%q", e.ns, e.key)
+		usage -= e.inf.size.get()
+		e.inf.stop()
+		delete(f.informers[e.ns], e.key)
+	}
+	if usage > f.budget {
+		log.Warn().Msgf("Informer caches use %d bytes, exceeding the %d bytes budget", usage, f.budget)
+	}
+}
+
+// keySelector returns the selectors of a selection key.
+func keySelector(key string) string {
+	tokens := strings.Split(key, selectionSep)
+	if len(tokens) < 2 {
+		return ""
+	}
+	ss := make([]string, 0, len(tokens)-1)
+	for _, t := range tokens[1:] {
+		if t != "" {
+			ss = append(ss, t)
+		}
+	}
+
+	return strings.Join(ss, ",")
+}
@@ -0,0 +1,78 @@
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFactoryEvict(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		budget int64
+		e      []string
+	}{
+		"unlimited": {e: []string{"v1/pods", "v1/nodes", "v1/secrets"}},
+		"underBudget": {
+			budget: 1000,
+			e:      []string{"v1/pods", "v1/nodes", "v1/secrets"},
+		},
+		"overBudget": {
+			budget: 500,
+			e:      []string{"v1/pods", "v1/nodes"},
+		},
+		"keepRecent": {
+			budget: 10,
+			e:      []string{"v1/pods"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := NewFactory(nil)
+			f.SetMemoryBudget(u.budget)
+			f.informers["ns1"] = map[string]*informer{
+				"v1/pods":    makeInformer(now.Add(-10*time.Second), 300),
+				"v1/nodes":   makeInformer(now.Add(-2*time.Minute), 200),
+				"v1/secrets": makeInformer(now.Add(-3*time.Minute), 400),
+			}
+			f.evict(now)
+
+			var kk []string
+			for _, gvr := range []string{"v1/pods", "v1/nodes", "v1/secrets"} {
+				if _, ok := f.informers["ns1"][gvr]; ok {
+					kk = append(kk, gvr)
+				}
+			}
+			assert.Equal(t, u.e, kk)
+		})
+	}
+}
+
+func TestKeySelector(t *testing.T) {
+	uu := map[string]struct {
+		key, e string
+	}{
+		"none":   {key: "v1/pods"},
+		"labels": {key: newSelection("app=fred", "").key("v1/pods"), e: "app=fred"},
+		"fields": {key: newSelection("", "spec.nodeName=n1").key("v1/pods"), e: "spec.nodeName=n1"},
+		"both":   {key: newSelection("app=fred", "spec.nodeName=n1").key("v1/pods"), e: "app=fred,spec.nodeName=n1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, keySelector(u.key))
+		})
+	}
+}
+
+func makeInformer(used time.Time, size int64) *informer {
+	i := newInformer(nil)
+	i.touch(used)
+	i.size.bytes = size
+
+	return i
+}
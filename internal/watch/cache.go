@@ -0,0 +1,200 @@
+package watch
+
+import (
+	"sync/atomic"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/perf"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kwatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	di "k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+	// Approximate go memory overhead of unstructured values.
+	mapOverhead    = 48
+	sliceOverhead  = 24
+	stringOverhead = 16
+	scalarSize     = 16
+)
+
+// cacheInformer caches a resource as unstructured objects. Bulky metadata
+// not needed to browse resources is stripped before objects are cached.
+type cacheInformer struct {
+	inf    cache.SharedIndexInformer
+	gr     schema.GroupResource
+	health *watchHealth
+}
+
+var _ informers.GenericInformer = (*cacheInformer)(nil)
+
+func newCacheInformer(lw *cache.ListWatch, gvr client.GVR, indexers cache.Indexers) *cacheInformer {
+	h := newWatchHealth(gvr.String())
+	return &cacheInformer{
+		inf: cache.NewSharedIndexInformer(
+			stripped(tracked(lw, h)),
+			&unstructured.Unstructured{},
+			defaultResync,
+			indexers,
+		),
+		gr:     gvr.GVR().GroupResource(),
+		health: h,
+	}
+}
+
+// Informer returns the shared informer.
+func (c *cacheInformer) Informer() cache.SharedIndexInformer {
+	return c.inf
+}
+
+// Lister returns the cache lister.
+func (c *cacheInformer) Lister() cache.GenericLister {
+	return cache.NewGenericLister(c.inf.GetIndexer(), c.gr)
+}
+
+func dynListWatch(dial dynamic.Interface, gvr client.GVR, ns string, tweak di.TweakListOptionsFunc) *cache.ListWatch {
+	res := dial.Resource(gvr.GVR()).Namespace(ns)
+	return &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			if tweak != nil {
+				tweak(&opts)
+			}
+			return res.List(opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (kwatch.Interface, error) {
+			if tweak != nil {
+				tweak(&opts)
+			}
+			return res.Watch(opts)
+		},
+	}
+}
+
+// stripped strips listed and watched objects before they are cached.
+func stripped(lw *cache.ListWatch) *cache.ListWatch {
+	return &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			o, err := lw.List(opts)
+			if err != nil {
+				return nil, err
+			}
+			if ll, ok := o.(*unstructured.UnstructuredList); ok {
+				for i := range ll.Items {
+					strip(&ll.Items[i])
+				}
+			}
+			return o, nil
+		},
+		WatchFunc: func(opts metav1.ListOptions) (kwatch.Interface, error) {
+			w, err := lw.Watch(opts)
+			if err != nil {
+				return nil, err
+			}
+			return kwatch.Filter(w, func(evt kwatch.Event) (kwatch.Event, bool) {
+				if u, ok := evt.Object.(*unstructured.Unstructured); ok {
+					strip(u)
+				}
+				return evt, true
+			}), nil
+		},
+	}
+}
+
+// strip removes managed fields and last applied configurations.
+func strip(u *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(u.Object, "metadata", "managedFields")
+	aa := u.GetAnnotations()
+	if _, ok := aa[lastAppliedAnnotation]; !ok {
+		return
+	}
+	delete(aa, lastAppliedAnnotation)
+	u.SetAnnotations(aa)
+}
+
+// cacheSize tracks the approximate memory held by an informer cache.
+type cacheSize struct {
+	bytes int64
+}
+
+var _ cache.ResourceEventHandler = (*cacheSize)(nil)
+
+// OnAdd notifies an object was cached.
+func (c *cacheSize) OnAdd(o interface{}) {
+	atomic.AddInt64(&c.bytes, objectSize(o))
+}
+
+// OnUpdate notifies a cached object changed.
+func (c *cacheSize) OnUpdate(old, o interface{}) {
+	atomic.AddInt64(&c.bytes, objectSize(o)-objectSize(old))
+}
+
+// OnDelete notifies an object was evicted.
+func (c *cacheSize) OnDelete(o interface{}) {
+	if t, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = t.Obj
+	}
+	atomic.AddInt64(&c.bytes, -objectSize(o))
+}
+
+func (c *cacheSize) get() int64 {
+	return atomic.LoadInt64(&c.bytes)
+}
+
+// objectSize estimates the memory held by a cached object.
+func objectSize(o interface{}) int64 {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return 0
+	}
+
+	return valueSize(u.Object)
+}
+
+func valueSize(v interface{}) int64 {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		n := int64(mapOverhead)
+		for k, vv := range v {
+			n += stringOverhead + int64(len(k)) + valueSize(vv)
+		}
+		return n
+	case []interface{}:
+		n := int64(sliceOverhead)
+		for _, vv := range v {
+			n += valueSize(vv)
+		}
+		return n
+	case string:
+		return stringOverhead + int64(len(v))
+	default:
+		return scalarSize
+	}
+}
+
+// eventCounter records a resource watch events rate.
+type eventCounter string
+
+var _ cache.ResourceEventHandler = eventCounter("")
+
+// OnAdd notifies an object was added.
+func (e eventCounter) OnAdd(interface{}) {
+	perf.Stats.RecordEvent(string(e))
+}
+
+// OnUpdate notifies an object changed.
+func (e eventCounter) OnUpdate(_, _ interface{}) {
+	perf.Stats.RecordEvent(string(e))
+}
+
+// OnDelete notifies an object was deleted.
+func (e eventCounter) OnDelete(interface{}) {
+	perf.Stats.RecordEvent(string(e))
+}
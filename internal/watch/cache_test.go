@@ -0,0 +1,46 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestStrip(t *testing.T) {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name": "fred",
+			"annotations": map[string]interface{}{
+				lastAppliedAnnotation: "{}",
+				"blee":                "duh",
+			},
+			"managedFields": []interface{}{
+				map[string]interface{}{"manager": "kubectl"},
+			},
+		},
+	}}
+	strip(&u)
+
+	assert.Equal(t, "fred", u.GetName())
+	assert.Equal(t, map[string]string{"blee": "duh"}, u.GetAnnotations())
+	_, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "metadata", "managedFields")
+	assert.False(t, ok)
+}
+
+func TestCacheSize(t *testing.T) {
+	o1 := &unstructured.Unstructured{Object: map[string]interface{}{"a": "fred"}}
+	o2 := &unstructured.Unstructured{Object: map[string]interface{}{"a": "fred", "b": []interface{}{int64(1)}}}
+	s1, s2 := objectSize(o1), objectSize(o2)
+	assert.Equal(t, int64(mapOverhead+stringOverhead+1+stringOverhead+4), s1)
+	assert.True(t, s2 > s1)
+
+	var c cacheSize
+	c.OnAdd(o1)
+	assert.Equal(t, s1, c.get())
+	c.OnUpdate(o1, o2)
+	assert.Equal(t, s2, c.get())
+	c.OnDelete(cache.DeletedFinalStateUnknown{Key: "fred", Obj: o2})
+	assert.Equal(t, int64(0), c.get())
+}
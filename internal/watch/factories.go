@@ -0,0 +1,112 @@
+package watch
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+// Factories tracks informer factories for contexts other than the active one.
+type Factories struct {
+	config    *client.Config
+	factories map[string]*Factory
+	idleTTL   time.Duration
+	paging    [2]int
+	budget    int64
+	mx        sync.Mutex
+}
+
+// NewFactories returns a new context factories tracker.
+func NewFactories(cfg *client.Config) *Factories {
+	return &Factories{
+		config:    cfg,
+		factories: make(map[string]*Factory),
+		idleTTL:   DefaultIdleTTL,
+	}
+}
+
+// SetPaging sets the large collections listing settings.
+func (f *Factories) SetPaging(threshold, size int) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.paging = [2]int{threshold, size}
+	for _, fac := range f.factories {
+		fac.SetPaging(threshold, size)
+	}
+}
+
+// SetMemoryBudget sets the informer caches memory budget of each context.
+func (f *Factories) SetMemoryBudget(b int64) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.budget = b
+	for _, fac := range f.factories {
+		fac.SetMemoryBudget(b)
+	}
+}
+
+// SetIdleTTL sets the time unused informers are kept running.
+func (f *Factories) SetIdleTTL(d time.Duration) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.idleTTL = d
+	for _, fac := range f.factories {
+		fac.SetIdleTTL(d)
+	}
+}
+
+// For returns a started factory for the given context, connecting to the
+// context cluster on first use.
+func (f *Factories) For(context string) (*Factory, error) {
+	f.mx.Lock()
+	fac, ok := f.factories[context]
+	f.mx.Unlock()
+	if ok {
+		return fac, nil
+	}
+	if _, err := f.config.GetContext(context); err != nil {
+		return nil, err
+	}
+
+	conn := client.InitConnectionOrDie(f.config.ContextConfig(context))
+	if !conn.CheckConnectivity() {
+		return nil, fmt.Errorf("unable to connect to context %q", context)
+	}
+	ns, err := conn.Config().CurrentNamespaceName()
+	if err != nil {
+		ns = client.AllNamespaces
+	}
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if fac, ok := f.factories[context]; ok {
+		return fac, nil
+	}
+	log.Debug().Msgf("Starting factory for context %q", context)
+	fac = NewFactory(conn)
+	fac.SetIdleTTL(f.idleTTL)
+	fac.SetPaging(f.paging[0], f.paging[1])
+	fac.SetMemoryBudget(f.budget)
+	fac.Start(ns)
+	f.factories[context] = fac
+
+	return fac, nil
+}
+
+// Terminate terminates all context factories.
+func (f *Factories) Terminate() {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	for k, fac := range f.factories {
+		fac.Terminate()
+		client.DropMetrics(fac.Client())
+		delete(f.factories, k)
+	}
+}
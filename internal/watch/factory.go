@@ -2,38 +2,91 @@ package watch
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	di "k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 )
 
 const (
 	defaultResync   = 10 * time.Minute
 	defaultWaitTime = 500 * time.Millisecond
+
+	// DefaultIdleTTL represents the time an unused informer is kept around.
+	DefaultIdleTTL = 5 * time.Minute
+
+	minReapInterval = 10 * time.Second
 )
 
-// Factory tracks various resource informers.
+// Factory tracks various resource informers. Informers are started the
+// first time a resource is accessed and stopped once idle for a while.
+// Collections too large to be cached are listed a page at a time instead.
 type Factory struct {
-	factories  map[string]di.DynamicSharedInformerFactory
-	client     client.Connection
-	stopChan   chan struct{}
-	forwarders Forwarders
-	mx         sync.RWMutex
+	informers     map[string]map[string]*informer
+	pagers        map[string]*pager
+	sized         map[string]sizing
+	client        client.Connection
+	stopChan      chan struct{}
+	idleTTL       time.Duration
+	budget        int64
+	pageThreshold int64
+	pageSize      int64
+	forwarders    Forwarders
+	namespaces    nsCache
+	timeline      *Timeline
+	protos        map[string]rest.Interface
+	mx            sync.RWMutex
+	protoMx       sync.Mutex
 }
 
 // NewFactory returns a new informers factory.
 func NewFactory(client client.Connection) *Factory {
 	return &Factory{
 		client:     client,
-		factories:  make(map[string]di.DynamicSharedInformerFactory),
+		informers:  make(map[string]map[string]*informer),
+		pagers:     make(map[string]*pager),
+		sized:      make(map[string]sizing),
+		protos:     make(map[string]rest.Interface),
+		idleTTL:    DefaultIdleTTL,
 		forwarders: NewForwarders(),
+		timeline:   NewTimeline(),
+	}
+}
+
+// Timeline returns the resources change timeline.
+func (f *Factory) Timeline() *Timeline {
+	return f.timeline
+}
+
+// SetPaging sets the collection size past which listings are paginated and
+// the page size. A threshold <= 0 disables paging.
+func (f *Factory) SetPaging(threshold, size int) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	f.pageThreshold, f.pageSize = int64(threshold), int64(size)
+}
+
+// SetIdleTTL sets the time an unused informer is kept running.
+func (f *Factory) SetIdleTTL(d time.Duration) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	if d <= 0 {
+		d = DefaultIdleTTL
 	}
+	f.idleTTL = d
 }
 
 // Start initializes the informers until caller cancels the context.
@@ -43,10 +96,13 @@ func (f *Factory) Start(ns string) {
 
 	log.Debug().Msgf("Factory START with ns `%q", ns)
 	f.stopChan = make(chan struct{})
-	for ns, fac := range f.factories {
-		log.Debug().Msgf("Starting factory in ns %q", ns)
-		fac.Start(f.stopChan)
+	for ns, ii := range f.informers {
+		log.Debug().Msgf("Starting informers in ns %q", ns)
+		for _, inf := range ii {
+			inf.run()
+		}
 	}
+	go f.reaper(f.stopChan)
 }
 
 // Terminate terminates all watchers and forwards.
@@ -58,41 +114,78 @@ func (f *Factory) Terminate() {
 		close(f.stopChan)
 		f.stopChan = nil
 	}
-	for k := range f.factories {
-		delete(f.factories, k)
+	for ns, ii := range f.informers {
+		for _, inf := range ii {
+			inf.stop()
+		}
+		delete(f.informers, ns)
+	}
+	for k := range f.pagers {
+		delete(f.pagers, k)
 	}
+	for k := range f.sized {
+		delete(f.sized, k)
+	}
+	f.resetProtos()
+	f.namespaces.reset()
 	f.forwarders.DeleteAll()
 }
 
 // List returns a resource collection.
 func (f *Factory) List(gvr, ns string, wait bool, labels labels.Selector) ([]runtime.Object, error) {
-	inf, err := f.CanForResource(ns, gvr, client.MonitorAccess)
+	p, wns, err := f.listingFor(gvr, ns, selection{})
 	if err != nil {
+		// Without cluster wide access, list each namespace instead.
+		if client.IsAllNamespaces(ns) && !f.canListAll(gvr) {
+			return f.listAccessible(gvr, wait, labels)
+		}
 		return nil, err
 	}
-	if wait {
-		f.waitForCacheSync(ns)
+	if p != nil {
+		return p.list(f.listFn(gvr, ns, selection{labels: labels.String()}), time.Now())
 	}
-	if client.IsClusterScoped(ns) {
-		return inf.Lister().List(labels)
+
+	return listFrom(f.ForResource(wns, gvr), ns, wait, labels)
+}
+
+// ListSelected returns a resource collection matching label and field
+// selectors. Field selectors are evaluated by the api server and so are label
+// selectors unless the whole collection is already cached or paginated.
+func (f *Factory) ListSelected(gvr, ns string, wait bool, lsel labels.Selector, fsel fields.Selector) ([]runtime.Object, error) {
+	if fsel.Empty() && (lsel.Empty() || f.isCached(gvr, ns) || f.isPaged(gvr, ns)) {
+		return f.List(gvr, ns, wait, lsel)
 	}
 
-	if client.IsAllNamespace(ns) {
-		ns = client.AllNamespaces
+	sel := selection{labels: lsel.String(), fields: fsel.String()}
+	p, wns, err := f.listingFor(gvr, ns, sel)
+	if err != nil {
+		return nil, err
+	}
+	if p != nil {
+		return p.list(f.listFn(gvr, ns, sel), time.Now())
 	}
-	return inf.Lister().ByNamespace(ns).List(labels)
+
+	return listFrom(f.forResource(wns, gvr, sel), ns, wait, labels.Everything())
 }
 
 // Get retrieves a given resource.
 func (f *Factory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
 	ns, n := namespaced(path)
-	inf, err := f.CanForResource(ns, gvr, []string{client.GetVerb})
-	if err != nil {
+	if _, ok := f.pagerFor(gvr, ns); ok {
+		return f.fetch(gvr, ns, n)
+	}
+	var (
+		inf informers.GenericInformer
+		err error
+	)
+	if wns, ok := f.sizedFor(gvr, ns); ok {
+		inf = f.ForResource(wns, gvr)
+	} else if inf, err = f.CanForResource(ns, gvr, []string{client.GetVerb}); err != nil {
 		return nil, err
 	}
 
 	if wait {
-		f.waitForCacheSync(ns)
+		waitForCacheSync(inf)
 	}
 	if client.IsClusterScoped(ns) {
 		return inf.Lister().Get(n)
@@ -101,38 +194,20 @@ func (f *Factory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime
 	return inf.Lister().ByNamespace(ns).Get(n)
 }
 
-func (f *Factory) waitForCacheSync(ns string) {
-	if client.IsClusterWide(ns) {
-		ns = client.AllNamespaces
-	}
-
-	if f.isClusterWide() {
-		ns = client.AllNamespaces
-	}
-
+// WaitForCacheSync waits for all informers to update their cache.
+func (f *Factory) WaitForCacheSync() {
 	f.mx.RLock()
-	defer f.mx.RUnlock()
-	fac, ok := f.factories[ns]
-	if !ok {
-		return
+	stop := f.stopChan
+	synced := make(map[string]cache.InformerSynced)
+	for ns, ii := range f.informers {
+		for gvr, inf := range ii {
+			synced[ns+":"+gvr] = inf.Informer().HasSynced
+		}
 	}
+	f.mx.RUnlock()
 
-	// Hang for a sec for the cache to refresh if still not done bail out!
-	c := make(chan struct{})
-	go func(c chan struct{}) {
-		<-time.After(defaultWaitTime)
-		close(c)
-	}(c)
-	_ = fac.WaitForCacheSync(c)
-}
-
-// WaitForCacheSync waits for all factories to update their cache.
-func (f *Factory) WaitForCacheSync() {
-	for ns, fac := range f.factories {
-		m := fac.WaitForCacheSync(f.stopChan)
-		for k, v := range m {
-			log.Debug().Msgf("CACHE `%q Loaded %t:%s", ns, v, k)
-		}
+	for k, s := range synced {
+		log.Debug().Msgf("CACHE `%q Loaded %t", k, cache.WaitForCacheSync(stop, s))
 	}
 }
 
@@ -141,15 +216,19 @@ func (f *Factory) Client() client.Connection {
 	return f.client
 }
 
-// FactoryFor returns a factory for a given namespace.
-func (f *Factory) FactoryFor(ns string) di.DynamicSharedInformerFactory {
-	return f.factories[ns]
-}
-
 // SetActiveNS sets the active namespace.
 func (f *Factory) SetActiveNS(ns string) {
-	if !f.isClusterWide() {
-		f.ensureFactory(ns)
+	if f.isClusterWide() {
+		return
+	}
+	if client.IsClusterWide(ns) {
+		ns = client.AllNamespaces
+	}
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if _, ok := f.informers[ns]; !ok {
+		f.informers[ns] = make(map[string]*informer)
 	}
 }
 
@@ -157,63 +236,392 @@ func (f *Factory) isClusterWide() bool {
 	f.mx.RLock()
 	defer f.mx.RUnlock()
 
-	_, ok := f.factories[client.AllNamespaces]
+	_, ok := f.informers[client.AllNamespaces]
 	return ok
 }
 
 // CanForResource return an informer is user has access.
 func (f *Factory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
+	ns, err := f.CanAccess(ns, gvr, verbs)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.ForResource(ns, gvr), nil
+}
+
+// CanAccess checks if user has access to a resource without starting an
+// informer. Returns the namespace to watch the resource from.
+func (f *Factory) CanAccess(ns, gvr string, verbs []string) (string, error) {
 	// If user can access resource cluster wide, prefer cluster wide factory.
 	if !client.IsClusterWide(ns) {
 		auth, err := f.Client().CanI(client.AllNamespaces, gvr, verbs)
 		if auth && err == nil {
-			return f.ForResource(client.AllNamespaces, gvr), nil
+			return client.AllNamespaces, nil
 		}
 	}
 	auth, err := f.Client().CanI(ns, gvr, verbs)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 	if !auth {
-		return nil, fmt.Errorf("%v access denied on resource %q:%q", verbs, ns, gvr)
+		return "", fmt.Errorf("%v access denied on resource %q:%q", verbs, ns, gvr)
 	}
 
-	return f.ForResource(ns, gvr), nil
+	return ns, nil
 }
 
-// ForResource returns an informer for a given resource.
-func (f *Factory) ForResource(ns, gvr string) informers.GenericInformer {
-	fact := f.ensureFactory(ns)
-	inf := fact.ForResource(toGVR(gvr))
-	if inf == nil {
-		log.Error().Err(fmt.Errorf("MEOW! No informer for %q:%q", ns, gvr))
-		return inf
+// PageFor returns the state of a resource listing using the given label
+// and field selectors if paginated.
+func (f *Factory) PageFor(gvr, ns, lsel, fsel string) (Page, bool) {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	p, ok := f.pagers[pageKey(f.pageGVR(gvr, ns, lsel, fsel), ns)]
+	if !ok {
+		return Page{}, false
+	}
+
+	return p.current(), true
+}
+
+// LoadMore extends a paginated resource listing by another page. Returns
+// false if the listing is not paginated or fully loaded.
+func (f *Factory) LoadMore(gvr, ns, lsel, fsel string) bool {
+	f.mx.RLock()
+	p, ok := f.pagers[pageKey(f.pageGVR(gvr, ns, lsel, fsel), ns)]
+	f.mx.RUnlock()
+	if !ok {
+		return false
+	}
+
+	return p.more()
+}
+
+// pageGVR returns the key of a listing using the given selectors. Label
+// only selections are paged along with the whole collection.
+func (f *Factory) pageGVR(gvr, ns, lsel, fsel string) string {
+	sel := newSelection(lsel, fsel)
+	if sel.fields != "" {
+		return sel.key(gvr)
+	}
+	if _, ok := f.pagers[pageKey(gvr, ns)]; ok || sel.labels == "" {
+		return gvr
 	}
 
+	return sel.key(gvr)
+}
+
+// listingFor returns a pager if the resource collection is too large to be
+// cached or the namespace to cache it from otherwise. Decisions expire with
+// the idle TTL so collections are probed again as they grow or shrink.
+func (f *Factory) listingFor(gvr, ns string, sel selection) (*pager, string, error) {
+	key := pageKey(sel.key(gvr), ns)
+	f.mx.RLock()
+	p, paged := f.pagers[key]
+	sz, sized := f.sized[key]
+	threshold, size := f.pageThreshold, f.pageSize
+	f.mx.RUnlock()
+	if paged && !p.isStale() {
+		return p, "", nil
+	}
+	if sized {
+		return nil, sz.ns, nil
+	}
+
+	wns, err := f.CanAccess(ns, gvr, client.MonitorAccess)
+	if err != nil || threshold <= 0 {
+		return nil, wns, err
+	}
+	if f.hasInformer(wns, sel.key(gvr)) {
+		return nil, f.setSized(key, wns), nil
+	}
+	page, large, err := probe(f.listFn(gvr, wns, sel), threshold)
+	if err != nil {
+		return nil, "", err
+	}
+	if !large {
+		return nil, f.setSized(key, wns), nil
+	}
+	// Cluster wide collection is too large, see if namespace alone fits.
+	if pageKey(sel.key(gvr), wns) != key {
+		if page, large, err = probe(f.listFn(gvr, ns, sel), threshold); err != nil {
+			return nil, "", err
+		}
+		if !large {
+			return nil, f.setSized(key, ns), nil
+		}
+	}
+
+	f.mx.Lock()
+	defer f.mx.Unlock()
+	if paged {
+		p.reprobed(time.Now())
+		return p, "", nil
+	}
+	log.Debug().Msgf("Paginating %q:%q listing (%d items)", ns, sel.key(gvr), page.Total)
+	p = newPager(size, page)
+	f.pagers[key] = p
+
+	return p, "", nil
+}
+
+// setSized records a collection is small enough to be cached from a given
+// namespace, dropping its pager if any.
+func (f *Factory) setSized(key, ns string) string {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	delete(f.pagers, key)
+	f.sized[key] = sizing{ns: ns, at: time.Now()}
+
+	return ns
+}
+
+// isPaged checks if a whole resource collection is paginated.
+func (f *Factory) isPaged(gvr, ns string) bool {
 	f.mx.RLock()
 	defer f.mx.RUnlock()
-	fact.Start(f.stopChan)
+	_, ok := f.pagers[pageKey(gvr, ns)]
 
-	return inf
+	return ok
+}
+
+// isCached checks if a whole resource collection is cached.
+func (f *Factory) isCached(gvr, ns string) bool {
+	return f.hasInformer(client.AllNamespaces, gvr) || f.hasInformer(ns, gvr)
 }
 
-func (f *Factory) ensureFactory(ns string) di.DynamicSharedInformerFactory {
+func (f *Factory) hasInformer(ns, gvr string) bool {
 	if client.IsClusterWide(ns) {
 		ns = client.AllNamespaces
 	}
+
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+	_, ok := f.informers[ns][gvr]
+
+	return ok
+}
+
+// pagerFor returns the pager of a paginated resource listing if any.
+func (f *Factory) pagerFor(gvr, ns string) (*pager, bool) {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	if p, ok := f.pagers[pageKey(gvr, ns)]; ok {
+		return p, true
+	}
+	p, ok := f.pagers[pageKey(gvr, client.AllNamespaces)]
+
+	return p, ok
+}
+
+// sizedFor returns the namespace a resource listing is cached from if known.
+func (f *Factory) sizedFor(gvr, ns string) (string, bool) {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	if sz, ok := f.sized[pageKey(gvr, ns)]; ok {
+		return sz.ns, true
+	}
+	sz, ok := f.sized[pageKey(gvr, client.AllNamespaces)]
+
+	return sz.ns, ok
+}
+
+func (f *Factory) listFn(gvr, ns string, sel selection) ListFunc {
+	if c, ok := f.protoClient(gvr); ok {
+		g := client.NewGVR(gvr)
+		return func(opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+			sel.apply(&opts)
+			return protoList(c, g, ns, opts)
+		}
+	}
+	dial := f.client.DynDialOrDie().Resource(toGVR(gvr))
+	return func(opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+		sel.apply(&opts)
+		if client.IsClusterWide(ns) {
+			return dial.List(opts)
+		}
+		return dial.Namespace(ns).List(opts)
+	}
+}
+
+func (f *Factory) fetch(gvr, ns, n string) (runtime.Object, error) {
+	dial := f.client.DynDialOrDie().Resource(toGVR(gvr))
+	if client.IsClusterScoped(ns) {
+		return dial.Get(n, metav1.GetOptions{})
+	}
+
+	return dial.Namespace(ns).Get(n, metav1.GetOptions{})
+}
+
+// ForResource returns an informer for a given resource, starting it on
+// first access.
+func (f *Factory) ForResource(ns, gvr string) informers.GenericInformer {
+	return f.forResource(ns, gvr, selection{})
+}
+
+func (f *Factory) forResource(ns, gvr string, sel selection) informers.GenericInformer {
+	if client.IsClusterWide(ns) {
+		ns = client.AllNamespaces
+	}
+
 	f.mx.Lock()
 	defer f.mx.Unlock()
-	if fac, ok := f.factories[ns]; ok {
-		return fac
-	}
-	f.factories[ns] = di.NewFilteredDynamicSharedInformerFactory(
-		f.client.DynDialOrDie(),
-		defaultResync,
-		ns,
-		nil,
-	)
+	ii, ok := f.informers[ns]
+	if !ok {
+		ii = make(map[string]*informer)
+		f.informers[ns] = ii
+	}
+	key := sel.key(gvr)
+	inf, ok := ii[key]
+	if !ok {
+		log.Debug().Msgf("Creating informer for %q:%q", ns, key)
+		var tweak di.TweakListOptionsFunc
+		if !sel.empty() {
+			tweak = sel.apply
+		}
+		inf = newInformer(f.genericInformer(ns, gvr, tweak))
+		inf.Informer().AddEventHandler(eventCounter(gvr))
+		inf.Informer().AddEventHandler(f.timeline.Handler(gvr))
+		ii[key] = inf
+	}
+	inf.touch(time.Now())
+	if f.stopChan != nil {
+		inf.run()
+	}
+
+	return inf
+}
+
+// genericInformer returns an informer for a given resource. Built-in resources
+// are transported as protobuf when enabled, others as json.
+func (f *Factory) genericInformer(ns, gvr string, tweak di.TweakListOptionsFunc) informers.GenericInformer {
+	g := client.NewGVR(gvr)
+	indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+	if c, ok := f.protoClient(gvr); ok {
+		return newCacheInformer(protoListWatch(c, g, ns, tweak), g, indexers)
+	}
+
+	return newCacheInformer(dynListWatch(f.client.DynDialOrDie(), g, ns, tweak), g, indexers)
+}
+
+// protoClient returns a protobuf rest client if the resource is a built-in type.
+// Clients are shared per group version so their transport, which is not
+// cached when dialing through a tunnel, gets reused.
+func (f *Factory) protoClient(gvr string) (rest.Interface, bool) {
+	cfg := f.client.Config()
+	if cfg == nil || !cfg.Protobuf() {
+		return nil, false
+	}
+	g := client.NewGVR(gvr)
+	if !client.IsBuiltIn(g) {
+		return nil, false
+	}
+
+	f.protoMx.Lock()
+	defer f.protoMx.Unlock()
+	gv := g.GV().String()
+	if c, ok := f.protos[gv]; ok {
+		return c, true
+	}
+	c, err := client.ProtoRESTClient(f.client.RestConfigOrDie(), g)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Protobuf client failed for %q. Using json", gvr)
+		return nil, false
+	}
+	f.protos[gv] = c
+
+	return c, true
+}
+
+// resetProtos drops the protobuf clients so they pick up the current
+// connection settings.
+func (f *Factory) resetProtos() {
+	f.protoMx.Lock()
+	defer f.protoMx.Unlock()
+
+	for k := range f.protos {
+		delete(f.protos, k)
+	}
+}
+
+// Informers returns the number of running informers.
+func (f *Factory) Informers() int {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
 
-	return f.factories[ns]
+	var count int
+	for _, ii := range f.informers {
+		for _, inf := range ii {
+			if inf.running() {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func (f *Factory) reaper(stop <-chan struct{}) {
+	f.mx.RLock()
+	interval := f.idleTTL / 2
+	if f.budget > 0 {
+		interval = minReapInterval
+	}
+	f.mx.RUnlock()
+	if interval < minReapInterval {
+		interval = minReapInterval
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-t.C:
+			f.reap(now)
+		}
+	}
+}
+
+// reap stops informers that have not been accessed within the idle TTL and
+// evicts informers past the memory budget.
+func (f *Factory) reap(now time.Time) {
+	f.mx.Lock()
+	defer f.mx.Unlock()
+
+	for ns, ii := range f.informers {
+		for gvr, inf := range ii {
+			if !inf.isIdle(now, f.idleTTL) {
+				continue
+			}
+			log.Debug().Msgf("Stopping idle informer for %q:%q", ns, gvr)
+			inf.stop()
+			delete(ii, gvr)
+		}
+	}
+	f.evict(now)
+	for k, p := range f.pagers {
+		if p.isIdle(now, f.idleTTL) {
+			delete(f.pagers, k)
+			continue
+		}
+		p.expire(now, f.idleTTL)
+	}
+	// Collections sizes may change so they are checked again on next use.
+	for k, sz := range f.sized {
+		ns := sz.ns
+		if client.IsClusterWide(ns) {
+			ns = client.AllNamespaces
+		}
+		if _, key := parsePageKey(k); f.informers[ns][key] == nil || now.Sub(sz.at) > f.idleTTL {
+			delete(f.sized, k)
+		}
+	}
 }
 
 // AddForwarder registers a new portforward for a given container.
@@ -246,3 +654,54 @@ func (f *Factory) ForwarderFor(path string) (Forwarder, bool) {
 	fwd, ok := f.forwarders[path]
 	return fwd, ok
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// sizing records the namespace a collection small enough to be cached is
+// cached from.
+type sizing struct {
+	ns string
+	at time.Time
+}
+
+func pageKey(gvr, ns string) string {
+	if client.IsClusterWide(ns) {
+		ns = client.AllNamespaces
+	}
+
+	return ns + "|" + gvr
+}
+
+func parsePageKey(k string) (string, string) {
+	i := strings.Index(k, "|")
+	if i < 0 {
+		return "", k
+	}
+
+	return k[:i], k[i+1:]
+}
+
+func listFrom(inf informers.GenericInformer, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	if wait {
+		waitForCacheSync(inf)
+	}
+	if client.IsClusterScoped(ns) {
+		return inf.Lister().List(sel)
+	}
+
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	return inf.Lister().ByNamespace(ns).List(sel)
+}
+
+func waitForCacheSync(inf informers.GenericInformer) {
+	// Hang for a sec for the cache to refresh if still not done bail out!
+	c := make(chan struct{})
+	go func(c chan struct{}) {
+		<-time.After(defaultWaitTime)
+		close(c)
+	}(c)
+	_ = cache.WaitForCacheSync(c, inf.Informer().HasSynced)
+}
@@ -0,0 +1,127 @@
+package watch
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// maxListWorkers represents the number of namespaces listed at once.
+	maxListWorkers = 16
+
+	namespacesTTL = 1 * time.Minute
+)
+
+// SetFallbackNamespaces sets the namespaces to list resources from when
+// namespaces can't be listed.
+func (f *Factory) SetFallbackNamespaces(nn []string) {
+	f.namespaces.setFallback(nn)
+}
+
+// canListAll checks if a resource can be listed cluster wide.
+func (f *Factory) canListAll(gvr string) bool {
+	auth, err := f.Client().CanI(client.AllNamespaces, gvr, client.MonitorAccess)
+
+	return auth && err == nil
+}
+
+// listAccessible lists a resource from each namespace the user has access
+// to. Namespaces are listed concurrently.
+func (f *Factory) listAccessible(gvr string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	nn, err := f.namespaces.get(time.Now(), f.namespaceNames)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mx     sync.Mutex
+		sem    = make(chan struct{}, maxListWorkers)
+		oo     []runtime.Object
+		listed int
+	)
+	for _, ns := range nn {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(ns string) {
+			defer func() { <-sem; wg.Done() }()
+			inf, err := f.CanForResource(ns, gvr, client.MonitorAccess)
+			if err != nil {
+				return
+			}
+			ll, err := listFrom(inf, ns, wait, sel)
+			if err != nil {
+				log.Warn().Err(err).Msgf("List %q:%q failed", ns, gvr)
+				return
+			}
+			mx.Lock()
+			oo, listed = append(oo, ll...), listed+1
+			mx.Unlock()
+		}(ns)
+	}
+	wg.Wait()
+	if listed == 0 {
+		return nil, fmt.Errorf("%v access denied on resource %q in all namespaces", client.MonitorAccess, gvr)
+	}
+
+	return oo, nil
+}
+
+func (f *Factory) namespaceNames() ([]string, error) {
+	nns, err := f.Client().ValidNamespaces()
+	if err != nil {
+		return nil, err
+	}
+
+	return f.Client().Config().NamespaceNames(nns), nil
+}
+
+// nsCache caches the namespaces a resource is listed from.
+type nsCache struct {
+	names    []string
+	fallback []string
+	at       time.Time
+	mx       sync.Mutex
+}
+
+func (c *nsCache) setFallback(nn []string) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.fallback = nn
+}
+
+func (c *nsCache) reset() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.names, c.fallback = nil, nil
+}
+
+// get returns the cached namespaces or lists them again once expired. The
+// fallback namespaces are used if namespaces can't be listed.
+func (c *nsCache) get(now time.Time, list func() ([]string, error)) ([]string, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.names != nil && now.Sub(c.at) < namespacesTTL {
+		return c.names, nil
+	}
+	nn, err := list()
+	if err != nil {
+		if len(c.fallback) == 0 {
+			return nil, err
+		}
+		log.Debug().Err(err).Msgf("Namespaces list failed. Using fallback namespaces")
+		nn = c.fallback
+	}
+	c.names, c.at = nn, now
+
+	return nn, nil
+}
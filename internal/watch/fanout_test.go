@@ -0,0 +1,46 @@
+package watch
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNSCacheGet(t *testing.T) {
+	var calls int
+	list := func() ([]string, error) {
+		calls++
+		return []string{"ns1", "ns2"}, nil
+	}
+	var c nsCache
+	now := time.Now()
+
+	nn, err := c.get(now, list)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"ns1", "ns2"}, nn)
+	_, _ = c.get(now.Add(namespacesTTL/2), list)
+	assert.Equal(t, 1, calls)
+	_, _ = c.get(now.Add(namespacesTTL), list)
+	assert.Equal(t, 2, calls)
+}
+
+func TestNSCacheFallback(t *testing.T) {
+	denied := func() ([]string, error) {
+		return nil, errors.New("denied")
+	}
+	var c nsCache
+
+	_, err := c.get(time.Now(), denied)
+	assert.NotNil(t, err)
+
+	c.setFallback([]string{"fred"})
+	nn, err := c.get(time.Now(), denied)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"fred"}, nn)
+
+	c.reset()
+	_, err = c.get(time.Now(), denied)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,187 @@
+package watch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kwatch "k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	minWatchBackoff = 1 * time.Second
+	maxWatchBackoff = 30 * time.Second
+	backoffJitter   = 0.5
+)
+
+// StaleSince returns the time a resource listing cached data went stale
+// if its watch dropped.
+func (f *Factory) StaleSince(gvr, ns, lsel, fsel string) (time.Time, bool) {
+	if client.IsClusterWide(ns) {
+		ns = client.AllNamespaces
+	}
+
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	var (
+		since time.Time
+		stale bool
+	)
+	for _, n := range []string{ns, client.AllNamespaces} {
+		ii, ok := f.informers[n]
+		if !ok {
+			continue
+		}
+		for _, key := range []string{newSelection(lsel, fsel).key(gvr), gvr} {
+			inf, ok := ii[key]
+			if !ok {
+				continue
+			}
+			if at, ok := inf.staleSince(); ok && (!stale || at.Before(since)) {
+				since, stale = at, true
+			}
+		}
+	}
+
+	return since, stale
+}
+
+// watchHealth tracks an informer connection to the api-server. Cached data
+// is stale from the first failed list or watch till the connection recovers.
+// Reconnects back off until a list succeeds or the watch delivers events.
+type watchHealth struct {
+	gvr        string
+	failures   int
+	stale      bool
+	staleSince time.Time
+	mx         sync.Mutex
+}
+
+func newWatchHealth(gvr string) *watchHealth {
+	return &watchHealth{gvr: gvr}
+}
+
+// failed records a failed list or watch.
+func (h *watchHealth) failed(err error, now time.Time) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	if !h.stale {
+		log.Warn().Err(err).Msgf("Watch dropped for %q. Reconnecting", h.gvr)
+		h.stale, h.staleSince = true, now
+	}
+	h.failures++
+}
+
+// connected records a watch reconnect. The cache is in sync again but the
+// connection is not trusted till it delivers events.
+func (h *watchHealth) connected() {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	h.reconnected()
+}
+
+// recovered records a successful list or watch event.
+func (h *watchHealth) recovered() {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	h.reconnected()
+	h.failures = 0
+}
+
+func (h *watchHealth) reconnected() {
+	if h.stale {
+		log.Info().Msgf("Watch reconnected for %q", h.gvr)
+	}
+	h.stale, h.staleSince = false, time.Time{}
+}
+
+// staleness returns the time the cached data went stale if any.
+func (h *watchHealth) staleness() (time.Time, bool) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	return h.staleSince, h.stale
+}
+
+// wait waits out the reconnect backoff if any.
+func (h *watchHealth) wait() {
+	if d := h.delay(); d > 0 {
+		time.Sleep(wait.Jitter(d, backoffJitter))
+	}
+}
+
+// delay returns the time to wait before reconnecting.
+func (h *watchHealth) delay() time.Duration {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	return backoff(h.failures)
+}
+
+// backoff returns an exponential delay given consecutive failures.
+func backoff(failures int) time.Duration {
+	if failures == 0 {
+		return 0
+	}
+	d := minWatchBackoff
+	for i := 1; i < failures && d < maxWatchBackoff; i++ {
+		d *= 2
+	}
+	if d > maxWatchBackoff {
+		d = maxWatchBackoff
+	}
+
+	return d
+}
+
+// tracked reconnects a list watch with a jittered backoff and records its
+// health.
+func tracked(lw *cache.ListWatch, h *watchHealth) *cache.ListWatch {
+	return &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			h.wait()
+			o, err := lw.List(opts)
+			if err != nil {
+				h.failed(err, time.Now())
+				return nil, err
+			}
+			h.recovered()
+			return o, nil
+		},
+		WatchFunc: func(opts metav1.ListOptions) (kwatch.Interface, error) {
+			h.wait()
+			w, err := lw.Watch(opts)
+			if err != nil {
+				h.failed(err, time.Now())
+				return nil, err
+			}
+			h.connected()
+			return trackedWatch(w, h), nil
+		},
+	}
+}
+
+// trackedWatch records the errors a watch delivers. Expired resource
+// versions are routine and only trigger a relist.
+func trackedWatch(w kwatch.Interface, h *watchHealth) kwatch.Interface {
+	return kwatch.Filter(w, func(evt kwatch.Event) (kwatch.Event, bool) {
+		if evt.Type != kwatch.Error {
+			h.recovered()
+			return evt, true
+		}
+		if err := apierrors.FromObject(evt.Object); !apierrors.IsGone(err) && !apierrors.IsResourceExpired(err) {
+			h.failed(err, time.Now())
+		}
+		return evt, true
+	})
+}
@@ -0,0 +1,105 @@
+package watch
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kwatch "k8s.io/apimachinery/pkg/watch"
+)
+
+func TestBackoff(t *testing.T) {
+	uu := map[string]struct {
+		failures int
+		e        time.Duration
+	}{
+		"none":   {},
+		"first":  {failures: 1, e: time.Second},
+		"third":  {failures: 3, e: 4 * time.Second},
+		"capped": {failures: 10, e: maxWatchBackoff},
+		"many":   {failures: 1000, e: maxWatchBackoff},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, backoff(u.failures))
+		})
+	}
+}
+
+func TestWatchHealth(t *testing.T) {
+	h := newWatchHealth("v1/pods")
+	_, ok := h.staleness()
+	assert.False(t, ok)
+	assert.Equal(t, time.Duration(0), h.delay())
+
+	at := time.Now()
+	h.failed(errors.New("boom"), at)
+	h.failed(errors.New("boom"), at.Add(time.Second))
+	since, ok := h.staleness()
+	assert.True(t, ok)
+	assert.Equal(t, at, since)
+	assert.Equal(t, 2*time.Second, h.delay())
+
+	h.recovered()
+	_, ok = h.staleness()
+	assert.False(t, ok)
+	assert.Equal(t, time.Duration(0), h.delay())
+}
+
+func TestWatchHealthConnected(t *testing.T) {
+	h := newWatchHealth("v1/pods")
+	h.failed(errors.New("boom"), time.Now())
+	h.failed(errors.New("boom"), time.Now())
+
+	h.connected()
+	_, ok := h.staleness()
+	assert.False(t, ok)
+	assert.Equal(t, 2*time.Second, h.delay())
+}
+
+func TestTrackedWatch(t *testing.T) {
+	uu := map[string]struct {
+		evt   kwatch.Event
+		stale bool
+	}{
+		"added": {
+			evt: kwatch.Event{Type: kwatch.Added, Object: &metav1.Status{}},
+		},
+		"error": {
+			evt:   kwatch.Event{Type: kwatch.Error, Object: &apierrors.NewInternalError(errors.New("boom")).ErrStatus},
+			stale: true,
+		},
+		"expired": {
+			evt: kwatch.Event{Type: kwatch.Error, Object: &apierrors.NewGone("too old").ErrStatus},
+		},
+		"expiredVersion": {
+			evt: kwatch.Event{Type: kwatch.Error, Object: &apierrors.NewResourceExpired("too old").ErrStatus},
+		},
+		"notFound": {
+			evt:   kwatch.Event{Type: kwatch.Error, Object: &apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, "fred").ErrStatus},
+			stale: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			h := newWatchHealth("v1/pods")
+			fw := kwatch.NewFake()
+			w := trackedWatch(fw, h)
+			defer w.Stop()
+
+			go fw.Action(u.evt.Type, u.evt.Object)
+			evt := <-w.ResultChan()
+			assert.Equal(t, u.evt.Type, evt.Type)
+			_, stale := h.staleness()
+			assert.Equal(t, u.stale, stale)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package watch
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/informers"
+)
+
+// informer tracks a resource informer, its cache size and when it was last
+// accessed.
+type informer struct {
+	informers.GenericInformer
+
+	size     cacheSize
+	stopChan chan struct{}
+	lastUsed time.Time
+	mx       sync.Mutex
+}
+
+func newInformer(inf informers.GenericInformer) *informer {
+	i := informer{GenericInformer: inf}
+	if inf != nil {
+		inf.Informer().AddEventHandler(&i.size)
+	}
+
+	return &i
+}
+
+func (i *informer) touch(t time.Time) {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	i.lastUsed = t
+}
+
+func (i *informer) used() time.Time {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	return i.lastUsed
+}
+
+func (i *informer) isIdle(now time.Time, ttl time.Duration) bool {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	return now.Sub(i.lastUsed) > ttl
+}
+
+func (i *informer) running() bool {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	return i.stopChan != nil
+}
+
+func (i *informer) run() {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	if i.stopChan != nil {
+		return
+	}
+	i.stopChan = make(chan struct{})
+	go i.Informer().Run(i.stopChan)
+}
+
+func (i *informer) stop() {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	if i.stopChan == nil {
+		return
+	}
+	close(i.stopChan)
+	i.stopChan = nil
+}
+
+// staleSince returns the time the cached data went stale if any.
+func (i *informer) staleSince() (time.Time, bool) {
+	c, ok := i.GenericInformer.(*cacheInformer)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	return c.health.staleness()
+}
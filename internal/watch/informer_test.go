@@ -0,0 +1,39 @@
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInformerIsIdle(t *testing.T) {
+	now := time.Now()
+	uu := map[string]struct {
+		used time.Time
+		ttl  time.Duration
+		e    bool
+	}{
+		"fresh": {used: now.Add(-10 * time.Second), ttl: time.Minute},
+		"idle":  {used: now.Add(-2 * time.Minute), ttl: time.Minute, e: true},
+		"never": {ttl: time.Minute, e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			i := newInformer(nil)
+			if !u.used.IsZero() {
+				i.touch(u.used)
+			}
+			assert.Equal(t, u.e, i.isIdle(now, u.ttl))
+		})
+	}
+}
+
+func TestInformerStopIdle(t *testing.T) {
+	i := newInformer(nil)
+	assert.False(t, i.running())
+	i.stop()
+	assert.False(t, i.running())
+}
@@ -0,0 +1,64 @@
+package watch
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/perf"
+)
+
+// Metrics returns informer caches and port-forwards metrics.
+func (f *Factory) Metrics() []perf.Metric {
+	objects := perf.Metric{
+		Name: "k9s_informer_cache_objects",
+		Help: "Number of objects held by informer caches.",
+		Type: "gauge",
+	}
+	bytes := perf.Metric{
+		Name: "k9s_informer_cache_bytes",
+		Help: "Approximate memory held by informer caches.",
+		Type: "gauge",
+	}
+	for _, s := range f.CacheStats() {
+		ll := map[string]string{
+			"namespace": cacheNamespace(s.Namespace),
+			"gvr":       s.GVR,
+			"selector":  s.Selector,
+		}
+		objects.Samples = append(objects.Samples, perf.MetricSample{Labels: ll, Value: float64(s.Items)})
+		bytes.Samples = append(bytes.Samples, perf.MetricSample{Labels: ll, Value: float64(s.Bytes)})
+	}
+
+	active, total := f.forwarderCounts()
+	forwards := perf.Metric{
+		Name: "k9s_port_forwards",
+		Help: "Number of port-forwards by state.",
+		Type: "gauge",
+		Samples: []perf.MetricSample{
+			{Labels: map[string]string{"state": "active"}, Value: float64(active)},
+			{Labels: map[string]string{"state": "inactive"}, Value: float64(total - active)},
+		},
+	}
+
+	return []perf.Metric{objects, bytes, forwards}
+}
+
+func (f *Factory) forwarderCounts() (int, int) {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	var active int
+	for _, fw := range f.forwarders {
+		if fw.Active() {
+			active++
+		}
+	}
+
+	return active, len(f.forwarders)
+}
+
+func cacheNamespace(ns string) string {
+	if client.IsAllNamespaces(ns) {
+		return "all"
+	}
+
+	return ns
+}
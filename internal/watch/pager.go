@@ -0,0 +1,224 @@
+package watch
+
+import (
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Page represents the state of a paginated listing.
+type Page struct {
+	// Loaded represents the number of items listed so far.
+	Loaded int
+	// Total represents the collection size or -1 if unknown.
+	Total int64
+	// More indicates more items can be loaded.
+	More bool
+}
+
+// pageResync represents the time pages past the first one are kept before
+// being listed again.
+const pageResync = 30 * time.Second
+
+// ListFunc fetches a chunk of a resource collection.
+type ListFunc func(opts metav1.ListOptions) (*unstructured.UnstructuredList, error)
+
+// pager lists a large collection a chunk at a time. Refreshes only fetch the
+// first page, pages loaded past it being kept along with the continue token
+// of the next page until they are due for a resync.
+type pager struct {
+	size     int64
+	page     Page
+	rest     []*unstructured.Unstructured
+	restAt   time.Time
+	cont     string
+	wanted   int
+	probedAt time.Time
+	stale    bool
+	lastUsed time.Time
+	mx       sync.Mutex
+}
+
+func newPager(size int64, page Page) *pager {
+	return &pager{
+		size:     size,
+		page:     page,
+		probedAt: time.Now(),
+	}
+}
+
+// list fetches the first page of the collection along with the pages loaded
+// so far and the ones requested since the last listing.
+func (p *pager) list(fetch ListFunc, now time.Time) ([]runtime.Object, error) {
+	p.mx.Lock()
+	p.lastUsed = now
+	size, rest, restAt, cont, wanted := p.size, p.rest, p.restAt, p.cont, p.wanted
+	p.mx.Unlock()
+
+	head, err := fetch(metav1.ListOptions{Limit: size})
+	if err != nil {
+		return nil, err
+	}
+	pages := wanted
+	switch {
+	case head.GetContinue() == "":
+		rest, cont = nil, ""
+	case len(rest) == 0:
+		cont = head.GetContinue()
+	case now.Sub(restAt) > pageResync:
+		// Pages past the first one went stale, list them again.
+		pages += pageCount(len(rest), size)
+		rest, cont = nil, head.GetContinue()
+	}
+	more, next, err := fetchPages(fetch, size, cont, pages)
+	if isExpired(err) && len(rest) > 0 {
+		// The continue token expired, reload all pages past the first one.
+		pages += pageCount(len(rest), size)
+		rest = nil
+		more, next, err = fetchPages(fetch, size, head.GetContinue(), pages)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) == 0 {
+		restAt = now
+	}
+	rest, cont = append(rest[:len(rest):len(rest)], more...), next
+
+	oo := make([]runtime.Object, 0, len(head.Items)+len(rest))
+	seen := make(map[string]struct{}, len(head.Items))
+	for i := range head.Items {
+		oo = append(oo, &head.Items[i])
+		seen[itemKey(&head.Items[i])] = struct{}{}
+	}
+	// Items may have moved to the first page since their page got loaded.
+	for _, u := range rest {
+		if _, ok := seen[itemKey(u)]; !ok {
+			oo = append(oo, u)
+		}
+	}
+	var total int64 = -1
+	if cont == "" {
+		total = int64(len(oo))
+	} else if c := head.GetRemainingItemCount(); c != nil {
+		total = int64(len(head.Items)) + *c
+	}
+
+	p.mx.Lock()
+	p.rest, p.restAt, p.cont = rest, restAt, cont
+	p.wanted -= wanted
+	if cont == "" {
+		p.wanted = 0
+	}
+	p.page = Page{Loaded: len(oo), Total: total, More: cont != ""}
+	p.mx.Unlock()
+
+	return oo, nil
+}
+
+// more requests another page on the next listing.
+func (p *pager) more() bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if !p.page.More {
+		return false
+	}
+	p.wanted++
+
+	return true
+}
+
+func (p *pager) current() Page {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	return p.page
+}
+
+func (p *pager) isIdle(now time.Time, ttl time.Duration) bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	return now.Sub(p.lastUsed) > ttl
+}
+
+// expire flags the pager for a new probe once probed longer than ttl ago
+// since the collection size may have changed.
+func (p *pager) expire(now time.Time, ttl time.Duration) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	if now.Sub(p.probedAt) > ttl {
+		p.stale = true
+	}
+}
+
+func (p *pager) isStale() bool {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	return p.stale
+}
+
+// reprobed records a new probe keeping the pages loaded so far.
+func (p *pager) reprobed(now time.Time) {
+	p.mx.Lock()
+	defer p.mx.Unlock()
+
+	p.probedAt, p.stale = now, false
+}
+
+// fetchPages fetches the given number of pages starting at a continue token.
+// Returns the items fetched and the continue token of the next page.
+func fetchPages(fetch ListFunc, size int64, cont string, pages int) ([]*unstructured.Unstructured, string, error) {
+	var uu []*unstructured.Unstructured
+	for ; pages > 0 && cont != ""; pages-- {
+		ll, err := fetch(metav1.ListOptions{Limit: size, Continue: cont})
+		if err != nil {
+			return nil, "", err
+		}
+		for i := range ll.Items {
+			uu = append(uu, &ll.Items[i])
+		}
+		cont = ll.GetContinue()
+	}
+
+	return uu, cont, nil
+}
+
+// pageCount returns the number of pages holding the given items.
+func pageCount(items int, size int64) int {
+	return (items + int(size) - 1) / int(size)
+}
+
+func isExpired(err error) bool {
+	return err != nil && (apierrors.ReasonForError(err) == metav1.StatusReasonExpired || apierrors.IsGone(err))
+}
+
+func itemKey(u *unstructured.Unstructured) string {
+	return u.GetNamespace() + "/" + u.GetName()
+}
+
+// probe fetches the head of a collection to check whether it exceeds the
+// given threshold.
+func probe(fetch ListFunc, threshold int64) (Page, bool, error) {
+	ll, err := fetch(metav1.ListOptions{Limit: threshold})
+	if err != nil {
+		return Page{}, false, err
+	}
+	if ll.GetContinue() == "" {
+		return Page{}, false, nil
+	}
+
+	page := Page{Loaded: len(ll.Items), Total: -1, More: true}
+	if c := ll.GetRemainingItemCount(); c != nil {
+		page.Total = int64(len(ll.Items)) + *c
+	}
+
+	return page, true, nil
+}
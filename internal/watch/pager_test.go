@@ -0,0 +1,226 @@
+package watch
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPagerList(t *testing.T) {
+	uu := map[string]struct {
+		items, size int
+		more        bool
+		e           Page
+		calls       int
+	}{
+		"small": {
+			items: 3, size: 5,
+			e:     Page{Loaded: 3, Total: 3},
+			calls: 1,
+		},
+		"first": {
+			items: 12, size: 5,
+			e:     Page{Loaded: 5, Total: 12, More: true},
+			calls: 1,
+		},
+		"more": {
+			items: 12, size: 5, more: true,
+			e:     Page{Loaded: 10, Total: 12, More: true},
+			calls: 2,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			fetch, calls := makeListFn(u.items)
+			p := newPager(int64(u.size), Page{More: true})
+			if u.more {
+				assert.True(t, p.more())
+			}
+			oo, err := p.list(fetch, time.Now())
+
+			assert.Nil(t, err)
+			assert.Equal(t, u.e.Loaded, len(oo))
+			assert.Equal(t, u.e, p.current())
+			assert.Equal(t, u.calls, *calls)
+		})
+	}
+}
+
+func TestPagerMoreLoaded(t *testing.T) {
+	fetch, _ := makeListFn(3)
+	p := newPager(5, Page{More: true})
+	_, err := p.list(fetch, time.Now())
+
+	assert.Nil(t, err)
+	assert.False(t, p.more())
+}
+
+func TestPagerRefresh(t *testing.T) {
+	fetch, calls := makeListFn(12)
+	p := newPager(5, Page{More: true})
+	assert.True(t, p.more())
+	_, err := p.list(fetch, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, *calls)
+
+	// Refreshes only fetch the first page.
+	oo, err := p.list(fetch, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(oo))
+	assert.Equal(t, 3, *calls)
+
+	// Loading more only fetches the next page.
+	assert.True(t, p.more())
+	oo, err = p.list(fetch, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 12, len(oo))
+	assert.Equal(t, Page{Loaded: 12, Total: 12}, p.current())
+	assert.Equal(t, 5, *calls)
+	assert.False(t, p.more())
+}
+
+func TestPagerResync(t *testing.T) {
+	fetch, calls := makeListFn(12)
+	p := newPager(5, Page{More: true})
+	now := time.Now()
+	assert.True(t, p.more())
+	_, err := p.list(fetch, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, *calls)
+
+	_, err = p.list(fetch, now.Add(pageResync))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, *calls)
+
+	// Stale pages past the first one are listed again.
+	oo, err := p.list(fetch, now.Add(pageResync+time.Second))
+	assert.Nil(t, err)
+	assert.Equal(t, 10, len(oo))
+	assert.Equal(t, 5, *calls)
+
+	// Loading more keeps the resynced pages fresh.
+	assert.True(t, p.more())
+	_, err = p.list(fetch, now.Add(pageResync+2*time.Second))
+	assert.Nil(t, err)
+	assert.Equal(t, Page{Loaded: 12, Total: 12}, p.current())
+	assert.Equal(t, 7, *calls)
+}
+
+func TestPagerExpiredToken(t *testing.T) {
+	fetch, calls := makeListFn(12)
+	p := newPager(5, Page{More: true})
+	assert.True(t, p.more())
+	_, err := p.list(fetch, time.Now())
+	assert.Nil(t, err)
+
+	var done bool
+	expired := func(opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+		if opts.Continue == "10" && !done {
+			done = true
+			return nil, apierrors.NewGone("continue token expired")
+		}
+		return fetch(opts)
+	}
+	assert.True(t, p.more())
+	oo, err := p.list(expired, time.Now())
+	assert.Nil(t, err)
+	assert.Equal(t, 12, len(oo))
+	// Pages past the first one are reloaded from a fresh token.
+	assert.Equal(t, 5, *calls)
+}
+
+func TestPagerExpire(t *testing.T) {
+	p := newPager(5, Page{More: true})
+	now := time.Now()
+	p.expire(now, time.Minute)
+	assert.False(t, p.isStale())
+
+	p.expire(now.Add(2*time.Minute), time.Minute)
+	assert.True(t, p.isStale())
+
+	p.reprobed(now.Add(2 * time.Minute))
+	assert.False(t, p.isStale())
+}
+
+func TestProbe(t *testing.T) {
+	uu := map[string]struct {
+		items     int
+		threshold int64
+		large     bool
+		e         Page
+	}{
+		"small": {items: 10, threshold: 10},
+		"large": {items: 25, threshold: 10, large: true, e: Page{Loaded: 10, Total: 25, More: true}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			fetch, _ := makeListFn(u.items)
+			page, large, err := probe(fetch, u.threshold)
+
+			assert.Nil(t, err)
+			assert.Equal(t, u.large, large)
+			assert.Equal(t, u.e, page)
+		})
+	}
+}
+
+func TestPageKey(t *testing.T) {
+	uu := map[string]struct {
+		gvr, ns, e string
+	}{
+		"ns":      {gvr: "v1/pods", ns: "default", e: "default|v1/pods"},
+		"all":     {gvr: "v1/pods", ns: "all", e: "|v1/pods"},
+		"cluster": {gvr: "v1/nodes", ns: "-", e: "|v1/nodes"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			key := pageKey(u.gvr, u.ns)
+			assert.Equal(t, u.e, key)
+			_, gvr := parsePageKey(key)
+			assert.Equal(t, u.gvr, gvr)
+		})
+	}
+}
+
+// Helpers...
+
+// makeListFn simulates an api server paginating a collection of n items.
+func makeListFn(n int) (ListFunc, *int) {
+	var calls int
+	return func(opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+		calls++
+		start := 0
+		if opts.Continue != "" {
+			start, _ = strconv.Atoi(opts.Continue)
+		}
+		end := n
+		if opts.Limit > 0 && start+int(opts.Limit) < n {
+			end = start + int(opts.Limit)
+		}
+
+		var ll unstructured.UnstructuredList
+		for i := start; i < end; i++ {
+			var u unstructured.Unstructured
+			u.SetName("fred-" + strconv.Itoa(i))
+			ll.Items = append(ll.Items, u)
+		}
+		if end < n {
+			ll.SetContinue(strconv.Itoa(end))
+			rest := int64(n - end)
+			ll.SetRemainingItemCount(&rest)
+		}
+
+		return &ll, nil
+	}, &calls
+}
@@ -0,0 +1,117 @@
+package watch
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	kwatch "k8s.io/apimachinery/pkg/watch"
+	di "k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+)
+
+func protoListWatch(c rest.Interface, gvr client.GVR, ns string, tweak di.TweakListOptionsFunc) *cache.ListWatch {
+	return &cache.ListWatch{
+		ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+			if tweak != nil {
+				tweak(&opts)
+			}
+			return protoList(c, gvr, ns, opts)
+		},
+		WatchFunc: func(opts metav1.ListOptions) (kwatch.Interface, error) {
+			if tweak != nil {
+				tweak(&opts)
+			}
+			opts.Watch = true
+			w, err := protoRequest(c, gvr, ns, opts).Watch()
+			if err != nil {
+				return nil, err
+			}
+			return kwatch.Filter(w, func(evt kwatch.Event) (kwatch.Event, bool) {
+				if evt.Type == kwatch.Error {
+					return evt, true
+				}
+				u, err := toUnstructured(evt.Object, gvr)
+				if err != nil {
+					log.Error().Err(err).Msgf("Dropping %s watch event", gvr)
+					return evt, false
+				}
+				evt.Object = u
+				return evt, true
+			}), nil
+		},
+	}
+}
+
+func protoRequest(c rest.Interface, gvr client.GVR, ns string, opts metav1.ListOptions) *rest.Request {
+	return c.Get().
+		NamespaceIfScoped(ns, !client.IsClusterWide(ns)).
+		Resource(gvr.R()).
+		VersionedParams(&opts, scheme.ParameterCodec)
+}
+
+func protoList(c rest.Interface, gvr client.GVR, ns string, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	o, err := protoRequest(c, gvr, ns, opts).Do().Get()
+	if err != nil {
+		return nil, err
+	}
+
+	return toUnstructuredList(o, gvr)
+}
+
+func toUnstructuredList(o runtime.Object, gvr client.GVR) (*unstructured.UnstructuredList, error) {
+	lm, err := meta.ListAccessor(o)
+	if err != nil {
+		return nil, err
+	}
+	oo, err := meta.ExtractList(o)
+	if err != nil {
+		return nil, err
+	}
+
+	ll := unstructured.UnstructuredList{Object: map[string]interface{}{}}
+	ll.SetAPIVersion(gvr.GV().String())
+	ll.SetResourceVersion(lm.GetResourceVersion())
+	ll.SetContinue(lm.GetContinue())
+	ll.SetRemainingItemCount(lm.GetRemainingItemCount())
+	ll.Items = make([]unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		u, err := toUnstructured(o, gvr)
+		if err != nil {
+			return nil, err
+		}
+		ll.Items = append(ll.Items, *u)
+	}
+
+	return &ll, nil
+}
+
+// toUnstructured converts a typed object to an unstructured one. Objects
+// decoded from protobuf carry no type information so it is set from the
+// scheme prior to the conversion.
+func toUnstructured(o runtime.Object, gvr client.GVR) (*unstructured.Unstructured, error) {
+	if o.GetObjectKind().GroupVersionKind().Kind == "" {
+		kk, _, err := scheme.Scheme.ObjectKinds(o)
+		if err != nil {
+			return nil, err
+		}
+		gvk := kk[0]
+		for _, k := range kk {
+			if k.GroupVersion() == gvr.GV() {
+				gvk = k
+				break
+			}
+		}
+		o.GetObjectKind().SetGroupVersionKind(gvk)
+	}
+	m, err := runtime.DefaultUnstructuredConverter.ToUnstructured(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unstructured.Unstructured{Object: m}, nil
+}
@@ -0,0 +1,116 @@
+package watch
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+)
+
+func TestToUnstructured(t *testing.T) {
+	po := v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "fred"}}
+
+	u, err := toUnstructured(&po, client.NewGVR("v1/pods"))
+	assert.Nil(t, err)
+	assert.Equal(t, "v1", u.GetAPIVersion())
+	assert.Equal(t, "Pod", u.GetKind())
+	assert.Equal(t, "ns1", u.GetNamespace())
+	assert.Equal(t, "fred", u.GetName())
+}
+
+func TestToUnstructuredList(t *testing.T) {
+	remain := int64(10)
+	ll := v1.PodList{
+		ListMeta: metav1.ListMeta{ResourceVersion: "42", Continue: "next", RemainingItemCount: &remain},
+		Items: []v1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "fred"}},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "ns2", Name: "blee"}},
+		},
+	}
+
+	uu, err := toUnstructuredList(&ll, client.NewGVR("v1/pods"))
+	assert.Nil(t, err)
+	assert.Equal(t, "42", uu.GetResourceVersion())
+	assert.Equal(t, "next", uu.GetContinue())
+	assert.Equal(t, &remain, uu.GetRemainingItemCount())
+	assert.Equal(t, 2, len(uu.Items))
+	assert.Equal(t, "Pod", uu.Items[1].GetKind())
+	assert.Equal(t, "blee", uu.Items[1].GetName())
+}
+
+func TestFactoryTerminateProtos(t *testing.T) {
+	f := NewFactory(nil)
+	f.protos["v1"] = &rest.RESTClient{}
+	f.Terminate()
+
+	assert.Equal(t, 0, len(f.protos))
+}
+
+// BenchmarkListDecode compares decoding a pod list served as json into
+// unstructured objects, as dynamic informers do, with decoding the same list
+// served as protobuf and converting it.
+func BenchmarkListDecode(b *testing.B) {
+	ll := v1.PodList{Items: make([]v1.Pod, 100)}
+	for i := range ll.Items {
+		ll.Items[i] = v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "ns1",
+				Name:      fmt.Sprintf("fred-%d", i),
+				Labels:    map[string]string{"app": "fred"},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{Name: "c1", Image: "fred:0.0.1"}},
+			},
+		}
+	}
+	gvr := client.NewGVR("v1/pods")
+
+	b.Run("json", func(b *testing.B) {
+		raw := encodeList(b, &ll, runtime.ContentTypeJSON)
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, _, err := unstructured.UnstructuredJSONScheme.Decode(raw, nil, nil); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("protobuf", func(b *testing.B) {
+		raw := encodeList(b, &ll, client.ProtobufContentType)
+		dec := scheme.Codecs.UniversalDeserializer()
+		b.ResetTimer()
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			o, _, err := dec.Decode(raw, nil, nil)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err := toUnstructuredList(o, gvr); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+// Helpers...
+
+func encodeList(b *testing.B, o runtime.Object, mediaType string) []byte {
+	info, ok := runtime.SerializerInfoForMediaType(scheme.Codecs.SupportedMediaTypes(), mediaType)
+	if !ok {
+		b.Fatalf("no serializer for %s", mediaType)
+	}
+	raw, err := runtime.Encode(scheme.Codecs.EncoderForVersion(info.Serializer, v1.SchemeGroupVersion), o)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return raw
+}
@@ -0,0 +1,60 @@
+package watch
+
+import "sort"
+
+// ObjectRef references a cached resource.
+type ObjectRef struct {
+	GVR  string
+	Path string
+}
+
+// CachedRefs lists the resources held by the running informers across all
+// resources and namespaces. Only the cache keys are read, objects are not copied.
+func (f *Factory) CachedRefs() []ObjectRef {
+	f.mx.RLock()
+	defer f.mx.RUnlock()
+
+	refs := newRefSet()
+	for _, ii := range f.informers {
+		for key, inf := range ii {
+			if inf.GenericInformer == nil || !inf.running() {
+				continue
+			}
+			refs.add(keyGVR(key), inf.Informer().GetStore().ListKeys())
+		}
+	}
+
+	return refs.list()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// refSet dedups references as informers may overlap, ie all namespaces vs a namespace.
+type refSet map[ObjectRef]struct{}
+
+func newRefSet() refSet {
+	return make(refSet)
+}
+
+func (s refSet) add(gvr string, paths []string) {
+	for _, p := range paths {
+		s[ObjectRef{GVR: gvr, Path: p}] = struct{}{}
+	}
+}
+
+// list returns the references sorted by resource and path.
+func (s refSet) list() []ObjectRef {
+	rr := make([]ObjectRef, 0, len(s))
+	for r := range s {
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		if rr[i].GVR != rr[j].GVR {
+			return rr[i].GVR < rr[j].GVR
+		}
+		return rr[i].Path < rr[j].Path
+	})
+
+	return rr
+}
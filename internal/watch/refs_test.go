@@ -0,0 +1,28 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefSet(t *testing.T) {
+	s := newRefSet()
+	s.add("v1/pods", []string{"ns1/p2", "ns1/p1"})
+	s.add("v1/pods", []string{"ns1/p1"})
+	s.add("apps/v1/deployments", []string{"ns1/d1"})
+	s.add("v1/nodes", nil)
+
+	assert.Equal(t, []ObjectRef{
+		{GVR: "apps/v1/deployments", Path: "ns1/d1"},
+		{GVR: "v1/pods", Path: "ns1/p1"},
+		{GVR: "v1/pods", Path: "ns1/p2"},
+	}, s.list())
+}
+
+func TestFactoryCachedRefsIdle(t *testing.T) {
+	f := NewFactory(nil)
+	f.informers["ns1"] = map[string]*informer{"v1/pods": newInformer(nil)}
+
+	assert.Equal(t, []ObjectRef{}, f.CachedRefs())
+}
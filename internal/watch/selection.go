@@ -0,0 +1,61 @@
+package watch
+
+import (
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const selectionSep = "?"
+
+// selection represents label and field selectors evaluated by the api server.
+type selection struct {
+	labels, fields string
+}
+
+// newSelection returns a selection in canonical form so equivalent
+// selectors share the same informers.
+func newSelection(lsel, fsel string) selection {
+	if sel, err := labels.Parse(lsel); err == nil {
+		lsel = sel.String()
+	}
+	if sel, err := fields.ParseSelector(fsel); err == nil {
+		fsel = sel.String()
+	}
+
+	return selection{labels: lsel, fields: fsel}
+}
+
+func (s selection) empty() bool {
+	return s.labels == "" && s.fields == ""
+}
+
+// key returns a resource key for the selection.
+func (s selection) key(gvr string) string {
+	if s.empty() {
+		return gvr
+	}
+
+	return gvr + selectionSep + s.labels + selectionSep + s.fields
+}
+
+// apply sets the selection on list options.
+func (s selection) apply(opts *metav1.ListOptions) {
+	if s.labels != "" {
+		opts.LabelSelector = s.labels
+	}
+	if s.fields != "" {
+		opts.FieldSelector = s.fields
+	}
+}
+
+// keyGVR returns the resource of a selection key.
+func keyGVR(key string) string {
+	if i := strings.Index(key, selectionSep); i >= 0 {
+		return key[:i]
+	}
+
+	return key
+}
@@ -0,0 +1,36 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSelectionKey(t *testing.T) {
+	uu := map[string]struct {
+		lsel, fsel, e string
+	}{
+		"none":   {e: "v1/pods"},
+		"labels": {lsel: "env=prod,app=fred", e: "v1/pods?app=fred,env=prod?"},
+		"fields": {fsel: "status.phase=Running", e: "v1/pods??status.phase=Running"},
+		"both":   {lsel: "app=fred", fsel: "spec.nodeName=n1", e: "v1/pods?app=fred?spec.nodeName=n1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			key := newSelection(u.lsel, u.fsel).key("v1/pods")
+			assert.Equal(t, u.e, key)
+			assert.Equal(t, "v1/pods", keyGVR(key))
+		})
+	}
+}
+
+func TestSelectionApply(t *testing.T) {
+	var opts metav1.ListOptions
+	newSelection("app=fred", "status.phase=Running").apply(&opts)
+
+	assert.Equal(t, "app=fred", opts.LabelSelector)
+	assert.Equal(t, "status.phase=Running", opts.FieldSelector)
+}
@@ -0,0 +1,372 @@
+package watch
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DefaultTimelineRevisions represents the number of revisions kept per object.
+const DefaultTimelineRevisions = 50
+
+// maxTimelineObjects represents the number of objects whose revisions are
+// kept in memory. Least recently used objects are evicted past this limit.
+const maxTimelineObjects = 100
+
+// Revision operations.
+const (
+	// RevisionInitial represents the state of an object prior to its first recorded change.
+	RevisionInitial = "INITIAL"
+	// RevisionUpdated represents a modified object.
+	RevisionUpdated = "UPDATED"
+	// RevisionDeleted represents a deleted object.
+	RevisionDeleted = "DELETED"
+)
+
+const (
+	secretGVR          = "v1/secrets"
+	secretDigestPrefix = "hmac:"
+)
+
+// Revision represents a recorded state of an object.
+type Revision struct {
+	Version string                 `json:"version"`
+	Op      string                 `json:"op"`
+	Time    time.Time              `json:"time"`
+	Object  map[string]interface{} `json:"object,omitempty"`
+}
+
+// Timeline records the changes of watched objects.
+type Timeline struct {
+	watched  map[string]int
+	revs     map[string][]Revision
+	lru      []string
+	pending  map[string][]Revision
+	saving   bool
+	flushing sync.WaitGroup
+	key      []byte
+	max      int
+	dir      string
+	mx       sync.RWMutex
+}
+
+// NewTimeline returns a new timeline.
+func NewTimeline() *Timeline {
+	return &Timeline{
+		watched: make(map[string]int),
+		revs:    make(map[string][]Revision),
+		pending: make(map[string][]Revision),
+		key:     sessionKey(),
+		max:     DefaultTimelineRevisions,
+	}
+}
+
+// Configure sets the number of revisions kept per object and the directory
+// revisions are persisted to. An empty directory disables persistence.
+// Revisions recorded so far are dropped since they may belong to another
+// cluster.
+func (t *Timeline) Configure(max int, dir string) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	if max <= 0 {
+		max = DefaultTimelineRevisions
+	}
+	t.max, t.dir = max, dir
+	t.revs, t.lru = make(map[string][]Revision), nil
+}
+
+// Watch starts recording the changes of an object. Calls are reference
+// counted so an object is recorded as long as one of its views is open.
+func (t *Timeline) Watch(gvr, path string) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	t.watched[timelineKey(gvr, path)]++
+}
+
+// Unwatch stops recording the changes of an object.
+func (t *Timeline) Unwatch(gvr, path string) {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	k := timelineKey(gvr, path)
+	if t.watched[k] <= 1 {
+		delete(t.watched, k)
+		return
+	}
+	t.watched[k]--
+}
+
+// IsWatched checks if an object changes are being recorded.
+func (t *Timeline) IsWatched(gvr, path string) bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.watched[timelineKey(gvr, path)] > 0
+}
+
+// Revisions returns the recorded revisions of an object, oldest first.
+func (t *Timeline) Revisions(gvr, path string) []Revision {
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	k := timelineKey(gvr, path)
+	rr, ok := t.revs[k]
+	if !ok && t.dir != "" {
+		rr = t.load(gvr, path)
+	}
+	t.store(k, rr)
+
+	return append([]Revision(nil), rr...)
+}
+
+// Handler returns an informer event handler recording a resource changes.
+func (t *Timeline) Handler(gvr string) cache.ResourceEventHandler {
+	return timelineRecorder{gvr: gvr, timeline: t}
+}
+
+func (t *Timeline) record(gvr string, old, o interface{}, op string) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	path := client.FQN(u.GetNamespace(), u.GetName())
+	if !t.IsWatched(gvr, path) {
+		return
+	}
+
+	t.mx.Lock()
+	defer t.mx.Unlock()
+
+	k := timelineKey(gvr, path)
+	rr, ok := t.revs[k]
+	if !ok && t.dir != "" {
+		rr = t.load(gvr, path)
+	}
+	if n := len(rr); n > 0 && rr[n-1].Version == u.GetResourceVersion() && rr[n-1].Op == op {
+		return
+	}
+	rr = append([]Revision(nil), rr...)
+	if ou, ok := old.(*unstructured.Unstructured); ok && len(rr) == 0 {
+		rr = append(rr, t.newRevision(gvr, ou, RevisionInitial))
+	}
+	rr = append(rr, t.newRevision(gvr, u, op))
+	if len(rr) > t.max {
+		rr = rr[len(rr)-t.max:]
+	}
+	t.store(k, rr)
+	if t.dir != "" {
+		t.persist(timelineFile(t.dir, gvr, path), rr)
+	}
+}
+
+// store caches an object revisions, evicting the least recently used objects
+// that are no longer watched past the objects limit.
+func (t *Timeline) store(k string, rr []Revision) {
+	t.revs[k] = rr
+	for i, key := range t.lru {
+		if key == k {
+			t.lru = append(t.lru[:i], t.lru[i+1:]...)
+			break
+		}
+	}
+	t.lru = append(t.lru, k)
+
+	for i := 0; len(t.revs) > maxTimelineObjects && i < len(t.lru); {
+		key := t.lru[i]
+		if t.watched[key] > 0 {
+			i++
+			continue
+		}
+		delete(t.revs, key)
+		t.lru = append(t.lru[:i], t.lru[i+1:]...)
+	}
+}
+
+// persist queues an object revisions to be saved. Saves happen off the
+// recording path, only the latest revisions of an object are written.
+func (t *Timeline) persist(f string, rr []Revision) {
+	t.pending[f] = rr
+	if t.saving {
+		return
+	}
+	t.saving = true
+	t.flushing.Add(1)
+	go t.flush()
+}
+
+func (t *Timeline) flush() {
+	defer t.flushing.Done()
+
+	for {
+		t.mx.Lock()
+		var (
+			f  string
+			rr []Revision
+		)
+		for f, rr = range t.pending {
+			break
+		}
+		if f == "" {
+			t.saving = false
+			t.mx.Unlock()
+			return
+		}
+		delete(t.pending, f)
+		t.mx.Unlock()
+
+		save(f, rr)
+	}
+}
+
+func (t *Timeline) load(gvr, path string) []Revision {
+	f := timelineFile(t.dir, gvr, path)
+	if rr, ok := t.pending[f]; ok {
+		return rr
+	}
+	raw, err := ioutil.ReadFile(f)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Warn().Err(err).Msgf("Unable to load timeline %s %s", gvr, path)
+		}
+		return nil
+	}
+	var rr []Revision
+	if err := json.Unmarshal(raw, &rr); err != nil {
+		log.Warn().Err(err).Msgf("Invalid timeline %s %s", gvr, path)
+		return nil
+	}
+	if gvr == secretGVR {
+		for _, r := range rr {
+			t.redactSecret(r.Object)
+		}
+	}
+
+	return rr
+}
+
+func save(f string, rr []Revision) {
+	raw, err := json.Marshal(rr)
+	if err == nil {
+		if err = os.MkdirAll(filepath.Dir(f), 0700); err == nil {
+			err = ioutil.WriteFile(f, raw, 0600)
+		}
+	}
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to save timeline %s", f)
+	}
+}
+
+func timelineFile(dir, gvr, path string) string {
+	return filepath.Join(dir, timelineName(gvr), timelineName(path)+".json")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// timelineRecorder records a resource watch modifications.
+type timelineRecorder struct {
+	gvr      string
+	timeline *Timeline
+}
+
+var _ cache.ResourceEventHandler = timelineRecorder{}
+
+// OnAdd notifies an object was added.
+func (timelineRecorder) OnAdd(interface{}) {}
+
+// OnUpdate notifies an object changed.
+func (r timelineRecorder) OnUpdate(old, o interface{}) {
+	r.timeline.record(r.gvr, old, o, RevisionUpdated)
+}
+
+// OnDelete notifies an object was deleted.
+func (r timelineRecorder) OnDelete(o interface{}) {
+	if t, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = t.Obj
+	}
+	r.timeline.record(r.gvr, nil, o, RevisionDeleted)
+}
+
+// newRevision snapshots an object, dropping the fields changing on every
+// update. Secret values are never recorded.
+func (t *Timeline) newRevision(gvr string, u *unstructured.Unstructured, op string) Revision {
+	m := runtime.DeepCopyJSON(u.Object)
+	unstructured.RemoveNestedField(m, "metadata", "managedFields")
+	unstructured.RemoveNestedField(m, "metadata", "resourceVersion")
+	if gvr == secretGVR {
+		t.redactSecret(m)
+	}
+
+	return Revision{
+		Version: u.GetResourceVersion(),
+		Op:      op,
+		Time:    time.Now(),
+		Object:  m,
+	}
+}
+
+// redactSecret replaces a secret values with keyed digests so changes can
+// still be told apart without keeping the values around. Digests are keyed
+// per session so they can't be brute forced offline.
+func (t *Timeline) redactSecret(m map[string]interface{}) {
+	for _, k := range []string{"data", "stringData"} {
+		dd, ok := m[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for kk, v := range dd {
+			dd[kk] = secretDigest(t.key, v)
+		}
+	}
+	aa, ok, _ := unstructured.NestedMap(m, "metadata", "annotations")
+	if v, found := aa[lastAppliedAnnotation]; ok && found {
+		aa[lastAppliedAnnotation] = secretDigest(t.key, v)
+		_ = unstructured.SetNestedMap(m, aa, "metadata", "annotations")
+	}
+}
+
+func secretDigest(key []byte, v interface{}) string {
+	s := fmt.Sprintf("%v", v)
+	if strings.HasPrefix(s, secretDigestPrefix) {
+		return s
+	}
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(s))
+
+	return secretDigestPrefix + hex.EncodeToString(mac.Sum(nil))[:12]
+}
+
+// sessionKey returns a random key used to digest secrets for this session.
+func sessionKey() []byte {
+	k := make([]byte, 32)
+	if _, err := rand.Read(k); err != nil {
+		log.Warn().Err(err).Msg("Unable to generate timeline session key")
+	}
+
+	return k
+}
+
+func timelineKey(gvr, path string) string {
+	return gvr + ":" + path
+}
+
+func timelineName(s string) string {
+	return strings.Replace(s, "/", "_", -1)
+}
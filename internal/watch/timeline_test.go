@@ -0,0 +1,173 @@
+package watch
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestTimelineWatch(t *testing.T) {
+	tl := NewTimeline()
+	tl.Watch("v1/pods", "ns1/fred")
+	tl.Watch("v1/pods", "ns1/fred")
+	tl.Unwatch("v1/pods", "ns1/fred")
+	assert.True(t, tl.IsWatched("v1/pods", "ns1/fred"))
+	assert.False(t, tl.IsWatched("v1/pods", "ns1/blee"))
+
+	tl.Unwatch("v1/pods", "ns1/fred")
+	tl.Unwatch("v1/pods", "ns1/fred")
+	assert.False(t, tl.IsWatched("v1/pods", "ns1/fred"))
+}
+
+func TestTimelineRecord(t *testing.T) {
+	tl := NewTimeline()
+	h := tl.Handler("v1/configmaps")
+
+	h.OnUpdate(makeTimelineCM("1", "a"), makeTimelineCM("2", "b"))
+	assert.Equal(t, 0, len(tl.Revisions("v1/configmaps", "ns1/fred")))
+
+	tl.Watch("v1/configmaps", "ns1/blee")
+	h.OnUpdate(makeTimelineCM("1", "a"), makeTimelineCM("2", "b"))
+	assert.Equal(t, 0, len(tl.Revisions("v1/configmaps", "ns1/fred")))
+
+	tl.Watch("v1/configmaps", "ns1/fred")
+	h.OnUpdate(makeTimelineCM("1", "a"), makeTimelineCM("2", "b"))
+	h.OnUpdate(makeTimelineCM("1", "a"), makeTimelineCM("2", "b"))
+	h.OnUpdate(makeTimelineCM("2", "b"), makeTimelineCM("3", "c"))
+	h.OnDelete(cache.DeletedFinalStateUnknown{Obj: makeTimelineCM("3", "c")})
+
+	rr := tl.Revisions("v1/configmaps", "ns1/fred")
+	assert.Equal(t, 4, len(rr))
+	for i, e := range []struct{ version, op string }{
+		{"1", RevisionInitial},
+		{"2", RevisionUpdated},
+		{"3", RevisionUpdated},
+		{"3", RevisionDeleted},
+	} {
+		assert.Equal(t, e.version, rr[i].Version)
+		assert.Equal(t, e.op, rr[i].Op)
+	}
+	_, ok, _ := unstructured.NestedFieldNoCopy(rr[0].Object, "metadata", "resourceVersion")
+	assert.False(t, ok)
+}
+
+func TestTimelineMaxRevisions(t *testing.T) {
+	tl := NewTimeline()
+	tl.Configure(2, "")
+	tl.Watch("v1/configmaps", "ns1/fred")
+	h := tl.Handler("v1/configmaps")
+	h.OnUpdate(makeTimelineCM("1", "a"), makeTimelineCM("2", "b"))
+	h.OnUpdate(makeTimelineCM("2", "b"), makeTimelineCM("3", "c"))
+
+	rr := tl.Revisions("v1/configmaps", "ns1/fred")
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, "2", rr[0].Version)
+	assert.Equal(t, "3", rr[1].Version)
+}
+
+func TestTimelinePersist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-timeline")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	tl := NewTimeline()
+	tl.Configure(10, dir)
+	tl.Watch("v1/configmaps", "ns1/fred")
+	tl.Handler("v1/configmaps").OnUpdate(makeTimelineCM("1", "a"), makeTimelineCM("2", "b"))
+	tl.flushing.Wait()
+
+	tl = NewTimeline()
+	tl.Configure(10, dir)
+	rr := tl.Revisions("v1/configmaps", "ns1/fred")
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, "b", rr[1].Object["data"].(map[string]interface{})["key"])
+}
+
+func TestTimelineRecordSecret(t *testing.T) {
+	dir, err := ioutil.TempDir("", "k9s-timeline")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	tl := NewTimeline()
+	tl.Configure(10, dir)
+	tl.Watch("v1/secrets", "ns1/fred")
+	tl.Handler("v1/secrets").OnUpdate(makeTimelineSecret("1", "s3cr3t"), makeTimelineSecret("2", "t0ps3cr3t"))
+
+	rr := tl.Revisions("v1/secrets", "ns1/fred")
+	assert.Equal(t, 2, len(rr))
+	v1, v2 := rr[0].Object["data"].(map[string]interface{})["password"], rr[1].Object["data"].(map[string]interface{})["password"]
+	assert.Contains(t, v1, "hmac:")
+	assert.NotEqual(t, v1, v2)
+	aa, _, _ := unstructured.NestedStringMap(rr[1].Object, "metadata", "annotations")
+	assert.Contains(t, aa[lastAppliedAnnotation], "hmac:")
+	assert.NotEqual(t, v1, secretDigest(sessionKey(), "s3cr3t"))
+
+	tl.flushing.Wait()
+	ff, err := filepath.Glob(filepath.Join(dir, "*", "*.json"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(ff))
+	raw, err := ioutil.ReadFile(ff[0])
+	assert.Nil(t, err)
+	assert.NotContains(t, string(raw), "s3cr3t")
+}
+
+func TestTimelineEvict(t *testing.T) {
+	tl := NewTimeline()
+	tl.Watch("v1/configmaps", "ns1/fred")
+	tl.Handler("v1/configmaps").OnUpdate(makeTimelineCM("1", "a"), makeTimelineCM("2", "b"))
+	for i := 0; i < maxTimelineObjects; i++ {
+		tl.Revisions("v1/configmaps", fmt.Sprintf("ns1/cm%d", i))
+	}
+	tl.Revisions("v1/configmaps", "ns1/cm0")
+
+	assert.Equal(t, maxTimelineObjects, len(tl.revs))
+	assert.Equal(t, 2, len(tl.revs["v1/configmaps:ns1/fred"]))
+	_, ok := tl.revs["v1/configmaps:ns1/cm0"]
+	assert.True(t, ok)
+	_, ok = tl.revs["v1/configmaps:ns1/cm1"]
+	assert.False(t, ok)
+}
+
+// Helpers...
+
+func makeTimelineSecret(version, value string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":            "fred",
+			"namespace":       "ns1",
+			"resourceVersion": version,
+			"annotations": map[string]interface{}{
+				lastAppliedAnnotation: `{"stringData":{"password":"` + value + `"}}`,
+			},
+		},
+		"stringData": map[string]interface{}{
+			"password": value,
+		},
+		"data": map[string]interface{}{
+			"password": value,
+		},
+	}}
+}
+
+func makeTimelineCM(version, value string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "fred",
+			"namespace":       "ns1",
+			"resourceVersion": version,
+		},
+		"data": map[string]interface{}{
+			"key": value,
+		},
+	}}
+}